@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -29,10 +30,21 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryValidatorOutstandingRewards(),
 		GetCmdQueryValidatorCommission(),
+		GetCmdQueryValidatorRewardRate(),
+		GetCmdQueryValidatorHistoricalRewards(),
+		GetCmdQueryValidatorCurrentRewards(),
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
+		GetCmdQueryDelegatorStartingInfo(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryLiquidStakingFeesCollected(),
+		GetCmdQueryCommunityPoolStreams(),
+		GetCmdQueryCommunityPoolStream(),
 		GetCmdQueryTokenizeShareRecordReward(),
+		GetCmdQueryTokenizeShareRecordRewardById(),
+		GetCmdQueryValidatorTokenizeShareRecordRewards(),
+		GetCmdQueryDelegatorAutoRestakes(),
+		GetCmdQueryValidatorCommissionRecipients(),
 	)
 
 	return distQueryCmd
@@ -322,6 +334,131 @@ $ %s query distribution community-pool
 	return cmd
 }
 
+// GetCmdQueryLiquidStakingFeesCollected returns the command for fetching the
+// cumulative liquid staking reward fees.
+func GetCmdQueryLiquidStakingFeesCollected() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquid-staking-fees",
+		Args:  cobra.NoArgs,
+		Short: "Query the liquid staking reward fees sent to the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative liquid staking reward fees skimmed from the rewards
+withdrawn by liquid staking providers and tokenize share records and sent to the community pool.
+
+Example:
+$ %s query distribution liquid-staking-fees
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LiquidStakingFeesCollected(cmd.Context(), &types.QueryLiquidStakingFeesCollectedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCommunityPoolStreams implements the query community pool streams command.
+func GetCmdQueryCommunityPoolStreams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-streams",
+		Args:  cobra.NoArgs,
+		Short: "Query the active community pool streams",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the community pool streams created by governance that are not fully paid yet.
+
+Example:
+$ %s query distribution community-pool-streams
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CommunityPoolStreams(
+				cmd.Context(),
+				&types.QueryCommunityPoolStreamsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "community pool streams")
+	return cmd
+}
+
+// GetCmdQueryCommunityPoolStream implements the query community pool stream command.
+func GetCmdQueryCommunityPoolStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a community pool stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a community pool stream by id, including the amount already paid
+and the time of its next payment.
+
+Example:
+$ %s query distribution community-pool-stream 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s not a valid uint, please input a valid stream-id", args[0])
+			}
+
+			res, err := queryClient.CommunityPoolStream(
+				cmd.Context(),
+				&types.QueryCommunityPoolStreamRequest{StreamId: streamID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryTokenizeShareRecordReward implements the query tokenize share record rewards
 func GetCmdQueryTokenizeShareRecordReward() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -366,3 +503,406 @@ $ %s query distribution tokenize-share-record-rewards %s1gghjut3ccd8ay0zduzj64hw
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryTokenizeShareRecordRewardById implements the query tokenize share record reward by id command.
+func GetCmdQueryTokenizeShareRecordRewardById() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-reward [record-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the rewards of a tokenize share record",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards accrued by a tokenize share record, including rewards
+not yet withdrawn from the validator.
+
+Example:
+$ %s query distribution tokenize-share-record-reward 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("record-id %s not a valid uint, please input a valid record-id", args[0])
+			}
+
+			res, err := queryClient.TokenizeShareRecordRewardById(
+				cmd.Context(),
+				&types.QueryTokenizeShareRecordRewardByIdRequest{RecordId: recordID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryValidatorTokenizeShareRecordRewards implements the query validator tokenize share record rewards command.
+func GetCmdQueryValidatorTokenizeShareRecordRewards() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-tokenize-share-record-rewards [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the rewards of all tokenize share records of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards accrued by the tokenize share records of a validator.
+
+Example:
+$ %s query distribution validator-tokenize-share-record-rewards %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorTokenizeShareRecordRewards(
+				cmd.Context(),
+				&types.QueryValidatorTokenizeShareRecordRewardsRequest{
+					ValidatorAddress: validatorAddr.String(),
+					Pagination:       pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator tokenize share record rewards")
+	return cmd
+}
+
+// GetCmdQueryDelegatorAutoRestakes implements the query delegator auto restakes command.
+func GetCmdQueryDelegatorAutoRestakes() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-restakes [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validators a delegator auto restakes rewards with",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators of the delegations of a delegator with auto restake enabled.
+
+Example:
+$ %s query distribution auto-restakes %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorAutoRestakes(
+				cmd.Context(),
+				&types.QueryDelegatorAutoRestakesRequest{DelegatorAddress: delAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryValidatorCommissionRecipients implements the query validator commission recipients command.
+func GetCmdQueryValidatorCommissionRecipients() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "commission-recipients [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the recipients the commission of a validator is split across",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the weighted list of recipients the commission of a validator is split across.
+An empty list means the commission is sent to the withdraw address of the operator.
+
+Example:
+$ %s query distribution commission-recipients %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorCommissionRecipients(
+				cmd.Context(),
+				&types.QueryValidatorCommissionRecipientsRequest{ValidatorAddress: valAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryValidatorRewardRate implements the query validator reward rate command.
+func GetCmdQueryValidatorRewardRate() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "reward-rate [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the estimated annualized reward rate of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the estimated annualized rewards per token delegated to a validator,
+net of commission, measured over a trailing window of block time.
+
+Example:
+$ %s query distribution reward-rate %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --window 24h
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			window, err := cmd.Flags().GetDuration(FlagWindow)
+			if err != nil {
+				return err
+			}
+			if window < time.Second {
+				return fmt.Errorf("window must be at least one second")
+			}
+
+			res, err := queryClient.ValidatorRewardRate(
+				cmd.Context(),
+				&types.QueryValidatorRewardRateRequest{
+					ValidatorAddress: validatorAddr.String(),
+					WindowSeconds:    uint64(window / time.Second),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagWindow, types.DefaultRewardRateWindow, "Trailing window the reward rate is measured over")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryValidatorHistoricalRewards implements the query validator historical rewards command.
+func GetCmdQueryValidatorHistoricalRewards() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "historical-rewards [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the historical rewards periods of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the historical rewards periods of a validator, with the cumulative reward
+ratio, the reference count and the end time of each period.
+
+Example:
+$ %s query distribution historical-rewards %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorHistoricalRewards(
+				cmd.Context(),
+				&types.QueryValidatorHistoricalRewardsRequest{
+					ValidatorAddress: validatorAddr.String(),
+					Pagination:       pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator historical rewards")
+	return cmd
+}
+
+// GetCmdQueryValidatorCurrentRewards implements the query validator current rewards command.
+func GetCmdQueryValidatorCurrentRewards() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "current-rewards [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the current rewards period of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current rewards period of a validator and the rewards accumulated in it.
+
+Example:
+$ %s query distribution current-rewards %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorCurrentRewards(
+				cmd.Context(),
+				&types.QueryValidatorCurrentRewardsRequest{ValidatorAddress: validatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDelegatorStartingInfo implements the query delegator starting info command.
+func GetCmdQueryDelegatorStartingInfo() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "starting-info [delegator-addr] [validator-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the starting info of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the starting info of a delegation: the period its rewards accrue from,
+its stake and the height it was created at. The starting info of a tokenize share
+record is queried with the module account of the record as delegator.
+
+Example:
+$ %s query distribution starting-info %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorStartingInfo(
+				cmd.Context(),
+				&types.QueryDelegatorStartingInfoRequest{
+					DelegatorAddress: delegatorAddr.String(),
+					ValidatorAddress: validatorAddr.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -21,6 +22,10 @@ import (
 var (
 	FlagCommission       = "commission"
 	FlagMaxMessagesPerTx = "max-msgs"
+	FlagRecordIDs        = "record-ids"
+	FlagStartRecordID    = "start-record-id"
+	FlagLimit            = "limit"
+	FlagWindow           = "window"
 )
 
 const (
@@ -44,6 +49,9 @@ func NewTxCmd() *cobra.Command {
 		NewFundCommunityPoolCmd(),
 		NewWithdrawTokenizeShareRecordRewardCmd(),
 		NewWithdrawAllTokenizeShareRecordRewardCmd(),
+		NewSweepTokenizeShareRecordCmd(),
+		NewSetAutoRestakeCmd(),
+		NewSetCommissionRecipientsCmd(),
 	)
 
 	return distTxCmd
@@ -332,6 +340,149 @@ Where proposal.json contains:
 	return cmd
 }
 
+// GetCmdSubmitCommunityPoolStreamProposal implements the command to submit a community-pool-stream proposal
+func GetCmdSubmitCommunityPoolStreamProposal() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "community-pool-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool stream proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool stream proposal along with an initial deposit.
+The amount is paid from the community pool to the recipient in equal installments,
+one every cadence between the start time and the end time.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Stream",
+  "description": "Pay me some Atoms every day!",
+  "recipient": "%s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": "30000stake",
+  "start_time": "2023-01-01T00:00:00Z",
+  "end_time": "2023-01-31T00:00:00Z",
+  "cadence": "24h",
+  "deposit": "1000stake"
+}
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseCommunityPoolStreamProposalWithDeposit(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(proposal.Amount)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := time.Parse(time.RFC3339, proposal.StartTime)
+			if err != nil {
+				return fmt.Errorf("invalid start time: %w", err)
+			}
+
+			endTime, err := time.Parse(time.RFC3339, proposal.EndTime)
+			if err != nil {
+				return fmt.Errorf("invalid end time: %w", err)
+			}
+
+			cadence, err := time.ParseDuration(proposal.Cadence)
+			if err != nil {
+				return fmt.Errorf("invalid cadence: %w", err)
+			}
+
+			from := clientCtx.GetFromAddress()
+			recpAddr, err := sdk.AccAddressFromBech32(proposal.Recipient)
+			if err != nil {
+				return err
+			}
+			content := types.NewCommunityPoolStreamProposal(proposal.Title, proposal.Description, recpAddr, amount, startTime, endTime, cadence)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelCommunityPoolStreamProposal implements the command to submit a cancel-community-pool-stream proposal
+func GetCmdSubmitCancelCommunityPoolStreamProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-community-pool-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a community pool stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel a community pool stream along with an initial deposit.
+The installments not paid yet stay in the community pool.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal cancel-community-pool-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Cancel Community Pool Stream",
+  "description": "Stop paying stream 1",
+  "stream_id": "1",
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseCancelCommunityPoolStreamProposalWithDeposit(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewCancelCommunityPoolStreamProposal(proposal.Title, proposal.Description, proposal.StreamId)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
 // WithdrawAllTokenizeShareRecordReward defines a method to withdraw reward for all owning TokenizeShareRecord
 func NewWithdrawAllTokenizeShareRecordRewardCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -341,10 +492,17 @@ func NewWithdrawAllTokenizeShareRecordRewardCmd() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Withdraw reward for all owned TokenizeShareRecord
 
+At most %d records are withdrawn per transaction. Either the records to withdraw
+can be given with --%s, or the owned records can be walked with --%s and --%s,
+continuing from the next_record_id of the previous response.
+
 Example:
-$ %s tx distribution withdraw-tokenize-share-rewards --from mykey
+$ %s tx distribution withdraw-all-tokenize-share-rewards --from mykey
+$ %s tx distribution withdraw-all-tokenize-share-rewards --%s=1,4,7 --from mykey
+$ %s tx distribution withdraw-all-tokenize-share-rewards --%s=101 --%s=50 --from mykey
 `,
-				version.AppName,
+				types.MaxTokenizeShareRecordWithdrawals, FlagRecordIDs, FlagStartRecordID, FlagLimit,
+				version.AppName, version.AppName, FlagRecordIDs, version.AppName, FlagStartRecordID, FlagLimit,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -353,12 +511,36 @@ $ %s tx distribution withdraw-tokenize-share-rewards --from mykey
 				return err
 			}
 
-			msg := types.NewMsgWithdrawAllTokenizeShareRecordReward(clientCtx.GetFromAddress())
+			recordIDs, err := cmd.Flags().GetUintSlice(FlagRecordIDs)
+			if err != nil {
+				return err
+			}
+			startRecordID, err := cmd.Flags().GetUint64(FlagStartRecordID)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint64(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			ids := make([]uint64, len(recordIDs))
+			for i, id := range recordIDs {
+				ids[i] = uint64(id)
+			}
+
+			msg := types.NewMsgWithdrawAllTokenizeShareRecordReward(clientCtx.GetFromAddress(), ids, startRecordID, limit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().UintSlice(FlagRecordIDs, nil, "Comma separated ids of the records to withdraw the rewards of")
+	cmd.Flags().Uint64(FlagStartRecordID, 0, "Id of the first owned record to withdraw the rewards of")
+	cmd.Flags().Uint64(FlagLimit, 0, fmt.Sprintf("Maximum number of records to withdraw the rewards of (0 for %d)", types.MaxTokenizeShareRecordWithdrawals))
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -400,3 +582,136 @@ $ %s tx distribution withdraw-tokenize-share-rewards 1 --from mykey
 
 	return cmd
 }
+
+// NewSweepTokenizeShareRecordCmd returns a CLI command handler for recovering the
+// balance of an owned TokenizeShareRecord that is no longer delegated.
+func NewSweepTokenizeShareRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep-tokenize-share-record [record-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Recover the balance of an owned TokenizeShareRecord that is no longer delegated",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send the balance left in the module account of an owned TokenizeShareRecord to
+the owner and remove the record. Only records whose validator was removed or whose
+delegation no longer exists can be swept.
+
+Example:
+$ %s tx distribution sweep-tokenize-share-record 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSweepTokenizeShareRecord(clientCtx.GetFromAddress(), recordID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoRestakeCmd returns a CLI command handler for enabling or disabling
+// the auto restake of a delegation's rewards.
+func NewSetAutoRestakeCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-restake [validator-addr] [enabled]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Enable or disable the auto restake of the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the periodic restake of the rewards of a delegation.
+While enabled, the rewards are withdrawn and delegated back to the validator.
+Auto restake requires the withdraw address to be the delegator address.
+
+Example:
+$ %s tx distribution set-auto-restake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRestake(delAddr, valAddr, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetCommissionRecipientsCmd returns a CLI command handler for setting the
+// recipients the commission of a validator is split across.
+func NewSetCommissionRecipientsCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-commission-recipients [address:weight,...]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Set the recipients the commission of a validator is split across",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the weighted list of recipients the commission of the validator
+operated by the sender is split across. The weights must sum to 1.
+Omit the recipients to send the commission to the withdraw address of the operator again.
+
+Example:
+$ %[1]s tx distribution set-commission-recipients %[2]s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p:0.7,%[2]s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq:0.3 --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			var recipients []types.CommissionRecipient
+			if len(args) > 0 {
+				recipients, err = parseCommissionRecipients(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetCommissionRecipients(valAddr, recipients)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
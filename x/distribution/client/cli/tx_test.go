@@ -86,3 +86,22 @@ func TestParseProposal(t *testing.T) {
 	require.Equal(t, "1000stake", proposal.Deposit)
 	require.Equal(t, "1000stake", proposal.Amount)
 }
+
+func Test_parseCommissionRecipients(t *testing.T) {
+	recipients, err := parseCommissionRecipients("cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq:0.7, cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p:0.3")
+	require.NoError(t, err)
+	require.Len(t, recipients, 2)
+	require.Equal(t, "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq", recipients[0].Address)
+	require.Equal(t, sdk.NewDecWithPrec(7, 1), recipients[0].Weight)
+	require.Equal(t, "cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p", recipients[1].Address)
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), recipients[1].Weight)
+
+	_, err = parseCommissionRecipients("cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq")
+	require.Error(t, err)
+
+	_, err = parseCommissionRecipients("cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq:abc")
+	require.Error(t, err)
+
+	_, err = parseCommissionRecipients("invalid:0.5")
+	require.Error(t, err)
+}
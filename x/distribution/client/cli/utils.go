@@ -1,9 +1,12 @@
 package cli
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 )
 
@@ -22,3 +25,58 @@ func ParseCommunityPoolSpendProposalWithDeposit(cdc codec.JSONCodec, proposalFil
 
 	return proposal, nil
 }
+
+// ParseCommunityPoolStreamProposalWithDeposit reads and parses a CommunityPoolStreamProposalWithDeposit from a file.
+func ParseCommunityPoolStreamProposalWithDeposit(cdc codec.JSONCodec, proposalFile string) (types.CommunityPoolStreamProposalWithDeposit, error) {
+	proposal := types.CommunityPoolStreamProposalWithDeposit{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCancelCommunityPoolStreamProposalWithDeposit reads and parses a CancelCommunityPoolStreamProposalWithDeposit from a file.
+func ParseCancelCommunityPoolStreamProposalWithDeposit(cdc codec.JSONCodec, proposalFile string) (types.CancelCommunityPoolStreamProposalWithDeposit, error) {
+	proposal := types.CancelCommunityPoolStreamProposalWithDeposit{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// parseCommissionRecipients parses a comma separated list of address:weight pairs.
+func parseCommissionRecipients(arg string) ([]types.CommissionRecipient, error) {
+	var recipients []types.CommissionRecipient
+	for _, pair := range strings.Split(arg, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid commission recipient %q, expected address:weight", pair)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		weight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight of %s: %w", parts[0], err)
+		}
+
+		recipients = append(recipients, types.NewCommissionRecipient(addr, weight))
+	}
+	return recipients, nil
+}
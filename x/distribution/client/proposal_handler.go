@@ -1,13 +1,41 @@
 package client
 
 import (
-	"github.com/cosmos/cosmos-sdk/x/distribution/client/rest"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	distrrest "github.com/cosmos/cosmos-sdk/x/distribution/client/rest"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/client/cli"
 )
 
 // ProposalHandler is the community spend proposal handler.
 var (
 	// ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal)
-	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, distrrest.ProposalRESTHandler)
+
+	// CommunityPoolStreamProposalHandler is the community pool stream proposal handler.
+	CommunityPoolStreamProposalHandler = govclient.NewProposalHandler(
+		cli.GetCmdSubmitCommunityPoolStreamProposal, unsupportedProposalRESTHandler("community_pool_stream"),
+	)
+
+	// CancelCommunityPoolStreamProposalHandler is the community pool stream cancellation proposal handler.
+	CancelCommunityPoolStreamProposalHandler = govclient.NewProposalHandler(
+		cli.GetCmdSubmitCancelCommunityPoolStreamProposal, unsupportedProposalRESTHandler("cancel_community_pool_stream"),
+	)
 )
+
+// unsupportedProposalRESTHandler returns a legacy REST handler rejecting the proposal,
+// the proposals added by this module are only submitted through the CLI or gRPC.
+func unsupportedProposalRESTHandler(subRoute string) govclient.RESTHandlerFn {
+	return func(clientCtx client.Context) govrest.ProposalRESTHandler {
+		return govrest.ProposalRESTHandler{
+			SubRoute: subRoute,
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "legacy REST route is not supported for this proposal type")
+			},
+		}
+	}
+}
@@ -78,7 +78,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true}`,
+			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true,"restake_interval":"1000","max_restakes_per_block":100,"liquid_staking_reward_fee":"0.000000000000000000"}`,
 		},
 		{
 			"text output",
@@ -86,6 +86,9 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			`base_proposer_reward: "0.010000000000000000"
 bonus_proposer_reward: "0.040000000000000000"
 community_tax: "0.020000000000000000"
+liquid_staking_reward_fee: "0.000000000000000000"
+max_restakes_per_block: 100
+restake_interval: "1000"
 withdraw_addr_enabled: true`,
 		},
 	}
@@ -789,6 +792,30 @@ func (s *IntegrationTestSuite) TestNewWithdrawAllTokenizeShareRecordRewardCmd()
 			},
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"valid transaction of withdraw tokenize share record reward from a start record id",
+			[]string{
+				fmt.Sprintf("--%s=1", cli.FlagStartRecordID),
+				fmt.Sprintf("--%s=10", cli.FlagLimit),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"record ids combined with a limit",
+			[]string{
+				fmt.Sprintf("--%s=1,2", cli.FlagRecordIDs),
+				fmt.Sprintf("--%s=10", cli.FlagLimit),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -38,6 +38,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgWithdrawAllTokenizeShareRecordReward:
 			res, err := msgServer.WithdrawAllTokenizeShareRecordReward(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetAutoRestake:
+			res, err := msgServer.SetAutoRestake(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetCommissionRecipients:
+			res, err := msgServer.SetCommissionRecipients(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSweepTokenizeShareRecord:
+			res, err := msgServer.SweepTokenizeShareRecord(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distribution message type: %T", msg)
 		}
@@ -50,6 +59,12 @@ func NewCommunityPoolSpendProposalHandler(k keeper.Keeper) govtypes.Handler {
 		case *types.CommunityPoolSpendProposal:
 			return keeper.HandleCommunityPoolSpendProposal(ctx, k, c)
 
+		case *types.CommunityPoolStreamProposal:
+			return keeper.HandleCommunityPoolStreamProposal(ctx, k, c)
+
+		case *types.CancelCommunityPoolStreamProposal:
+			return keeper.HandleCancelCommunityPoolStreamProposal(ctx, k, c)
+
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distr proposal content type: %T", c)
 		}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+// CreateCommunityPoolStream creates a stream paying the amount of the proposal from
+// the community pool to its recipient in installments, and returns its id.
+func (k Keeper) CreateCommunityPoolStream(ctx sdk.Context, p *types.CommunityPoolStreamProposal) (uint64, error) {
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return 0, err
+	}
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", p.Recipient)
+	}
+
+	if !p.EndTime.After(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidCommunityPoolStream, "end time %s has already passed", p.EndTime)
+	}
+
+	id := k.GetLastCommunityPoolStreamID(ctx) + 1
+	stream := types.NewCommunityPoolStream(id, recipient, p.Amount, p.StartTime, p.EndTime, p.Cadence)
+	if err := stream.Validate(); err != nil {
+		return 0, err
+	}
+
+	k.SetCommunityPoolStream(ctx, stream)
+	k.SetLastCommunityPoolStreamID(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateCommunityPoolStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, stream.Amount.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// CancelCommunityPoolStream removes a stream, the installments already paid are
+// not returned and the rest of the amount stays in the community pool.
+func (k Keeper) CancelCommunityPoolStream(ctx sdk.Context, id uint64) error {
+	stream, found := k.GetCommunityPoolStream(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCommunityPoolStreamNotFound, "stream %d", id)
+	}
+
+	k.DeleteCommunityPoolStream(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelCommunityPoolStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, stream.Amount.Sub(stream.Paid).String()),
+		),
+	)
+
+	return nil
+}
+
+// ProcessCommunityPoolStreams pays the installments of the community pool streams
+// released by the block time. A payment the community pool cannot cover is retried
+// in the following blocks, and a stream is removed once it is fully paid.
+func (k Keeper) ProcessCommunityPoolStreams(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+
+	// collect the due streams first, as paying them writes to the store being iterated
+	var due []types.CommunityPoolStream
+	k.IterateCommunityPoolStreams(ctx, func(stream types.CommunityPoolStream) (stop bool) {
+		if !blockTime.Before(stream.NextPaymentTime) {
+			due = append(due, stream)
+		}
+		return false
+	})
+
+	for _, stream := range due {
+		payment := stream.ReleasedAt(blockTime).Sub(stream.Paid)
+		if !payment.IsZero() {
+			recipient := sdk.MustAccAddressFromBech32(stream.Recipient)
+
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.DistributeFromFeePool(cacheCtx, payment, recipient); err != nil {
+				k.Logger(ctx).Error("failed to pay community pool stream",
+					"stream", stream.Id, "amount", payment.String(), "err", err)
+				continue
+			}
+			write()
+
+			stream.Paid = stream.Paid.Add(payment...)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCommunityPoolStreamPayment,
+					sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(stream.Id, 10)),
+					sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, payment.String()),
+				),
+			)
+		}
+
+		if stream.Paid.IsAllGTE(stream.Amount) {
+			k.DeleteCommunityPoolStream(ctx, stream.Id)
+			continue
+		}
+
+		stream.NextPaymentTime = stream.NextPaymentTimeAfter(blockTime)
+		k.SetCommunityPoolStream(ctx, stream)
+	}
+}
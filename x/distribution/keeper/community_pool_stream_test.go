@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+func TestCommunityPoolStream(t *testing.T) {
+	app := simapp.Setup(t, false)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: start})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	recipient := addr[1]
+	stake := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amt)) }
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, stake(1000), addr[0]))
+	initBalance := app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom).Amount
+
+	// module accounts cannot be streamed to
+	moduleAddr := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	p := types.NewCommunityPoolStreamProposal("title", "desc", moduleAddr, stake(900), start, start.Add(3*time.Hour), time.Hour)
+	err := keeper.HandleCommunityPoolStreamProposal(ctx, app.DistrKeeper, p)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// 3 installments of 300, one per hour
+	p = types.NewCommunityPoolStreamProposal("title", "desc", recipient, stake(900), start, start.Add(3*time.Hour), time.Hour)
+	require.NoError(t, keeper.HandleCommunityPoolStreamProposal(ctx, app.DistrKeeper, p))
+	require.Equal(t, uint64(1), app.DistrKeeper.GetLastCommunityPoolStreamID(ctx))
+
+	stream, found := app.DistrKeeper.GetCommunityPoolStream(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, start.Add(time.Hour), stream.NextPaymentTime)
+
+	paid := func() sdk.Int {
+		return app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom).Amount.Sub(initBalance)
+	}
+
+	// nothing is paid before the first installment
+	ctx = ctx.WithBlockTime(start.Add(30 * time.Minute))
+	app.DistrKeeper.ProcessCommunityPoolStreams(ctx)
+	require.True(t, paid().IsZero())
+
+	// missed installments are paid together
+	ctx = ctx.WithBlockTime(start.Add(2*time.Hour + time.Minute))
+	app.DistrKeeper.ProcessCommunityPoolStreams(ctx)
+	require.Equal(t, sdk.NewInt(600), paid())
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 400)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	stream, found = app.DistrKeeper.GetCommunityPoolStream(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, stake(600), stream.Paid)
+	require.Equal(t, start.Add(3*time.Hour), stream.NextPaymentTime)
+
+	// the stream is removed once fully paid
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Hour))
+	app.DistrKeeper.ProcessCommunityPoolStreams(ctx)
+	require.Equal(t, sdk.NewInt(900), paid())
+	_, found = app.DistrKeeper.GetCommunityPoolStream(ctx, 1)
+	require.False(t, found)
+}
+
+func TestCommunityPoolStreamInsufficientPoolAndCancel(t *testing.T) {
+	app := simapp.Setup(t, false)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: start})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	recipient := addr[1]
+	stake := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amt)) }
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+	initBalance := app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom).Amount
+
+	p := types.NewCommunityPoolStreamProposal("title", "desc", recipient, stake(1000), start, start.Add(10*time.Hour), time.Hour)
+	require.NoError(t, keeper.HandleCommunityPoolStreamProposal(ctx, app.DistrKeeper, p))
+
+	// a payment the community pool cannot cover is retried in a later block
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	app.DistrKeeper.ProcessCommunityPoolStreams(ctx)
+	stream, found := app.DistrKeeper.GetCommunityPoolStream(ctx, 1)
+	require.True(t, found)
+	require.True(t, stream.Paid.IsZero())
+	require.Equal(t, start.Add(time.Hour), stream.NextPaymentTime)
+
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, stake(1000), addr[0]))
+	app.DistrKeeper.ProcessCommunityPoolStreams(ctx)
+	require.Equal(t, initBalance.AddRaw(100), app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom).Amount)
+
+	// cancelling the stream leaves the rest in the community pool
+	err := keeper.HandleCancelCommunityPoolStreamProposal(ctx, app.DistrKeeper, types.NewCancelCommunityPoolStreamProposal("title", "desc", 1))
+	require.NoError(t, err)
+	_, found = app.DistrKeeper.GetCommunityPoolStream(ctx, 1)
+	require.False(t, found)
+
+	ctx = ctx.WithBlockTime(start.Add(5 * time.Hour))
+	app.DistrKeeper.ProcessCommunityPoolStreams(ctx)
+	require.Equal(t, initBalance.AddRaw(100), app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 900)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	err = keeper.HandleCancelCommunityPoolStreamProposal(ctx, app.DistrKeeper, types.NewCancelCommunityPoolStreamProposal("title", "desc", 1))
+	require.ErrorIs(t, err, types.ErrCommunityPoolStreamNotFound)
+}
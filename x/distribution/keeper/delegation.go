@@ -56,16 +56,43 @@ func (k Keeper) calculateDelegationRewardsBetween(ctx sdk.Context, val sdkstakin
 
 // calculate the total rewards accrued by a delegation
 func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val sdkstaking.ValidatorI, del sdkstaking.DelegationI, endingPeriod uint64) (rewards sdk.DecCoins) {
+	rewards, _ = k.calculateDelegationRewards(ctx, val, del, endingPeriod)
+	return rewards
+}
+
+// EstimateDelegationRewards calculates the total rewards accrued by a delegation,
+// including the rewards of the validator's current period, without incrementing
+// the validator period. It does not modify state, so it is safe to use in queries.
+func (k Keeper) EstimateDelegationRewards(ctx sdk.Context, val sdkstaking.ValidatorI, del sdkstaking.DelegationI) sdk.DecCoins {
+	current := k.GetValidatorCurrentRewards(ctx, val.GetOperator())
+
+	// rewards up to the last completed period, and the stake the delegation
+	// has held since
+	rewards, stake := k.calculateDelegationRewards(ctx, val, del, current.Period-1)
+
+	// rewards of the current period, calculated as incrementing the period would
+	// for a validator with tokens; without tokens they go to the community pool
+	if val.GetTokens().IsPositive() && stake.IsPositive() {
+		ratio := current.Rewards.QuoDecTruncate(sdk.NewDecFromInt(val.GetTokens()))
+		rewards = rewards.Add(ratio.MulDecTruncate(stake)...)
+	}
+
+	return rewards
+}
+
+// calculateDelegationRewards calculates the total rewards accrued by a delegation
+// up to the ending period, and returns them with the delegation's stake in that period
+func (k Keeper) calculateDelegationRewards(ctx sdk.Context, val sdkstaking.ValidatorI, del sdkstaking.DelegationI, endingPeriod uint64) (rewards sdk.DecCoins, stake sdk.Dec) {
 	// fetch starting info for delegation
 	startingInfo := k.GetDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
 
 	if startingInfo.Height == uint64(ctx.BlockHeight()) {
 		// started this height, no rewards yet
-		return rewards
+		return rewards, sdk.ZeroDec()
 	}
 
 	startingPeriod := startingInfo.PreviousPeriod
-	stake := startingInfo.Stake
+	stake = startingInfo.Stake
 
 	// Iterate through slashes and withdraw with calculated staking for
 	// distribution periods. These period offsets are dependent on *when* slashes
@@ -135,7 +162,7 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val sdkstaking.Valid
 
 	// calculate rewards for final period
 	rewards = rewards.Add(k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake)...)
-	return rewards
+	return rewards, stake
 }
 
 func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val sdkstaking.ValidatorI, del sdkstaking.DelegationI) (sdk.Coins, error) {
@@ -166,6 +193,14 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val sdkstaking.Valida
 	// truncate coins, return remainder to community pool
 	coins, remainder := rewards.TruncateDecimal()
 
+	// skim the liquid staking reward fee from the rewards of liquid staking
+	// providers and tokenize share records, it is sent to the community pool
+	var fee sdk.Coins
+	if k.stakingKeeper.AccountIsLiquidStakingProvider(del.GetDelegatorAddr()) {
+		fee, _ = sdk.NewDecCoinsFromCoins(coins...).MulDecTruncate(k.GetLiquidStakingRewardFee(ctx)).TruncateDecimal()
+		coins = coins.Sub(fee)
+	}
+
 	// add coins to user account
 	if !coins.IsZero() {
 		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
@@ -180,6 +215,19 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val sdkstaking.Valida
 	k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(rewards)})
 	feePool := k.GetFeePool(ctx)
 	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
+	if !fee.IsZero() {
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(fee...)...)
+		k.SetLiquidStakingFeesCollected(ctx, k.GetLiquidStakingFeesCollected(ctx).Add(fee...))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLiquidStakingRewardFee,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, del.GetDelegatorAddr().String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator().String()),
+			),
+		)
+	}
 	k.SetFeePool(ctx, feePool)
 
 	// decrement reference count of starting period
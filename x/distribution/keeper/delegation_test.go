@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -8,6 +9,8 @@ import (
 
 	simapp_test "github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
@@ -141,7 +144,7 @@ func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
 	require.NoError(t, err)
 
 	// try withdrawing rewards before no reward is allocated
-	coins, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddrs[1]))
+	coins, _, _, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddrs[1]), nil, 0, 0)
 	require.Nil(t, err)
 	require.Equal(t, coins, sdk.Coins{})
 
@@ -161,7 +164,7 @@ func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
 	beforeBalance := app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddrs[1]), sdk.DefaultBondDenom)
 
 	// withdraw rewards
-	coins, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddrs[1]))
+	coins, _, _, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddrs[1]), nil, 0, 0)
 	require.Nil(t, err)
 
 	// check return value
@@ -191,6 +194,238 @@ func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
 	require.Equal(t, midBalance.Amount.Add(coins.AmountOf(sdk.DefaultBondDenom)), finalBalance.Amount)
 }
 
+func TestWithdrawAllTokenizeShareRecordRewardBounded(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize three records owned by addr[1] and one owned by addr[2]
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	for _, owner := range []sdk.AccAddress{addr[1], addr[1], addr[1], addr[2]} {
+		_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+			DelegatorAddress:    addr[0].String(),
+			ValidatorAddress:    valAddrs[0].String(),
+			TokenizedShareOwner: owner.String(),
+			Amount:              sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)),
+		})
+		require.NoError(t, err)
+	}
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial)}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial)}
+	err := app.MintKeeper.MintCoins(ctx, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, coins)
+	require.NoError(t, err)
+
+	// records of another owner cannot be withdrawn
+	_, _, _, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, addr[1], []uint64{1, 4}, 0, 0)
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	// a limited withdrawal returns the record to continue from
+	total, results, next, err := app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, addr[1], nil, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, uint64(1), results[0].RecordId)
+	require.Equal(t, uint64(2), results[1].RecordId)
+	require.Empty(t, results[0].Error)
+	require.False(t, results[0].Amount.IsZero())
+	require.Equal(t, results[0].Amount.Add(results[1].Amount...), total)
+	require.Equal(t, uint64(3), next)
+
+	total, results, next, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, addr[1], nil, next, 2)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, uint64(3), results[0].RecordId)
+	require.Equal(t, results[0].Amount, total)
+	require.Equal(t, uint64(0), next)
+
+	// explicitly listed records are withdrawn, the rewards were already claimed
+	total, results, next, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, addr[1], []uint64{3, 1}, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, uint64(3), results[0].RecordId)
+	require.Equal(t, uint64(1), results[1].RecordId)
+	require.True(t, total.IsZero())
+	require.Equal(t, uint64(0), next)
+}
+
+func TestSweepTokenizeShareRecord(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// record 1 is delegated
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    addr[0].String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: addr[1].String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)),
+	})
+	require.NoError(t, err)
+
+	// record 2 is no longer delegated and still holds a balance
+	record := stakingtypes.TokenizeShareRecord{
+		Id:            2,
+		Owner:         addr[1].String(),
+		ModuleAccount: fmt.Sprintf("%s%d", stakingtypes.TokenizeShareModuleAccountPrefix, 2),
+		Validator:     valAddrs[0].String(),
+	}
+	require.NoError(t, app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+	app.StakingKeeper.SetLastTokenizeShareRecordID(ctx, 2)
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)))
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, record.GetModuleAddress(), coins))
+
+	// the rewards of an undelegated record cannot be withdrawn
+	_, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1], record.Id)
+	require.ErrorIs(t, err, sdkdistr.ErrNoDelegationExists)
+
+	// withdrawing all rewards reports the failure in the record's result
+	_, results, _, err := app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, addr[1], []uint64{record.Id}, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, record.Id, results[0].RecordId)
+	require.Contains(t, results[0].Error, "sweep the record instead")
+
+	// a delegated record cannot be swept
+	_, err = app.DistrKeeper.SweepTokenizeShareRecord(ctx, addr[1], 1)
+	require.ErrorIs(t, err, stakingtypes.ErrTokenizeShareRecordStillDelegated)
+
+	// only the owner can sweep the record
+	_, err = app.DistrKeeper.SweepTokenizeShareRecord(ctx, addr[2], record.Id)
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	beforeBalance := app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom)
+	amount, err := app.DistrKeeper.SweepTokenizeShareRecord(ctx, addr[1], record.Id)
+	require.NoError(t, err)
+	require.Equal(t, coins, amount)
+
+	afterBalance := app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom)
+	require.Equal(t, beforeBalance.Amount.Add(coins.AmountOf(sdk.DefaultBondDenom)), afterBalance.Amount)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.Error(t, err)
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+}
+
+func TestWithdrawCompoundingTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	valPower := int64(100)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, valPower, true)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize shares in compound mode
+	delTokens := sdk.NewInt(1000000)
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    sdk.AccAddress(valAddrs[0]).String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: sdk.AccAddress(valAddrs[1]).String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, delTokens),
+		Compound:            true,
+	})
+	require.NoError(t, err)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, record.Compound)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial)}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial)}
+	err = app.MintKeeper.MintCoins(ctx, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, coins)
+	require.NoError(t, err)
+
+	// rewards in other denoms are still sent to the owner
+	otherRewards := sdk.Coins{sdk.NewInt64Coin("photon", 100)}
+	err = app.MintKeeper.MintCoins(ctx, otherRewards)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, record.GetModuleAddress(), otherRewards)
+	require.NoError(t, err)
+
+	beforeBalance := app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddrs[1]), sdk.DefaultBondDenom)
+	beforeLiquidStake := app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)
+
+	// withdraw rewards
+	coins, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddrs[1]), record.Id)
+	require.NoError(t, err)
+	require.Equal(t, otherRewards, coins)
+
+	// the rewards in the bond denom are delegated back to the validator
+	compounded := sdk.NewInt(50000)
+	require.Equal(t, beforeBalance, app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddrs[1]), sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+	require.Equal(t, beforeLiquidStake.Add(compounded), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	require.True(t, found)
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, delTokens.Add(compounded), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	// redeeming the share tokens returns the compounded stake
+	shareTokenBalance := app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddrs[0]), record.GetShareTokenDenom())
+	require.Equal(t, delTokens, shareTokenBalance.Amount)
+
+	res, err := msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &stakingtypes.MsgRedeemTokensforShares{
+		DelegatorAddress: sdk.AccAddress(valAddrs[0]).String(),
+		Amount:           shareTokenBalance,
+	})
+	require.NoError(t, err)
+	require.Equal(t, delTokens.Add(compounded), res.Amount.Amount)
+}
+
 func TestCalculateRewardsAfterSlash(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -465,6 +700,64 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	)
 }
 
+func TestWithdrawDelegationRewardsLiquidStakingFee(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// fund a liquid staking provider account, which has a 32 byte address
+	liquidAddr := sdk.AccAddress(address.Module("icahost", []byte("provider")))
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 100)
+	require.NoError(t, simapp_test.FundAccount(app.BankKeeper, ctx, liquidAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, delTokens))))
+
+	// create a validator with a regular and a liquid delegation of the same size
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.Delegate(addr[1], valAddrs[0], delTokens)
+	tstaking.Delegate(liquidAddr, valAddrs[0], delTokens)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// charge a 10% fee on liquid staked rewards
+	params := app.DistrKeeper.GetParams(ctx)
+	params.LiquidStakingRewardFee = sdk.NewDecWithPrec(1, 1)
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// allocate some rewards
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial)}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial)}
+	err := app.MintKeeper.MintCoins(ctx, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, coins)
+	require.NoError(t, err)
+
+	reward := initial.QuoRaw(3)
+	fee := reward.QuoRaw(10)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	// the regular delegator does not pay the fee
+	rewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, addr[1], valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, reward)), rewards)
+	require.True(t, app.DistrKeeper.GetLiquidStakingFeesCollected(ctx).IsZero())
+
+	// the liquid staking provider pays the fee, which is sent to the community pool
+	rewards, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, liquidAddr, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, reward.Sub(fee))), rewards)
+	require.Equal(t, reward.Sub(fee), app.BankKeeper.GetBalance(ctx, liquidAddr, sdk.DefaultBondDenom).Amount)
+
+	feeCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, fee))
+	require.Equal(t, feeCoins, app.DistrKeeper.GetLiquidStakingFeesCollected(ctx))
+	require.Equal(t, communityPool.Add(sdk.NewDecCoinsFromCoins(feeCoins...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
@@ -53,6 +54,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorHistoricalRewards(ctx, valAddr, his.Period, his.Rewards)
 	}
+	for _, his := range data.ValidatorHistoricalRewardsTimes {
+		valAddr, err := sdk.ValAddressFromBech32(his.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorHistoricalRewardsTime(ctx, valAddr, his.Period, his.Time)
+	}
+	// index the periods exported without an end time
+	for _, his := range data.ValidatorHistoricalRewards {
+		valAddr, err := sdk.ValAddressFromBech32(his.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.indexValidatorHistoricalRewardsPeriod(ctx, valAddr, his.Period)
+	}
 	for _, cur := range data.ValidatorCurrentRewards {
 		valAddr, err := sdk.ValAddressFromBech32(cur.ValidatorAddress)
 		if err != nil {
@@ -76,6 +92,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, restake := range data.DelegatorAutoRestakes {
+		delegatorAddress := sdk.MustAccAddressFromBech32(restake.DelegatorAddress)
+		valAddr, err := sdk.ValAddressFromBech32(restake.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetDelegatorAutoRestake(ctx, delegatorAddress, valAddr)
+	}
+	for _, rec := range data.ValidatorCommissionRecipients {
+		valAddr, err := sdk.ValAddressFromBech32(rec.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorCommissionRecipients(ctx, valAddr, types.ValidatorCommissionRecipients{Recipients: rec.Recipients})
+	}
+	k.SetLiquidStakingFeesCollected(ctx, data.LiquidStakingFeesCollected)
+	for _, stream := range data.CommunityPoolStreams {
+		k.SetCommunityPoolStream(ctx, stream)
+	}
+	k.SetLastCommunityPoolStreamID(ctx, data.LastCommunityPoolStreamId)
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -181,5 +217,48 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	restakes := make([]types.DelegatorAutoRestake, 0)
+	k.IterateDelegatorAutoRestakes(ctx, func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+		restakes = append(restakes, types.DelegatorAutoRestake{
+			DelegatorAddress: del.String(),
+			ValidatorAddress: val.String(),
+		})
+		return false
+	})
+
+	recipients := make([]types.ValidatorCommissionRecipientsRecord, 0)
+	k.IterateValidatorCommissionRecipients(ctx,
+		func(val sdk.ValAddress, rec types.ValidatorCommissionRecipients) (stop bool) {
+			recipients = append(recipients, types.ValidatorCommissionRecipientsRecord{
+				ValidatorAddress: val.String(),
+				Recipients:       rec.Recipients,
+			})
+			return false
+		},
+	)
+
+	fees := k.GetLiquidStakingFeesCollected(ctx)
+
+	streams := make([]types.CommunityPoolStream, 0)
+	k.IterateCommunityPoolStreams(ctx, func(stream types.CommunityPoolStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+
+	lastStreamID := k.GetLastCommunityPoolStreamID(ctx)
+
+	hisTimes := make([]types.ValidatorHistoricalRewardsTimeRecord, 0)
+	k.IterateValidatorHistoricalRewardsTimes(ctx,
+		func(val sdk.ValAddress, period uint64, t time.Time) (stop bool) {
+			hisTimes = append(hisTimes, types.ValidatorHistoricalRewardsTimeRecord{
+				ValidatorAddress: val.String(),
+				Period:           period,
+				Time:             t,
+			})
+			return false
+		},
+	)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, restakes, recipients, fees,
+		streams, lastStreamID, hisTimes)
 }
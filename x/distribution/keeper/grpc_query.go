@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"math"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -14,6 +17,7 @@ import (
 	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -69,6 +73,111 @@ func (k Keeper) ValidatorCommission(c context.Context, req *types.QueryValidator
 	return &types.QueryValidatorCommissionResponse{Commission: commission}, nil
 }
 
+// ValidatorRewardRate queries the estimated annualized reward per token of a validator
+func (k Keeper) ValidatorRewardRate(c context.Context, req *types.QueryValidatorRewardRateRequest) (*types.QueryValidatorRewardRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	window := types.DefaultRewardRateWindow
+	if req.WindowSeconds != 0 {
+		if req.WindowSeconds > uint64(math.MaxInt64/int64(time.Second)) {
+			return nil, status.Errorf(codes.InvalidArgument, "window of %d seconds is too long", req.WindowSeconds)
+		}
+		window = time.Duration(req.WindowSeconds) * time.Second
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, startTime, endTime, err := k.EstimateValidatorRewardRate(ctx, valAdr, window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorRewardRateResponse{Rate: rate, StartTime: startTime, EndTime: endTime}, nil
+}
+
+// ValidatorHistoricalRewards queries the historical rewards periods of a validator
+func (k Keeper) ValidatorHistoricalRewards(c context.Context, req *types.QueryValidatorHistoricalRewardsRequest) (*types.QueryValidatorHistoricalRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	// the periods are paged over the end time index, which is keyed by the big endian
+	// period and also holds the periods whose end time is unknown
+	periods := make([]types.ValidatorHistoricalRewardsPeriod, 0)
+	store := ctx.KVStore(k.storeKey)
+	timeStore := prefix.NewStore(store, types.GetValidatorHistoricalRewardsTimePrefix(valAddr))
+
+	pageRes, err := query.Paginate(timeStore, req.Pagination, func(key []byte, value []byte) error {
+		periodNum := binary.BigEndian.Uint64(key)
+		period := types.ValidatorHistoricalRewardsPeriod{
+			Period:  periodNum,
+			Rewards: k.GetValidatorHistoricalRewards(ctx, valAddr, periodNum),
+		}
+		if len(value) > 0 {
+			endTime, err := sdk.ParseTimeBytes(value)
+			if err != nil {
+				return err
+			}
+			period.EndTime = &endTime
+		}
+
+		periods = append(periods, period)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorHistoricalRewardsResponse{HistoricalRewards: periods, Pagination: pageRes}, nil
+}
+
+// ValidatorCurrentRewards queries the current rewards period of a validator
+func (k Keeper) ValidatorCurrentRewards(c context.Context, req *types.QueryValidatorCurrentRewardsRequest) (*types.QueryValidatorCurrentRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	// the rewards of a validator are removed along with the validator
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddress)
+	}
+
+	rewards := k.GetValidatorCurrentRewards(ctx, valAddr)
+
+	return &types.QueryValidatorCurrentRewardsResponse{Rewards: rewards}, nil
+}
+
 // ValidatorSlashes queries slash events of a validator
 func (k Keeper) ValidatorSlashes(c context.Context, req *types.QueryValidatorSlashesRequest) (*types.QueryValidatorSlashesResponse, error) {
 	if req == nil {
@@ -221,6 +330,40 @@ func (k Keeper) DelegatorValidators(c context.Context, req *types.QueryDelegator
 	return &types.QueryDelegatorValidatorsResponse{Validators: validators}, nil
 }
 
+// DelegatorStartingInfo queries the starting info of a delegation
+func (k Keeper) DelegatorStartingInfo(c context.Context, req *types.QueryDelegatorStartingInfoRequest) (*types.QueryDelegatorStartingInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasDelegatorStartingInfo(ctx, valAddr, delAddr) {
+		return nil, status.Errorf(codes.NotFound, "starting info of delegation from %s to %s not found", req.DelegatorAddress, req.ValidatorAddress)
+	}
+
+	info := k.GetDelegatorStartingInfo(ctx, valAddr, delAddr)
+
+	return &types.QueryDelegatorStartingInfoResponse{StartingInfo: info}, nil
+}
+
 // DelegatorWithdrawAddress queries Query/delegatorWithdrawAddress
 func (k Keeper) DelegatorWithdrawAddress(c context.Context, req *types.QueryDelegatorWithdrawAddressRequest) (*types.QueryDelegatorWithdrawAddressResponse, error) {
 	if req == nil {
@@ -241,6 +384,52 @@ func (k Keeper) DelegatorWithdrawAddress(c context.Context, req *types.QueryDele
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// ValidatorCommissionRecipients queries the recipients the commission of a validator is split across
+func (k Keeper) ValidatorCommissionRecipients(c context.Context, req *types.QueryValidatorCommissionRecipientsRequest) (*types.QueryValidatorCommissionRecipientsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	recipients := k.GetValidatorCommissionRecipients(ctx, valAdr).Recipients
+	if recipients == nil {
+		recipients = []types.CommissionRecipient{}
+	}
+
+	return &types.QueryValidatorCommissionRecipientsResponse{Recipients: recipients}, nil
+}
+
+// DelegatorAutoRestakes queries the validators a delegator auto restakes the rewards of
+func (k Keeper) DelegatorAutoRestakes(c context.Context, req *types.QueryDelegatorAutoRestakesRequest) (*types.QueryDelegatorAutoRestakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validators := make([]string, 0)
+	for _, valAddr := range k.GetDelegatorAutoRestakes(ctx, delAdr) {
+		validators = append(validators, valAddr.String())
+	}
+
+	return &types.QueryDelegatorAutoRestakesResponse{Validators: validators}, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Keeper) CommunityPool(c context.Context, _ *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -249,53 +438,173 @@ func (k Keeper) CommunityPool(c context.Context, _ *types.QueryCommunityPoolRequ
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
 
+// LiquidStakingFeesCollected queries the cumulative liquid staking reward fees sent to the community pool
+func (k Keeper) LiquidStakingFeesCollected(c context.Context, _ *types.QueryLiquidStakingFeesCollectedRequest) (*types.QueryLiquidStakingFeesCollectedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	fees := k.GetLiquidStakingFeesCollected(ctx)
+
+	return &types.QueryLiquidStakingFeesCollectedResponse{Fees: fees}, nil
+}
+
+// CommunityPoolStreams queries the active community pool streams
+func (k Keeper) CommunityPoolStreams(c context.Context, req *types.QueryCommunityPoolStreamsRequest) (*types.QueryCommunityPoolStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	streams := make([]types.CommunityPoolStream, 0)
+	store := ctx.KVStore(k.storeKey)
+	streamsStore := prefix.NewStore(store, types.CommunityPoolStreamPrefix)
+
+	pageRes, err := query.Paginate(streamsStore, req.Pagination, func(key []byte, value []byte) error {
+		var stream types.CommunityPoolStream
+		if err := k.cdc.Unmarshal(value, &stream); err != nil {
+			return err
+		}
+
+		streams = append(streams, stream)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCommunityPoolStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
+
+// CommunityPoolStream queries a community pool stream by id
+func (k Keeper) CommunityPoolStream(c context.Context, req *types.QueryCommunityPoolStreamRequest) (*types.QueryCommunityPoolStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	stream, found := k.GetCommunityPoolStream(ctx, req.StreamId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "community pool stream %d not found", req.StreamId)
+	}
+
+	return &types.QueryCommunityPoolStreamResponse{Stream: stream}, nil
+}
+
 // TokenizeShareRecordReward returns estimated amount of reward from tokenize share record ownership
 func (k Keeper) TokenizeShareRecordReward(c context.Context, req *types.QueryTokenizeShareRecordRewardRequest) (*types.QueryTokenizeShareRecordRewardResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
 
-	totalRewards := sdk.DecCoins{}
-	rewards := []types.TokenizeShareRecordReward{}
+	ctx := sdk.UnwrapSDKContext(c)
 
 	ownerAddr, err := sdk.AccAddressFromBech32(req.OwnerAddress)
 	if err != nil {
 		return nil, err
 	}
+
 	records := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	rewards, totalRewards, err := k.tokenizeShareRecordRewards(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTokenizeShareRecordRewardResponse{
+		Rewards: rewards,
+		Total:   totalRewards,
+	}, nil
+}
+
+// TokenizeShareRecordRewardById returns estimated amount of reward of a tokenize share record
+func (k Keeper) TokenizeShareRecordRewardById(c context.Context, req *types.QueryTokenizeShareRecordRewardByIdRequest) (*types.QueryTokenizeShareRecordRewardByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, req.RecordId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	reward, err := k.tokenizeShareRecordReward(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTokenizeShareRecordRewardByIdResponse{Reward: reward}, nil
+}
+
+// ValidatorTokenizeShareRecordRewards returns estimated amount of reward of the tokenize share records of a validator
+func (k Keeper) ValidatorTokenizeShareRecordRewards(c context.Context, req *types.QueryValidatorTokenizeShareRecordRewardsRequest) (*types.QueryValidatorTokenizeShareRecordRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	records, pageRes, err := k.stakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddr, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, totalRewards, err := k.tokenizeShareRecordRewards(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorTokenizeShareRecordRewardsResponse{
+		Rewards:    rewards,
+		Total:      totalRewards,
+		Pagination: pageRes,
+	}, nil
+}
+
+// tokenizeShareRecordRewards returns the estimated rewards of each tokenize share record and their sum
+func (k Keeper) tokenizeShareRecordRewards(ctx sdk.Context, records []stakingtypes.TokenizeShareRecord) ([]types.TokenizeShareRecordReward, sdk.DecCoins, error) {
+	totalRewards := sdk.DecCoins{}
+	rewards := []types.TokenizeShareRecordReward{}
+
 	for _, record := range records {
-		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		reward, err := k.tokenizeShareRecordReward(ctx, record)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
-		moduleAddr := record.GetModuleAddress()
-		moduleBalance := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
-		moduleBalanceDecCoins := sdk.NewDecCoinsFromCoins(moduleBalance...)
+		rewards = append(rewards, reward)
+		totalRewards = totalRewards.Add(reward.Reward...)
+	}
 
-		val := k.stakingKeeper.Validator(ctx, valAddr)
-		del := k.stakingKeeper.Delegation(ctx, moduleAddr, valAddr)
-		if val != nil && del != nil {
-			// withdraw rewards
-			endingPeriod := k.IncrementValidatorPeriod(ctx, val)
-			recordReward := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	return rewards, totalRewards, nil
+}
 
-			rewards = append(rewards, types.TokenizeShareRecordReward{
-				RecordId: record.Id,
-				Reward:   recordReward.Add(moduleBalanceDecCoins...),
-			})
-			totalRewards = totalRewards.Add(recordReward...)
-		}
+// tokenizeShareRecordReward returns the estimated rewards of a tokenize share record: the
+// rewards accrued by its delegation plus the rewards already withdrawn to its module account
+func (k Keeper) tokenizeShareRecordReward(ctx sdk.Context, record stakingtypes.TokenizeShareRecord) (types.TokenizeShareRecordReward, error) {
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return types.TokenizeShareRecordReward{}, err
+	}
 
-		if !moduleBalance.IsZero() {
-			rewards = append(rewards, types.TokenizeShareRecordReward{
-				RecordId: record.Id,
-				Reward:   moduleBalanceDecCoins,
-			})
-			totalRewards = totalRewards.Add(moduleBalanceDecCoins...)
-		}
+	moduleAddr := record.GetModuleAddress()
+	reward := sdk.NewDecCoinsFromCoins(k.bankKeeper.GetAllBalances(ctx, moduleAddr)...)
+
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	del := k.stakingKeeper.Delegation(ctx, moduleAddr, valAddr)
+	if val != nil && del != nil {
+		reward = reward.Add(k.EstimateDelegationRewards(ctx, val, del)...)
 	}
 
-	return &types.QueryTokenizeShareRecordRewardResponse{
-		Rewards: rewards,
-		Total:   totalRewards,
+	return types.TokenizeShareRecordReward{
+		RecordId: record.Id,
+		Reward:   reward,
 	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -74,10 +75,13 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 			"valid request",
 			func() {
 				params = types.Params{
-					CommunityTax:        sdk.NewDecWithPrec(3, 1),
-					BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
-					BonusProposerReward: sdk.NewDecWithPrec(1, 1),
-					WithdrawAddrEnabled: true,
+					CommunityTax:           sdk.NewDecWithPrec(3, 1),
+					BaseProposerReward:     sdk.NewDecWithPrec(2, 1),
+					BonusProposerReward:    sdk.NewDecWithPrec(1, 1),
+					WithdrawAddrEnabled:    true,
+					RestakeInterval:        500,
+					MaxRestakesPerBlock:    20,
+					LiquidStakingRewardFee: sdk.NewDecWithPrec(5, 2),
 				}
 
 				app.DistrKeeper.SetParams(ctx, params)
@@ -556,6 +560,100 @@ func (suite *KeeperTestSuite) TestGRPCDelegationRewards() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCValidatorRewardsPeriods() {
+	app, ctx, addrs, valAddrs := suite.app, suite.ctx, suite.addrs, suite.valAddrs
+	ctx = ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tstaking := teststaking.NewHelper(suite.T(), ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// a second delegation ends the current period of the validator
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(10)}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	tstaking.Ctx = ctx
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(100))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.DistrKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	// historical rewards
+	_, err := queryClient.ValidatorHistoricalRewards(gocontext.Background(), &types.QueryValidatorHistoricalRewardsRequest{})
+	suite.Require().Error(err)
+
+	expPeriods := make(map[uint64]types.ValidatorHistoricalRewards)
+	app.DistrKeeper.IterateValidatorHistoricalRewards(ctx, func(valAddr sdk.ValAddress, period uint64, rewards types.ValidatorHistoricalRewards) (stop bool) {
+		if valAddr.Equals(valAddrs[0]) {
+			expPeriods[period] = rewards
+		}
+		return false
+	})
+	suite.Require().NotEmpty(expPeriods)
+
+	historical, err := queryClient.ValidatorHistoricalRewards(gocontext.Background(), &types.QueryValidatorHistoricalRewardsRequest{
+		ValidatorAddress: valAddrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(historical.HistoricalRewards, len(expPeriods))
+	for i, period := range historical.HistoricalRewards {
+		if i > 0 {
+			suite.Require().Greater(period.Period, historical.HistoricalRewards[i-1].Period)
+		}
+		suite.Require().Equal(expPeriods[period.Period], period.Rewards)
+
+		endTime, found := app.DistrKeeper.GetValidatorHistoricalRewardsTime(ctx, valAddrs[0], period.Period)
+		suite.Require().True(found)
+		suite.Require().NotNil(period.EndTime)
+		suite.Require().Equal(endTime, *period.EndTime)
+	}
+
+	historical, err = queryClient.ValidatorHistoricalRewards(gocontext.Background(), &types.QueryValidatorHistoricalRewardsRequest{
+		ValidatorAddress: valAddrs[0].String(),
+		Pagination:       &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(historical.HistoricalRewards, 1)
+	suite.Require().Equal(uint64(len(expPeriods)), historical.Pagination.Total)
+
+	// current rewards
+	_, err = queryClient.ValidatorCurrentRewards(gocontext.Background(), &types.QueryValidatorCurrentRewardsRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.ValidatorCurrentRewards(gocontext.Background(), &types.QueryValidatorCurrentRewardsRequest{
+		ValidatorAddress: valAddrs[1].String(),
+	})
+	suite.Require().Error(err)
+
+	current, err := queryClient.ValidatorCurrentRewards(gocontext.Background(), &types.QueryValidatorCurrentRewardsRequest{
+		ValidatorAddress: valAddrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddrs[0]), current.Rewards)
+
+	// delegator starting info
+	_, err = queryClient.DelegatorStartingInfo(gocontext.Background(), &types.QueryDelegatorStartingInfoRequest{
+		ValidatorAddress: valAddrs[0].String(),
+	})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DelegatorStartingInfo(gocontext.Background(), &types.QueryDelegatorStartingInfoRequest{
+		DelegatorAddress: addrs[0].String(),
+		ValidatorAddress: valAddrs[1].String(),
+	})
+	suite.Require().Error(err)
+
+	startingInfo, err := queryClient.DelegatorStartingInfo(gocontext.Background(), &types.QueryDelegatorStartingInfoRequest{
+		DelegatorAddress: addrs[1].String(),
+		ValidatorAddress: valAddrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.DistrKeeper.GetDelegatorStartingInfo(ctx, valAddrs[0], addrs[1]), startingInfo.StartingInfo)
+	suite.Require().Equal(current.Rewards.Period-1, startingInfo.StartingInfo.PreviousPeriod)
+}
+
 func (suite *KeeperTestSuite) TestGRPCDelegatorWithdrawAddress() {
 	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
 
@@ -734,6 +832,80 @@ func (suite *KeeperTestSuite) TestGRPCTokenizeShareRecordReward() {
 		},
 		Total: sdk.DecCoins{sdk.NewInt64DecCoin("stake", 50000)},
 	}, rewards)
+
+	// rewards of the current period are included without ending the period
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	currentRewards := app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddrs[0])
+
+	err = app.MintKeeper.MintCoins(ctx, coins)
+	suite.Require().NoError(err)
+	err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, coins)
+	suite.Require().NoError(err)
+
+	// rewards already withdrawn to the record's module account are counted once
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	suite.Require().NoError(err)
+	withdrawn := sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 7)}
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, record.GetModuleAddress(), withdrawn))
+
+	expReward := types.TokenizeShareRecordReward{
+		RecordId: 1,
+		Reward:   sdk.DecCoins{sdk.NewInt64DecCoin("stake", 100007)},
+	}
+
+	rewards, err = queryClient.TokenizeShareRecordReward(gocontext.Background(), &types.QueryTokenizeShareRecordRewardRequest{
+		OwnerAddress: sdk.AccAddress(valAddrs[0]).String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.TokenizeShareRecordReward{expReward}, rewards.Rewards)
+	suite.Require().Equal(expReward.Reward, rewards.Total)
+	suite.Require().Equal(currentRewards, app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddrs[0]))
+
+	rewardByID, err := queryClient.TokenizeShareRecordRewardById(gocontext.Background(), &types.QueryTokenizeShareRecordRewardByIdRequest{
+		RecordId: 1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expReward, rewardByID.Reward)
+
+	_, err = queryClient.TokenizeShareRecordRewardById(gocontext.Background(), &types.QueryTokenizeShareRecordRewardByIdRequest{
+		RecordId: 2,
+	})
+	suite.Require().Error(err)
+
+	// tokenize a second record to page through the validator's records
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    sdk.AccAddress(valAddrs[0]).String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: sdk.AccAddress(valAddrs[1]).String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, delTokens),
+	})
+	suite.Require().NoError(err)
+
+	validatorRewards, err := queryClient.ValidatorTokenizeShareRecordRewards(gocontext.Background(), &types.QueryValidatorTokenizeShareRecordRewardsRequest{
+		ValidatorAddress: valAddrs[0].String(),
+		Pagination:       &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(validatorRewards.Rewards, 1)
+	suite.Require().Equal(uint64(1), validatorRewards.Rewards[0].RecordId)
+	suite.Require().Equal(validatorRewards.Rewards[0].Reward, validatorRewards.Total)
+	suite.Require().Equal(uint64(2), validatorRewards.Pagination.Total)
+	suite.Require().NotNil(validatorRewards.Pagination.NextKey)
+
+	validatorRewards, err = queryClient.ValidatorTokenizeShareRecordRewards(gocontext.Background(), &types.QueryValidatorTokenizeShareRecordRewardsRequest{
+		ValidatorAddress: valAddrs[0].String(),
+		Pagination:       &query.PageRequest{Key: validatorRewards.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(validatorRewards.Rewards, 1)
+	suite.Require().Equal(uint64(2), validatorRewards.Rewards[0].RecordId)
+	suite.Require().True(validatorRewards.Total.IsZero())
+
+	validatorRewards, err = queryClient.ValidatorTokenizeShareRecordRewards(gocontext.Background(), &types.QueryValidatorTokenizeShareRecordRewardsRequest{
+		ValidatorAddress: valAddrs[1].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(validatorRewards.Rewards)
 }
 
 func TestDistributionTestSuite(t *testing.T) {
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -42,12 +41,9 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 		feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
 		h.k.SetFeePool(ctx, feePool)
 
-		// add to validator account
+		// send to the commission recipients of the validator
 		if !coins.IsZero() {
-			accAddr := sdk.AccAddress(valAddr)
-			withdrawAddr := h.k.GetDelegatorWithdrawAddr(ctx, accAddr)
-
-			if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins); err != nil {
+			if err := h.k.sendValidatorCommission(ctx, valAddr, coins); err != nil {
 				return err
 			}
 		}
@@ -75,6 +71,9 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 	// clear current rewards
 	h.k.DeleteValidatorCurrentRewards(ctx, valAddr)
 
+	// clear commission recipients
+	h.k.DeleteValidatorCommissionRecipients(ctx, valAddr)
+
 	return nil
 }
 
@@ -126,6 +125,28 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+// stop auto restaking the rewards of the removed delegation
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.DeleteDelegatorAutoRestake(ctx, delAddr, valAddr)
+	return nil
+}
+
+func (h Hooks) AfterTokenizeShareRecordCreated(_ sdk.Context, _ uint64) error {
+	return nil
+}
+
+func (h Hooks) AfterTokenizeShareRecordTransferred(_ sdk.Context, _ uint64, _, _ sdk.AccAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterSharesRedeemed(_ sdk.Context, _ uint64, _ sdk.AccAddress, _ sdk.ValAddress, _ sdk.Int) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBondChanged(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterTokenizeShareLockChanged(_ sdk.Context, _ sdk.AccAddress, _ stakingtypes.TokenizeShareLockStatus) error {
 	return nil
 }
@@ -14,6 +14,7 @@ import (
 	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
 // Keeper of the distribution store
@@ -136,10 +137,7 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(sdk.NewDecCoinsFromCoins(commission...))})
 
 	if !commission.IsZero() {
-		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
-		if err != nil {
+		if err := k.sendValidatorCommission(ctx, valAddr, commission); err != nil {
 			return nil, err
 		}
 	}
@@ -154,6 +152,82 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	return commission, nil
 }
 
+// SetCommissionRecipients sets the weighted list of recipients the commission of a
+// validator is split across. An empty list sends the commission to the withdraw
+// address of the validator operator again.
+func (k Keeper) SetCommissionRecipients(ctx sdk.Context, valAddr sdk.ValAddress, recipients []types.CommissionRecipient) error {
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return sdkdistr.ErrNoValidatorExists
+	}
+	if err := types.ValidateCommissionRecipients(recipients); err != nil {
+		return err
+	}
+
+	if len(recipients) == 0 {
+		k.DeleteValidatorCommissionRecipients(ctx, valAddr)
+		return nil
+	}
+
+	for _, recipient := range recipients {
+		addr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return err
+		}
+		if k.bankKeeper.BlockedAddr(addr) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", addr)
+		}
+	}
+
+	k.SetValidatorCommissionRecipients(ctx, valAddr, types.ValidatorCommissionRecipients{Recipients: recipients})
+	return nil
+}
+
+// sendValidatorCommission sends the withdrawn commission of a validator to its
+// commission recipients, or to the withdraw address of the operator if it has none.
+// Each recipient receives its weight of every coin, truncated, and the last
+// recipient receives what is left so no commission remains in the module.
+func (k Keeper) sendValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress, commission sdk.Coins) error {
+	recipients := k.GetValidatorCommissionRecipients(ctx, valAddr).Recipients
+	if len(recipients) == 0 {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valAddr))
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
+	}
+
+	remaining := commission
+	for i, recipient := range recipients {
+		addr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return err
+		}
+
+		share := remaining
+		if i < len(recipients)-1 {
+			share = sdk.NewCoins()
+			for _, coin := range commission {
+				share = share.Add(sdk.NewCoin(coin.Denom, recipient.Weight.MulInt(coin.Amount).TruncateInt()))
+			}
+			remaining = remaining.Sub(share)
+		}
+		if share.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, share); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSplitCommission,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, share.String()),
+			),
+		)
+	}
+	return nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx sdk.Context) (totalRewards sdk.DecCoins) {
 	k.IterateValidatorOutstandingRewards(ctx,
@@ -200,7 +274,8 @@ func (k Keeper) WithdrawSingleShareRecordReward(ctx sdk.Context, recordID uint64
 
 	val := k.stakingKeeper.Validator(ctx, valAddr)
 	del := k.stakingKeeper.Delegation(ctx, record.GetModuleAddress(), valAddr)
-	if val != nil && del != nil {
+	delegated := val != nil && del != nil
+	if delegated {
 		// withdraw rewards into reward module account and send it to reward owner
 		cacheCtx, write := ctx.CacheContext()
 		_, err = k.WithdrawDelegationRewards(cacheCtx, record.GetModuleAddress(), valAddr)
@@ -211,13 +286,11 @@ func (k Keeper) WithdrawSingleShareRecordReward(ctx sdk.Context, recordID uint64
 	}
 
 	// apply changes when the module account has positive balance
-	balances := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress())
+	balances, err := k.payoutTokenizeShareRecordRewards(ctx, record, owner, delegated)
+	if err != nil {
+		return err
+	}
 	if !balances.Empty() {
-		err = k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), owner, balances)
-		if err != nil {
-			return err
-		}
-
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeWithdrawTokenizeShareReward,
@@ -240,36 +313,11 @@ func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk
 		return nil, types.ErrNotTokenizeShareRecordOwner
 	}
 
-	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	rewards, err := k.withdrawTokenizeShareRecordReward(ctx, record, ownerAddr)
 	if err != nil {
 		return nil, err
 	}
 
-	val := k.stakingKeeper.Validator(ctx, valAddr)
-	if val == nil {
-		return nil, err
-	}
-
-	del := k.stakingKeeper.Delegation(ctx, record.GetModuleAddress(), valAddr)
-	if del == nil {
-		return nil, err
-	}
-
-	// withdraw rewards into reward module account and send it to reward owner
-	_, err = k.WithdrawDelegationRewards(ctx, record.GetModuleAddress(), valAddr)
-	if err != nil {
-		return nil, err
-	}
-
-	// apply changes when the module account has positive balance
-	rewards := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress())
-	if !rewards.Empty() {
-		err = k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), ownerAddr, rewards)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeWithdrawTokenizeShareReward,
@@ -281,47 +329,70 @@ func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk
 	return rewards, nil
 }
 
-// withdraw reward for all owning TokenizeShareRecord
-func (k Keeper) WithdrawAllTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
-	totalRewards := sdk.Coins{}
-
-	records := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
-
-	for _, record := range records {
-		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
-		if err != nil {
-			return nil, err
+// WithdrawAllTokenizeShareRecordReward withdraws the rewards of the tokenize share records owned by
+// the owner, either the given records or, if none are given, at most limit owned records starting
+// from startRecordID. Each record is withdrawn separately and a failure is reported in its result
+// instead of aborting the others. The id of the next owned record is returned if the limit was hit.
+func (k Keeper) WithdrawAllTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress,
+	recordIDs []uint64, startRecordID, limit uint64,
+) (sdk.Coins, []types.TokenizeShareRecordWithdrawal, uint64, error) {
+	var records []stakingtypes.TokenizeShareRecord
+	var nextRecordID uint64
+
+	if len(recordIDs) > 0 {
+		for _, id := range recordIDs {
+			record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, id)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			if record.Owner != ownerAddr.String() {
+				return nil, nil, 0, errorsmod.Wrapf(types.ErrNotTokenizeShareRecordOwner, "record %d", id)
+			}
+			records = append(records, record)
 		}
-
-		val := k.stakingKeeper.Validator(ctx, valAddr)
-		if val == nil {
-			continue
+	} else {
+		if limit == 0 || limit > types.MaxTokenizeShareRecordWithdrawals {
+			limit = types.MaxTokenizeShareRecordWithdrawals
 		}
 
-		del := k.stakingKeeper.Delegation(ctx, record.GetModuleAddress(), valAddr)
-		if del == nil {
-			continue
-		}
+		k.stakingKeeper.IterateTokenizeShareRecordsByOwner(ctx, ownerAddr, startRecordID,
+			func(record stakingtypes.TokenizeShareRecord) bool {
+				if uint64(len(records)) == limit {
+					nextRecordID = record.Id
+					return true
+				}
+				records = append(records, record)
+				return false
+			},
+		)
+	}
 
-		// withdraw rewards into reward module account and send it to reward owner
+	totalRewards := sdk.Coins{}
+	results := make([]types.TokenizeShareRecordWithdrawal, 0, len(records))
+	for _, record := range records {
+		// withdraw in a cache context so that a failing record does not affect the others
 		cacheCtx, write := ctx.CacheContext()
-		_, err = k.WithdrawDelegationRewards(cacheCtx, record.GetModuleAddress(), valAddr)
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		rewards, err := k.withdrawTokenizeShareRecordReward(cacheCtx, record, ownerAddr)
 		if err != nil {
-			k.Logger(ctx).Error(err.Error())
+			k.Logger(ctx).Error("failed to withdraw tokenize share record reward", "record_id", record.Id, "err", err)
+			results = append(results, types.TokenizeShareRecordWithdrawal{
+				RecordId: record.Id,
+				Amount:   sdk.Coins{},
+				Error:    err.Error(),
+			})
 			continue
 		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 
-		// apply changes when the module account has positive balance
-		balances := k.bankKeeper.GetAllBalances(cacheCtx, record.GetModuleAddress())
-		if !balances.Empty() {
-			err = k.bankKeeper.SendCoins(cacheCtx, record.GetModuleAddress(), ownerAddr, balances)
-			if err != nil {
-				k.Logger(ctx).Error(err.Error())
-				continue
-			}
-			write()
-			totalRewards = totalRewards.Add(balances...)
+		if !rewards.Empty() {
+			totalRewards = totalRewards.Add(rewards...)
 		}
+		results = append(results, types.TokenizeShareRecordWithdrawal{
+			RecordId: record.Id,
+			Amount:   rewards,
+		})
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -332,5 +403,116 @@ func (k Keeper) WithdrawAllTokenizeShareRecordReward(ctx sdk.Context, ownerAddr
 		),
 	)
 
-	return totalRewards, nil
+	return totalRewards, results, nextRecordID, nil
+}
+
+// withdrawTokenizeShareRecordReward withdraws the delegation rewards of the record's module account
+// and pays them out to the owner.
+func (k Keeper) withdrawTokenizeShareRecordReward(ctx sdk.Context, record stakingtypes.TokenizeShareRecord,
+	ownerAddr sdk.AccAddress,
+) (sdk.Coins, error) {
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	// the balance left in the module account of a record that is no longer delegated
+	// is recovered with SweepTokenizeShareRecord
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return nil, errorsmod.Wrapf(sdkdistr.ErrNoValidatorExists, "validator of record %d was removed, sweep the record instead", record.Id)
+	}
+	if k.stakingKeeper.Delegation(ctx, record.GetModuleAddress(), valAddr) == nil {
+		return nil, errorsmod.Wrapf(sdkdistr.ErrNoDelegationExists, "record %d is no longer delegated, sweep the record instead", record.Id)
+	}
+
+	// withdraw rewards into reward module account and send it to reward owner
+	if _, err := k.WithdrawDelegationRewards(ctx, record.GetModuleAddress(), valAddr); err != nil {
+		return nil, err
+	}
+
+	return k.payoutTokenizeShareRecordRewards(ctx, record, ownerAddr, true)
+}
+
+// SweepTokenizeShareRecord sends the balance left in the module account of a tokenize share
+// record that is no longer delegated, e.g. because its validator was removed, to the record
+// owner and removes the record. Records that are still delegated fail, their rewards are
+// withdrawn with WithdrawTokenizeShareRecordReward instead.
+func (k Keeper) SweepTokenizeShareRecord(ctx sdk.Context, ownerAddr sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Owner != ownerAddr.String() {
+		return nil, types.ErrNotTokenizeShareRecordOwner
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.stakingKeeper.Validator(ctx, valAddr) != nil && k.stakingKeeper.Delegation(ctx, record.GetModuleAddress(), valAddr) != nil {
+		return nil, errorsmod.Wrapf(stakingtypes.ErrTokenizeShareRecordStillDelegated, "record %d", recordID)
+	}
+
+	amount, err := k.payoutTokenizeShareRecordRewards(ctx, record, ownerAddr, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.stakingKeeper.RemoveUndelegatedTokenizeShareRecord(ctx, recordID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSweepTokenizeShareRecord,
+			sdk.NewAttribute(types.AttributeKeyRecordID, fmt.Sprintf("%d", recordID)),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, ownerAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return amount, nil
+}
+
+// payoutTokenizeShareRecordRewards sends the rewards held by the record's module account to
+// the owner and returns the amount sent. For compounding records that are still delegated,
+// rewards in the bond denom are delegated back to the validator instead; if that is not
+// possible, e.g. because a liquid staking cap would be exceeded, they are sent to the owner.
+func (k Keeper) payoutTokenizeShareRecordRewards(ctx sdk.Context, record stakingtypes.TokenizeShareRecord,
+	owner sdk.AccAddress, delegated bool,
+) (sdk.Coins, error) {
+	balances := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress())
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if amount := balances.AmountOf(bondDenom); record.Compound && delegated && amount.IsPositive() {
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.stakingKeeper.CompoundTokenizeShareRecordRewards(cacheCtx, record, amount); err != nil {
+			k.Logger(ctx).Error("failed to compound tokenize share record rewards",
+				"record_id", record.Id, "err", err)
+		} else {
+			write()
+			compounded := sdk.NewCoin(bondDenom, amount)
+			balances = balances.Sub(sdk.Coins{compounded})
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCompoundTokenizeShareReward,
+					sdk.NewAttribute(types.AttributeKeyRecordID, fmt.Sprintf("%d", record.Id)),
+					sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, compounded.String()),
+				),
+			)
+		}
+	}
+
+	if balances.Empty() {
+		return balances, nil
+	}
+	if err := k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), owner, balances); err != nil {
+		return nil, err
+	}
+	return balances, nil
 }
@@ -9,8 +9,11 @@ import (
 
 	simapp_test "github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -87,6 +90,109 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	require.True(t, true)
 }
 
+func TestWithdrawValidatorCommissionRecipients(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+
+	recipients := []types.CommissionRecipient{
+		types.NewCommissionRecipient(addr[1], sdk.NewDecWithPrec(7, 1)),
+		types.NewCommissionRecipient(addr[2], sdk.NewDecWithPrec(3, 1)),
+	}
+
+	// the validator must exist
+	err := app.DistrKeeper.SetCommissionRecipients(ctx, valAddrs[1], recipients)
+	require.ErrorIs(t, err, sdkdistr.ErrNoValidatorExists)
+
+	// blocked addresses cannot receive commission
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	err = app.DistrKeeper.SetCommissionRecipients(ctx, valAddrs[0], []types.CommissionRecipient{
+		types.NewCommissionRecipient(distrAcc.GetAddress(), sdk.OneDec()),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	err = app.DistrKeeper.SetCommissionRecipients(ctx, valAddrs[0], recipients)
+	require.NoError(t, err)
+	require.Equal(t, recipients, app.DistrKeeper.GetValidatorCommissionRecipients(ctx, valAddrs[0]).Recipients)
+
+	setCommission := func() {
+		valCommission := sdk.DecCoins{
+			sdk.NewDecCoinFromDec("mytoken", sdk.NewDec(3)),
+			sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(105, 1)),
+		}
+		coins := sdk.NewCoins(sdk.NewCoin("mytoken", sdk.NewInt(3)), sdk.NewCoin("stake", sdk.NewInt(11)))
+		require.NoError(t, simapp_test.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), coins))
+		app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], types.ValidatorOutstandingRewards{Rewards: valCommission})
+		app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddrs[0], types.ValidatorAccumulatedCommission{Commission: valCommission})
+	}
+	balances := func() []sdk.Coins {
+		return []sdk.Coins{
+			app.BankKeeper.GetAllBalances(ctx, addr[0]),
+			app.BankKeeper.GetAllBalances(ctx, addr[1]),
+			app.BankKeeper.GetAllBalances(ctx, addr[2]),
+		}
+	}
+
+	// the commission is split by weight, the last recipient receiving the rounding
+	setCommission()
+	before := balances()
+	commission, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("mytoken", sdk.NewInt(3)), sdk.NewCoin("stake", sdk.NewInt(10))), commission)
+
+	after := balances()
+	require.Equal(t, before[0], after[0])
+	require.Equal(t, before[1].Add(sdk.NewCoin("mytoken", sdk.NewInt(2)), sdk.NewCoin("stake", sdk.NewInt(7))), after[1])
+	require.Equal(t, before[2].Add(sdk.NewCoin("mytoken", sdk.NewInt(1)), sdk.NewCoin("stake", sdk.NewInt(3))), after[2])
+
+	// the forced withdrawal of a removed validator is split as well
+	setCommission()
+	before = balances()
+	err = app.DistrKeeper.Hooks().AfterValidatorRemoved(ctx, nil, valAddrs[0])
+	require.NoError(t, err)
+
+	after = balances()
+	require.Equal(t, before[0], after[0])
+	require.Equal(t, before[1].Add(sdk.NewCoin("mytoken", sdk.NewInt(2)), sdk.NewCoin("stake", sdk.NewInt(7))), after[1])
+	require.Equal(t, before[2].Add(sdk.NewCoin("mytoken", sdk.NewInt(1)), sdk.NewCoin("stake", sdk.NewInt(3))), after[2])
+	require.Empty(t, app.DistrKeeper.GetValidatorCommissionRecipients(ctx, valAddrs[0]).Recipients)
+}
+
+func TestSetCommissionRecipientsClear(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+
+	err := app.DistrKeeper.SetCommissionRecipients(ctx, valAddrs[0], []types.CommissionRecipient{
+		types.NewCommissionRecipient(addr[1], sdk.OneDec()),
+	})
+	require.NoError(t, err)
+
+	// an empty list sends the commission to the withdraw address of the operator again
+	err = app.DistrKeeper.SetCommissionRecipients(ctx, valAddrs[0], nil)
+	require.NoError(t, err)
+	require.Empty(t, app.DistrKeeper.GetValidatorCommissionRecipients(ctx, valAddrs[0]).Recipients)
+
+	valCommission := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDec(2))}
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, simapp_test.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2)))))
+	app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], types.ValidatorOutstandingRewards{Rewards: valCommission})
+	app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddrs[0], types.ValidatorAccumulatedCommission{Commission: valCommission})
+
+	before := app.BankKeeper.GetBalance(ctx, addr[0], "stake")
+	_, err = app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, before.AddAmount(sdk.NewInt(2)), app.BankKeeper.GetBalance(ctx, addr[0], "stake"))
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
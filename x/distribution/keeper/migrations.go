@@ -1 +1,36 @@
 package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// The params added since version 2 are set to their defaults, as reading a
+// param missing from the store panics. The existing historical rewards periods,
+// whose end times were not recorded, are indexed so that they are queried in
+// period order.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !m.keeper.paramSpace.Has(ctx, pair.Key) {
+			m.keeper.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	m.keeper.IterateValidatorHistoricalRewards(ctx, func(val sdk.ValAddress, period uint64, _ types.ValidatorHistoricalRewards) (stop bool) {
+		m.keeper.indexValidatorHistoricalRewardsPeriod(ctx, val, period)
+		return false
+	})
+	return nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.CommunityTax = sdk.NewDecWithPrec(5, 2)
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// remove the params added since version 2, as on a chain upgraded from it
+	newKeys := [][]byte{
+		types.ParamStoreKeyRestakeInterval,
+		types.ParamStoreKeyMaxRestakesPerBlock,
+		types.ParamStoreKeyLiquidStakingRewardFee,
+	}
+	subspace := app.GetSubspace(types.ModuleName)
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+	for _, key := range newKeys {
+		store.Delete(key)
+		require.False(t, subspace.Has(ctx, key))
+	}
+
+	migrator := keeper.NewMigrator(app.DistrKeeper)
+	require.NoError(t, migrator.Migrate2to3(ctx))
+
+	// the removed params are set to their defaults and the others are kept
+	defaults := types.DefaultParams()
+	params.RestakeInterval = defaults.RestakeInterval
+	params.MaxRestakesPerBlock = defaults.MaxRestakesPerBlock
+	params.LiquidStakingRewardFee = defaults.LiquidStakingRewardFee
+	require.Equal(t, params, app.DistrKeeper.GetParams(ctx))
+
+	// the begin blocker reads the migrated params
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+}
+
+func TestMigrate2to3HistoricalRewardsPeriods(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// periods recorded before their end times were, whose little endian keys are not in period order
+	valAddr := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())[0])
+	rewards := types.NewValidatorHistoricalRewards(sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1)}, 1)
+	app.DistrKeeper.SetValidatorHistoricalRewards(ctx, valAddr, 1, rewards)
+	app.DistrKeeper.SetValidatorHistoricalRewards(ctx, valAddr, 256, rewards)
+	endTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	app.DistrKeeper.SetValidatorHistoricalRewardsTime(ctx, valAddr, 257, endTime)
+	app.DistrKeeper.SetValidatorHistoricalRewards(ctx, valAddr, 257, rewards)
+
+	migrator := keeper.NewMigrator(app.DistrKeeper)
+	require.NoError(t, migrator.Migrate2to3(ctx))
+
+	// the periods are queried in period order, the recorded end time is kept
+	res, err := app.DistrKeeper.ValidatorHistoricalRewards(sdk.WrapSDKContext(ctx), &types.QueryValidatorHistoricalRewardsRequest{
+		ValidatorAddress: valAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.ValidatorHistoricalRewardsPeriod{
+		{Period: 1, Rewards: rewards},
+		{Period: 256, Rewards: rewards},
+		{Period: 257, Rewards: rewards, EndTime: &endTime},
+	}, res.HistoricalRewards)
+
+	_, found := app.DistrKeeper.GetValidatorHistoricalRewardsTime(ctx, valAddr, 1)
+	require.False(t, found)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/armon/go-metrics"
 
@@ -166,7 +167,7 @@ func (k msgServer) WithdrawAllTokenizeShareRecordReward(goCtx context.Context, m
 	if err != nil {
 		return nil, err
 	}
-	amount, err := k.Keeper.WithdrawAllTokenizeShareRecordReward(ctx, ownerAddr)
+	amount, results, nextRecordID, err := k.Keeper.WithdrawAllTokenizeShareRecordReward(ctx, ownerAddr, msg.RecordIds, msg.StartRecordId, msg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -191,7 +192,95 @@ func (k msgServer) WithdrawAllTokenizeShareRecordReward(goCtx context.Context, m
 		),
 	)
 
-	return &types.MsgWithdrawAllTokenizeShareRecordRewardResponse{}, nil
+	return &types.MsgWithdrawAllTokenizeShareRecordRewardResponse{
+		Results:      results,
+		NextRecordId: nextRecordID,
+	}, nil
+}
+
+// SetAutoRestake defines a method to enable or disable the automatic restaking of the rewards of a delegation
+func (k msgServer) SetAutoRestake(goCtx context.Context, msg *types.MsgSetAutoRestake) (*types.MsgSetAutoRestakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.SetAutoRestake(ctx, delegatorAddress, valAddr, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgSetAutoRestakeResponse{}, nil
+}
+
+// SetCommissionRecipients defines a method to set the recipients the commission of a validator is split across
+func (k msgServer) SetCommissionRecipients(goCtx context.Context, msg *types.MsgSetCommissionRecipients) (*types.MsgSetCommissionRecipientsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.SetCommissionRecipients(ctx, valAddr, msg.Recipients); err != nil {
+		return nil, err
+	}
+
+	attrs := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress)}
+	for _, recipient := range msg.Recipients {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRecipient, recipient.Address))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeSetCommissionRecipients, attrs...),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(valAddr).String()),
+		),
+	})
+
+	return &types.MsgSetCommissionRecipientsResponse{}, nil
+}
+
+func (k msgServer) SweepTokenizeShareRecord(goCtx context.Context, msg *types.MsgSweepTokenizeShareRecord) (*types.MsgSweepTokenizeShareRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := k.Keeper.SweepTokenizeShareRecord(ctx, ownerAddr, msg.RecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress),
+		),
+	)
+
+	return &types.MsgSweepTokenizeShareRecordResponse{Amount: amount}, nil
 }
 
 func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundCommunityPool) (*types.MsgFundCommunityPoolResponse, error) {
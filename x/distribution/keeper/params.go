@@ -41,3 +41,24 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetRestakeInterval returns the number of blocks between the starts of auto
+// restake passes.
+func (k Keeper) GetRestakeInterval(ctx sdk.Context) (interval int64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyRestakeInterval, &interval)
+	return interval
+}
+
+// GetMaxRestakesPerBlock returns the maximum number of auto restakes executed
+// in a single block.
+func (k Keeper) GetMaxRestakesPerBlock(ctx sdk.Context) (maxRestakes uint32) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxRestakesPerBlock, &maxRestakes)
+	return maxRestakes
+}
+
+// GetLiquidStakingRewardFee returns the fraction of the rewards withdrawn by
+// liquid staking providers and tokenize share records sent to the community pool.
+func (k Keeper) GetLiquidStakingRewardFee(ctx sdk.Context) (percent sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyLiquidStakingRewardFee, &percent)
+	return percent
+}
@@ -28,3 +28,28 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p *types.Commun
 
 	return nil
 }
+
+// HandleCommunityPoolStreamProposal is a handler for executing a passed community pool stream proposal
+func HandleCommunityPoolStreamProposal(ctx sdk.Context, k Keeper, p *types.CommunityPoolStreamProposal) error {
+	id, err := k.CreateCommunityPoolStream(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("created community pool stream", "id", id, "amount", p.Amount.String(), "recipient", p.Recipient)
+
+	return nil
+}
+
+// HandleCancelCommunityPoolStreamProposal is a handler for executing a passed community pool stream cancellation proposal
+func HandleCancelCommunityPoolStreamProposal(ctx sdk.Context, k Keeper, p *types.CancelCommunityPoolStreamProposal) error {
+	if err := k.CancelCommunityPoolStream(ctx, p.StreamId); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("cancelled community pool stream", "id", p.StreamId)
+
+	return nil
+}
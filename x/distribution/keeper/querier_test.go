@@ -130,10 +130,13 @@ func TestQueries(t *testing.T) {
 
 	// test param queries
 	params := types.Params{
-		CommunityTax:        sdk.NewDecWithPrec(3, 1),
-		BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
-		BonusProposerReward: sdk.NewDecWithPrec(1, 1),
-		WithdrawAddrEnabled: true,
+		CommunityTax:           sdk.NewDecWithPrec(3, 1),
+		BaseProposerReward:     sdk.NewDecWithPrec(2, 1),
+		BonusProposerReward:    sdk.NewDecWithPrec(1, 1),
+		WithdrawAddrEnabled:    true,
+		RestakeInterval:        500,
+		MaxRestakesPerBlock:    20,
+		LiquidStakingRewardFee: sdk.NewDecWithPrec(5, 2),
 	}
 
 	app.DistrKeeper.SetParams(ctx, params)
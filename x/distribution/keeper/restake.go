@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+// SetAutoRestake enables or disables the automatic restaking of the rewards of a delegation.
+// Rewards can only be restaked from the delegator's account, so auto restake can only be
+// enabled while the delegator withdraws its rewards to itself.
+func (k Keeper) SetAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	if !enabled {
+		if !k.HasDelegatorAutoRestake(ctx, delAddr, valAddr) {
+			return types.ErrAutoRestakeNotEnabled
+		}
+		k.DeleteDelegatorAutoRestake(ctx, delAddr, valAddr)
+		return nil
+	}
+
+	if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+		return sdkdistr.ErrNoDelegationExists
+	}
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return types.ErrAutoRestakeWithdrawAddr
+	}
+
+	k.SetDelegatorAutoRestake(ctx, delAddr, valAddr)
+	return nil
+}
+
+// ProcessAutoRestakes restakes the rewards of the delegations with auto restake enabled.
+// A pass over all of them starts every RestakeInterval blocks, and at most
+// MaxRestakesPerBlock are restaked per block; a pass that does not fit in a single
+// block resumes from the cursor in the following blocks.
+func (k Keeper) ProcessAutoRestakes(ctx sdk.Context) {
+	interval := k.GetRestakeInterval(ctx)
+	if interval <= 0 {
+		k.DeleteAutoRestakeCursor(ctx)
+		return
+	}
+
+	start := k.GetAutoRestakeCursor(ctx)
+	if start == nil {
+		if ctx.BlockHeight()%interval != 0 {
+			return
+		}
+		start = types.DelegatorAutoRestakePrefix
+	}
+
+	// collect the batch first, as restaking writes to the store being iterated
+	maxRestakes := k.GetMaxRestakesPerBlock(ctx)
+	var batch [][]byte
+	var next []byte
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.DelegatorAutoRestakePrefix))
+	for ; iter.Valid(); iter.Next() {
+		if uint32(len(batch)) == maxRestakes {
+			next = iter.Key()
+			break
+		}
+		batch = append(batch, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range batch {
+		delAddr, valAddr := types.GetDelegatorAutoRestakeAddresses(key)
+
+		cacheCtx, write := ctx.CacheContext()
+		amount, err := k.autoRestake(cacheCtx, delAddr, valAddr)
+		if err != nil {
+			k.Logger(ctx).Error("failed to auto restake rewards",
+				"delegator", delAddr.String(), "validator", valAddr.String(), "err", err)
+			continue
+		}
+		write()
+
+		if amount.IsPositive() {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeAutoRestake,
+					sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), amount).String()),
+				),
+			)
+		}
+	}
+
+	if next == nil {
+		k.DeleteAutoRestakeCursor(ctx)
+	} else {
+		k.SetAutoRestakeCursor(ctx, next)
+	}
+}
+
+// autoRestake withdraws the rewards of a delegation and delegates those in the bond denom
+// back to the validator, returning the amount restaked. Delegations that no longer exist
+// are removed from auto restaking, and those whose rewards are withdrawn to another
+// address are skipped.
+func (k Keeper) autoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Int, error) {
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if val == nil || del == nil {
+		k.DeleteDelegatorAutoRestake(ctx, delAddr, valAddr)
+		return sdk.ZeroInt(), nil
+	}
+
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return sdk.ZeroInt(), nil
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	amount := rewards.AmountOf(k.stakingKeeper.BondDenom(ctx))
+	if !amount.IsPositive() {
+		return amount, nil
+	}
+
+	if _, err := k.stakingKeeper.DelegateTokens(ctx, delAddr, valAddr, amount); err != nil {
+		return sdk.ZeroInt(), err
+	}
+	return amount, nil
+}
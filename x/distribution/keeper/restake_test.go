@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+)
+
+func TestSetAutoRestake(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// auto restake cannot be enabled without a delegation
+	err := app.DistrKeeper.SetAutoRestake(ctx, addr[1], valAddrs[0], true)
+	require.ErrorIs(t, err, sdkdistr.ErrNoDelegationExists)
+
+	// nor while rewards are withdrawn to another address
+	tstaking.Delegate(addr[1], valAddrs[0], sdk.NewInt(1000000))
+	err = app.DistrKeeper.SetWithdrawAddr(ctx, addr[1], addr[2])
+	require.NoError(t, err)
+	err = app.DistrKeeper.SetAutoRestake(ctx, addr[1], valAddrs[0], true)
+	require.ErrorIs(t, err, types.ErrAutoRestakeWithdrawAddr)
+
+	err = app.DistrKeeper.SetWithdrawAddr(ctx, addr[1], addr[1])
+	require.NoError(t, err)
+	err = app.DistrKeeper.SetAutoRestake(ctx, addr[1], valAddrs[0], true)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.ValAddress{valAddrs[0]}, app.DistrKeeper.GetDelegatorAutoRestakes(ctx, addr[1]))
+
+	// disabling removes the entry, and fails once it is gone
+	err = app.DistrKeeper.SetAutoRestake(ctx, addr[1], valAddrs[0], false)
+	require.NoError(t, err)
+	require.Empty(t, app.DistrKeeper.GetDelegatorAutoRestakes(ctx, addr[1]))
+	err = app.DistrKeeper.SetAutoRestake(ctx, addr[1], valAddrs[0], false)
+	require.ErrorIs(t, err, types.ErrAutoRestakeNotEnabled)
+
+	// removing the delegation removes the entry
+	err = app.DistrKeeper.SetAutoRestake(ctx, addr[1], valAddrs[0], true)
+	require.NoError(t, err)
+	tstaking.Undelegate(addr[1], valAddrs[0], sdk.NewInt(1000000), true)
+	require.False(t, app.DistrKeeper.HasDelegatorAutoRestake(ctx, addr[1], valAddrs[0]))
+}
+
+func TestProcessAutoRestakes(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.Delegate(addr[1], valAddrs[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	tstaking.Delegate(addr[2], valAddrs[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	for _, del := range addr[1:] {
+		err := app.DistrKeeper.SetAutoRestake(ctx, del, valAddrs[0], true)
+		require.NoError(t, err)
+	}
+
+	// restake every 10 blocks, one delegation per block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.RestakeInterval = 10
+	params.MaxRestakesPerBlock = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial)}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial)}
+	err := app.MintKeeper.MintCoins(ctx, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, coins)
+	require.NoError(t, err)
+
+	delegated := func(del sdk.AccAddress) sdk.Int {
+		validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddrs[0])
+		require.True(t, found)
+		delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, del, valAddrs[0])
+		require.True(t, found)
+		return validator.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+	before := []sdk.Int{delegated(addr[1]), delegated(addr[2])}
+	reward := initial.QuoRaw(3)
+
+	// nothing happens outside of the interval
+	ctx = ctx.WithBlockHeight(9)
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Nil(t, app.DistrKeeper.GetAutoRestakeCursor(ctx))
+	require.Equal(t, before, []sdk.Int{delegated(addr[1]), delegated(addr[2])})
+
+	// the pass starts with the first delegation and stores a cursor for the next
+	ctx = ctx.WithBlockHeight(10)
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.NotNil(t, app.DistrKeeper.GetAutoRestakeCursor(ctx))
+	restaked := 0
+	for i, del := range addr[1:] {
+		if delegated(del).Equal(before[i].Add(reward)) {
+			restaked++
+		}
+	}
+	require.Equal(t, 1, restaked)
+
+	// and resumes in the following block
+	ctx = ctx.WithBlockHeight(11)
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Nil(t, app.DistrKeeper.GetAutoRestakeCursor(ctx))
+	for i, del := range addr[1:] {
+		require.Equal(t, before[i].Add(reward), delegated(del))
+	}
+
+	// rewards withdrawn to another address are not restaked
+	err = app.DistrKeeper.SetWithdrawAddr(ctx, addr[1], addr[0])
+	require.NoError(t, err)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	err = app.MintKeeper.MintCoins(ctx, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, coins)
+	require.NoError(t, err)
+
+	params.MaxRestakesPerBlock = 10
+	app.DistrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(20)
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Nil(t, app.DistrKeeper.GetAutoRestakeCursor(ctx))
+	require.Equal(t, before[0].Add(reward), delegated(addr[1]))
+	require.True(t, delegated(addr[2]).GT(before[1].Add(reward)))
+}
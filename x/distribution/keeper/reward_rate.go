@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+// rewardRateYear is the length of the year validator reward rates are annualized over
+const rewardRateYear = 365 * 24 * time.Hour
+
+// EstimateValidatorRewardRate estimates the annualized rewards per token delegated to a
+// validator over the window ending at the block time, from the growth of its
+// cumulative reward ratio. The ratio only accrues the rewards left after the
+// commission, so the rate is net of commission.
+//
+// The window starts at the end of the latest historical rewards period ended
+// before the start of the requested window, or of the earliest one still stored
+// if the older periods were pruned, and the window measured is returned.
+func (k Keeper) EstimateValidatorRewardRate(ctx sdk.Context, valAddr sdk.ValAddress, window time.Duration) (rate sdk.DecCoins, startTime, endTime time.Time, err error) {
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	if val == nil {
+		return nil, startTime, endTime, sdkdistr.ErrNoValidatorExists
+	}
+
+	// cumulative reward ratio as of the block time, including the current period
+	current := k.GetValidatorCurrentRewards(ctx, valAddr)
+	endRatio := k.GetValidatorHistoricalRewards(ctx, valAddr, current.Period-1).CumulativeRewardRatio
+	if val.GetTokens().IsPositive() {
+		endRatio = endRatio.Add(current.Rewards.QuoDecTruncate(sdk.NewDecFromInt(val.GetTokens()))...)
+	}
+	endTime = ctx.BlockTime()
+
+	// find the period the window starts at, the periods are iterated in order
+	windowStart := endTime.Add(-window)
+	var startPeriod uint64
+	found := false
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetValidatorHistoricalRewardsTimePrefix(valAddr))
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		// skip the periods whose end time is unknown
+		if len(iter.Value()) == 0 {
+			continue
+		}
+		t, err := sdk.ParseTimeBytes(iter.Value())
+		if err != nil {
+			panic(err)
+		}
+		if found && t.After(windowStart) {
+			break
+		}
+		startPeriod, startTime, found = binary.BigEndian.Uint64(iter.Key()), t, true
+	}
+	iter.Close()
+
+	if !found || !endTime.After(startTime) {
+		return sdk.DecCoins{}, endTime, endTime, nil
+	}
+
+	earned := endRatio.Sub(k.GetValidatorHistoricalRewards(ctx, valAddr, startPeriod).CumulativeRewardRatio)
+	scale := sdk.NewDec(int64(rewardRateYear)).QuoInt64(int64(endTime.Sub(startTime)))
+
+	return earned.MulDecTruncate(scale), startTime, endTime, nil
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestEstimateValidatorRewardRate(t *testing.T) {
+	app := simapp.Setup(t, false)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: start})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	_, _, _, err := app.DistrKeeper.EstimateValidatorRewardRate(ctx, valAddrs[0], 24*time.Hour)
+	require.ErrorIs(t, err, sdkdistr.ErrNoValidatorExists)
+
+	// create validator with 50% commission and 100 tokens
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// no rewards history yet
+	rate, startTime, endTime, err := app.DistrKeeper.EstimateValidatorRewardRate(ctx, valAddrs[0], 24*time.Hour)
+	require.NoError(t, err)
+	require.True(t, rate.IsZero())
+	require.Equal(t, start, startTime)
+	require.Equal(t, start, endTime)
+
+	// 10 of the 20 tokens allocated a day go to the delegators, 0.1 per token a day
+	// or 36.5 a year
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	ctx = ctx.WithBlockTime(start.Add(24 * time.Hour))
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 20)))
+
+	// the window starts at the earliest period stored when the history is shorter
+	rate, startTime, endTime, err = app.DistrKeeper.EstimateValidatorRewardRate(ctx, valAddrs[0], 7*24*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(365, 1))), rate)
+	require.Equal(t, start, startTime)
+	require.Equal(t, start.Add(24*time.Hour), endTime)
+
+	// the rewards of the ended periods are accounted the same way
+	app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+	ctx = ctx.WithBlockTime(start.Add(48 * time.Hour))
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 40)))
+
+	// 0.2 per token over the last day
+	rate, startTime, _, err = app.DistrKeeper.EstimateValidatorRewardRate(ctx, valAddrs[0], 24*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(73))), rate)
+	require.Equal(t, start.Add(24*time.Hour), startTime)
+
+	// 0.3 per token over both days
+	rate, startTime, _, err = app.DistrKeeper.EstimateValidatorRewardRate(ctx, valAddrs[0], 48*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5475, 2))), rate)
+	require.Equal(t, start, startTime)
+
+	// removing the validator removes its rewards history
+	app.DistrKeeper.DeleteValidatorHistoricalRewards(ctx, valAddrs[0])
+	app.DistrKeeper.IterateValidatorHistoricalRewardsTimes(ctx, func(val sdk.ValAddress, _ uint64, _ time.Time) (stop bool) {
+		require.NotEqual(t, valAddrs[0], val)
+		return false
+	})
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -154,29 +156,82 @@ func (k Keeper) IterateValidatorHistoricalRewards(ctx sdk.Context, handler func(
 	}
 }
 
-// delete a historical reward
+// delete a historical reward, along with the end time of its period
 func (k Keeper) DeleteValidatorHistoricalReward(ctx sdk.Context, val sdk.ValAddress, period uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetValidatorHistoricalRewardsKey(val, period))
+	store.Delete(types.GetValidatorHistoricalRewardsTimeKey(val, period))
 }
 
-// delete historical rewards for a validator
+// delete historical rewards for a validator, along with the end times of their periods
 func (k Keeper) DeleteValidatorHistoricalRewards(ctx sdk.Context, val sdk.ValAddress) {
+	k.deletePrefix(ctx, types.GetValidatorHistoricalRewardsPrefix(val))
+	k.deletePrefix(ctx, types.GetValidatorHistoricalRewardsTimePrefix(val))
+}
+
+// delete all historical rewards, along with the end times of their periods
+func (k Keeper) DeleteAllValidatorHistoricalRewards(ctx sdk.Context) {
+	k.deletePrefix(ctx, types.ValidatorHistoricalRewardsPrefix)
+	k.deletePrefix(ctx, types.ValidatorHistoricalRewardsTimePrefix)
+}
+
+// delete all the entries under a prefix
+func (k Keeper) deletePrefix(ctx sdk.Context, prefix []byte) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorHistoricalRewardsPrefix(val))
+	iter := sdk.KVStorePrefixIterator(store, prefix)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		store.Delete(iter.Key())
 	}
 }
 
-// delete all historical rewards
-func (k Keeper) DeleteAllValidatorHistoricalRewards(ctx sdk.Context) {
+// get the block time a historical rewards period ended at
+func (k Keeper) GetValidatorHistoricalRewardsTime(ctx sdk.Context, val sdk.ValAddress, period uint64) (t time.Time, found bool) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.ValidatorHistoricalRewardsPrefix)
+	b := store.Get(types.GetValidatorHistoricalRewardsTimeKey(val, period))
+	if len(b) == 0 {
+		return t, false
+	}
+	t, err := sdk.ParseTimeBytes(b)
+	if err != nil {
+		panic(err)
+	}
+	return t, true
+}
+
+// set the block time a historical rewards period ended at
+func (k Keeper) SetValidatorHistoricalRewardsTime(ctx sdk.Context, val sdk.ValAddress, period uint64, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorHistoricalRewardsTimeKey(val, period), sdk.FormatTimeBytes(t))
+}
+
+// index a historical rewards period whose end time is unknown, so that it is still
+// iterated in period order; the period keeps its end time if it has one
+func (k Keeper) indexValidatorHistoricalRewardsPeriod(ctx sdk.Context, val sdk.ValAddress, period uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorHistoricalRewardsTimeKey(val, period)
+	if !store.Has(key) {
+		store.Set(key, []byte{})
+	}
+}
+
+// iterate over the end times of the historical rewards periods, ordered by period for each validator
+func (k Keeper) IterateValidatorHistoricalRewardsTimes(ctx sdk.Context, handler func(val sdk.ValAddress, period uint64, t time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorHistoricalRewardsTimePrefix)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
-		store.Delete(iter.Key())
+		if len(iter.Value()) == 0 {
+			continue
+		}
+		t, err := sdk.ParseTimeBytes(iter.Value())
+		if err != nil {
+			panic(err)
+		}
+		addr, period := types.GetValidatorHistoricalRewardsTimeAddressPeriod(iter.Key())
+		if handler(addr, period, t) {
+			break
+		}
 	}
 }
 
@@ -383,3 +438,179 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// set auto restake for a delegation
+func (k Keeper) SetDelegatorAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegatorAutoRestakeKey(delAddr, valAddr), []byte{})
+}
+
+// check whether auto restake is enabled for a delegation
+func (k Keeper) HasDelegatorAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDelegatorAutoRestakeKey(delAddr, valAddr))
+}
+
+// delete auto restake for a delegation
+func (k Keeper) DeleteDelegatorAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorAutoRestakeKey(delAddr, valAddr))
+}
+
+// iterate over the delegations with auto restake enabled
+func (k Keeper) IterateDelegatorAutoRestakes(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorAutoRestakePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del, val := types.GetDelegatorAutoRestakeAddresses(iter.Key())
+		if handler(del, val) {
+			break
+		}
+	}
+}
+
+// get the validators a delegator has auto restake enabled for
+func (k Keeper) GetDelegatorAutoRestakes(ctx sdk.Context, delAddr sdk.AccAddress) (validators []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorAutoRestakePrefix(delAddr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, val := types.GetDelegatorAutoRestakeAddresses(iter.Key())
+		validators = append(validators, val)
+	}
+	return validators
+}
+
+// get the auto restake key the unfinished auto restake pass resumes from
+func (k Keeper) GetAutoRestakeCursor(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.AutoRestakeCursorKey)
+}
+
+// set the auto restake key the unfinished auto restake pass resumes from
+func (k Keeper) SetAutoRestakeCursor(ctx sdk.Context, key []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoRestakeCursorKey, key)
+}
+
+// delete the auto restake cursor, once the auto restake pass is finished
+func (k Keeper) DeleteAutoRestakeCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoRestakeCursorKey)
+}
+
+// get the commission recipients of a validator
+func (k Keeper) GetValidatorCommissionRecipients(ctx sdk.Context, val sdk.ValAddress) (recipients types.ValidatorCommissionRecipients) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorCommissionRecipientsKey(val))
+	if b == nil {
+		return types.ValidatorCommissionRecipients{}
+	}
+	k.cdc.MustUnmarshal(b, &recipients)
+	return
+}
+
+// set the commission recipients of a validator
+func (k Keeper) SetValidatorCommissionRecipients(ctx sdk.Context, val sdk.ValAddress, recipients types.ValidatorCommissionRecipients) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&recipients)
+	store.Set(types.GetValidatorCommissionRecipientsKey(val), b)
+}
+
+// delete the commission recipients of a validator
+func (k Keeper) DeleteValidatorCommissionRecipients(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorCommissionRecipientsKey(val))
+}
+
+// iterate over the commission recipients of the validators
+func (k Keeper) IterateValidatorCommissionRecipients(ctx sdk.Context, handler func(val sdk.ValAddress, recipients types.ValidatorCommissionRecipients) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorCommissionRecipientsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var recipients types.ValidatorCommissionRecipients
+		k.cdc.MustUnmarshal(iter.Value(), &recipients)
+		val := types.GetValidatorCommissionRecipientsAddress(iter.Key())
+		if handler(val, recipients) {
+			break
+		}
+	}
+}
+
+// get the cumulative liquid staking reward fees sent to the community pool
+func (k Keeper) GetLiquidStakingFeesCollected(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.LiquidStakingFeesCollectedKey)
+	if b == nil {
+		return sdk.Coins{}
+	}
+	var fees types.LiquidStakingFeesCollected
+	k.cdc.MustUnmarshal(b, &fees)
+	return fees.Fees
+}
+
+// set the cumulative liquid staking reward fees sent to the community pool
+func (k Keeper) SetLiquidStakingFeesCollected(ctx sdk.Context, fees sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&types.LiquidStakingFeesCollected{Fees: fees})
+	store.Set(types.LiquidStakingFeesCollectedKey, b)
+}
+
+// get a community pool stream
+func (k Keeper) GetCommunityPoolStream(ctx sdk.Context, id uint64) (stream types.CommunityPoolStream, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetCommunityPoolStreamKey(id))
+	if b == nil {
+		return stream, false
+	}
+	k.cdc.MustUnmarshal(b, &stream)
+	return stream, true
+}
+
+// set a community pool stream
+func (k Keeper) SetCommunityPoolStream(ctx sdk.Context, stream types.CommunityPoolStream) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&stream)
+	store.Set(types.GetCommunityPoolStreamKey(stream.Id), b)
+}
+
+// delete a community pool stream
+func (k Keeper) DeleteCommunityPoolStream(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCommunityPoolStreamKey(id))
+}
+
+// iterate over the community pool streams, ordered by id
+func (k Keeper) IterateCommunityPoolStreams(ctx sdk.Context, handler func(stream types.CommunityPoolStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.CommunityPoolStreamPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.CommunityPoolStream
+		k.cdc.MustUnmarshal(iter.Value(), &stream)
+		if handler(stream) {
+			break
+		}
+	}
+}
+
+// get the id of the last community pool stream created
+func (k Keeper) GetLastCommunityPoolStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.LastCommunityPoolStreamIDKey)
+	if b == nil {
+		return 0
+	}
+	var id gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(b, &id)
+	return id.Value
+}
+
+// set the id of the last community pool stream created
+func (k Keeper) SetLastCommunityPoolStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.LastCommunityPoolStreamIDKey, b)
+}
@@ -13,6 +13,7 @@ import (
 func (k Keeper) initializeValidator(ctx sdk.Context, val sdkstaking.ValidatorI) {
 	// set initial historical rewards (period 0) with reference count of 1
 	k.SetValidatorHistoricalRewards(ctx, val.GetOperator(), 0, types.NewValidatorHistoricalRewards(sdk.DecCoins{}, 1))
+	k.SetValidatorHistoricalRewardsTime(ctx, val.GetOperator(), 0, ctx.BlockTime())
 
 	// set current rewards (starting at period 1)
 	k.SetValidatorCurrentRewards(ctx, val.GetOperator(), types.NewValidatorCurrentRewards(sdk.DecCoins{}, 1))
@@ -56,6 +57,7 @@ func (k Keeper) IncrementValidatorPeriod(ctx sdk.Context, val sdkstaking.Validat
 
 	// set new historical rewards with reference count of 1
 	k.SetValidatorHistoricalRewards(ctx, val.GetOperator(), rewards.Period, types.NewValidatorHistoricalRewards(historical.Add(current...), 1))
+	k.SetValidatorHistoricalRewardsTime(ctx, val.GetOperator(), rewards.Period, ctx.BlockTime())
 
 	// set current rewards, incrementing period by 1
 	k.SetValidatorCurrentRewards(ctx, val.GetOperator(), types.NewValidatorCurrentRewards(sdk.DecCoins{}, rewards.Period+1))
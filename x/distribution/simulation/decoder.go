@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
@@ -63,6 +65,49 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.DelegatorAutoRestakePrefix):
+			delAddrA, valAddrA := types.GetDelegatorAutoRestakeAddresses(kvA.Key)
+			delAddrB, valAddrB := types.GetDelegatorAutoRestakeAddresses(kvB.Key)
+			return fmt.Sprintf("%v %v\n%v %v", delAddrA, valAddrA, delAddrB, valAddrB)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoRestakeCursorKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorCommissionRecipientsPrefix):
+			var recipientsA, recipientsB types.ValidatorCommissionRecipients
+			cdc.MustUnmarshal(kvA.Value, &recipientsA)
+			cdc.MustUnmarshal(kvB.Value, &recipientsB)
+			return fmt.Sprintf("%v\n%v", recipientsA, recipientsB)
+
+		case bytes.Equal(kvA.Key[:1], types.LiquidStakingFeesCollectedKey):
+			var feesA, feesB types.LiquidStakingFeesCollected
+			cdc.MustUnmarshal(kvA.Value, &feesA)
+			cdc.MustUnmarshal(kvB.Value, &feesB)
+			return fmt.Sprintf("%v\n%v", feesA.Fees, feesB.Fees)
+
+		case bytes.Equal(kvA.Key[:1], types.CommunityPoolStreamPrefix):
+			var streamA, streamB types.CommunityPoolStream
+			cdc.MustUnmarshal(kvA.Value, &streamA)
+			cdc.MustUnmarshal(kvB.Value, &streamB)
+			return fmt.Sprintf("%v\n%v", streamA, streamB)
+
+		case bytes.Equal(kvA.Key[:1], types.LastCommunityPoolStreamIDKey):
+			var idA, idB gogotypes.UInt64Value
+			cdc.MustUnmarshal(kvA.Value, &idA)
+			cdc.MustUnmarshal(kvB.Value, &idB)
+			return fmt.Sprintf("%v\n%v", idA.Value, idB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorHistoricalRewardsTimePrefix):
+			timeA, err := sdk.ParseTimeBytes(kvA.Value)
+			if err != nil {
+				panic(err)
+			}
+			timeB, err := sdk.ParseTimeBytes(kvB.Value)
+			if err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", timeA, timeB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
@@ -3,7 +3,9 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -34,6 +36,11 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
+	recipients := types.ValidatorCommissionRecipients{Recipients: []types.CommissionRecipient{types.NewCommissionRecipient(delAddr1, sdk.OneDec())}}
+	fees := types.LiquidStakingFeesCollected{Fees: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))}
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	stream := types.NewCommunityPoolStream(1, delAddr1, fees.Fees, startTime, startTime.Add(time.Hour), time.Minute)
+	lastStreamID := gogotypes.UInt64Value{Value: 1}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -46,6 +53,11 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshal(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshal(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshal(&slashEvent)},
+			{Key: types.GetValidatorCommissionRecipientsKey(valAddr1), Value: cdc.MustMarshal(&recipients)},
+			{Key: types.LiquidStakingFeesCollectedKey, Value: cdc.MustMarshal(&fees)},
+			{Key: types.GetCommunityPoolStreamKey(1), Value: cdc.MustMarshal(&stream)},
+			{Key: types.LastCommunityPoolStreamIDKey, Value: cdc.MustMarshal(&lastStreamID)},
+			{Key: types.GetValidatorHistoricalRewardsTimeKey(valAddr1, 100), Value: sdk.FormatTimeBytes(startTime)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -63,6 +75,11 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"ValidatorCommissionRecipients", fmt.Sprintf("%v\n%v", recipients, recipients)},
+		{"LiquidStakingFeesCollected", fmt.Sprintf("%v\n%v", fees.Fees, fees.Fees)},
+		{"CommunityPoolStream", fmt.Sprintf("%v\n%v", stream, stream)},
+		{"LastCommunityPoolStreamID", fmt.Sprintf("%v\n%v", lastStreamID.Value, lastStreamID.Value)},
+		{"ValidatorHistoricalRewardsTime", fmt.Sprintf("%v\n%v", startTime, startTime)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
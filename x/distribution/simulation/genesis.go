@@ -9,15 +9,19 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 )
 
 // Simulation parameter constants
 const (
-	CommunityTax        = "community_tax"
-	BaseProposerReward  = "base_proposer_reward"
-	BonusProposerReward = "bonus_proposer_reward"
-	WithdrawEnabled     = "withdraw_enabled"
+	CommunityTax           = "community_tax"
+	BaseProposerReward     = "base_proposer_reward"
+	BonusProposerReward    = "bonus_proposer_reward"
+	WithdrawEnabled        = "withdraw_enabled"
+	RestakeInterval        = "restake_interval"
+	MaxRestakesPerBlock    = "max_restakes_per_block"
+	LiquidStakingRewardFee = "liquid_staking_reward_fee"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +44,21 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenRestakeInterval returns a randomized RestakeInterval parameter.
+func GenRestakeInterval(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// GenMaxRestakesPerBlock returns a randomized MaxRestakesPerBlock parameter.
+func GenMaxRestakesPerBlock(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 50))
+}
+
+// GenLiquidStakingRewardFee randomized LiquidStakingRewardFee
+func GenLiquidStakingRewardFee(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(11)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,13 +85,34 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var restakeInterval int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RestakeInterval, &restakeInterval, simState.Rand,
+		func(r *rand.Rand) { restakeInterval = GenRestakeInterval(r) },
+	)
+
+	var maxRestakesPerBlock uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxRestakesPerBlock, &maxRestakesPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxRestakesPerBlock = GenMaxRestakesPerBlock(r) },
+	)
+
+	var liquidStakingRewardFee sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, LiquidStakingRewardFee, &liquidStakingRewardFee, simState.Rand,
+		func(r *rand.Rand) { liquidStakingRewardFee = GenLiquidStakingRewardFee(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			BaseProposerReward:  baseProposerReward,
-			BonusProposerReward: bonusProposerReward,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:           communityTax,
+			BaseProposerReward:     baseProposerReward,
+			BonusProposerReward:    bonusProposerReward,
+			WithdrawAddrEnabled:    withdrawEnabled,
+			RestakeInterval:        restakeInterval,
+			MaxRestakesPerBlock:    maxRestakesPerBlock,
+			LiquidStakingRewardFee: liquidStakingRewardFee,
 		},
 	}
 
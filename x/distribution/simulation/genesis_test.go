@@ -42,11 +42,15 @@ func TestRandomizedGenState(t *testing.T) {
 	dec1, _ := sdk.NewDecFromStr("0.170000000000000000")
 	dec2, _ := sdk.NewDecFromStr("0.010000000000000000")
 	dec3, _ := sdk.NewDecFromStr("0.210000000000000000")
+	dec4, _ := sdk.NewDecFromStr("0.000000000000000000")
 
 	require.Equal(t, dec1, distrGenesis.Params.BaseProposerReward)
 	require.Equal(t, dec2, distrGenesis.Params.BonusProposerReward)
 	require.Equal(t, dec3, distrGenesis.Params.CommunityTax)
 	require.Equal(t, true, distrGenesis.Params.WithdrawAddrEnabled)
+	require.Equal(t, int64(89), distrGenesis.Params.RestakeInterval)
+	require.Equal(t, uint32(33), distrGenesis.Params.MaxRestakesPerBlock)
+	require.Equal(t, dec4, distrGenesis.Params.LiquidStakingRewardFee)
 	require.Len(t, distrGenesis.DelegatorStartingInfos, 0)
 	require.Len(t, distrGenesis.DelegatorWithdrawInfos, 0)
 	require.Len(t, distrGenesis.ValidatorSlashEvents, 0)
@@ -285,7 +285,7 @@ func SimulateMsgWithdrawTokenizeShareRecordReward(ak types.AccountKeeper, bk typ
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawTokenizeShareRecordReward, "account private key is nil"), nil, nil
 		}
 
-		msg := types.NewMsgWithdrawAllTokenizeShareRecordReward(rewardOwner.Address)
+		msg := types.NewMsgWithdrawAllTokenizeShareRecordReward(rewardOwner.Address, nil, 0, 0)
 
 		account := ak.GetAccount(ctx, rewardOwner.Address)
 		spendable := bk.SpendableCoins(ctx, account.GetAddress())
@@ -19,6 +19,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// cdc.RegisterConcrete(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
 	cdc.RegisterConcrete(&MsgWithdrawTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawTokenizeShareRecordReward", nil)
 	cdc.RegisterConcrete(&MsgWithdrawAllTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawAllTokenizeShareRecordReward", nil)
+	cdc.RegisterConcrete(&MsgSetAutoRestake{}, "cosmos-sdk/MsgSetAutoRestake", nil)
+	cdc.RegisterConcrete(&MsgSetCommissionRecipients{}, "cosmos-sdk/MsgSetCommissionRecipients", nil)
+	cdc.RegisterConcrete(&MsgSweepTokenizeShareRecord{}, "cosmos-sdk/MsgSweepTokenizeShareRecord", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -30,10 +33,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgFundCommunityPool{},
 		&MsgWithdrawTokenizeShareRecordReward{},
 		&MsgWithdrawAllTokenizeShareRecordReward{},
+		&MsgSetAutoRestake{},
+		&MsgSetCommissionRecipients{},
+		&MsgSweepTokenizeShareRecord{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&CommunityPoolSpendProposal{},
+		&CommunityPoolStreamProposal{},
+		&CancelCommunityPoolStreamProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCommunityPoolStream creates a new community pool stream, its first installment
+// is released one cadence after the start time.
+func NewCommunityPoolStream(id uint64, recipient sdk.AccAddress, amount sdk.Coins,
+	startTime, endTime time.Time, cadence time.Duration,
+) CommunityPoolStream {
+	stream := CommunityPoolStream{
+		Id:        id,
+		Recipient: recipient.String(),
+		Amount:    amount,
+		Paid:      sdk.Coins{},
+		StartTime: startTime,
+		EndTime:   endTime,
+		Cadence:   cadence,
+	}
+	stream.NextPaymentTime = stream.NextPaymentTimeAfter(startTime)
+	return stream
+}
+
+// ValidateCommunityPoolStreamSchedule validates the schedule of a community pool stream.
+func ValidateCommunityPoolStreamSchedule(startTime, endTime time.Time, cadence time.Duration) error {
+	if !endTime.After(startTime) {
+		return errorsmod.Wrap(ErrInvalidCommunityPoolStream, "end time must be after start time")
+	}
+	if cadence <= 0 {
+		return errorsmod.Wrap(ErrInvalidCommunityPoolStream, "cadence must be positive")
+	}
+	if cadence > endTime.Sub(startTime) {
+		return errorsmod.Wrap(ErrInvalidCommunityPoolStream, "cadence cannot exceed the stream duration")
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of a community pool stream.
+func (s CommunityPoolStream) Validate() error {
+	if s.Id == 0 {
+		return errorsmod.Wrap(ErrInvalidCommunityPoolStream, "stream id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCommunityPoolStream, "invalid recipient address %s: %s", s.Recipient, err)
+	}
+	if !s.Amount.IsValid() || s.Amount.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidCommunityPoolStream, "invalid amount %s", s.Amount)
+	}
+	if !s.Paid.IsValid() || !s.Paid.IsAllLTE(s.Amount) {
+		return errorsmod.Wrapf(ErrInvalidCommunityPoolStream, "invalid paid amount %s", s.Paid)
+	}
+	return ValidateCommunityPoolStreamSchedule(s.StartTime, s.EndTime, s.Cadence)
+}
+
+// ReleasedAt returns the part of the stream amount released by the given time, an
+// equal share of the amount is released every cadence and the rest at the end time.
+func (s CommunityPoolStream) ReleasedAt(t time.Time) sdk.Coins {
+	if !t.Before(s.EndTime) {
+		return s.Amount
+	}
+	if t.Before(s.StartTime) {
+		return sdk.Coins{}
+	}
+
+	installments := int64(t.Sub(s.StartTime) / s.Cadence)
+	elapsed := installments * int64(s.Cadence)
+	duration := int64(s.EndTime.Sub(s.StartTime))
+
+	released := sdk.Coins{}
+	for _, coin := range s.Amount {
+		released = released.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(elapsed).QuoRaw(duration)))
+	}
+	return released
+}
+
+// NextPaymentTimeAfter returns the time the first installment after the given time
+// is released at, capped at the end time.
+func (s CommunityPoolStream) NextPaymentTimeAfter(t time.Time) time.Time {
+	var installments int64
+	if !t.Before(s.StartTime) {
+		installments = int64(t.Sub(s.StartTime) / s.Cadence)
+	}
+
+	next := s.StartTime.Add(time.Duration(installments+1) * s.Cadence)
+	if next.After(s.EndTime) {
+		return s.EndTime
+	}
+	return next
+}
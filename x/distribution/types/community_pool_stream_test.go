@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCommunityPoolStreamSchedule(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("atom", 10))
+
+	// 3 installments of a third of the amount, 10 hours apart
+	stream := NewCommunityPoolStream(1, delAddr1, amount, start, start.Add(30*time.Hour), 10*time.Hour)
+	require.NoError(t, stream.Validate())
+	require.Equal(t, start.Add(10*time.Hour), stream.NextPaymentTime)
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		released sdk.Coins
+		next     time.Time
+	}{
+		{"before start", start.Add(-time.Hour), sdk.Coins{}, start.Add(10 * time.Hour)},
+		{"at start", start, sdk.Coins{}, start.Add(10 * time.Hour)},
+		{"first installment", start.Add(10 * time.Hour), sdk.NewCoins(sdk.NewInt64Coin("stake", 333), sdk.NewInt64Coin("atom", 3)), start.Add(20 * time.Hour)},
+		{"between installments", start.Add(25 * time.Hour), sdk.NewCoins(sdk.NewInt64Coin("stake", 666), sdk.NewInt64Coin("atom", 6)), start.Add(30 * time.Hour)},
+		{"at end", start.Add(30 * time.Hour), amount, start.Add(30 * time.Hour)},
+		{"after end", start.Add(40 * time.Hour), amount, start.Add(30 * time.Hour)},
+	}
+	for _, tc := range tests {
+		require.True(t, tc.released.IsEqual(stream.ReleasedAt(tc.at)), tc.name)
+		require.Equal(t, tc.next, stream.NextPaymentTimeAfter(tc.at), tc.name)
+	}
+
+	// a cadence that does not divide the duration releases the rest at the end time
+	stream = NewCommunityPoolStream(1, delAddr1, amount, start, start.Add(25*time.Hour), 10*time.Hour)
+	require.Equal(t, start.Add(25*time.Hour), stream.NextPaymentTimeAfter(start.Add(20*time.Hour)))
+	require.Equal(t, sdk.NewInt(800), stream.ReleasedAt(start.Add(24*time.Hour)).AmountOf("stake"))
+	require.True(t, amount.IsEqual(stream.ReleasedAt(start.Add(25*time.Hour))))
+}
+
+func TestCommunityPoolStreamValidate(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	valid := NewCommunityPoolStream(1, delAddr1, amount, start, start.Add(time.Hour), time.Minute)
+
+	tests := []struct {
+		name      string
+		malleate  func(s *CommunityPoolStream)
+		expectErr bool
+	}{
+		{"valid", func(s *CommunityPoolStream) {}, false},
+		{"zero id", func(s *CommunityPoolStream) { s.Id = 0 }, true},
+		{"invalid recipient", func(s *CommunityPoolStream) { s.Recipient = "invalid" }, true},
+		{"zero amount", func(s *CommunityPoolStream) { s.Amount = sdk.Coins{} }, true},
+		{"overpaid", func(s *CommunityPoolStream) { s.Paid = sdk.NewCoins(sdk.NewInt64Coin("stake", 1001)) }, true},
+		{"end before start", func(s *CommunityPoolStream) { s.EndTime = start.Add(-time.Hour) }, true},
+		{"zero cadence", func(s *CommunityPoolStream) { s.Cadence = 0 }, true},
+		{"cadence longer than duration", func(s *CommunityPoolStream) { s.Cadence = 2 * time.Hour }, true},
+	}
+	for _, tc := range tests {
+		stream := valid
+		tc.malleate(&stream)
+		err := stream.Validate()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestCommunityPoolStreamProposalValidateBasic(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	require.NoError(t, NewCommunityPoolStreamProposal("title", "desc", delAddr1, amount, start, start.Add(time.Hour), time.Minute).ValidateBasic())
+	require.Error(t, NewCommunityPoolStreamProposal("", "desc", delAddr1, amount, start, start.Add(time.Hour), time.Minute).ValidateBasic())
+	require.Error(t, NewCommunityPoolStreamProposal("title", "desc", delAddr1, sdk.Coins{}, start, start.Add(time.Hour), time.Minute).ValidateBasic())
+	require.Error(t, NewCommunityPoolStreamProposal("title", "desc", emptyDelAddr, amount, start, start.Add(time.Hour), time.Minute).ValidateBasic())
+	require.Error(t, NewCommunityPoolStreamProposal("title", "desc", delAddr1, amount, start, start, time.Minute).ValidateBasic())
+
+	require.NoError(t, NewCancelCommunityPoolStreamProposal("title", "desc", 1).ValidateBasic())
+	require.Error(t, NewCancelCommunityPoolStreamProposal("title", "desc", 0).ValidateBasic())
+}
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +36,15 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// restake_interval is the number of blocks between the starts of auto restake
+	// passes, 0 disables auto restaking.
+	RestakeInterval int64 `protobuf:"varint,5,opt,name=restake_interval,json=restakeInterval,proto3" json:"restake_interval,omitempty"`
+	// max_restakes_per_block bounds the auto restakes executed in a single block,
+	// a pass that does not fit is continued in the following blocks.
+	MaxRestakesPerBlock uint32 `protobuf:"varint,6,opt,name=max_restakes_per_block,json=maxRestakesPerBlock,proto3" json:"max_restakes_per_block,omitempty"`
+	// liquid_staking_reward_fee is the fraction of the rewards withdrawn by liquid
+	// staking providers and tokenize share records sent to the community pool.
+	LiquidStakingRewardFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=liquid_staking_reward_fee,json=liquidStakingRewardFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_staking_reward_fee"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,6 +86,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetRestakeInterval() int64 {
+	if m != nil {
+		return m.RestakeInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRestakesPerBlock() uint32 {
+	if m != nil {
+		return m.MaxRestakesPerBlock
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -238,6 +265,140 @@ func (m *ValidatorAccumulatedCommission) GetCommission() github_com_cosmos_cosmo
 	return nil
 }
 
+// CommissionRecipient is a recipient of a weighted share of a validator's
+// commission.
+type CommissionRecipient struct {
+	// address is the account the share of the commission is sent to.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the fraction of the commission sent to the address.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *CommissionRecipient) Reset()         { *m = CommissionRecipient{} }
+func (m *CommissionRecipient) String() string { return proto.CompactTextString(m) }
+func (*CommissionRecipient) ProtoMessage()    {}
+func (*CommissionRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{4}
+}
+func (m *CommissionRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionRecipient.Merge(m, src)
+}
+func (m *CommissionRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionRecipient proto.InternalMessageInfo
+
+// ValidatorCommissionRecipients is the weighted list of recipients the
+// commission of a validator is split across. The weights sum to one.
+type ValidatorCommissionRecipients struct {
+	Recipients []CommissionRecipient `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *ValidatorCommissionRecipients) Reset()         { *m = ValidatorCommissionRecipients{} }
+func (m *ValidatorCommissionRecipients) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionRecipients) ProtoMessage()    {}
+func (*ValidatorCommissionRecipients) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{5}
+}
+func (m *ValidatorCommissionRecipients) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCommissionRecipients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCommissionRecipients.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorCommissionRecipients) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionRecipients.Merge(m, src)
+}
+func (m *ValidatorCommissionRecipients) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCommissionRecipients) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionRecipients.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCommissionRecipients proto.InternalMessageInfo
+
+func (m *ValidatorCommissionRecipients) GetRecipients() []CommissionRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// LiquidStakingFeesCollected is the cumulative amount of liquid staking reward
+// fees sent to the community pool.
+type LiquidStakingFeesCollected struct {
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *LiquidStakingFeesCollected) Reset()         { *m = LiquidStakingFeesCollected{} }
+func (m *LiquidStakingFeesCollected) String() string { return proto.CompactTextString(m) }
+func (*LiquidStakingFeesCollected) ProtoMessage()    {}
+func (*LiquidStakingFeesCollected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{6}
+}
+func (m *LiquidStakingFeesCollected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidStakingFeesCollected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidStakingFeesCollected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidStakingFeesCollected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidStakingFeesCollected.Merge(m, src)
+}
+func (m *LiquidStakingFeesCollected) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidStakingFeesCollected) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidStakingFeesCollected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidStakingFeesCollected proto.InternalMessageInfo
+
+func (m *LiquidStakingFeesCollected) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
 // ValidatorOutstandingRewards represents outstanding (un-withdrawn) rewards
 // for a validator inexpensive to track, allows simple sanity checks.
 type ValidatorOutstandingRewards struct {
@@ -248,7 +409,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{4}
+	return fileDescriptor_c3e6168184371676, []int{7}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +458,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{5}
+	return fileDescriptor_c3e6168184371676, []int{8}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +502,7 @@ type ValidatorSlashEvents struct {
 func (m *ValidatorSlashEvents) Reset()      { *m = ValidatorSlashEvents{} }
 func (*ValidatorSlashEvents) ProtoMessage() {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{6}
+	return fileDescriptor_c3e6168184371676, []int{9}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +547,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{7}
+	return fileDescriptor_c3e6168184371676, []int{10}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -435,7 +596,7 @@ type CommunityPoolSpendProposal struct {
 func (m *CommunityPoolSpendProposal) Reset()      { *m = CommunityPoolSpendProposal{} }
 func (*CommunityPoolSpendProposal) ProtoMessage() {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{8}
+	return fileDescriptor_c3e6168184371676, []int{11}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,6 +625,195 @@ func (m *CommunityPoolSpendProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposal proto.InternalMessageInfo
 
+// CommunityPoolStreamProposal details a proposal to pay community funds to a
+// recipient in installments, released every cadence between start_time and
+// end_time.
+type CommunityPoolStreamProposal struct {
+	Title       string                                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Recipient   string                                   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	StartTime   time.Time                                `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime     time.Time                                `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	Cadence     time.Duration                            `protobuf:"bytes,7,opt,name=cadence,proto3,stdduration" json:"cadence"`
+}
+
+func (m *CommunityPoolStreamProposal) Reset()      { *m = CommunityPoolStreamProposal{} }
+func (*CommunityPoolStreamProposal) ProtoMessage() {}
+func (*CommunityPoolStreamProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{12}
+}
+func (m *CommunityPoolStreamProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolStreamProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolStreamProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolStreamProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolStreamProposal.Merge(m, src)
+}
+func (m *CommunityPoolStreamProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolStreamProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolStreamProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolStreamProposal proto.InternalMessageInfo
+
+// CancelCommunityPoolStreamProposal details a proposal to cancel the remaining
+// payments of a community pool stream.
+type CancelCommunityPoolStreamProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	StreamId    uint64 `protobuf:"varint,3,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *CancelCommunityPoolStreamProposal) Reset()      { *m = CancelCommunityPoolStreamProposal{} }
+func (*CancelCommunityPoolStreamProposal) ProtoMessage() {}
+func (*CancelCommunityPoolStreamProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{13}
+}
+func (m *CancelCommunityPoolStreamProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelCommunityPoolStreamProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelCommunityPoolStreamProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelCommunityPoolStreamProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCommunityPoolStreamProposal.Merge(m, src)
+}
+func (m *CancelCommunityPoolStreamProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelCommunityPoolStreamProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCommunityPoolStreamProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCommunityPoolStreamProposal proto.InternalMessageInfo
+
+// CommunityPoolStream is a payment from the community pool to a recipient,
+// released in installments every cadence between start_time and end_time.
+type CommunityPoolStream struct {
+	Id        uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient string                                   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// paid is the part of amount already sent to the recipient.
+	Paid      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=paid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid"`
+	StartTime time.Time                                `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time                                `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	Cadence   time.Duration                            `protobuf:"bytes,7,opt,name=cadence,proto3,stdduration" json:"cadence"`
+	// next_payment_time is the time the next installment is released at.
+	NextPaymentTime time.Time `protobuf:"bytes,8,opt,name=next_payment_time,json=nextPaymentTime,proto3,stdtime" json:"next_payment_time"`
+}
+
+func (m *CommunityPoolStream) Reset()         { *m = CommunityPoolStream{} }
+func (m *CommunityPoolStream) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolStream) ProtoMessage()    {}
+func (*CommunityPoolStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{14}
+}
+func (m *CommunityPoolStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolStream.Merge(m, src)
+}
+func (m *CommunityPoolStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolStream proto.InternalMessageInfo
+
+func (m *CommunityPoolStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CommunityPoolStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *CommunityPoolStream) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *CommunityPoolStream) GetPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
+	}
+	return nil
+}
+
+func (m *CommunityPoolStream) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *CommunityPoolStream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *CommunityPoolStream) GetCadence() time.Duration {
+	if m != nil {
+		return m.Cadence
+	}
+	return 0
+}
+
+func (m *CommunityPoolStream) GetNextPaymentTime() time.Time {
+	if m != nil {
+		return m.NextPaymentTime
+	}
+	return time.Time{}
+}
+
 // DelegatorStartingInfo represents the starting info for a delegator reward
 // period. It tracks the previous validator period, the delegation's amount of
 // staking token, and the creation height (to check later on if any slashes have
@@ -480,7 +830,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{9}
+	return fileDescriptor_c3e6168184371676, []int{15}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +884,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{10}
+	return fileDescriptor_c3e6168184371676, []int{16}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -573,7 +923,7 @@ func (m *TokenizeShareRecordReward) Reset()         { *m = TokenizeShareRecordRe
 func (m *TokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
 func (*TokenizeShareRecordReward) ProtoMessage()    {}
 func (*TokenizeShareRecordReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{11}
+	return fileDescriptor_c3e6168184371676, []int{17}
 }
 func (m *TokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -602,6 +952,70 @@ func (m *TokenizeShareRecordReward) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TokenizeShareRecordReward proto.InternalMessageInfo
 
+// TokenizeShareRecordWithdrawal is the result of withdrawing the rewards of a
+// tokenize share record.
+type TokenizeShareRecordWithdrawal struct {
+	RecordId uint64 `protobuf:"varint,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// amount defines the rewards sent to the owner.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// error describes why the withdrawal failed, it is empty on success.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *TokenizeShareRecordWithdrawal) Reset()         { *m = TokenizeShareRecordWithdrawal{} }
+func (m *TokenizeShareRecordWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecordWithdrawal) ProtoMessage()    {}
+func (*TokenizeShareRecordWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{18}
+}
+func (m *TokenizeShareRecordWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecordWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecordWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecordWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecordWithdrawal.Merge(m, src)
+}
+func (m *TokenizeShareRecordWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecordWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecordWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecordWithdrawal proto.InternalMessageInfo
+
+func (m *TokenizeShareRecordWithdrawal) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecordWithdrawal) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *TokenizeShareRecordWithdrawal) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // CommunityPoolSpendProposalWithDeposit defines a CommunityPoolSpendProposal
 // with a deposit
 type CommunityPoolSpendProposalWithDeposit struct {
@@ -616,7 +1030,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{12}
+	return fileDescriptor_c3e6168184371676, []int{19}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,91 +1059,225 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Params)(nil), "liquidstaking.distribution.v1beta1.Params")
-	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "liquidstaking.distribution.v1beta1.ValidatorHistoricalRewards")
-	proto.RegisterType((*ValidatorCurrentRewards)(nil), "liquidstaking.distribution.v1beta1.ValidatorCurrentRewards")
-	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "liquidstaking.distribution.v1beta1.ValidatorAccumulatedCommission")
-	proto.RegisterType((*ValidatorOutstandingRewards)(nil), "liquidstaking.distribution.v1beta1.ValidatorOutstandingRewards")
-	proto.RegisterType((*ValidatorSlashEvent)(nil), "liquidstaking.distribution.v1beta1.ValidatorSlashEvent")
-	proto.RegisterType((*ValidatorSlashEvents)(nil), "liquidstaking.distribution.v1beta1.ValidatorSlashEvents")
-	proto.RegisterType((*FeePool)(nil), "liquidstaking.distribution.v1beta1.FeePool")
-	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "liquidstaking.distribution.v1beta1.CommunityPoolSpendProposal")
-	proto.RegisterType((*DelegatorStartingInfo)(nil), "liquidstaking.distribution.v1beta1.DelegatorStartingInfo")
-	proto.RegisterType((*DelegationDelegatorReward)(nil), "liquidstaking.distribution.v1beta1.DelegationDelegatorReward")
-	proto.RegisterType((*TokenizeShareRecordReward)(nil), "liquidstaking.distribution.v1beta1.TokenizeShareRecordReward")
-	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "liquidstaking.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
-}
+// CommunityPoolStreamProposalWithDeposit defines a CommunityPoolStreamProposal
+// with a deposit
+type CommunityPoolStreamProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Recipient   string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	StartTime   string `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     string `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Cadence     string `protobuf:"bytes,7,opt,name=cadence,proto3" json:"cadence,omitempty"`
+	Deposit     string `protobuf:"bytes,8,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *CommunityPoolStreamProposalWithDeposit) Reset() {
+	*m = CommunityPoolStreamProposalWithDeposit{}
+}
+func (m *CommunityPoolStreamProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolStreamProposalWithDeposit) ProtoMessage()    {}
+func (*CommunityPoolStreamProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{20}
+}
+func (m *CommunityPoolStreamProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolStreamProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolStreamProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolStreamProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolStreamProposalWithDeposit.Merge(m, src)
+}
+func (m *CommunityPoolStreamProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolStreamProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolStreamProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolStreamProposalWithDeposit proto.InternalMessageInfo
+
+// CancelCommunityPoolStreamProposalWithDeposit defines a
+// CancelCommunityPoolStreamProposal with a deposit
+type CancelCommunityPoolStreamProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	StreamId    uint64 `protobuf:"varint,3,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Deposit     string `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *CancelCommunityPoolStreamProposalWithDeposit) Reset() {
+	*m = CancelCommunityPoolStreamProposalWithDeposit{}
+}
+func (m *CancelCommunityPoolStreamProposalWithDeposit) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CancelCommunityPoolStreamProposalWithDeposit) ProtoMessage() {}
+func (*CancelCommunityPoolStreamProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{21}
+}
+func (m *CancelCommunityPoolStreamProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelCommunityPoolStreamProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelCommunityPoolStreamProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelCommunityPoolStreamProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCommunityPoolStreamProposalWithDeposit.Merge(m, src)
+}
+func (m *CancelCommunityPoolStreamProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelCommunityPoolStreamProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCommunityPoolStreamProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCommunityPoolStreamProposalWithDeposit proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "liquidstaking.distribution.v1beta1.Params")
+	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "liquidstaking.distribution.v1beta1.ValidatorHistoricalRewards")
+	proto.RegisterType((*ValidatorCurrentRewards)(nil), "liquidstaking.distribution.v1beta1.ValidatorCurrentRewards")
+	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "liquidstaking.distribution.v1beta1.ValidatorAccumulatedCommission")
+	proto.RegisterType((*CommissionRecipient)(nil), "liquidstaking.distribution.v1beta1.CommissionRecipient")
+	proto.RegisterType((*ValidatorCommissionRecipients)(nil), "liquidstaking.distribution.v1beta1.ValidatorCommissionRecipients")
+	proto.RegisterType((*LiquidStakingFeesCollected)(nil), "liquidstaking.distribution.v1beta1.LiquidStakingFeesCollected")
+	proto.RegisterType((*ValidatorOutstandingRewards)(nil), "liquidstaking.distribution.v1beta1.ValidatorOutstandingRewards")
+	proto.RegisterType((*ValidatorSlashEvent)(nil), "liquidstaking.distribution.v1beta1.ValidatorSlashEvent")
+	proto.RegisterType((*ValidatorSlashEvents)(nil), "liquidstaking.distribution.v1beta1.ValidatorSlashEvents")
+	proto.RegisterType((*FeePool)(nil), "liquidstaking.distribution.v1beta1.FeePool")
+	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "liquidstaking.distribution.v1beta1.CommunityPoolSpendProposal")
+	proto.RegisterType((*CommunityPoolStreamProposal)(nil), "liquidstaking.distribution.v1beta1.CommunityPoolStreamProposal")
+	proto.RegisterType((*CancelCommunityPoolStreamProposal)(nil), "liquidstaking.distribution.v1beta1.CancelCommunityPoolStreamProposal")
+	proto.RegisterType((*CommunityPoolStream)(nil), "liquidstaking.distribution.v1beta1.CommunityPoolStream")
+	proto.RegisterType((*DelegatorStartingInfo)(nil), "liquidstaking.distribution.v1beta1.DelegatorStartingInfo")
+	proto.RegisterType((*DelegationDelegatorReward)(nil), "liquidstaking.distribution.v1beta1.DelegationDelegatorReward")
+	proto.RegisterType((*TokenizeShareRecordReward)(nil), "liquidstaking.distribution.v1beta1.TokenizeShareRecordReward")
+	proto.RegisterType((*TokenizeShareRecordWithdrawal)(nil), "liquidstaking.distribution.v1beta1.TokenizeShareRecordWithdrawal")
+	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "liquidstaking.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*CommunityPoolStreamProposalWithDeposit)(nil), "liquidstaking.distribution.v1beta1.CommunityPoolStreamProposalWithDeposit")
+	proto.RegisterType((*CancelCommunityPoolStreamProposalWithDeposit)(nil), "liquidstaking.distribution.v1beta1.CancelCommunityPoolStreamProposalWithDeposit")
+}
 
 func init() {
 	proto.RegisterFile("distribution/v1beta1/distribution.proto", fileDescriptor_c3e6168184371676)
 }
 
 var fileDescriptor_c3e6168184371676 = []byte{
-	// 1005 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xb4, 0x8e, 0x93, 0x4e, 0x69, 0x02, 0x13, 0x27, 0x75, 0x4c, 0x65, 0x47, 0x2b, 0xd1,
-	0x06, 0x2a, 0xdb, 0xb4, 0x3d, 0x20, 0x45, 0x5c, 0xf2, 0x0b, 0xd1, 0x13, 0xd1, 0xa6, 0x02, 0xc4,
-	0x65, 0x35, 0xde, 0x7d, 0xb1, 0x47, 0xd9, 0x9d, 0xd9, 0xcc, 0xcc, 0x3a, 0x09, 0xd7, 0x1e, 0xf8,
-	0x71, 0x02, 0x71, 0x41, 0x1c, 0x50, 0x8e, 0x08, 0x71, 0xcc, 0x3f, 0xc0, 0xad, 0xe2, 0x54, 0x7a,
-	0x29, 0xe2, 0x10, 0x50, 0x72, 0x41, 0xfc, 0x15, 0x68, 0x76, 0xc6, 0x6b, 0x17, 0x02, 0xf4, 0x90,
-	0xa8, 0xa7, 0xe4, 0xbd, 0xb7, 0xf3, 0xbe, 0xef, 0x7b, 0xf3, 0xde, 0x1b, 0xe3, 0x5b, 0x11, 0x53,
-	0x5a, 0xb2, 0x6e, 0xa6, 0x99, 0xe0, 0x9d, 0xc1, 0x9d, 0x2e, 0x68, 0x7a, 0xa7, 0x33, 0xee, 0x6c,
-	0xa7, 0x52, 0x68, 0x41, 0xbc, 0x98, 0xed, 0x66, 0x2c, 0x52, 0x9a, 0xee, 0x30, 0xde, 0x6b, 0x3f,
-	0xf3, 0x85, 0x3b, 0x56, 0xaf, 0xf6, 0x44, 0x4f, 0xe4, 0x9f, 0x77, 0xcc, 0x7f, 0xf6, 0x64, 0xbd,
-	0x11, 0x0a, 0x95, 0x08, 0xd5, 0xe9, 0x52, 0x05, 0x05, 0x42, 0x28, 0x98, 0xcb, 0x5c, 0x5f, 0xb0,
-	0xf1, 0xc0, 0x1e, 0xb4, 0x86, 0x0d, 0x79, 0x9f, 0x5c, 0xc6, 0x95, 0x4d, 0x2a, 0x69, 0xa2, 0x08,
-	0xc5, 0xd7, 0x42, 0x91, 0x24, 0x19, 0x67, 0xfa, 0x20, 0xd0, 0x74, 0xbf, 0x86, 0x16, 0xd1, 0xd2,
-	0x95, 0xd5, 0xb7, 0x1f, 0x1d, 0x37, 0x4b, 0xbf, 0x1e, 0x37, 0x6f, 0xf6, 0x98, 0xee, 0x67, 0xdd,
-	0x76, 0x28, 0x12, 0x97, 0xc2, 0xfd, 0x69, 0xa9, 0x68, 0xa7, 0xa3, 0x0f, 0x52, 0x50, 0xed, 0x75,
-	0x08, 0x9f, 0x1c, 0xb5, 0xb0, 0x43, 0x58, 0x87, 0xd0, 0x7f, 0xa9, 0x48, 0xf9, 0x80, 0xee, 0x13,
-	0x8e, 0xab, 0x86, 0xa3, 0x21, 0x92, 0x0a, 0x05, 0x32, 0x90, 0xb0, 0x47, 0x65, 0x54, 0xbb, 0x74,
-	0x0e, 0x48, 0xc4, 0x64, 0xde, 0x74, 0x89, 0xfd, 0x3c, 0x2f, 0x49, 0xf1, 0x5c, 0x57, 0xf0, 0x4c,
-	0xfd, 0x03, 0xf0, 0xf2, 0x39, 0x00, 0xce, 0xe6, 0xa9, 0xff, 0x86, 0x78, 0x17, 0xcf, 0xed, 0x31,
-	0xdd, 0x8f, 0x24, 0xdd, 0x0b, 0x68, 0x14, 0xc9, 0x00, 0x38, 0xed, 0xc6, 0x10, 0xd5, 0xca, 0x8b,
-	0x68, 0x69, 0xca, 0x9f, 0x1d, 0x06, 0x57, 0xa2, 0x48, 0x6e, 0xd8, 0xd0, 0x72, 0xf9, 0xeb, 0xc3,
-	0x66, 0xc9, 0xfb, 0x19, 0xe1, 0xfa, 0xfb, 0x34, 0x66, 0x11, 0xd5, 0x42, 0xbe, 0xcb, 0x94, 0x16,
-	0x92, 0x85, 0x34, 0xb6, 0x79, 0x15, 0xf9, 0x0c, 0xe1, 0xeb, 0x61, 0x96, 0x64, 0x31, 0xd5, 0x6c,
-	0x00, 0x4e, 0x47, 0x20, 0xa9, 0x66, 0xa2, 0x86, 0x16, 0x2f, 0x2f, 0x5d, 0xbd, 0x7b, 0xa3, 0xed,
-	0xc8, 0x99, 0x42, 0x0c, 0x3b, 0xc6, 0x30, 0x5d, 0x13, 0x8c, 0xaf, 0xde, 0x33, 0x5a, 0xbf, 0xff,
-	0xad, 0x79, 0xfb, 0xf9, 0xb4, 0x9a, 0x33, 0xca, 0x9f, 0x1b, 0x21, 0x5a, 0x1e, 0xbe, 0xc1, 0x23,
-	0xb7, 0xf0, 0x8c, 0x84, 0x6d, 0x90, 0xc0, 0x43, 0x08, 0x42, 0x91, 0x71, 0x9d, 0xdf, 0xe0, 0x35,
-	0x7f, 0xba, 0x70, 0xaf, 0x19, 0xaf, 0xf7, 0x2d, 0xc2, 0xd7, 0x0b, 0x4d, 0x6b, 0x99, 0x94, 0xc0,
-	0xf5, 0x50, 0xd0, 0x0e, 0x9e, 0xb4, 0x22, 0xd4, 0xc5, 0xf1, 0x1f, 0x22, 0x90, 0x79, 0x5c, 0x49,
-	0x41, 0x32, 0x61, 0x5b, 0xad, 0xec, 0x3b, 0xcb, 0xfb, 0x0a, 0xe1, 0x46, 0x41, 0x70, 0x25, 0x74,
-	0x72, 0x21, 0x5a, 0x13, 0x49, 0xc2, 0x94, 0x62, 0x82, 0x93, 0x5d, 0x8c, 0xc3, 0xc2, 0xba, 0x38,
-	0xaa, 0x63, 0x20, 0xde, 0xe7, 0x08, 0xbf, 0x5a, 0xb0, 0x7a, 0x2f, 0xd3, 0x4a, 0x53, 0x1e, 0x31,
-	0xde, 0x7b, 0x11, 0xa5, 0xf3, 0xbe, 0x41, 0x78, 0xb6, 0x20, 0xb3, 0x15, 0x53, 0xd5, 0xdf, 0x18,
-	0x00, 0xd7, 0xe4, 0x75, 0xfc, 0xf2, 0x60, 0xe8, 0x0e, 0x5c, 0x71, 0x51, 0x5e, 0xdc, 0x99, 0xc2,
-	0xbf, 0x99, 0xbb, 0xc9, 0x87, 0x78, 0x6a, 0x5b, 0xd2, 0xd0, 0x6c, 0xb2, 0x73, 0x19, 0xf5, 0x22,
-	0x9b, 0xf7, 0x25, 0xc2, 0xd5, 0x33, 0xc8, 0x29, 0xa2, 0xf0, 0xfc, 0x88, 0x9d, 0x32, 0x81, 0x00,
-	0xf2, 0x88, 0xab, 0xd8, 0x5b, 0xed, 0xff, 0xdf, 0xb6, 0xed, 0x33, 0x32, 0xaf, 0x96, 0x0d, 0x73,
-	0xbf, 0x3a, 0x38, 0x03, 0xd4, 0x0d, 0xf2, 0x43, 0x84, 0x27, 0xdf, 0x01, 0xd8, 0x14, 0x22, 0x26,
-	0xfb, 0x78, 0x7a, 0xb4, 0x53, 0x53, 0x21, 0xe2, 0x8b, 0xbb, 0xb0, 0xd1, 0xf2, 0x36, 0xc8, 0xde,
-	0xc3, 0x4b, 0xb8, 0xbe, 0x36, 0xee, 0xd9, 0x4a, 0x81, 0x47, 0x76, 0x5b, 0xd1, 0x98, 0x54, 0xf1,
-	0x84, 0x66, 0x3a, 0x06, 0xbb, 0xe4, 0x7d, 0x6b, 0x90, 0x45, 0x7c, 0x35, 0x02, 0x15, 0x4a, 0x96,
-	0x8e, 0xee, 0xca, 0x1f, 0x77, 0x91, 0x1b, 0xf8, 0x8a, 0x84, 0x90, 0xa5, 0x0c, 0xb8, 0xb6, 0x5b,
-	0xd4, 0x1f, 0x39, 0x48, 0x88, 0x2b, 0x34, 0xc9, 0xf7, 0x41, 0x39, 0x97, 0xb9, 0x70, 0xa6, 0xcc,
-	0x5c, 0xe3, 0x9b, 0x4e, 0xe3, 0xd2, 0x73, 0x68, 0xb4, 0x02, 0x5d, 0xea, 0xe5, 0x37, 0x3e, 0x3d,
-	0x6c, 0x96, 0x4c, 0xa5, 0xff, 0x38, 0x6c, 0x96, 0x7e, 0x3a, 0x6a, 0xd5, 0x1d, 0x46, 0x4f, 0x0c,
-	0xc6, 0x20, 0xb8, 0x06, 0xae, 0xbd, 0x1f, 0x11, 0x9e, 0x5b, 0x87, 0x18, 0x7a, 0xf9, 0x55, 0x69,
-	0x2a, 0x35, 0xe3, 0xbd, 0xfb, 0x7c, 0x3b, 0xdf, 0x61, 0xa9, 0x84, 0x01, 0x13, 0xe6, 0x75, 0x18,
-	0xef, 0xde, 0xe9, 0xa1, 0xdb, 0x35, 0xaf, 0x8f, 0x27, 0x4c, 0x93, 0xc0, 0xb9, 0x74, 0xae, 0x4d,
-	0x45, 0x6e, 0xe3, 0x4a, 0x1f, 0x58, 0xaf, 0x6f, 0x4b, 0x58, 0x5e, 0x9d, 0xfd, 0xf3, 0xb8, 0x39,
-	0x13, 0x4a, 0x30, 0xdb, 0x95, 0x07, 0x36, 0xe4, 0xbb, 0x4f, 0xbc, 0xa7, 0x08, 0x2f, 0x38, 0x0d,
-	0x4c, 0xf0, 0x42, 0x8d, 0x7b, 0x70, 0x36, 0xf0, 0x2b, 0xa3, 0x46, 0x37, 0x2f, 0x0e, 0x28, 0xe5,
-	0x5e, 0xee, 0xda, 0x93, 0xa3, 0x56, 0xd5, 0x81, 0xaf, 0xd8, 0xc8, 0x96, 0x96, 0x66, 0x8f, 0x8c,
-	0x26, 0xd7, 0xf9, 0x09, 0xc3, 0x95, 0xe2, 0x2d, 0xbe, 0xa0, 0x06, 0x75, 0x00, 0xcb, 0x53, 0xee,
-	0xfe, 0x90, 0xf7, 0x03, 0xc2, 0x0b, 0x0f, 0xc4, 0x0e, 0x70, 0xf6, 0x31, 0x6c, 0xf5, 0xa9, 0x04,
-	0x1f, 0x42, 0x21, 0x23, 0xa7, 0xac, 0x8e, 0xa7, 0x64, 0x6e, 0xdf, 0x1f, 0x5e, 0x4d, 0x61, 0xbf,
-	0x18, 0xba, 0x4f, 0x11, 0x7e, 0xed, 0xdf, 0x47, 0xea, 0x03, 0xa6, 0xfb, 0xeb, 0x90, 0x0a, 0xc5,
-	0xf4, 0x05, 0x4d, 0xd7, 0xfc, 0xd8, 0x74, 0x99, 0x90, 0xb3, 0x48, 0x0d, 0x4f, 0x46, 0x16, 0xb8,
-	0x36, 0x91, 0x07, 0x86, 0xe6, 0xf2, 0xcd, 0x21, 0xf7, 0xff, 0x1e, 0x93, 0xd5, 0xee, 0x77, 0x27,
-	0x0d, 0xf4, 0xe8, 0xa4, 0x81, 0x1e, 0x9f, 0x34, 0xd0, 0xef, 0x27, 0x0d, 0xf4, 0xc5, 0x69, 0xa3,
-	0xf4, 0xf8, 0xb4, 0x51, 0xfa, 0xe5, 0xb4, 0x51, 0xfa, 0x68, 0x7d, 0xac, 0x6c, 0x6c, 0x37, 0xce,
-	0xcc, 0x1b, 0xc5, 0x78, 0xd8, 0xb1, 0x1b, 0x94, 0xe9, 0x83, 0x96, 0xdb, 0xa2, 0xad, 0x44, 0x44,
-	0x59, 0x0c, 0x9d, 0xfd, 0x67, 0x7e, 0xde, 0xda, 0xc2, 0x76, 0x2b, 0xf9, 0x0f, 0xce, 0x7b, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x23, 0x17, 0x82, 0x10, 0x0b, 0x00, 0x00,
+	// 1489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x4a, 0x14, 0x45, 0x3e, 0xd7, 0x92, 0xbd, 0xfa, 0x30, 0x45, 0xdb, 0xa4, 0x4a, 0xa0,
+	0xb6, 0x5c, 0x57, 0x64, 0x2d, 0x1f, 0x0a, 0x08, 0x2d, 0x0a, 0x8b, 0xb2, 0x51, 0x01, 0x05, 0x2a,
+	0xac, 0x84, 0xb6, 0x28, 0x50, 0x2c, 0x86, 0xbb, 0x4f, 0xd4, 0x40, 0xbb, 0x3b, 0xf4, 0xcc, 0x90,
+	0x92, 0x0a, 0xb4, 0x17, 0x5f, 0xda, 0x9e, 0x5c, 0xf4, 0x62, 0xe4, 0x10, 0xf8, 0x90, 0x00, 0x41,
+	0xe0, 0xa3, 0x81, 0x9c, 0x73, 0x08, 0xe0, 0xe4, 0xe4, 0xf8, 0x92, 0x20, 0x07, 0xdb, 0x90, 0x2f,
+	0x41, 0xfe, 0x8a, 0x60, 0x76, 0x66, 0x97, 0xd4, 0x87, 0x3f, 0x64, 0x90, 0x31, 0xe0, 0x93, 0x34,
+	0x6f, 0xf6, 0xbd, 0xf7, 0x7b, 0xdf, 0x6f, 0x08, 0x97, 0x7d, 0x2a, 0x24, 0xa7, 0x8d, 0xb6, 0xa4,
+	0x2c, 0xaa, 0x75, 0xae, 0x35, 0x50, 0x92, 0x6b, 0xb5, 0x5e, 0x62, 0xb5, 0xc5, 0x99, 0x64, 0x76,
+	0x25, 0xa0, 0xb7, 0xdb, 0xd4, 0x17, 0x92, 0x6c, 0xd3, 0xa8, 0x59, 0x3d, 0xf0, 0x85, 0x61, 0x2b,
+	0x4e, 0x35, 0x59, 0x93, 0xc5, 0x9f, 0xd7, 0xd4, 0x7f, 0x9a, 0xb3, 0x58, 0xf2, 0x98, 0x08, 0x99,
+	0xa8, 0x35, 0x88, 0xc0, 0x54, 0x83, 0xc7, 0xa8, 0x91, 0x5c, 0x9c, 0xd5, 0xf7, 0xae, 0x66, 0xd4,
+	0x87, 0x84, 0xb5, 0xc9, 0x58, 0x33, 0xc0, 0x5a, 0x7c, 0x6a, 0xb4, 0x37, 0x6b, 0x7e, 0x9b, 0x93,
+	0x2e, 0xa8, 0x62, 0xf9, 0xf0, 0xbd, 0xa4, 0x21, 0x0a, 0x49, 0xc2, 0x96, 0xfe, 0xa0, 0xf2, 0x3c,
+	0x03, 0xd9, 0x35, 0xc2, 0x49, 0x28, 0x6c, 0x02, 0xa7, 0x3d, 0x16, 0x86, 0xed, 0x88, 0xca, 0x3d,
+	0x57, 0x92, 0xdd, 0x82, 0x35, 0x67, 0xcd, 0xe7, 0x97, 0x7f, 0xfb, 0xe8, 0x69, 0x79, 0xe8, 0xbb,
+	0xa7, 0xe5, 0x4b, 0x4d, 0x2a, 0xb7, 0xda, 0x8d, 0xaa, 0xc7, 0x42, 0x83, 0xc1, 0xfc, 0x59, 0x10,
+	0xfe, 0x76, 0x4d, 0xee, 0xb5, 0x50, 0x54, 0x57, 0xd0, 0x7b, 0xf2, 0x70, 0x01, 0x0c, 0xc4, 0x15,
+	0xf4, 0x9c, 0x9f, 0xa5, 0x22, 0x37, 0xc8, 0xae, 0x1d, 0xc1, 0x94, 0x32, 0x52, 0x59, 0xd2, 0x62,
+	0x02, 0xb9, 0xcb, 0x71, 0x87, 0x70, 0xbf, 0x30, 0xdc, 0x07, 0x4d, 0xb6, 0x92, 0xbc, 0x66, 0x04,
+	0x3b, 0xb1, 0x5c, 0xbb, 0x05, 0xd3, 0x0d, 0x16, 0xb5, 0xc5, 0x11, 0x85, 0x23, 0x7d, 0x50, 0x38,
+	0x19, 0x8b, 0x3e, 0xa4, 0x71, 0x11, 0xa6, 0x77, 0xa8, 0xdc, 0xf2, 0x39, 0xd9, 0x71, 0x89, 0xef,
+	0x73, 0x17, 0x23, 0xd2, 0x08, 0xd0, 0x2f, 0x64, 0xe6, 0xac, 0xf9, 0x9c, 0x33, 0x99, 0x5c, 0xde,
+	0xf0, 0x7d, 0x7e, 0x53, 0x5f, 0xd9, 0x57, 0xe0, 0x0c, 0x57, 0x41, 0xd9, 0x46, 0x97, 0x46, 0x12,
+	0x79, 0x87, 0x04, 0x85, 0xd1, 0x39, 0x6b, 0x7e, 0xc4, 0x99, 0x30, 0xf4, 0x55, 0x43, 0xb6, 0xaf,
+	0xc3, 0x4c, 0x48, 0x76, 0x5d, 0x43, 0x16, 0x6e, 0x0b, 0xb9, 0xdb, 0x08, 0x98, 0xb7, 0x5d, 0xc8,
+	0xce, 0x59, 0xf3, 0xa7, 0x9d, 0xc9, 0x90, 0xec, 0x3a, 0xe6, 0x72, 0x0d, 0xf9, 0xb2, 0xba, 0xb2,
+	0x77, 0x60, 0x56, 0xe7, 0xa6, 0x6b, 0x92, 0xd3, 0x78, 0xc1, 0xdd, 0x44, 0x2c, 0x8c, 0xf5, 0xc1,
+	0x13, 0x33, 0x5a, 0xfc, 0xba, 0x96, 0xae, 0x3d, 0x71, 0x0b, 0x71, 0x29, 0x73, 0xef, 0x7e, 0x79,
+	0xa8, 0xf2, 0xb5, 0x05, 0xc5, 0x3f, 0x93, 0x80, 0xfa, 0x44, 0x32, 0xfe, 0x07, 0x2a, 0x24, 0xe3,
+	0xd4, 0x23, 0x81, 0xfe, 0x4c, 0xd8, 0xff, 0xb1, 0xe0, 0x9c, 0xd7, 0x0e, 0xdb, 0x01, 0x91, 0xb4,
+	0x83, 0x09, 0xb4, 0x38, 0x8b, 0x0b, 0xd6, 0xdc, 0xc8, 0xfc, 0xa9, 0xc5, 0x0b, 0x55, 0xa3, 0x4b,
+	0x45, 0x38, 0xa9, 0x25, 0xa5, 0xb8, 0xce, 0x68, 0xb4, 0x7c, 0x5d, 0x41, 0xff, 0xf4, 0x59, 0xf9,
+	0xea, 0x9b, 0x41, 0x57, 0x3c, 0xc2, 0x99, 0xee, 0x6a, 0xd4, 0x38, 0x1c, 0xa5, 0xcf, 0xbe, 0x0c,
+	0x13, 0x1c, 0x37, 0x91, 0x63, 0xe4, 0xa1, 0xeb, 0xb1, 0x76, 0x24, 0xe3, 0xd4, 0x3c, 0xed, 0x8c,
+	0xa7, 0xe4, 0xba, 0xa2, 0x56, 0x3e, 0xb4, 0xe0, 0x5c, 0x6a, 0x53, 0xbd, 0xcd, 0x39, 0x46, 0x32,
+	0x31, 0x68, 0x1b, 0xc6, 0xb4, 0x11, 0x62, 0x70, 0xf8, 0x13, 0x0d, 0xf6, 0x0c, 0x64, 0x5b, 0xc8,
+	0x29, 0xd3, 0x35, 0x94, 0x71, 0xcc, 0xa9, 0xf2, 0x7f, 0x0b, 0x4a, 0x29, 0xc0, 0x1b, 0x9e, 0x31,
+	0x17, 0xfd, 0x3a, 0x0b, 0x43, 0x2a, 0x04, 0x65, 0x91, 0x7d, 0x1b, 0xc0, 0x4b, 0x4f, 0x83, 0x83,
+	0xda, 0xa3, 0xa4, 0xf2, 0xb1, 0x05, 0x93, 0x5d, 0x04, 0x0e, 0x7a, 0xb4, 0x45, 0x31, 0x92, 0xf6,
+	0x22, 0x8c, 0xa9, 0x62, 0x41, 0x21, 0x4c, 0xd3, 0x29, 0x3c, 0x79, 0xb8, 0x30, 0x65, 0xa0, 0xdc,
+	0xd0, 0x37, 0xeb, 0x92, 0xab, 0xe4, 0x4a, 0x3e, 0xb4, 0x37, 0x20, 0xbb, 0x83, 0xb4, 0xb9, 0x25,
+	0xfb, 0xd2, 0x3d, 0x8c, 0xac, 0xa5, 0xcc, 0xbf, 0x55, 0xca, 0xfe, 0x0b, 0x2e, 0x76, 0xa3, 0x7b,
+	0x14, 0xaf, 0xb0, 0xff, 0x0e, 0xc0, 0xd3, 0x93, 0xf1, 0xdd, 0x6f, 0xaa, 0xaf, 0x9f, 0x00, 0xd5,
+	0x63, 0xa4, 0x2d, 0x67, 0x14, 0x72, 0xa7, 0x47, 0x60, 0xe5, 0x9f, 0x50, 0xfc, 0x63, 0x6f, 0x49,
+	0xdd, 0x42, 0x14, 0x75, 0x16, 0x04, 0xe8, 0x49, 0xf4, 0x6d, 0x17, 0x32, 0x9b, 0x88, 0x89, 0xda,
+	0xd9, 0x63, 0x43, 0x16, 0xc7, 0xeb, 0xd7, 0x26, 0x5e, 0xf3, 0x6f, 0xe0, 0x12, 0x1d, 0xac, 0x58,
+	0x70, 0xe5, 0xbf, 0x16, 0x9c, 0x4f, 0xed, 0xff, 0x53, 0x5b, 0x0a, 0x49, 0x22, 0x3f, 0xad, 0xec,
+	0x9f, 0x36, 0xc3, 0x2b, 0x1f, 0x58, 0x30, 0x99, 0x82, 0x59, 0x0f, 0x88, 0xd8, 0xba, 0xd9, 0x51,
+	0x39, 0x73, 0x05, 0xce, 0x74, 0x12, 0xb2, 0x6b, 0x6a, 0xc0, 0x8a, 0x6b, 0x60, 0x22, 0xa5, 0xaf,
+	0xc5, 0x64, 0xfb, 0xaf, 0x90, 0xdb, 0xe4, 0xc4, 0x53, 0x81, 0xe8, 0x4b, 0xb2, 0xa4, 0xd2, 0x2a,
+	0xff, 0xb3, 0x60, 0xea, 0x18, 0x70, 0xc2, 0x16, 0x30, 0xd3, 0x45, 0x27, 0xd4, 0x85, 0x8b, 0x9d,
+	0x93, 0x26, 0xcb, 0x31, 0x92, 0x4d, 0xb2, 0x4c, 0x75, 0x8e, 0x51, 0x6a, 0xfa, 0xed, 0x1d, 0x0b,
+	0xc6, 0x6e, 0x21, 0xae, 0x31, 0x16, 0xd8, 0xbb, 0x30, 0xde, 0x9d, 0xe9, 0x2d, 0xc6, 0x82, 0xc1,
+	0x05, 0xac, 0xbb, 0x3c, 0x28, 0xcd, 0x95, 0x3b, 0xc3, 0x50, 0xac, 0xf7, 0x52, 0xd6, 0x5b, 0x18,
+	0xf9, 0x7a, 0x5a, 0x92, 0xc0, 0x9e, 0x82, 0x51, 0x49, 0x65, 0x80, 0xba, 0xde, 0x1d, 0x7d, 0xb0,
+	0xe7, 0xe0, 0x94, 0x8f, 0xc2, 0xe3, 0xb4, 0xd5, 0x8d, 0x95, 0xd3, 0x4b, 0xb2, 0x2f, 0x40, 0x3e,
+	0xad, 0x13, 0x3d, 0xc5, 0x9d, 0x2e, 0xc1, 0xf6, 0x20, 0x4b, 0xc2, 0xb8, 0x6d, 0x67, 0xfa, 0x5f,
+	0x1b, 0x46, 0xf4, 0xd2, 0x2f, 0x55, 0x8b, 0x50, 0x9e, 0xfe, 0xfe, 0x7e, 0x79, 0xe8, 0xab, 0x87,
+	0x0b, 0x45, 0xa3, 0xa3, 0xc9, 0x3a, 0x3d, 0x2a, 0x22, 0x89, 0x91, 0xac, 0x7c, 0x39, 0x02, 0xe7,
+	0x0f, 0x7a, 0x41, 0x72, 0x24, 0xe1, 0x7b, 0xe0, 0x06, 0xbb, 0x0e, 0x20, 0x24, 0xe1, 0xd2, 0x95,
+	0x34, 0xc4, 0x78, 0x5f, 0x39, 0xb5, 0x58, 0xac, 0xea, 0x7d, 0xb3, 0x9a, 0xec, 0x9b, 0xd5, 0x8d,
+	0x64, 0xdf, 0x5c, 0xce, 0x29, 0x4d, 0x77, 0x9f, 0x95, 0x2d, 0x27, 0x1f, 0xf3, 0xa9, 0x1b, 0xfb,
+	0xf7, 0x90, 0xc3, 0xc8, 0xd7, 0x22, 0xb2, 0x27, 0x10, 0x31, 0x86, 0x91, 0x1f, 0x0b, 0xf8, 0x1d,
+	0x8c, 0x79, 0xc4, 0x57, 0x83, 0x39, 0xde, 0x64, 0x94, 0xad, 0x87, 0xf9, 0x57, 0xcc, 0x4a, 0xac,
+	0xd9, 0xef, 0xc5, 0xec, 0x86, 0xe7, 0x44, 0xb1, 0xfc, 0xc8, 0x82, 0x9f, 0xd7, 0x49, 0xe4, 0x61,
+	0x30, 0x88, 0x88, 0x9e, 0x87, 0xbc, 0x88, 0x25, 0xb9, 0x54, 0xaf, 0xa7, 0x19, 0x27, 0xa7, 0x09,
+	0xab, 0xfe, 0x89, 0x60, 0xde, 0xcf, 0xc0, 0xe4, 0x31, 0x00, 0xed, 0x71, 0x18, 0xa6, 0x49, 0x87,
+	0x1c, 0xa6, 0xfe, 0xc1, 0x14, 0x1a, 0x7e, 0x79, 0x0a, 0x8d, 0x0c, 0x2e, 0x85, 0x5c, 0xc8, 0xb4,
+	0x08, 0xf5, 0x07, 0x91, 0xa5, 0xb1, 0xe0, 0xf7, 0x22, 0x47, 0xed, 0x35, 0x38, 0x1b, 0xe1, 0xae,
+	0x74, 0x5b, 0x64, 0x2f, 0xc4, 0xc8, 0xd8, 0x92, 0x3b, 0x01, 0x90, 0x09, 0xc5, 0xbe, 0xa6, 0xb9,
+	0xd5, 0x7d, 0xe5, 0x73, 0x0b, 0xa6, 0x57, 0x30, 0xc0, 0x66, 0x3c, 0x40, 0x94, 0xa1, 0x34, 0x6a,
+	0xae, 0x46, 0x9b, 0xf1, 0x02, 0xdc, 0xe2, 0xd8, 0xa1, 0xac, 0x2d, 0x0e, 0xce, 0xd4, 0xf1, 0x84,
+	0x6c, 0x46, 0xaa, 0x03, 0xa3, 0xf1, 0x2b, 0xa3, 0x2f, 0xf3, 0x54, 0x8b, 0xb2, 0xaf, 0x42, 0x76,
+	0x4b, 0x6f, 0x74, 0x71, 0xfe, 0x2f, 0x4f, 0xfe, 0xf0, 0xb4, 0x3c, 0xe1, 0x71, 0x8c, 0x3d, 0xe3,
+	0xea, 0x2b, 0xc7, 0x7c, 0x52, 0xf9, 0xc6, 0x82, 0x59, 0x63, 0x03, 0x65, 0x51, 0x6a, 0x8d, 0x79,
+	0x86, 0xdd, 0x84, 0xb3, 0xdd, 0xf1, 0xfb, 0xa6, 0xab, 0x65, 0x77, 0x9f, 0x30, 0x74, 0x9b, 0x42,
+	0x36, 0x7d, 0xa1, 0x0e, 0x68, 0x6c, 0x1a, 0x05, 0x4b, 0x39, 0x53, 0xe2, 0x56, 0xe5, 0x81, 0x05,
+	0xb3, 0x1b, 0x6c, 0x1b, 0x23, 0xfa, 0x0f, 0x5c, 0xdf, 0x22, 0x1c, 0x1d, 0xf4, 0x18, 0xf7, 0x8d,
+	0x65, 0x45, 0xc8, 0xf1, 0xf8, 0xbc, 0x9a, 0x84, 0x26, 0x3d, 0xbf, 0x1b, 0xb8, 0x9f, 0x59, 0x70,
+	0xf1, 0x18, 0xb8, 0x7f, 0x31, 0x0f, 0x5d, 0x12, 0xa8, 0xd6, 0xa6, 0x21, 0xba, 0xf4, 0x28, 0xe6,
+	0x6e, 0xa3, 0x19, 0x1e, 0x5c, 0xa3, 0x99, 0x82, 0x51, 0xe4, 0x9c, 0x71, 0x33, 0x2a, 0xf5, 0x41,
+	0xa5, 0xd0, 0x2f, 0x5e, 0xbe, 0xa2, 0x28, 0x03, 0x56, 0xb0, 0xc5, 0x04, 0x95, 0x03, 0x1a, 0xd3,
+	0x33, 0x3d, 0x63, 0x5a, 0x5d, 0x25, 0x68, 0x0b, 0x30, 0xe6, 0x6b, 0xc5, 0x71, 0xcb, 0xca, 0x3b,
+	0xc9, 0x71, 0xe9, 0x52, 0xe2, 0xf5, 0xd7, 0xcc, 0x80, 0x07, 0xc3, 0x70, 0xe9, 0x15, 0x43, 0xea,
+	0xdd, 0x99, 0x76, 0xf1, 0x48, 0x43, 0xce, 0xf7, 0xb6, 0xda, 0xd9, 0x43, 0xad, 0x36, 0xdf, 0x6d,
+	0xa2, 0x85, 0x83, 0x4d, 0x34, 0xdf, 0xed, 0x8f, 0x3d, 0xee, 0xca, 0xbd, 0x9d, 0xbb, 0xbe, 0xb0,
+	0xe0, 0x57, 0xaf, 0x9d, 0xec, 0xfd, 0x70, 0xda, 0xab, 0x86, 0x7c, 0xaf, 0x1d, 0x99, 0xb7, 0xb2,
+	0x63, 0xb9, 0xf1, 0xc9, 0x7e, 0xc9, 0x7a, 0xb4, 0x5f, 0xb2, 0x1e, 0xef, 0x97, 0xac, 0xe7, 0xfb,
+	0x25, 0xeb, 0xee, 0x8b, 0xd2, 0xd0, 0xe3, 0x17, 0xa5, 0xa1, 0x6f, 0x5f, 0x94, 0x86, 0xfe, 0xb6,
+	0xd2, 0x53, 0x36, 0xf4, 0x76, 0xd0, 0x16, 0x94, 0x45, 0x34, 0xf2, 0x6a, 0xfa, 0x21, 0x42, 0xe5,
+	0xde, 0x82, 0x79, 0x8c, 0x2c, 0x84, 0xcc, 0x6f, 0x07, 0x58, 0xdb, 0x3d, 0xf0, 0x33, 0xa7, 0x2e,
+	0xac, 0x46, 0x36, 0x1e, 0x35, 0xd7, 0x7f, 0x1c, 0x00, 0x66, 0x48, 0xdc, 0xfb, 0x18, 0x15, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -763,6 +1311,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.RestakeInterval != that1.RestakeInterval {
+		return false
+	}
+	if this.MaxRestakesPerBlock != that1.MaxRestakesPerBlock {
+		return false
+	}
+	if !this.LiquidStakingRewardFee.Equal(that1.LiquidStakingRewardFee) {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -858,6 +1415,91 @@ func (this *ValidatorAccumulatedCommission) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CommissionRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionRecipient)
+	if !ok {
+		that2, ok := that.(CommissionRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *ValidatorCommissionRecipients) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorCommissionRecipients)
+	if !ok {
+		that2, ok := that.(ValidatorCommissionRecipients)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Recipients) != len(that1.Recipients) {
+		return false
+	}
+	for i := range this.Recipients {
+		if !this.Recipients[i].Equal(&that1.Recipients[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *LiquidStakingFeesCollected) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LiquidStakingFeesCollected)
+	if !ok {
+		that2, ok := that.(LiquidStakingFeesCollected)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Fees) != len(that1.Fees) {
+		return false
+	}
+	for i := range this.Fees {
+		if !this.Fees[i].Equal(&that1.Fees[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *ValidatorOutstandingRewards) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -972,6 +1614,61 @@ func (this *FeePool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CommunityPoolStream) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityPoolStream)
+	if !ok {
+		that2, ok := that.(CommunityPoolStream)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if len(this.Paid) != len(that1.Paid) {
+		return false
+	}
+	for i := range this.Paid {
+		if !this.Paid[i].Equal(&that1.Paid[i]) {
+			return false
+		}
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	if this.Cadence != that1.Cadence {
+		return false
+	}
+	if !this.NextPaymentTime.Equal(that1.NextPaymentTime) {
+		return false
+	}
+	return true
+}
 func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1066,6 +1763,41 @@ func (this *TokenizeShareRecordReward) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecordWithdrawal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecordWithdrawal)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecordWithdrawal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RecordId != that1.RecordId {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
 func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1102,48 +1834,146 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CommunityPoolStreamProposalWithDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WithdrawAddrEnabled {
-		i--
-		if m.WithdrawAddrEnabled {
-			dAtA[i] = 1
+	that1, ok := that.(*CommunityPoolStreamProposalWithDeposit)
+	if !ok {
+		that2, ok := that.(CommunityPoolStreamProposalWithDeposit)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	{
-		size := m.BonusProposerReward.Size()
-		i -= size
-		if _, err := m.BonusProposerReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.BaseProposerReward.Size()
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.StartTime != that1.StartTime {
+		return false
+	}
+	if this.EndTime != that1.EndTime {
+		return false
+	}
+	if this.Cadence != that1.Cadence {
+		return false
+	}
+	if this.Deposit != that1.Deposit {
+		return false
+	}
+	return true
+}
+func (this *CancelCommunityPoolStreamProposalWithDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CancelCommunityPoolStreamProposalWithDeposit)
+	if !ok {
+		that2, ok := that.(CancelCommunityPoolStreamProposalWithDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.StreamId != that1.StreamId {
+		return false
+	}
+	if this.Deposit != that1.Deposit {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidStakingRewardFee.Size()
+		i -= size
+		if _, err := m.LiquidStakingRewardFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxRestakesPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxRestakesPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RestakeInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RestakeInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WithdrawAddrEnabled {
+		i--
+		if m.WithdrawAddrEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.BonusProposerReward.Size()
+		i -= size
+		if _, err := m.BonusProposerReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseProposerReward.Size()
 		i -= size
 		if _, err := m.BaseProposerReward.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
@@ -1286,6 +2116,120 @@ func (m *ValidatorAccumulatedCommission) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *CommissionRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCommissionRecipients) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionRecipients) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionRecipients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidStakingFeesCollected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidStakingFeesCollected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidStakingFeesCollected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorOutstandingRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1493,7 +2437,7 @@ func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+func (m *CommunityPoolStreamProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1503,40 +2447,79 @@ func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommunityPoolStreamProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommunityPoolStreamProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Cadence, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Cadence):])
+	if err1 != nil {
+		return 0, err1
 	}
-	{
-		size := m.Stake.Size()
-		i -= size
-		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	i -= n1
+	i = encodeVarintDistribution(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
 	}
+	i -= n2
+	i = encodeVarintDistribution(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x12
-	if m.PreviousPeriod != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
+	dAtA[i] = 0x32
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintDistribution(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+func (m *CancelCommunityPoolStreamProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1546,17 +2529,197 @@ func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+func (m *CancelCommunityPoolStreamProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CancelCommunityPoolStreamProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reward) > 0 {
+	if m.StreamId != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextPaymentTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextPaymentTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintDistribution(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Cadence, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Cadence):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintDistribution(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintDistribution(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintDistribution(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PreviousPeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reward) > 0 {
 		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
@@ -1622,6 +2785,55 @@ func (m *TokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecordWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecordWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecordWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RecordId != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1680,64 +2892,200 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CommunityPoolStreamProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommunityTax.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BaseProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BonusProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	if m.WithdrawAddrEnabled {
-		n += 2
-	}
-	return n
+
+func (m *CommunityPoolStreamProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorHistoricalRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CommunityPoolStreamProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CumulativeRewardRatio) > 0 {
-		for _, e := range m.CumulativeRewardRatio {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
-		}
-	}
-	if m.ReferenceCount != 0 {
-		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *ValidatorCurrentRewards) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Cadence) > 0 {
+		i -= len(m.Cadence)
+		copy(dAtA[i:], m.Cadence)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Cadence)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
-		}
+	if len(m.EndTime) > 0 {
+		i -= len(m.EndTime)
+		copy(dAtA[i:], m.EndTime)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.EndTime)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelCommunityPoolStreamProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelCommunityPoolStreamProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelCommunityPoolStreamProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BaseProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BonusProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.WithdrawAddrEnabled {
+		n += 2
+	}
+	if m.RestakeInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.RestakeInterval))
+	}
+	if m.MaxRestakesPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxRestakesPerBlock))
+	}
+	l = m.LiquidStakingRewardFee.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorHistoricalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeRewardRatio) > 0 {
+		for _, e := range m.CumulativeRewardRatio {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	}
+	return n
+}
+
+func (m *ValidatorCurrentRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
 	}
 	if m.Period != 0 {
 		n += 1 + sovDistribution(uint64(m.Period))
@@ -1760,6 +3108,51 @@ func (m *ValidatorAccumulatedCommission) Size() (n int) {
 	return n
 }
 
+func (m *CommissionRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorCommissionRecipients) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidStakingFeesCollected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ValidatorOutstandingRewards) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1846,6 +3239,95 @@ func (m *CommunityPoolSpendProposal) Size() (n int) {
 	return n
 }
 
+func (m *CommunityPoolStreamProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Cadence)
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *CancelCommunityPoolStreamProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovDistribution(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *CommunityPoolStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Cadence)
+	n += 1 + l + sovDistribution(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextPaymentTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
 func (m *DelegatorStartingInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1900,17 +3382,68 @@ func (m *TokenizeShareRecordReward) Size() (n int) {
 	return n
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
+func (m *TokenizeShareRecordWithdrawal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if m.RecordId != 0 {
+		n += 1 + sovDistribution(uint64(m.RecordId))
 	}
-	l = len(m.Description)
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *CommunityPoolStreamProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
 	if l > 0 {
 		n += 1 + l + sovDistribution(uint64(l))
 	}
@@ -1922,6 +3455,42 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDistribution(uint64(l))
 	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.EndTime)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Cadence)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelCommunityPoolStreamProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovDistribution(uint64(m.StreamId))
+	}
 	l = len(m.Deposit)
 	if l > 0 {
 		n += 1 + l + sovDistribution(uint64(l))
@@ -2032,9 +3601,1605 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusProposerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BonusProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeInterval", wireType)
+			}
+			m.RestakeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestakeInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestakesPerBlock", wireType)
+			}
+			m.MaxRestakesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRestakesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingRewardFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidStakingRewardFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
+			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = append(m.Commission, types.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCommissionRecipients) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionRecipients: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionRecipients: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, CommissionRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidStakingFeesCollected) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidStakingFeesCollected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidStakingFeesCollected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+			}
+			m.ValidatorPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
+			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolStreamProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolStreamProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolStreamProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cadence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Cadence, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelCommunityPoolStreamProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelCommunityPoolStreamProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelCommunityPoolStreamProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BonusProposerReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2062,15 +5227,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BonusProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var v int
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2080,12 +5243,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithdrawAddrEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2107,7 +5269,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
+func (m *CommunityPoolStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2130,15 +5292,66 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityPoolStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityPoolStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2165,16 +5378,16 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
-			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
 			}
-			m.ReferenceCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2184,64 +5397,29 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReferenceCount |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Paid = append(m.Paid, types.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2268,16 +5446,15 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			m.Period = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2287,64 +5464,61 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cadence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Cadence, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPaymentTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2371,8 +5545,7 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = append(m.Commission, types.DecCoin{})
-			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NextPaymentTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2397,7 +5570,7 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2416,21 +5589,40 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+			}
+			m.PreviousPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2440,26 +5632,45 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2481,7 +5692,7 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2504,17 +5715,17 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.ValidatorPeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2524,16 +5735,29 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2543,23 +5767,23 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reward = append(m.Reward, types.DecCoin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2584,7 +5808,7 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2607,15 +5831,34 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecordReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2642,8 +5885,8 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
-			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reward = append(m.Reward, types.DecCoin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2668,7 +5911,7 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeePool) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecordWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2691,15 +5934,34 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecordWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecordWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2726,11 +5988,43 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
-			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2752,7 +6046,7 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2775,10 +6069,10 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2881,7 +6175,7 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2891,25 +6185,55 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2932,7 +6256,7 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
+func (m *CommunityPoolStreamProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2955,17 +6279,49 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityPoolStreamProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityPoolStreamProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			m.PreviousPeriod = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2975,14 +6331,27 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3010,15 +6379,13 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3028,64 +6395,27 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3113,13 +6443,13 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.StartTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3129,81 +6459,29 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = append(m.Reward, types.DecCoin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TokenizeShareRecordReward: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cadence", wireType)
 			}
-			m.RecordId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3213,16 +6491,29 @@ func (m *TokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecordId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cadence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3232,25 +6523,23 @@ func (m *TokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = append(m.Reward, types.DecCoin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3273,7 +6562,7 @@ func (m *TokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *CancelCommunityPoolStreamProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3296,10 +6585,10 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelCommunityPoolStreamProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelCommunityPoolStreamProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3367,10 +6656,10 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var stringLen uint64
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3380,57 +6669,12 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
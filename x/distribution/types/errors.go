@@ -19,4 +19,9 @@ var (
 	// 	ErrNoValidatorExists       = errorsmod.Register(ModuleName, 12, "validator does not exist")
 	// 	ErrNoDelegationExists      = errorsmod.Register(ModuleName, 13, "delegation does not exist")
 	ErrNotTokenizeShareRecordOwner = errorsmod.Register(ModuleName, 44, "not tokenize share record owner")
+	ErrAutoRestakeNotEnabled       = errorsmod.Register(ModuleName, 45, "auto restake not enabled")
+	ErrAutoRestakeWithdrawAddr     = errorsmod.Register(ModuleName, 46, "auto restake requires rewards to be withdrawn to the delegator")
+	ErrInvalidCommissionRecipients = errorsmod.Register(ModuleName, 47, "invalid commission recipients")
+	ErrCommunityPoolStreamNotFound = errorsmod.Register(ModuleName, 48, "community pool stream not found")
+	ErrInvalidCommunityPoolStream  = errorsmod.Register(ModuleName, 49, "invalid community pool stream")
 )
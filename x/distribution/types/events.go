@@ -8,10 +8,25 @@ const (
 	EventTypeWithdrawRewards             = "withdraw_rewards"
 	EventTypeWithdrawCommission          = "withdraw_commission"
 	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
+	EventTypeCompoundTokenizeShareReward = "compound_tokenize_share_reward"
+	EventTypeSweepTokenizeShareRecord    = "sweep_tokenize_share_record"
 	EventTypeProposerReward              = "proposer_reward"
+	EventTypeSetAutoRestake              = "set_auto_restake"
+	EventTypeAutoRestake                 = "auto_restake"
+	EventTypeSetCommissionRecipients     = "set_commission_recipients"
+	EventTypeSplitCommission             = "split_commission"
+	EventTypeLiquidStakingRewardFee      = "liquid_staking_reward_fee"
+	EventTypeCreateCommunityPoolStream   = "create_community_pool_stream"
+	EventTypeCancelCommunityPoolStream   = "cancel_community_pool_stream"
+	EventTypeCommunityPoolStreamPayment  = "community_pool_stream_payment"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyRecordID        = "record_id"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyStreamID        = "stream_id"
 
 	AttributeValueCategory = ModuleName
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
@@ -71,7 +72,16 @@ type StakingKeeper interface {
 	GetAllSDKDelegations(ctx sdk.Context) []stakingtypes.Delegation
 
 	GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (tokenizeShareRecords []stakingtypes.TokenizeShareRecord)
+	IterateTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress, startRecordID uint64,
+		cb func(tokenizeShareRecord stakingtypes.TokenizeShareRecord) (stop bool))
 	GetTokenizeShareRecord(ctx sdk.Context, id uint64) (tokenizeShareRecord stakingtypes.TokenizeShareRecord, err error)
+	GetTokenizeShareRecordsByValidator(ctx sdk.Context, valAddr sdk.ValAddress, pageReq *query.PageRequest) (
+		tokenizeShareRecords []stakingtypes.TokenizeShareRecord, pageRes *query.PageResponse, err error)
+	CompoundTokenizeShareRecordRewards(ctx sdk.Context, record stakingtypes.TokenizeShareRecord, amount sdk.Int) (sdk.Dec, error)
+	RemoveUndelegatedTokenizeShareRecord(ctx sdk.Context, recordID uint64) error
+	BondDenom(ctx sdk.Context) string
+	AccountIsLiquidStakingProvider(address sdk.AccAddress) bool
+	DelegateTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens sdk.Int) (sdk.Dec, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
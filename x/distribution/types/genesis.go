@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,6 +11,8 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	restakes []DelegatorAutoRestake, recipients []ValidatorCommissionRecipientsRecord, fees sdk.Coins,
+	streams []CommunityPoolStream, lastStreamID uint64, historicalTimes []ValidatorHistoricalRewardsTimeRecord,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -21,6 +25,12 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		DelegatorAutoRestakes:           restakes,
+		ValidatorCommissionRecipients:   recipients,
+		LiquidStakingFeesCollected:      fees,
+		CommunityPoolStreams:            streams,
+		LastCommunityPoolStreamId:       lastStreamID,
+		ValidatorHistoricalRewardsTimes: historicalTimes,
 	}
 }
 
@@ -37,6 +47,12 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		DelegatorAutoRestakes:           []DelegatorAutoRestake{},
+		ValidatorCommissionRecipients:   []ValidatorCommissionRecipientsRecord{},
+		LiquidStakingFeesCollected:      sdk.Coins{},
+		CommunityPoolStreams:            []CommunityPoolStream{},
+		LastCommunityPoolStreamId:       0,
+		ValidatorHistoricalRewardsTimes: []ValidatorHistoricalRewardsTimeRecord{},
 	}
 }
 
@@ -45,5 +61,74 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := validateDelegatorAutoRestakes(gs.DelegatorAutoRestakes); err != nil {
+		return err
+	}
+	if err := validateValidatorCommissionRecipients(gs.ValidatorCommissionRecipients); err != nil {
+		return err
+	}
+	if err := gs.LiquidStakingFeesCollected.Validate(); err != nil {
+		return fmt.Errorf("invalid liquid staking fees collected: %w", err)
+	}
+	if err := validateCommunityPoolStreams(gs.CommunityPoolStreams, gs.LastCommunityPoolStreamId); err != nil {
+		return err
+	}
 	return gs.FeePool.ValidateGenesis()
 }
+
+func validateDelegatorAutoRestakes(restakes []DelegatorAutoRestake) error {
+	seen := make(map[string]bool, len(restakes))
+	for _, restake := range restakes {
+		if _, err := sdk.AccAddressFromBech32(restake.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid auto restake delegator address %s: %w", restake.DelegatorAddress, err)
+		}
+		if _, err := sdk.ValAddressFromBech32(restake.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid auto restake validator address %s: %w", restake.ValidatorAddress, err)
+		}
+
+		key := restake.DelegatorAddress + "/" + restake.ValidatorAddress
+		if seen[key] {
+			return fmt.Errorf("duplicate auto restake of %s to %s", restake.DelegatorAddress, restake.ValidatorAddress)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func validateValidatorCommissionRecipients(records []ValidatorCommissionRecipientsRecord) error {
+	seen := make(map[string]bool, len(records))
+	for _, record := range records {
+		if _, err := sdk.ValAddressFromBech32(record.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid commission recipients validator address %s: %w", record.ValidatorAddress, err)
+		}
+		if seen[record.ValidatorAddress] {
+			return fmt.Errorf("duplicate commission recipients of %s", record.ValidatorAddress)
+		}
+		seen[record.ValidatorAddress] = true
+
+		if len(record.Recipients) == 0 {
+			return fmt.Errorf("empty commission recipients of %s", record.ValidatorAddress)
+		}
+		if err := ValidateCommissionRecipients(record.Recipients); err != nil {
+			return fmt.Errorf("invalid commission recipients of %s: %w", record.ValidatorAddress, err)
+		}
+	}
+	return nil
+}
+
+func validateCommunityPoolStreams(streams []CommunityPoolStream, lastStreamID uint64) error {
+	seen := make(map[uint64]bool, len(streams))
+	for _, stream := range streams {
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+		if stream.Id > lastStreamID {
+			return fmt.Errorf("community pool stream id %d exceeds the last stream id %d", stream.Id, lastStreamID)
+		}
+		if seen[stream.Id] {
+			return fmt.Errorf("duplicate community pool stream %d", stream.Id)
+		}
+		seen[stream.Id] = true
+	}
+	return nil
+}
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -69,6 +73,89 @@ func (m *DelegatorWithdrawInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorWithdrawInfo proto.InternalMessageInfo
 
+// DelegatorAutoRestake is a delegation whose rewards are automatically restaked,
+// used for import/export via genesis json.
+type DelegatorAutoRestake struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *DelegatorAutoRestake) Reset()         { *m = DelegatorAutoRestake{} }
+func (m *DelegatorAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*DelegatorAutoRestake) ProtoMessage()    {}
+func (*DelegatorAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02ffc8100ab19bc0, []int{1}
+}
+func (m *DelegatorAutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorAutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorAutoRestake.Merge(m, src)
+}
+func (m *DelegatorAutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorAutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorAutoRestake proto.InternalMessageInfo
+
+// ValidatorCommissionRecipientsRecord is used for import/export via genesis json.
+type ValidatorCommissionRecipientsRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// recipients defines the recipients the commission of the validator is split across.
+	Recipients []CommissionRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *ValidatorCommissionRecipientsRecord) Reset()         { *m = ValidatorCommissionRecipientsRecord{} }
+func (m *ValidatorCommissionRecipientsRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionRecipientsRecord) ProtoMessage()    {}
+func (*ValidatorCommissionRecipientsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02ffc8100ab19bc0, []int{2}
+}
+func (m *ValidatorCommissionRecipientsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCommissionRecipientsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCommissionRecipientsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorCommissionRecipientsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionRecipientsRecord.Merge(m, src)
+}
+func (m *ValidatorCommissionRecipientsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCommissionRecipientsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionRecipientsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCommissionRecipientsRecord proto.InternalMessageInfo
+
 // ValidatorOutstandingRewardsRecord is used for import/export via genesis json.
 type ValidatorOutstandingRewardsRecord struct {
 	// validator_address is the address of the validator.
@@ -81,7 +168,7 @@ func (m *ValidatorOutstandingRewardsRecord) Reset()         { *m = ValidatorOuts
 func (m *ValidatorOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewardsRecord) ProtoMessage()    {}
 func (*ValidatorOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02ffc8100ab19bc0, []int{1}
+	return fileDescriptor_02ffc8100ab19bc0, []int{3}
 }
 func (m *ValidatorOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +210,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Reset()         { *m = ValidatorA
 func (m *ValidatorAccumulatedCommissionRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommissionRecord) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommissionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02ffc8100ab19bc0, []int{2}
+	return fileDescriptor_02ffc8100ab19bc0, []int{4}
 }
 func (m *ValidatorAccumulatedCommissionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -167,7 +254,7 @@ func (m *ValidatorHistoricalRewardsRecord) Reset()         { *m = ValidatorHisto
 func (m *ValidatorHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewardsRecord) ProtoMessage()    {}
 func (*ValidatorHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02ffc8100ab19bc0, []int{3}
+	return fileDescriptor_02ffc8100ab19bc0, []int{5}
 }
 func (m *ValidatorHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,6 +283,49 @@ func (m *ValidatorHistoricalRewardsRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorHistoricalRewardsRecord proto.InternalMessageInfo
 
+// ValidatorHistoricalRewardsTimeRecord is used for import / export via genesis json.
+type ValidatorHistoricalRewardsTimeRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// period defines the period the historical rewards apply to.
+	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// time defines the block time the period ended at.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *ValidatorHistoricalRewardsTimeRecord) Reset()         { *m = ValidatorHistoricalRewardsTimeRecord{} }
+func (m *ValidatorHistoricalRewardsTimeRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorHistoricalRewardsTimeRecord) ProtoMessage()    {}
+func (*ValidatorHistoricalRewardsTimeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02ffc8100ab19bc0, []int{6}
+}
+func (m *ValidatorHistoricalRewardsTimeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorHistoricalRewardsTimeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorHistoricalRewardsTimeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorHistoricalRewardsTimeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorHistoricalRewardsTimeRecord.Merge(m, src)
+}
+func (m *ValidatorHistoricalRewardsTimeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorHistoricalRewardsTimeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorHistoricalRewardsTimeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorHistoricalRewardsTimeRecord proto.InternalMessageInfo
+
 // ValidatorCurrentRewardsRecord is used for import / export via genesis json.
 type ValidatorCurrentRewardsRecord struct {
 	// validator_address is the address of the validator.
@@ -208,7 +338,7 @@ func (m *ValidatorCurrentRewardsRecord) Reset()         { *m = ValidatorCurrentR
 func (m *ValidatorCurrentRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewardsRecord) ProtoMessage()    {}
 func (*ValidatorCurrentRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02ffc8100ab19bc0, []int{4}
+	return fileDescriptor_02ffc8100ab19bc0, []int{7}
 }
 func (m *ValidatorCurrentRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,7 +381,7 @@ func (m *DelegatorStartingInfoRecord) Reset()         { *m = DelegatorStartingIn
 func (m *DelegatorStartingInfoRecord) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfoRecord) ProtoMessage()    {}
 func (*DelegatorStartingInfoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02ffc8100ab19bc0, []int{5}
+	return fileDescriptor_02ffc8100ab19bc0, []int{8}
 }
 func (m *DelegatorStartingInfoRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +426,7 @@ func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRe
 func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEventRecord) ProtoMessage()    {}
 func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02ffc8100ab19bc0, []int{6}
+	return fileDescriptor_02ffc8100ab19bc0, []int{9}
 }
 func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,13 +477,26 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// delegator_auto_restakes defines the delegations with auto restake enabled at genesis.
+	DelegatorAutoRestakes []DelegatorAutoRestake `protobuf:"bytes,11,rep,name=delegator_auto_restakes,json=delegatorAutoRestakes,proto3" json:"delegator_auto_restakes"`
+	// validator_commission_recipients defines the commission recipients of the validators at genesis.
+	ValidatorCommissionRecipients []ValidatorCommissionRecipientsRecord `protobuf:"bytes,12,rep,name=validator_commission_recipients,json=validatorCommissionRecipients,proto3" json:"validator_commission_recipients"`
+	// liquid_staking_fees_collected defines the cumulative liquid staking reward fees at genesis.
+	LiquidStakingFeesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=liquid_staking_fees_collected,json=liquidStakingFeesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquid_staking_fees_collected"`
+	// community_pool_streams defines the active community pool streams at genesis.
+	CommunityPoolStreams []CommunityPoolStream `protobuf:"bytes,14,rep,name=community_pool_streams,json=communityPoolStreams,proto3" json:"community_pool_streams"`
+	// last_community_pool_stream_id defines the id of the last community pool stream created.
+	LastCommunityPoolStreamId uint64 `protobuf:"varint,15,opt,name=last_community_pool_stream_id,json=lastCommunityPoolStreamId,proto3" json:"last_community_pool_stream_id,omitempty"`
+	// validator_historical_rewards_times defines the block times the historical
+	// rewards periods of the validators ended at.
+	ValidatorHistoricalRewardsTimes []ValidatorHistoricalRewardsTimeRecord `protobuf:"bytes,16,rep,name=validator_historical_rewards_times,json=validatorHistoricalRewardsTimes,proto3" json:"validator_historical_rewards_times"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02ffc8100ab19bc0, []int{7}
+	return fileDescriptor_02ffc8100ab19bc0, []int{10}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,9 +527,12 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "liquidstaking.distribution.v1beta1.DelegatorWithdrawInfo")
+	proto.RegisterType((*DelegatorAutoRestake)(nil), "liquidstaking.distribution.v1beta1.DelegatorAutoRestake")
+	proto.RegisterType((*ValidatorCommissionRecipientsRecord)(nil), "liquidstaking.distribution.v1beta1.ValidatorCommissionRecipientsRecord")
 	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "liquidstaking.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
 	proto.RegisterType((*ValidatorAccumulatedCommissionRecord)(nil), "liquidstaking.distribution.v1beta1.ValidatorAccumulatedCommissionRecord")
 	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "liquidstaking.distribution.v1beta1.ValidatorHistoricalRewardsRecord")
+	proto.RegisterType((*ValidatorHistoricalRewardsTimeRecord)(nil), "liquidstaking.distribution.v1beta1.ValidatorHistoricalRewardsTimeRecord")
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "liquidstaking.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "liquidstaking.distribution.v1beta1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "liquidstaking.distribution.v1beta1.ValidatorSlashEventRecord")
@@ -398,66 +544,85 @@ func init() {
 }
 
 var fileDescriptor_02ffc8100ab19bc0 = []byte{
-	// 930 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x8b, 0x23, 0x45,
-	0x14, 0x4e, 0x67, 0xc6, 0xec, 0x4c, 0x65, 0xc5, 0xb5, 0x77, 0x76, 0xec, 0x19, 0xd7, 0xce, 0x6c,
-	0x10, 0x5c, 0x5c, 0xd2, 0x61, 0x67, 0x0f, 0xa2, 0xa2, 0x32, 0x49, 0x46, 0x47, 0x10, 0x1c, 0x12,
-	0x50, 0x50, 0x30, 0x54, 0xba, 0x2b, 0x9d, 0x72, 0x3b, 0x5d, 0x99, 0xaa, 0xea, 0x1e, 0x03, 0x82,
-	0xa0, 0x17, 0x0f, 0x82, 0x9e, 0x15, 0x64, 0x8f, 0x22, 0x78, 0xf3, 0x8f, 0xd8, 0x8b, 0xb0, 0x78,
-	0xf2, 0xa4, 0x92, 0x5c, 0x04, 0xff, 0x02, 0x6f, 0xd2, 0x55, 0xd5, 0xbf, 0x98, 0x9e, 0xd9, 0x64,
-	0x67, 0xe6, 0x94, 0x54, 0xd7, 0xfb, 0xf1, 0x7d, 0xef, 0x7d, 0xbc, 0x57, 0xa0, 0xee, 0x60, 0xc6,
-	0x29, 0x1e, 0x04, 0x1c, 0x13, 0xbf, 0x19, 0xde, 0x1d, 0x20, 0x0e, 0xef, 0x36, 0x5d, 0xe4, 0x23,
-	0x86, 0x99, 0x35, 0xa1, 0x84, 0x13, 0xbd, 0xee, 0xe1, 0xa3, 0x00, 0x3b, 0x8c, 0xc3, 0xfb, 0xd8,
-	0x77, 0xad, 0xac, 0x87, 0xa5, 0x3c, 0xb6, 0x37, 0x5c, 0xe2, 0x12, 0x61, 0xde, 0x8c, 0xfe, 0x49,
-	0xcf, 0x6d, 0xd3, 0x26, 0x6c, 0x4c, 0x58, 0x73, 0x00, 0x19, 0x4a, 0x82, 0xdb, 0x04, 0xfb, 0xea,
-	0xfe, 0xa5, 0xc2, 0xec, 0xb9, 0x04, 0xd2, 0x70, 0x4b, 0x06, 0xea, 0xcb, 0x0c, 0xf2, 0x20, 0xaf,
-	0xea, 0xbf, 0x68, 0xe0, 0x46, 0x07, 0x79, 0xc8, 0x85, 0x9c, 0xd0, 0x0f, 0x31, 0x1f, 0x39, 0x14,
-	0x1e, 0xbf, 0xeb, 0x0f, 0x89, 0xbe, 0x0f, 0x9e, 0x75, 0xe2, 0x8b, 0x3e, 0x74, 0x1c, 0x8a, 0x18,
-	0x33, 0xb4, 0x1d, 0xed, 0xf6, 0x7a, 0xcb, 0xf8, 0xfd, 0xd7, 0xc6, 0x86, 0x0a, 0xb3, 0x27, 0x6f,
-	0x7a, 0x9c, 0x62, 0xdf, 0xed, 0x5e, 0x4b, 0x5c, 0xd4, 0x77, 0xbd, 0x0d, 0xae, 0x1d, 0xab, 0xb0,
-	0x49, 0x94, 0xf2, 0x63, 0xa2, 0x3c, 0x13, 0x7b, 0xa8, 0xcf, 0xaf, 0xad, 0x7d, 0xfd, 0xa0, 0x56,
-	0xfa, 0xe7, 0x41, 0xad, 0x54, 0xff, 0x4f, 0x03, 0xb7, 0x3e, 0x80, 0x1e, 0x76, 0xa2, 0x1c, 0xef,
-	0x07, 0x9c, 0x71, 0xe8, 0x3b, 0x91, 0x0f, 0x3a, 0x86, 0xd4, 0x61, 0x5d, 0x64, 0x13, 0xea, 0x44,
-	0xd8, 0xc3, 0xd8, 0x68, 0x71, 0xec, 0x89, 0x4b, 0x8c, 0xfd, 0x4b, 0x0d, 0x5c, 0x27, 0x69, 0x8e,
-	0x3e, 0x95, 0x49, 0x8c, 0xf2, 0xce, 0xca, 0xed, 0xea, 0xee, 0x4d, 0x4b, 0x85, 0x89, 0xfa, 0x13,
-	0xb7, 0xd2, 0xea, 0x20, 0xbb, 0x4d, 0xb0, 0xdf, 0xba, 0xf7, 0xf0, 0xcf, 0x5a, 0xe9, 0xe7, 0xbf,
-	0x6a, 0x77, 0x5c, 0xcc, 0x47, 0xc1, 0xc0, 0xb2, 0xc9, 0x58, 0x55, 0x5e, 0xfd, 0x34, 0x98, 0x73,
-	0xbf, 0xc9, 0xa7, 0x13, 0xc4, 0x62, 0x1f, 0xd6, 0xd5, 0xc9, 0x09, 0x46, 0x19, 0xee, 0x73, 0x0d,
-	0xbc, 0x98, 0x70, 0xdf, 0xb3, 0xed, 0x60, 0x1c, 0x78, 0x90, 0x23, 0xa7, 0x4d, 0xc6, 0x63, 0xcc,
-	0x18, 0x26, 0xfe, 0xc5, 0xd2, 0xff, 0x14, 0x54, 0x61, 0x9a, 0x45, 0x74, 0xad, 0xba, 0xdb, 0xb2,
-	0x1e, 0xaf, 0x67, 0xeb, 0x6c, 0x94, 0xad, 0xd5, 0xa8, 0x36, 0xdd, 0x6c, 0xf0, 0x0c, 0xcb, 0x7f,
-	0x35, 0xb0, 0x93, 0xf8, 0x1f, 0x60, 0xc6, 0x09, 0xc5, 0x36, 0xf4, 0x2e, 0xa5, 0xc1, 0x9b, 0xa0,
-	0x32, 0x41, 0x14, 0x13, 0x49, 0x6e, 0xb5, 0xab, 0x4e, 0xfa, 0x27, 0xe0, 0x4a, 0xdc, 0xeb, 0x15,
-	0xc1, 0xfa, 0xcd, 0xa5, 0x58, 0x9f, 0x40, 0xad, 0x18, 0xc7, 0x41, 0x33, 0x6c, 0x7f, 0xd3, 0xc0,
-	0x0b, 0x89, 0x5f, 0x3b, 0xa0, 0x14, 0xf9, 0xfc, 0x52, 0xa8, 0x7e, 0x9c, 0x52, 0x92, 0x8d, 0x7c,
-	0x7d, 0x29, 0x4a, 0x79, 0x68, 0xa7, 0xf3, 0xf9, 0xa1, 0x0c, 0x9e, 0x4f, 0xe6, 0x49, 0x8f, 0x43,
-	0xca, 0xb1, 0xef, 0x46, 0xf3, 0x24, 0x65, 0x73, 0x11, 0x53, 0xa5, 0xb0, 0x28, 0xe5, 0xa5, 0x8b,
-	0xe2, 0x80, 0xa7, 0x99, 0xc2, 0xd8, 0xc7, 0xfe, 0x90, 0xa8, 0x6e, 0xbf, 0xba, 0x48, 0x69, 0x0a,
-	0x59, 0xaa, 0xc2, 0x5c, 0x65, 0x99, 0x6f, 0x99, 0xea, 0x7c, 0x5b, 0x06, 0x5b, 0x49, 0x49, 0x7b,
-	0x1e, 0x64, 0xa3, 0xfd, 0x50, 0x54, 0xf5, 0x82, 0x45, 0x3d, 0x42, 0xd8, 0x1d, 0xf1, 0x58, 0xd4,
-	0xf2, 0x94, 0x11, 0xfb, 0x4a, 0x4e, 0xec, 0x47, 0xe0, 0x46, 0x9a, 0x96, 0x45, 0xa0, 0xfa, 0x28,
-	0x42, 0x65, 0xac, 0x8a, 0x62, 0xbc, 0xb2, 0x94, 0x4e, 0x52, 0x52, 0xaa, 0x14, 0xd7, 0xc3, 0x93,
-	0x57, 0x99, 0x8a, 0xfc, 0xb8, 0x0e, 0xae, 0xbe, 0x23, 0xf7, 0x65, 0x8f, 0x43, 0x8e, 0xf4, 0x03,
-	0x50, 0x99, 0x40, 0x0a, 0xc7, 0x92, 0x79, 0x75, 0xf7, 0xe5, 0x45, 0xd2, 0x1f, 0x0a, 0x0f, 0x95,
-	0x51, 0xf9, 0xeb, 0xef, 0x81, 0xb5, 0x21, 0x42, 0xfd, 0x09, 0x21, 0x9e, 0x92, 0xfc, 0x9d, 0x45,
-	0x62, 0xbd, 0x8d, 0xd0, 0x21, 0x21, 0x5e, 0x2c, 0xf1, 0xa1, 0x3c, 0xea, 0x53, 0x60, 0xa4, 0xc2,
-	0x4d, 0x36, 0x5a, 0x24, 0x9a, 0x68, 0x46, 0xac, 0x2c, 0xad, 0x9a, 0xec, 0xae, 0x55, 0xb9, 0x36,
-	0x9d, 0xa2, 0x4b, 0x21, 0xf6, 0x09, 0x45, 0x21, 0x26, 0x81, 0x58, 0xe1, 0x13, 0xc2, 0x10, 0x15,
-	0xcd, 0x39, 0x53, 0x17, 0xb1, 0xcb, 0xa1, 0xf2, 0xd0, 0x3f, 0x2f, 0x5e, 0x66, 0x4f, 0x09, 0xf0,
-	0xfb, 0x4b, 0x75, 0xf9, 0xb4, 0xc5, 0xab, 0x88, 0x14, 0xac, 0x31, 0xfd, 0x7b, 0x0d, 0xdc, 0xca,
-	0xa8, 0x3b, 0x1d, 0xfd, 0x7d, 0x3b, 0x59, 0x0c, 0xcc, 0xa8, 0x08, 0x30, 0x07, 0xe7, 0xdf, 0x31,
-	0x39, 0x3c, 0xb5, 0xf0, 0x4c, 0x5b, 0xa6, 0x7f, 0xa3, 0x81, 0x9b, 0x29, 0xb8, 0x51, 0x32, 0xbe,
-	0x93, 0x22, 0x5d, 0x11, 0xb8, 0x3a, 0xe7, 0xdb, 0x02, 0x39, 0x4c, 0xdb, 0xe1, 0xa9, 0x76, 0xfa,
-	0x57, 0x1a, 0xd8, 0x4a, 0xe1, 0xd8, 0x72, 0xf4, 0x26, 0x58, 0xd6, 0x04, 0x96, 0xbd, 0x73, 0x8c,
-	0xef, 0x1c, 0x90, 0xe7, 0xc2, 0x62, 0x23, 0xfd, 0x8b, 0xac, 0xe2, 0x73, 0x63, 0x92, 0x19, 0xeb,
-	0x02, 0xc3, 0x5b, 0x4f, 0x3c, 0x27, 0x73, 0x08, 0x52, 0xdd, 0x67, 0x4d, 0x98, 0x3e, 0x05, 0x9b,
-	0x85, 0x83, 0x89, 0x19, 0x40, 0xa4, 0x7f, 0xe3, 0x09, 0x27, 0x53, 0x2e, 0xf9, 0x46, 0xc1, 0x7c,
-	0xca, 0x2c, 0xb4, 0xd6, 0xe0, 0xa7, 0x99, 0xa9, 0x3d, 0x9c, 0x99, 0xda, 0xa3, 0x99, 0xa9, 0xfd,
-	0x3d, 0x33, 0xb5, 0xef, 0xe6, 0x66, 0xe9, 0xd1, 0xdc, 0x2c, 0xfd, 0x31, 0x37, 0x4b, 0x1f, 0x75,
-	0x32, 0xaf, 0x3b, 0x7c, 0xe4, 0x05, 0x91, 0x9c, 0xb0, 0x6f, 0x37, 0x25, 0x30, 0xcc, 0xa7, 0x0d,
-	0x05, 0xae, 0x31, 0x26, 0x4e, 0xe0, 0xa1, 0xe6, 0x67, 0xb9, 0xf7, 0xb9, 0x7c, 0xff, 0x0d, 0x2a,
-	0xe2, 0x2d, 0x7e, 0xef, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0x65, 0x4b, 0x32, 0x4f, 0x0c,
-	0x00, 0x00,
+	// 1239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0x24, 0xf9, 0xa6, 0xe9, 0xa4, 0xfd, 0x36, 0x9d, 0x26, 0xe9, 0x26, 0x34, 0x76, 0x1a,
+	0x90, 0x88, 0xa8, 0x62, 0xd3, 0xf4, 0x40, 0x01, 0xf1, 0x23, 0x4e, 0xd2, 0xa6, 0x12, 0x12, 0x91,
+	0x5d, 0x81, 0x04, 0x82, 0xd5, 0x7a, 0x77, 0xbc, 0x1e, 0xb2, 0xde, 0x71, 0x76, 0x66, 0x1d, 0x22,
+	0x21, 0x21, 0xc1, 0x85, 0x03, 0x82, 0x1e, 0xe0, 0x42, 0x2f, 0x3d, 0x22, 0x24, 0x38, 0xf1, 0x47,
+	0x94, 0x03, 0x52, 0x85, 0x84, 0xc4, 0x89, 0xa2, 0xe4, 0x82, 0xc4, 0x5f, 0xc0, 0x0d, 0xed, 0xcc,
+	0xec, 0xee, 0xac, 0xbc, 0x49, 0xec, 0x24, 0x45, 0x9c, 0xda, 0xd9, 0x99, 0xf7, 0x3e, 0x9f, 0xcf,
+	0xbc, 0xe7, 0xf7, 0xde, 0x04, 0x2e, 0x38, 0x84, 0xf1, 0x80, 0x34, 0x42, 0x4e, 0xa8, 0x5f, 0xe9,
+	0x5e, 0x6f, 0x60, 0x6e, 0x5d, 0xaf, 0xb8, 0xd8, 0xc7, 0x8c, 0xb0, 0x72, 0x27, 0xa0, 0x9c, 0xa2,
+	0x05, 0x8f, 0x6c, 0x87, 0xc4, 0x61, 0xdc, 0xda, 0x22, 0xbe, 0x5b, 0xd6, 0x2d, 0xca, 0xca, 0x62,
+	0x76, 0xd2, 0xa5, 0x2e, 0x15, 0xc7, 0x2b, 0xd1, 0xff, 0xa4, 0xe5, 0x6c, 0xd1, 0xa6, 0xac, 0x4d,
+	0x59, 0xa5, 0x61, 0x31, 0x9c, 0x38, 0xb7, 0x29, 0xf1, 0xd5, 0xfe, 0xb3, 0xb9, 0xe8, 0x19, 0x00,
+	0x79, 0x70, 0x46, 0x3a, 0x32, 0x25, 0x82, 0x5c, 0xa8, 0xad, 0x92, 0x4b, 0xa9, 0xeb, 0xe1, 0x8a,
+	0x58, 0x35, 0xc2, 0x66, 0x85, 0x93, 0x36, 0x66, 0xdc, 0x6a, 0x77, 0xe4, 0x81, 0x85, 0xef, 0x01,
+	0x9c, 0x5a, 0xc3, 0x1e, 0x76, 0x2d, 0x4e, 0x83, 0xb7, 0x09, 0x6f, 0x39, 0x81, 0xb5, 0x73, 0xc7,
+	0x6f, 0x52, 0xb4, 0x0e, 0x2f, 0x3a, 0xf1, 0x86, 0x69, 0x39, 0x4e, 0x80, 0x19, 0x33, 0xc0, 0x3c,
+	0x58, 0x3c, 0x5b, 0x35, 0x7e, 0xf9, 0x71, 0x69, 0x52, 0xe1, 0xac, 0xc8, 0x9d, 0x3a, 0x0f, 0x88,
+	0xef, 0xd6, 0x26, 0x12, 0x13, 0xf5, 0x1d, 0xad, 0xc2, 0x89, 0x1d, 0xe5, 0x36, 0xf1, 0x32, 0x74,
+	0x84, 0x97, 0x0b, 0xb1, 0x85, 0xfa, 0xfc, 0xd2, 0xd8, 0x67, 0x0f, 0x4a, 0x85, 0x3f, 0x1f, 0x94,
+	0x0a, 0x0b, 0x3f, 0x00, 0x38, 0x99, 0xf0, 0x5d, 0x09, 0x39, 0xad, 0x45, 0x72, 0xb6, 0xf0, 0x69,
+	0xd1, 0x5d, 0x87, 0x17, 0xbb, 0x96, 0x47, 0x9c, 0x8c, 0x9b, 0xa3, 0xf8, 0x4e, 0x24, 0x26, 0xbd,
+	0x84, 0x7f, 0x05, 0xf0, 0xe9, 0xb7, 0xe2, 0xed, 0x55, 0xda, 0x6e, 0x13, 0xc6, 0x08, 0xf5, 0x6b,
+	0xd8, 0x26, 0x1d, 0x82, 0x7d, 0xce, 0x6a, 0xd8, 0xa6, 0x81, 0x93, 0x0f, 0x0c, 0x06, 0x05, 0x46,
+	0xef, 0x41, 0x18, 0x24, 0xae, 0x8d, 0xa1, 0xf9, 0xe1, 0xc5, 0xf1, 0xe5, 0x17, 0xca, 0x47, 0xe7,
+	0x68, 0x39, 0x87, 0x5a, 0x75, 0xe4, 0xe1, 0xef, 0xa5, 0x42, 0x4d, 0x73, 0xa8, 0xe9, 0xfa, 0x1b,
+	0xc0, 0xab, 0x89, 0xae, 0x37, 0x43, 0xce, 0xb8, 0xe5, 0x3b, 0x11, 0x27, 0xbc, 0x63, 0x05, 0xce,
+	0x29, 0xab, 0xfa, 0x04, 0xc0, 0x4b, 0x34, 0xc5, 0x30, 0x03, 0x09, 0xa2, 0xf4, 0x5d, 0x29, 0x2b,
+	0x37, 0xd1, 0x2f, 0x29, 0x11, 0xb4, 0x86, 0xed, 0x55, 0x4a, 0xfc, 0xea, 0x8d, 0x48, 0xc4, 0x77,
+	0x8f, 0x4b, 0xd7, 0x5c, 0xc2, 0x5b, 0x61, 0xa3, 0x6c, 0xd3, 0xb6, 0xfa, 0x8d, 0xa8, 0x7f, 0x96,
+	0x98, 0xb3, 0x55, 0xe1, 0xbb, 0x1d, 0xcc, 0x62, 0x1b, 0x56, 0x43, 0xb4, 0x47, 0x91, 0xa6, 0x7d,
+	0x1f, 0xc0, 0x67, 0x12, 0xed, 0x2b, 0xb6, 0x1d, 0xb6, 0x43, 0xcf, 0xe2, 0xd8, 0xc9, 0xdc, 0xe1,
+	0x29, 0xca, 0xff, 0x00, 0x8e, 0x5b, 0x29, 0x8a, 0x48, 0xc7, 0xf1, 0xe5, 0x6a, 0x3f, 0x51, 0x3d,
+	0x9c, 0xa5, 0x0a, 0xb0, 0xee, 0x5c, 0x53, 0xf9, 0x17, 0x80, 0xf3, 0x89, 0xfd, 0x06, 0x61, 0x9c,
+	0x06, 0xc4, 0xb6, 0xbc, 0x27, 0x12, 0xe0, 0x69, 0x38, 0xda, 0xc1, 0x01, 0xa1, 0x52, 0xdc, 0x48,
+	0x4d, 0xad, 0xd0, 0xfb, 0xf0, 0x4c, 0x1c, 0xeb, 0x61, 0xa1, 0xfa, 0xd5, 0x81, 0x54, 0xf7, 0xb0,
+	0x56, 0x8a, 0x63, 0xa7, 0x9a, 0xda, 0x9f, 0xf4, 0x98, 0xf6, 0xd8, 0xdd, 0x25, 0x6d, 0xfc, 0xef,
+	0x28, 0xbe, 0x09, 0x47, 0xa2, 0x1a, 0xad, 0xe4, 0xce, 0x96, 0x65, 0x01, 0x2f, 0xc7, 0x05, 0xbc,
+	0x7c, 0x37, 0x2e, 0xe0, 0xd5, 0xb1, 0x48, 0xca, 0xbd, 0xc7, 0x25, 0x50, 0x13, 0x16, 0x9a, 0x96,
+	0x9f, 0x01, 0x9c, 0x4b, 0x6b, 0x4e, 0x18, 0x04, 0xd8, 0xe7, 0x4f, 0x24, 0x6c, 0xef, 0xa6, 0xe1,
+	0x91, 0x49, 0xf9, 0xf2, 0x40, 0xe1, 0xc9, 0x52, 0x3b, 0x38, 0x36, 0xf7, 0x87, 0xe0, 0x53, 0x49,
+	0xd1, 0xaf, 0x73, 0x2b, 0xe0, 0xc4, 0x77, 0xa3, 0x26, 0x95, 0xaa, 0xf9, 0xef, 0xd4, 0x7e, 0xe4,
+	0xc0, 0xf3, 0x4c, 0x71, 0x34, 0x89, 0xdf, 0xa4, 0x2a, 0x94, 0x2f, 0xf6, 0x73, 0x35, 0xb9, 0x2a,
+	0xd5, 0xc5, 0x9c, 0x63, 0xda, 0x37, 0xed, 0x76, 0xbe, 0x1c, 0x82, 0x33, 0xc9, 0x95, 0xd6, 0x3d,
+	0x8b, 0xb5, 0xd6, 0xbb, 0xe2, 0x56, 0x4f, 0x39, 0x5d, 0x5b, 0x98, 0xb8, 0x2d, 0x1e, 0xa7, 0xab,
+	0x5c, 0x69, 0x69, 0x3c, 0x9c, 0x49, 0xe3, 0x6d, 0x38, 0x95, 0xc2, 0xb2, 0x88, 0x94, 0x89, 0x23,
+	0x56, 0xc6, 0xc8, 0x3c, 0xe8, 0xb7, 0x25, 0xe5, 0x88, 0x52, 0x57, 0x71, 0xa9, 0xdb, 0xbb, 0xa5,
+	0xdd, 0xc8, 0x57, 0x17, 0xe0, 0xb9, 0xdb, 0x72, 0x4a, 0xab, 0x73, 0x8b, 0x63, 0xb4, 0x01, 0x47,
+	0x3b, 0x56, 0x60, 0xb5, 0xa5, 0xf2, 0xf1, 0xe5, 0xe7, 0xfa, 0x81, 0xdf, 0x14, 0x16, 0x0a, 0x51,
+	0xd9, 0xa3, 0x37, 0xe0, 0x58, 0x13, 0x63, 0xb3, 0x43, 0xa9, 0xa7, 0x52, 0xfe, 0x5a, 0x3f, 0xbe,
+	0x6e, 0x61, 0xbc, 0x49, 0xa9, 0x17, 0xa7, 0x78, 0x53, 0x2e, 0xd1, 0x2e, 0x34, 0xd2, 0xc4, 0x4d,
+	0xc6, 0xa4, 0x28, 0x69, 0xa2, 0x7a, 0x37, 0x3c, 0x70, 0xd6, 0xe8, 0x03, 0x9c, 0xc2, 0x9a, 0x76,
+	0xf2, 0x36, 0x45, 0xb2, 0x77, 0x02, 0xdc, 0x25, 0x34, 0x14, 0x83, 0x63, 0x87, 0x32, 0x1c, 0x18,
+	0x23, 0x47, 0xe5, 0x45, 0x6c, 0xb2, 0xa9, 0x2c, 0xd0, 0x47, 0xf9, 0x8d, 0xf9, 0x7f, 0x82, 0xfc,
+	0xfa, 0x40, 0x51, 0x3e, 0x68, 0x88, 0x50, 0x42, 0x72, 0x5a, 0x32, 0xfa, 0x06, 0xc0, 0xab, 0x5a,
+	0x76, 0xa7, 0x6d, 0xcc, 0xb4, 0x93, 0x26, 0xc7, 0x8c, 0x51, 0x41, 0x66, 0xe3, 0xe4, 0xfd, 0x32,
+	0xc3, 0xa7, 0xd4, 0x3d, 0xf4, 0x2c, 0x43, 0x9f, 0x03, 0x78, 0x25, 0x25, 0xd7, 0x4a, 0x5a, 0x4a,
+	0x72, 0x49, 0x67, 0x04, 0xaf, 0xb5, 0x93, 0x75, 0xb4, 0x0c, 0xa7, 0xd9, 0xee, 0x81, 0xe7, 0xd0,
+	0xa7, 0x00, 0xce, 0xa4, 0x74, 0x6c, 0x59, 0x7a, 0x13, 0x2e, 0x63, 0x82, 0xcb, 0xca, 0x09, 0xca,
+	0x77, 0x86, 0xc8, 0xe5, 0x6e, 0xfe, 0x21, 0xf4, 0xb1, 0x9e, 0xf1, 0x99, 0x32, 0xc9, 0x8c, 0xb3,
+	0x82, 0xc3, 0x6b, 0xc7, 0xae, 0x93, 0x19, 0x06, 0xd3, 0x4e, 0xde, 0x11, 0x86, 0x76, 0xe1, 0x74,
+	0x6e, 0x61, 0x62, 0x06, 0x14, 0xf0, 0xaf, 0x1c, 0xb3, 0x32, 0x65, 0xc0, 0x27, 0x73, 0xea, 0x13,
+	0x43, 0x5d, 0x78, 0x39, 0xd5, 0x6e, 0x85, 0x9c, 0x9a, 0x81, 0x7c, 0xbc, 0x30, 0x63, 0x5c, 0x60,
+	0xdf, 0x1c, 0x48, 0xba, 0xf6, 0xfa, 0x51, 0xb0, 0x53, 0x4e, 0xce, 0x1e, 0x43, 0x5f, 0x03, 0x58,
+	0xd2, 0x22, 0x9f, 0xa4, 0xa8, 0xa9, 0xbd, 0x14, 0xce, 0x09, 0x02, 0xb7, 0x07, 0x8b, 0xff, 0x81,
+	0xaf, 0x19, 0xc5, 0x67, 0xae, 0x7b, 0xd8, 0x51, 0xf4, 0x05, 0x80, 0x73, 0x12, 0xcf, 0x54, 0x80,
+	0x66, 0x13, 0x63, 0x66, 0xda, 0xd4, 0xf3, 0xb0, 0x1d, 0x4d, 0xba, 0xe7, 0x05, 0xab, 0x99, 0xdc,
+	0xf9, 0x5e, 0x0c, 0xf7, 0xcf, 0xab, 0xe1, 0x7e, 0xb1, 0x8f, 0xe1, 0x5e, 0x4e, 0xf6, 0xb3, 0x12,
+	0xb1, 0x2e, 0x01, 0x6f, 0x61, 0xcc, 0x56, 0x63, 0x38, 0xc4, 0xe0, 0x74, 0x74, 0x3b, 0xa1, 0x4f,
+	0xf8, 0xae, 0x28, 0xf1, 0x26, 0xe3, 0x01, 0x8e, 0xda, 0xc6, 0xff, 0x07, 0x7b, 0x48, 0x09, 0x0f,
+	0x51, 0x85, 0xaf, 0x0b, 0xfb, 0x38, 0x2b, 0xec, 0xde, 0x2d, 0x86, 0x5e, 0x87, 0x73, 0x9e, 0xc5,
+	0xb8, 0x99, 0x8b, 0x6c, 0x12, 0xc7, 0xb8, 0x20, 0x1a, 0xeb, 0x4c, 0x74, 0x28, 0xc7, 0xf7, 0x1d,
+	0x07, 0xdd, 0x07, 0x70, 0xe1, 0xb0, 0x42, 0x63, 0x8a, 0x47, 0xbf, 0x31, 0x71, 0x8c, 0x32, 0x78,
+	0xc8, 0x20, 0xdc, 0x53, 0x06, 0x73, 0xcf, 0x6a, 0x63, 0x5c, 0xb5, 0xf1, 0xed, 0x5e, 0x11, 0x3c,
+	0xdc, 0x2b, 0x82, 0x47, 0x7b, 0x45, 0xf0, 0xc7, 0x5e, 0x11, 0xdc, 0xdb, 0x2f, 0x16, 0x1e, 0xed,
+	0x17, 0x0b, 0xbf, 0xed, 0x17, 0x0b, 0xef, 0xac, 0x69, 0x21, 0x24, 0xdb, 0x5e, 0x18, 0x25, 0x0b,
+	0xf1, 0xed, 0x8a, 0xa4, 0x4b, 0xf8, 0xee, 0x92, 0xa2, 0xbc, 0xd4, 0xa6, 0x4e, 0xe8, 0xe1, 0xca,
+	0x87, 0x99, 0xbf, 0x85, 0xc8, 0x20, 0x37, 0x46, 0xc5, 0xa0, 0x7c, 0xe3, 0x9f, 0x01, 0x00, 0xa3,
+	0xc4, 0xab, 0xa0, 0xbb, 0x11, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -497,6 +662,87 @@ func (m *DelegatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorAutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorAutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCommissionRecipientsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionRecipientsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionRecipientsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -626,6 +872,49 @@ func (m *ValidatorHistoricalRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorHistoricalRewardsTimeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorHistoricalRewardsTimeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorHistoricalRewardsTimeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.Period != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorCurrentRewardsRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -783,6 +1072,83 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorHistoricalRewardsTimes) > 0 {
+		for iNdEx := len(m.ValidatorHistoricalRewardsTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorHistoricalRewardsTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.LastCommunityPoolStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastCommunityPoolStreamId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.CommunityPoolStreams) > 0 {
+		for iNdEx := len(m.CommunityPoolStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPoolStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.LiquidStakingFeesCollected) > 0 {
+		for iNdEx := len(m.LiquidStakingFeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidStakingFeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ValidatorCommissionRecipients) > 0 {
+		for iNdEx := len(m.ValidatorCommissionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCommissionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DelegatorAutoRestakes) > 0 {
+		for iNdEx := len(m.DelegatorAutoRestakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorAutoRestakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -939,20 +1305,56 @@ func (m *DelegatorWithdrawInfo) Size() (n int) {
 	return n
 }
 
-func (m *ValidatorOutstandingRewardsRecord) Size() (n int) {
+func (m *DelegatorAutoRestake) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if len(m.OutstandingRewards) > 0 {
-		for _, e := range m.OutstandingRewards {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorCommissionRecipientsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorOutstandingRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.OutstandingRewards) > 0 {
+		for _, e := range m.OutstandingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
 	return n
@@ -991,6 +1393,24 @@ func (m *ValidatorHistoricalRewardsRecord) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorHistoricalRewardsTimeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovGenesis(uint64(m.Period))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *ValidatorCurrentRewardsRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1102,16 +1522,393 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.DelegatorAutoRestakes) > 0 {
+		for _, e := range m.DelegatorAutoRestakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorCommissionRecipients) > 0 {
+		for _, e := range m.ValidatorCommissionRecipients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidStakingFeesCollected) > 0 {
+		for _, e := range m.LiquidStakingFeesCollected {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommunityPoolStreams) > 0 {
+		for _, e := range m.CommunityPoolStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastCommunityPoolStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastCommunityPoolStreamId))
+	}
+	if len(m.ValidatorHistoricalRewardsTimes) > 0 {
+		for _, e := range m.ValidatorHistoricalRewardsTimes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorWithdrawInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorWithdrawInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorAutoRestake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorAutoRestake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCommissionRecipientsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionRecipientsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionRecipientsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, CommissionRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
+func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1134,15 +1931,15 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorWithdrawInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorOutstandingRewardsRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorWithdrawInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorOutstandingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1170,13 +1967,13 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1186,23 +1983,25 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.OutstandingRewards = append(m.OutstandingRewards, types.DecCoin{})
+			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1225,7 +2024,7 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
+func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1248,10 +2047,10 @@ func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewardsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorAccumulatedCommissionRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorAccumulatedCommissionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1288,7 +2087,7 @@ func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1315,8 +2114,7 @@ func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutstandingRewards = append(m.OutstandingRewards, types.DecCoin{})
-			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Accumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1341,7 +2139,7 @@ func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
+func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1364,10 +2162,10 @@ func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommissionRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorHistoricalRewardsRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommissionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorHistoricalRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1403,8 +2201,27 @@ func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accumulated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1431,7 +2248,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Accumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1456,7 +2273,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
+func (m *ValidatorHistoricalRewardsTimeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1479,10 +2296,10 @@ func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewardsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorHistoricalRewardsTimeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorHistoricalRewardsTimeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1538,7 +2355,7 @@ func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1565,7 +2382,7 @@ func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2370,6 +3187,195 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAutoRestakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAutoRestakes = append(m.DelegatorAutoRestakes, DelegatorAutoRestake{})
+			if err := m.DelegatorAutoRestakes[len(m.DelegatorAutoRestakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommissionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorCommissionRecipients = append(m.ValidatorCommissionRecipients, ValidatorCommissionRecipientsRecord{})
+			if err := m.ValidatorCommissionRecipients[len(m.ValidatorCommissionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingFeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidStakingFeesCollected = append(m.LiquidStakingFeesCollected, types.Coin{})
+			if err := m.LiquidStakingFeesCollected[len(m.LiquidStakingFeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolStreams = append(m.CommunityPoolStreams, CommunityPoolStream{})
+			if err := m.CommunityPoolStreams[len(m.CommunityPoolStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommunityPoolStreamId", wireType)
+			}
+			m.LastCommunityPoolStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCommunityPoolStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorHistoricalRewardsTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorHistoricalRewardsTimes = append(m.ValidatorHistoricalRewardsTimes, ValidatorHistoricalRewardsTimeRecord{})
+			if err := m.ValidatorHistoricalRewardsTimes[len(m.ValidatorHistoricalRewardsTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
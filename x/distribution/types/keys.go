@@ -42,6 +42,20 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: []byte{}
+//
+// - 0x0A: the DelegatorAutoRestake key the current auto restake pass resumes from
+//
+// - 0x0B<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCommissionRecipients
+//
+// - 0x0C: LiquidStakingFeesCollected
+//
+// - 0x0D<streamID_Bytes>: CommunityPoolStream
+//
+// - 0x0E: the id of the last community pool stream created
+//
+// - 0x0F<valAddrLen (1 Byte)><valAddr_Bytes><period_Bytes>: the block time a ValidatorHistoricalRewards period ended at
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +67,13 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	DelegatorAutoRestakePrefix           = []byte{0x09} // key for delegations with auto restake enabled
+	AutoRestakeCursorKey                 = []byte{0x0A} // key for the next auto restake of an unfinished pass
+	ValidatorCommissionRecipientsPrefix  = []byte{0x0B} // key for validator commission recipients
+	LiquidStakingFeesCollectedKey        = []byte{0x0C} // key for the cumulative liquid staking reward fees
+	CommunityPoolStreamPrefix            = []byte{0x0D} // key for community pool streams
+	LastCommunityPoolStreamIDKey         = []byte{0x0E} // key for the id of the last community pool stream
+	ValidatorHistoricalRewardsTimePrefix = []byte{0x0F} // key for the end time of validator historical rewards periods
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -111,6 +132,20 @@ func GetValidatorHistoricalRewardsAddressPeriod(key []byte) (valAddr sdk.ValAddr
 	return
 }
 
+// GetValidatorHistoricalRewardsTimeAddressPeriod creates the address & period from a validator's historical rewards time key.
+func GetValidatorHistoricalRewardsTimeAddressPeriod(key []byte) (valAddr sdk.ValAddress, period uint64) {
+	// key is in the format:
+	// 0x0F<valAddrLen (1 Byte)><valAddr_Bytes><period_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	valAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+valAddrLen)
+	valAddr = sdk.ValAddress(key[2 : 2+valAddrLen])
+	b := key[2+valAddrLen:]
+	kv.AssertKeyLength(b, 8)
+	period = binary.BigEndian.Uint64(b)
+	return
+}
+
 // GetValidatorCurrentRewardsAddress creates the address from a validator's current rewards key.
 func GetValidatorCurrentRewardsAddress(key []byte) (valAddr sdk.ValAddress) {
 	// key is in the format:
@@ -152,6 +187,35 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// GetDelegatorAutoRestakeAddresses creates the addresses from a delegator auto restake key.
+func GetDelegatorAutoRestakeAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x09<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	kv.AssertKeyAtLeastLength(key, 4+delAddrLen)
+	valAddr = sdk.ValAddress(key[3+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
+// GetValidatorCommissionRecipientsAddress creates the address from a validator commission recipients key.
+func GetValidatorCommissionRecipientsAddress(key []byte) (valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0B<valAddrLen (1 Byte)><valAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.ValAddress(addr)
+}
+
 // GetValidatorOutstandingRewardsKey creates the outstanding rewards key for a validator.
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
@@ -179,6 +243,19 @@ func GetValidatorHistoricalRewardsKey(v sdk.ValAddress, k uint64) []byte {
 	return append(append(ValidatorHistoricalRewardsPrefix, address.MustLengthPrefix(v.Bytes())...), b...)
 }
 
+// GetValidatorHistoricalRewardsTimePrefix creates the prefix key for the end times of a validator's historical rewards periods.
+func GetValidatorHistoricalRewardsTimePrefix(v sdk.ValAddress) []byte {
+	return append(ValidatorHistoricalRewardsTimePrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetValidatorHistoricalRewardsTimeKey creates the key for the end time of a validator's historical rewards period,
+// the period is big endian so that the periods of a validator are iterated in order.
+func GetValidatorHistoricalRewardsTimeKey(v sdk.ValAddress, k uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, k)
+	return append(GetValidatorHistoricalRewardsTimePrefix(v), b...)
+}
+
 // GetValidatorCurrentRewardsKey creates the key for a validator's current rewards.
 func GetValidatorCurrentRewardsKey(v sdk.ValAddress) []byte {
 	return append(ValidatorCurrentRewardsPrefix, address.MustLengthPrefix(v.Bytes())...)
@@ -213,3 +290,23 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetDelegatorAutoRestakePrefix creates the prefix key for a delegator's auto restakes.
+func GetDelegatorAutoRestakePrefix(d sdk.AccAddress) []byte {
+	return append(DelegatorAutoRestakePrefix, address.MustLengthPrefix(d.Bytes())...)
+}
+
+// GetDelegatorAutoRestakeKey creates the key for the auto restake of a delegation.
+func GetDelegatorAutoRestakeKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetDelegatorAutoRestakePrefix(d), address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetValidatorCommissionRecipientsKey creates the key for a validator's commission recipients.
+func GetValidatorCommissionRecipientsKey(v sdk.ValAddress) []byte {
+	return append(ValidatorCommissionRecipientsPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetCommunityPoolStreamKey creates the key for a community pool stream.
+func GetCommunityPoolStreamKey(id uint64) []byte {
+	return append(CommunityPoolStreamPrefix, sdk.Uint64ToBigEndian(id)...)
+}
@@ -16,13 +16,23 @@ const (
 	TypeMsgFundCommunityPool                    = "fund_community_pool"
 	TypeMsgWithdrawTokenizeShareRecordReward    = "withdraw_tokenize_share_record_reward"
 	TypeMsgWithdrawAllTokenizeShareRecordReward = "withdraw_all_tokenize_share_record_reward"
+	TypeMsgSetAutoRestake                       = "set_auto_restake"
+	TypeMsgSetCommissionRecipients              = "set_commission_recipients"
+	TypeMsgSweepTokenizeShareRecord             = "sweep_tokenize_share_record"
 )
 
+// MaxTokenizeShareRecordWithdrawals is the maximum number of tokenize share records
+// a single MsgWithdrawAllTokenizeShareRecordReward withdraws the rewards of.
+const MaxTokenizeShareRecordWithdrawals = 100
+
 // Verify interface at compile time
 var (
 	_, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
 	_       sdk.Msg = &MsgWithdrawTokenizeShareRecordReward{}
 	_       sdk.Msg = &MsgWithdrawAllTokenizeShareRecordReward{}
+	_       sdk.Msg = &MsgSetAutoRestake{}
+	_       sdk.Msg = &MsgSetCommissionRecipients{}
+	_       sdk.Msg = &MsgSweepTokenizeShareRecord{}
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -205,9 +215,12 @@ func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() error {
 	return nil
 }
 
-func NewMsgWithdrawAllTokenizeShareRecordReward(ownerAddr sdk.AccAddress) *MsgWithdrawAllTokenizeShareRecordReward {
+func NewMsgWithdrawAllTokenizeShareRecordReward(ownerAddr sdk.AccAddress, recordIDs []uint64, startRecordID, limit uint64) *MsgWithdrawAllTokenizeShareRecordReward {
 	return &MsgWithdrawAllTokenizeShareRecordReward{
-		OwnerAddress: ownerAddr.String(),
+		OwnerAddress:  ownerAddr.String(),
+		RecordIds:     recordIDs,
+		StartRecordId: startRecordID,
+		Limit:         limit,
 	}
 }
 
@@ -233,6 +246,128 @@ func (msg MsgWithdrawAllTokenizeShareRecordReward) GetSignBytes() []byte {
 
 // quick validity check
 func (msg MsgWithdrawAllTokenizeShareRecordReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if msg.Limit > MaxTokenizeShareRecordWithdrawals {
+		return sdkerrors.ErrInvalidRequest.Wrapf("limit cannot exceed %d", MaxTokenizeShareRecordWithdrawals)
+	}
+	if len(msg.RecordIds) == 0 {
+		return nil
+	}
+	if msg.StartRecordId != 0 || msg.Limit != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("record ids cannot be combined with a start record id or limit")
+	}
+	if len(msg.RecordIds) > MaxTokenizeShareRecordWithdrawals {
+		return sdkerrors.ErrInvalidRequest.Wrapf("at most %d record ids allowed, got %d", MaxTokenizeShareRecordWithdrawals, len(msg.RecordIds))
+	}
+	seen := make(map[uint64]bool, len(msg.RecordIds))
+	for _, id := range msg.RecordIds {
+		if seen[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate record id %d", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func NewMsgSetAutoRestake(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) *MsgSetAutoRestake {
+	return &MsgSetAutoRestake{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+func (msg MsgSetAutoRestake) Route() string { return ModuleName }
+func (msg MsgSetAutoRestake) Type() string  { return TypeMsgSetAutoRestake }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetAutoRestake) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetAutoRestake) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetAutoRestake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	return nil
+}
+
+func NewMsgSetCommissionRecipients(valAddr sdk.ValAddress, recipients []CommissionRecipient) *MsgSetCommissionRecipients {
+	return &MsgSetCommissionRecipients{
+		ValidatorAddress: valAddr.String(),
+		Recipients:       recipients,
+	}
+}
+
+func (msg MsgSetCommissionRecipients) Route() string { return ModuleName }
+func (msg MsgSetCommissionRecipients) Type() string  { return TypeMsgSetCommissionRecipients }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetCommissionRecipients) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetCommissionRecipients) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetCommissionRecipients) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	return ValidateCommissionRecipients(msg.Recipients)
+}
+
+func NewMsgSweepTokenizeShareRecord(ownerAddr sdk.AccAddress, recordID uint64) *MsgSweepTokenizeShareRecord {
+	return &MsgSweepTokenizeShareRecord{
+		OwnerAddress: ownerAddr.String(),
+		RecordId:     recordID,
+	}
+}
+
+func (msg MsgSweepTokenizeShareRecord) Route() string { return ModuleName }
+func (msg MsgSweepTokenizeShareRecord) Type() string  { return TypeMsgSweepTokenizeShareRecord }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSweepTokenizeShareRecord) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSweepTokenizeShareRecord) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSweepTokenizeShareRecord) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
 	}
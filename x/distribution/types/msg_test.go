@@ -93,3 +93,86 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetCommissionRecipients
+func TestMsgSetCommissionRecipients(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		validatorAddr sdk.ValAddress
+		recipients    []CommissionRecipient
+		expectPass    bool
+	}{
+		{valAddr1, nil, true},
+		{valAddr1, []CommissionRecipient{NewCommissionRecipient(delAddr1, sdk.OneDec())}, true},
+		{valAddr1, []CommissionRecipient{NewCommissionRecipient(delAddr1, half), NewCommissionRecipient(delAddr2, half)}, true},
+		{emptyValAddr, []CommissionRecipient{NewCommissionRecipient(delAddr1, sdk.OneDec())}, false},
+		{valAddr1, []CommissionRecipient{NewCommissionRecipient(emptyDelAddr, sdk.OneDec())}, false},
+		{valAddr1, []CommissionRecipient{NewCommissionRecipient(delAddr1, half), NewCommissionRecipient(delAddr1, half)}, false},
+		{valAddr1, []CommissionRecipient{NewCommissionRecipient(delAddr1, half), NewCommissionRecipient(delAddr2, sdk.ZeroDec())}, false},
+		{valAddr1, []CommissionRecipient{NewCommissionRecipient(delAddr1, half), NewCommissionRecipient(delAddr2, half.Neg())}, false},
+		{valAddr1, []CommissionRecipient{NewCommissionRecipient(delAddr1, half), NewCommissionRecipient(delAddr2, sdk.NewDecWithPrec(4, 1))}, false},
+		{valAddr1, []CommissionRecipient{NewCommissionRecipient(delAddr1, half), NewCommissionRecipient(delAddr2, sdk.NewDecWithPrec(6, 1))}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetCommissionRecipients(tc.validatorAddr, tc.recipients)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgWithdrawAllTokenizeShareRecordReward
+func TestMsgWithdrawAllTokenizeShareRecordReward(t *testing.T) {
+	tooManyIDs := make([]uint64, MaxTokenizeShareRecordWithdrawals+1)
+	for i := range tooManyIDs {
+		tooManyIDs[i] = uint64(i + 1)
+	}
+
+	tests := []struct {
+		ownerAddr     sdk.AccAddress
+		recordIDs     []uint64
+		startRecordID uint64
+		limit         uint64
+		expectPass    bool
+	}{
+		{delAddr1, nil, 0, 0, true},
+		{delAddr1, nil, 10, MaxTokenizeShareRecordWithdrawals, true},
+		{delAddr1, []uint64{1, 2, 3}, 0, 0, true},
+		{emptyDelAddr, nil, 0, 0, false},
+		{delAddr1, nil, 0, MaxTokenizeShareRecordWithdrawals + 1, false},
+		{delAddr1, []uint64{1, 2}, 1, 0, false},
+		{delAddr1, []uint64{1, 2}, 0, 2, false},
+		{delAddr1, []uint64{1, 2, 1}, 0, 0, false},
+		{delAddr1, tooManyIDs, 0, 0, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawAllTokenizeShareRecordReward(tc.ownerAddr, tc.recordIDs, tc.startRecordID, tc.limit)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSweepTokenizeShareRecord
+func TestMsgSweepTokenizeShareRecord(t *testing.T) {
+	tests := []struct {
+		ownerAddr  sdk.AccAddress
+		recordID   uint64
+		expectPass bool
+	}{
+		{delAddr1, 1, true},
+		{emptyDelAddr, 1, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSweepTokenizeShareRecord(tc.ownerAddr, tc.recordID)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -11,10 +11,13 @@ import (
 
 // Parameter keys
 var (
-	ParamStoreKeyCommunityTax        = []byte("communitytax")
-	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
-	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
-	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyCommunityTax           = []byte("communitytax")
+	ParamStoreKeyBaseProposerReward     = []byte("baseproposerreward")
+	ParamStoreKeyBonusProposerReward    = []byte("bonusproposerreward")
+	ParamStoreKeyWithdrawAddrEnabled    = []byte("withdrawaddrenabled")
+	ParamStoreKeyRestakeInterval        = []byte("restakeinterval")
+	ParamStoreKeyMaxRestakesPerBlock    = []byte("maxrestakesperblock")
+	ParamStoreKeyLiquidStakingRewardFee = []byte("liquidstakingrewardfee")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -25,10 +28,13 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		WithdrawAddrEnabled: true,
+		CommunityTax:           sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:     sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:    sdk.NewDecWithPrec(4, 2), // 4%
+		WithdrawAddrEnabled:    true,
+		RestakeInterval:        1000,
+		MaxRestakesPerBlock:    100,
+		LiquidStakingRewardFee: sdk.ZeroDec(),
 	}
 }
 
@@ -44,6 +50,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyRestakeInterval, &p.RestakeInterval, validateRestakeInterval),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxRestakesPerBlock, &p.MaxRestakesPerBlock, validateMaxRestakesPerBlock),
+		paramtypes.NewParamSetPair(ParamStoreKeyLiquidStakingRewardFee, &p.LiquidStakingRewardFee, validateLiquidStakingRewardFee),
 	}
 }
 
@@ -69,6 +78,17 @@ func (p Params) ValidateBasic() error {
 			"sum of base, bonus proposer rewards, and community tax cannot be greater than one: %s", v,
 		)
 	}
+	if p.RestakeInterval < 0 {
+		return fmt.Errorf("restake interval cannot be negative: %d", p.RestakeInterval)
+	}
+	if p.MaxRestakesPerBlock == 0 {
+		return fmt.Errorf("max restakes per block must be positive")
+	}
+	if p.LiquidStakingRewardFee.IsNil() || p.LiquidStakingRewardFee.IsNegative() || p.LiquidStakingRewardFee.GT(sdk.OneDec()) {
+		return fmt.Errorf(
+			"liquid staking reward fee should be non-negative and less than one: %s", p.LiquidStakingRewardFee,
+		)
+	}
 
 	return nil
 }
@@ -138,3 +158,48 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateRestakeInterval(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("restake interval cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxRestakesPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max restakes per block must be positive")
+	}
+
+	return nil
+}
+
+func validateLiquidStakingRewardFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking reward fee must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking reward fee must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquid staking reward fee too large: %s", v)
+	}
+
+	return nil
+}
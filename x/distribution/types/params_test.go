@@ -13,29 +13,41 @@ func TestParams_ValidateBasic(t *testing.T) {
 	toDec := sdk.MustNewDecFromStr
 
 	type fields struct {
-		CommunityTax        sdk.Dec
-		BaseProposerReward  sdk.Dec
-		BonusProposerReward sdk.Dec
-		WithdrawAddrEnabled bool
+		CommunityTax           sdk.Dec
+		BaseProposerReward     sdk.Dec
+		BonusProposerReward    sdk.Dec
+		WithdrawAddrEnabled    bool
+		RestakeInterval        int64
+		MaxRestakesPerBlock    uint32
+		LiquidStakingRewardFee sdk.Dec
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		wantErr bool
 	}{
-		{"success", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false}, false},
-		{"negative community tax", fields{toDec("-0.1"), toDec("0.5"), toDec("0.4"), false}, true},
-		{"negative base proposer reward", fields{toDec("0.1"), toDec("-0.5"), toDec("0.4"), false}, true},
-		{"negative bonus proposer reward", fields{toDec("0.1"), toDec("0.5"), toDec("-0.4"), false}, true},
-		{"total sum greater than 1", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false}, true},
+		{"success", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 100, 10, toDec("0.05")}, false},
+		{"negative community tax", fields{toDec("-0.1"), toDec("0.5"), toDec("0.4"), false, 100, 10, toDec("0.05")}, true},
+		{"negative base proposer reward", fields{toDec("0.1"), toDec("-0.5"), toDec("0.4"), false, 100, 10, toDec("0.05")}, true},
+		{"negative bonus proposer reward", fields{toDec("0.1"), toDec("0.5"), toDec("-0.4"), false, 100, 10, toDec("0.05")}, true},
+		{"total sum greater than 1", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false, 100, 10, toDec("0.05")}, true},
+		{"auto restake disabled", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 0, 10, toDec("0.05")}, false},
+		{"negative restake interval", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, -1, 10, toDec("0.05")}, true},
+		{"zero max restakes per block", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 100, 0, toDec("0.05")}, true},
+		{"no liquid staking reward fee", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 100, 10, toDec("0")}, false},
+		{"negative liquid staking reward fee", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 100, 10, toDec("-0.05")}, true},
+		{"liquid staking reward fee greater than 1", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 100, 10, toDec("1.05")}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := types.Params{
-				CommunityTax:        tt.fields.CommunityTax,
-				BaseProposerReward:  tt.fields.BaseProposerReward,
-				BonusProposerReward: tt.fields.BonusProposerReward,
-				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
+				CommunityTax:           tt.fields.CommunityTax,
+				BaseProposerReward:     tt.fields.BaseProposerReward,
+				BonusProposerReward:    tt.fields.BonusProposerReward,
+				WithdrawAddrEnabled:    tt.fields.WithdrawAddrEnabled,
+				RestakeInterval:        tt.fields.RestakeInterval,
+				MaxRestakesPerBlock:    tt.fields.MaxRestakesPerBlock,
+				LiquidStakingRewardFee: tt.fields.LiquidStakingRewardFee,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
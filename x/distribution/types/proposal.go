@@ -3,6 +3,9 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -12,15 +15,27 @@ import (
 const (
 	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+	// ProposalTypeCommunityPoolStream defines the type for a CommunityPoolStreamProposal
+	ProposalTypeCommunityPoolStream = "CommunityPoolStream"
+	// ProposalTypeCancelCommunityPoolStream defines the type for a CancelCommunityPoolStreamProposal
+	ProposalTypeCancelCommunityPoolStream = "CancelCommunityPoolStream"
 )
 
-// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CommunityPoolSpendProposal{}
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CommunityPoolSpendProposal{}
+	_ govtypes.Content = &CommunityPoolStreamProposal{}
+	_ govtypes.Content = &CancelCommunityPoolStreamProposal{}
+)
 
 func init() {
 	// already registered in cosmos
 	// govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
 	// govtypes.RegisterProposalTypeCodec(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolStream)
+	govtypes.RegisterProposalTypeCodec(&CommunityPoolStreamProposal{}, "cosmos-sdk/CommunityPoolStreamProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelCommunityPoolStream)
+	govtypes.RegisterProposalTypeCodec(&CancelCommunityPoolStreamProposal{}, "cosmos-sdk/CancelCommunityPoolStreamProposal")
 }
 
 // NewCommunityPoolSpendProposal creates a new community pool spend proposal.
@@ -69,3 +84,98 @@ func (csp CommunityPoolSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount))
 	return b.String()
 }
+
+// NewCommunityPoolStreamProposal creates a new community pool stream proposal.
+//
+//nolint:interfacer
+func NewCommunityPoolStreamProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins,
+	startTime, endTime time.Time, cadence time.Duration,
+) *CommunityPoolStreamProposal {
+	return &CommunityPoolStreamProposal{title, description, recipient.String(), amount, startTime, endTime, cadence}
+}
+
+// GetTitle returns the title of a community pool stream proposal.
+func (csp *CommunityPoolStreamProposal) GetTitle() string { return csp.Title }
+
+// GetDescription returns the description of a community pool stream proposal.
+func (csp *CommunityPoolStreamProposal) GetDescription() string { return csp.Description }
+
+// ProposalRoute returns the routing key of a community pool stream proposal.
+func (csp *CommunityPoolStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool stream proposal.
+func (csp *CommunityPoolStreamProposal) ProposalType() string { return ProposalTypeCommunityPoolStream }
+
+// ValidateBasic runs basic stateless validity checks
+func (csp *CommunityPoolStreamProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(csp)
+	if err != nil {
+		return err
+	}
+	if !csp.Amount.IsValid() || csp.Amount.IsZero() {
+		return sdkdistr.ErrInvalidProposalAmount
+	}
+	if csp.Recipient == "" {
+		return sdkdistr.ErrEmptyProposalRecipient
+	}
+
+	return ValidateCommunityPoolStreamSchedule(csp.StartTime, csp.EndTime, csp.Cadence)
+}
+
+// String implements the Stringer interface.
+func (csp CommunityPoolStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Stream Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+  Start Time:  %s
+  End Time:    %s
+  Cadence:     %s
+`, csp.Title, csp.Description, csp.Recipient, csp.Amount, csp.StartTime, csp.EndTime, csp.Cadence))
+	return b.String()
+}
+
+// NewCancelCommunityPoolStreamProposal creates a new cancel community pool stream proposal.
+func NewCancelCommunityPoolStreamProposal(title, description string, streamID uint64) *CancelCommunityPoolStreamProposal {
+	return &CancelCommunityPoolStreamProposal{title, description, streamID}
+}
+
+// GetTitle returns the title of a cancel community pool stream proposal.
+func (csp *CancelCommunityPoolStreamProposal) GetTitle() string { return csp.Title }
+
+// GetDescription returns the description of a cancel community pool stream proposal.
+func (csp *CancelCommunityPoolStreamProposal) GetDescription() string { return csp.Description }
+
+// ProposalRoute returns the routing key of a cancel community pool stream proposal.
+func (csp *CancelCommunityPoolStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel community pool stream proposal.
+func (csp *CancelCommunityPoolStreamProposal) ProposalType() string {
+	return ProposalTypeCancelCommunityPoolStream
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (csp *CancelCommunityPoolStreamProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(csp)
+	if err != nil {
+		return err
+	}
+	if csp.StreamId == 0 {
+		return errorsmod.Wrap(ErrInvalidCommunityPoolStream, "stream id cannot be 0")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (csp CancelCommunityPoolStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Community Pool Stream Proposal:
+  Title:       %s
+  Description: %s
+  Stream ID:   %d
+`, csp.Title, csp.Description, csp.StreamId))
+	return b.String()
+}
@@ -10,10 +10,12 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
-	types1 "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -21,12 +23,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -308,31 +312,29 @@ func (m *QueryValidatorCommissionResponse) GetCommission() ValidatorAccumulatedC
 	return ValidatorAccumulatedCommission{}
 }
 
-// QueryValidatorSlashesRequest is the request type for the
-// Query/ValidatorSlashes RPC method
-type QueryValidatorSlashesRequest struct {
+// QueryValidatorCommissionRecipientsRequest is the request type for the
+// Query/ValidatorCommissionRecipients RPC method.
+type QueryValidatorCommissionRecipientsRequest struct {
 	// validator_address defines the validator address to query for.
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// starting_height defines the optional starting height to query the slashes.
-	StartingHeight uint64 `protobuf:"varint,2,opt,name=starting_height,json=startingHeight,proto3" json:"starting_height,omitempty"`
-	// starting_height defines the optional ending height to query the slashes.
-	EndingHeight uint64 `protobuf:"varint,3,opt,name=ending_height,json=endingHeight,proto3" json:"ending_height,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidatorSlashesRequest) Reset()         { *m = QueryValidatorSlashesRequest{} }
-func (m *QueryValidatorSlashesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryValidatorSlashesRequest) ProtoMessage()    {}
-func (*QueryValidatorSlashesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryValidatorCommissionRecipientsRequest) Reset() {
+	*m = QueryValidatorCommissionRecipientsRequest{}
+}
+func (m *QueryValidatorCommissionRecipientsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorCommissionRecipientsRequest) ProtoMessage() {}
+func (*QueryValidatorCommissionRecipientsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bee02899ef89b167, []int{6}
 }
-func (m *QueryValidatorSlashesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorCommissionRecipientsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidatorSlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorCommissionRecipientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidatorSlashesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorCommissionRecipientsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -342,39 +344,42 @@ func (m *QueryValidatorSlashesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryValidatorSlashesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidatorSlashesRequest.Merge(m, src)
+func (m *QueryValidatorCommissionRecipientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorCommissionRecipientsRequest.Merge(m, src)
 }
-func (m *QueryValidatorSlashesRequest) XXX_Size() int {
+func (m *QueryValidatorCommissionRecipientsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidatorSlashesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidatorSlashesRequest.DiscardUnknown(m)
+func (m *QueryValidatorCommissionRecipientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorCommissionRecipientsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidatorSlashesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorCommissionRecipientsRequest proto.InternalMessageInfo
 
-// QueryValidatorSlashesResponse is the response type for the
-// Query/ValidatorSlashes RPC method.
-type QueryValidatorSlashesResponse struct {
-	// slashes defines the slashes the validator received.
-	Slashes []ValidatorSlashEvent `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryValidatorCommissionRecipientsResponse is the response type for the
+// Query/ValidatorCommissionRecipients RPC method.
+type QueryValidatorCommissionRecipientsResponse struct {
+	// recipients defines the recipients the commission is split across, empty if
+	// the commission is sent to the withdraw address of the validator operator.
+	Recipients []CommissionRecipient `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients"`
 }
 
-func (m *QueryValidatorSlashesResponse) Reset()         { *m = QueryValidatorSlashesResponse{} }
-func (m *QueryValidatorSlashesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryValidatorSlashesResponse) ProtoMessage()    {}
-func (*QueryValidatorSlashesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryValidatorCommissionRecipientsResponse) Reset() {
+	*m = QueryValidatorCommissionRecipientsResponse{}
+}
+func (m *QueryValidatorCommissionRecipientsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorCommissionRecipientsResponse) ProtoMessage() {}
+func (*QueryValidatorCommissionRecipientsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bee02899ef89b167, []int{7}
 }
-func (m *QueryValidatorSlashesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorCommissionRecipientsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidatorSlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorCommissionRecipientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidatorSlashesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorCommissionRecipientsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -384,51 +389,40 @@ func (m *QueryValidatorSlashesResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryValidatorSlashesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidatorSlashesResponse.Merge(m, src)
+func (m *QueryValidatorCommissionRecipientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorCommissionRecipientsResponse.Merge(m, src)
 }
-func (m *QueryValidatorSlashesResponse) XXX_Size() int {
+func (m *QueryValidatorCommissionRecipientsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidatorSlashesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidatorSlashesResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryValidatorSlashesResponse proto.InternalMessageInfo
-
-func (m *QueryValidatorSlashesResponse) GetSlashes() []ValidatorSlashEvent {
-	if m != nil {
-		return m.Slashes
-	}
-	return nil
+func (m *QueryValidatorCommissionRecipientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorCommissionRecipientsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorSlashesResponse) GetPagination() *query.PageResponse {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_QueryValidatorCommissionRecipientsResponse proto.InternalMessageInfo
 
-// QueryDelegationTotalRewardsRequest is the request type for the
-// Query/DelegationTotalRewards RPC method.
-type QueryDelegationTotalRewardsRequest struct {
-	// delegator_address defines the delegator address to query for.
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+// QueryValidatorRewardRateRequest is the request type for the
+// Query/ValidatorRewardRate RPC method.
+type QueryValidatorRewardRateRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// window_seconds defines the length of the trailing window the rate is
+	// measured over, 7 days if unset.
+	WindowSeconds uint64 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
 }
 
-func (m *QueryDelegationTotalRewardsRequest) Reset()         { *m = QueryDelegationTotalRewardsRequest{} }
-func (m *QueryDelegationTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegationTotalRewardsRequest) ProtoMessage()    {}
-func (*QueryDelegationTotalRewardsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryValidatorRewardRateRequest) Reset()         { *m = QueryValidatorRewardRateRequest{} }
+func (m *QueryValidatorRewardRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRewardRateRequest) ProtoMessage()    {}
+func (*QueryValidatorRewardRateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bee02899ef89b167, []int{8}
 }
-func (m *QueryDelegationTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorRewardRateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegationTotalRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorRewardRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegationTotalRewardsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorRewardRateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -438,39 +432,43 @@ func (m *QueryDelegationTotalRewardsRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegationTotalRewardsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegationTotalRewardsRequest.Merge(m, src)
+func (m *QueryValidatorRewardRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRewardRateRequest.Merge(m, src)
 }
-func (m *QueryDelegationTotalRewardsRequest) XXX_Size() int {
+func (m *QueryValidatorRewardRateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegationTotalRewardsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegationTotalRewardsRequest.DiscardUnknown(m)
+func (m *QueryValidatorRewardRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRewardRateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegationTotalRewardsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorRewardRateRequest proto.InternalMessageInfo
 
-// QueryDelegationTotalRewardsResponse is the response type for the
-// Query/DelegationTotalRewards RPC method.
-type QueryDelegationTotalRewardsResponse struct {
-	// rewards defines all the rewards accrued by a delegator.
-	Rewards []DelegationDelegatorReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
-	// total defines the sum of all the rewards.
-	Total github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total"`
+// QueryValidatorRewardRateResponse is the response type for the
+// Query/ValidatorRewardRate RPC method.
+type QueryValidatorRewardRateResponse struct {
+	// rate defines the annualized rewards per token delegated, net of commission.
+	Rate github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rate"`
+	// start_time defines the start of the window the rate was measured over, the
+	// end of the latest rewards period ended before the requested window, or of the
+	// earliest rewards period stored if the validator has no history that old.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time defines the end of the window the rate was measured over.
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 }
 
-func (m *QueryDelegationTotalRewardsResponse) Reset()         { *m = QueryDelegationTotalRewardsResponse{} }
-func (m *QueryDelegationTotalRewardsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegationTotalRewardsResponse) ProtoMessage()    {}
-func (*QueryDelegationTotalRewardsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryValidatorRewardRateResponse) Reset()         { *m = QueryValidatorRewardRateResponse{} }
+func (m *QueryValidatorRewardRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRewardRateResponse) ProtoMessage()    {}
+func (*QueryValidatorRewardRateResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bee02899ef89b167, []int{9}
 }
-func (m *QueryDelegationTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorRewardRateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegationTotalRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorRewardRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegationTotalRewardsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorRewardRateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -480,51 +478,62 @@ func (m *QueryDelegationTotalRewardsResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegationTotalRewardsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegationTotalRewardsResponse.Merge(m, src)
+func (m *QueryValidatorRewardRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRewardRateResponse.Merge(m, src)
 }
-func (m *QueryDelegationTotalRewardsResponse) XXX_Size() int {
+func (m *QueryValidatorRewardRateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegationTotalRewardsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegationTotalRewardsResponse.DiscardUnknown(m)
+func (m *QueryValidatorRewardRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRewardRateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegationTotalRewardsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorRewardRateResponse proto.InternalMessageInfo
 
-func (m *QueryDelegationTotalRewardsResponse) GetRewards() []DelegationDelegatorReward {
+func (m *QueryValidatorRewardRateResponse) GetRate() github_com_cosmos_cosmos_sdk_types.DecCoins {
 	if m != nil {
-		return m.Rewards
+		return m.Rate
 	}
 	return nil
 }
 
-func (m *QueryDelegationTotalRewardsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.DecCoins {
+func (m *QueryValidatorRewardRateResponse) GetStartTime() time.Time {
 	if m != nil {
-		return m.Total
+		return m.StartTime
 	}
-	return nil
+	return time.Time{}
 }
 
-// QueryDelegatorValidatorsRequest is the request type for the
-// Query/DelegatorValidators RPC method.
-type QueryDelegatorValidatorsRequest struct {
-	// delegator_address defines the delegator address to query for.
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+func (m *QueryValidatorRewardRateResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
 }
 
-func (m *QueryDelegatorValidatorsRequest) Reset()         { *m = QueryDelegatorValidatorsRequest{} }
-func (m *QueryDelegatorValidatorsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorValidatorsRequest) ProtoMessage()    {}
-func (*QueryDelegatorValidatorsRequest) Descriptor() ([]byte, []int) {
+// QueryValidatorHistoricalRewardsRequest is the request type for the
+// Query/ValidatorHistoricalRewards RPC method.
+type QueryValidatorHistoricalRewardsRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorHistoricalRewardsRequest) Reset() {
+	*m = QueryValidatorHistoricalRewardsRequest{}
+}
+func (m *QueryValidatorHistoricalRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorHistoricalRewardsRequest) ProtoMessage()    {}
+func (*QueryValidatorHistoricalRewardsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bee02899ef89b167, []int{10}
 }
-func (m *QueryDelegatorValidatorsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorHistoricalRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorHistoricalRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorValidatorsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorHistoricalRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -534,37 +543,42 @@ func (m *QueryDelegatorValidatorsRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorValidatorsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorValidatorsRequest.Merge(m, src)
+func (m *QueryValidatorHistoricalRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorHistoricalRewardsRequest.Merge(m, src)
 }
-func (m *QueryDelegatorValidatorsRequest) XXX_Size() int {
+func (m *QueryValidatorHistoricalRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorValidatorsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorValidatorsRequest.DiscardUnknown(m)
+func (m *QueryValidatorHistoricalRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorHistoricalRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorValidatorsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorHistoricalRewardsRequest proto.InternalMessageInfo
 
-// QueryDelegatorValidatorsResponse is the response type for the
-// Query/DelegatorValidators RPC method.
-type QueryDelegatorValidatorsResponse struct {
-	// validators defines the validators a delegator is delegating for.
-	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+// QueryValidatorHistoricalRewardsResponse is the response type for the
+// Query/ValidatorHistoricalRewards RPC method.
+type QueryValidatorHistoricalRewardsResponse struct {
+	// historical_rewards defines the historical rewards periods of the validator,
+	// in period order.
+	HistoricalRewards []ValidatorHistoricalRewardsPeriod `protobuf:"bytes,1,rep,name=historical_rewards,json=historicalRewards,proto3" json:"historical_rewards"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorValidatorsResponse) Reset()         { *m = QueryDelegatorValidatorsResponse{} }
-func (m *QueryDelegatorValidatorsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorValidatorsResponse) ProtoMessage()    {}
-func (*QueryDelegatorValidatorsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryValidatorHistoricalRewardsResponse) Reset() {
+	*m = QueryValidatorHistoricalRewardsResponse{}
+}
+func (m *QueryValidatorHistoricalRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorHistoricalRewardsResponse) ProtoMessage()    {}
+func (*QueryValidatorHistoricalRewardsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bee02899ef89b167, []int{11}
 }
-func (m *QueryDelegatorValidatorsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorHistoricalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorHistoricalRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorValidatorsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorHistoricalRewardsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -574,37 +588,56 @@ func (m *QueryDelegatorValidatorsResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorValidatorsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorValidatorsResponse.Merge(m, src)
+func (m *QueryValidatorHistoricalRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorHistoricalRewardsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorValidatorsResponse) XXX_Size() int {
+func (m *QueryValidatorHistoricalRewardsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorValidatorsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorValidatorsResponse.DiscardUnknown(m)
+func (m *QueryValidatorHistoricalRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorHistoricalRewardsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorValidatorsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorHistoricalRewardsResponse proto.InternalMessageInfo
 
-// QueryDelegatorWithdrawAddressRequest is the request type for the
-// Query/DelegatorWithdrawAddress RPC method.
-type QueryDelegatorWithdrawAddressRequest struct {
-	// delegator_address defines the delegator address to query for.
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+func (m *QueryValidatorHistoricalRewardsResponse) GetHistoricalRewards() []ValidatorHistoricalRewardsPeriod {
+	if m != nil {
+		return m.HistoricalRewards
+	}
+	return nil
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDelegatorWithdrawAddressRequest{} }
-func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
-func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
+func (m *QueryValidatorHistoricalRewardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ValidatorHistoricalRewardsPeriod defines a historical rewards period of a
+// validator.
+type ValidatorHistoricalRewardsPeriod struct {
+	// period defines the period the historical rewards apply to.
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// rewards defines the historical rewards of the period.
+	Rewards ValidatorHistoricalRewards `protobuf:"bytes,2,opt,name=rewards,proto3" json:"rewards"`
+	// end_time defines the block time the period ended at, unset for periods
+	// ended before it was recorded.
+	EndTime *time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+}
+
+func (m *ValidatorHistoricalRewardsPeriod) Reset()         { *m = ValidatorHistoricalRewardsPeriod{} }
+func (m *ValidatorHistoricalRewardsPeriod) String() string { return proto.CompactTextString(m) }
+func (*ValidatorHistoricalRewardsPeriod) ProtoMessage()    {}
+func (*ValidatorHistoricalRewardsPeriod) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bee02899ef89b167, []int{12}
 }
-func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorHistoricalRewardsPeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorWithdrawAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorHistoricalRewardsPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorWithdrawAddressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorHistoricalRewardsPeriod.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -614,37 +647,58 @@ func (m *QueryDelegatorWithdrawAddressRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorWithdrawAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorWithdrawAddressRequest.Merge(m, src)
+func (m *ValidatorHistoricalRewardsPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorHistoricalRewardsPeriod.Merge(m, src)
 }
-func (m *QueryDelegatorWithdrawAddressRequest) XXX_Size() int {
+func (m *ValidatorHistoricalRewardsPeriod) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorWithdrawAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorWithdrawAddressRequest.DiscardUnknown(m)
+func (m *ValidatorHistoricalRewardsPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorHistoricalRewardsPeriod.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorWithdrawAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorHistoricalRewardsPeriod proto.InternalMessageInfo
 
-// QueryDelegatorWithdrawAddressResponse is the response type for the
-// Query/DelegatorWithdrawAddress RPC method.
-type QueryDelegatorWithdrawAddressResponse struct {
-	// withdraw_address defines the delegator address to query for.
-	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+func (m *ValidatorHistoricalRewardsPeriod) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDelegatorWithdrawAddressResponse{} }
-func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
-func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+func (m *ValidatorHistoricalRewardsPeriod) GetRewards() ValidatorHistoricalRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return ValidatorHistoricalRewards{}
+}
+
+func (m *ValidatorHistoricalRewardsPeriod) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+// QueryValidatorCurrentRewardsRequest is the request type for the
+// Query/ValidatorCurrentRewards RPC method.
+type QueryValidatorCurrentRewardsRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorCurrentRewardsRequest) Reset()         { *m = QueryValidatorCurrentRewardsRequest{} }
+func (m *QueryValidatorCurrentRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorCurrentRewardsRequest) ProtoMessage()    {}
+func (*QueryValidatorCurrentRewardsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bee02899ef89b167, []int{13}
 }
-func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorCurrentRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorCurrentRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorWithdrawAddressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorCurrentRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -654,35 +708,38 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorWithdrawAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorWithdrawAddressResponse.Merge(m, src)
+func (m *QueryValidatorCurrentRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorCurrentRewardsRequest.Merge(m, src)
 }
-func (m *QueryDelegatorWithdrawAddressResponse) XXX_Size() int {
+func (m *QueryValidatorCurrentRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorWithdrawAddressResponse.DiscardUnknown(m)
+func (m *QueryValidatorCurrentRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorCurrentRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorCurrentRewardsRequest proto.InternalMessageInfo
 
-// QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
-// method.
-type QueryCommunityPoolRequest struct {
+// QueryValidatorCurrentRewardsResponse is the response type for the
+// Query/ValidatorCurrentRewards RPC method.
+type QueryValidatorCurrentRewardsResponse struct {
+	// rewards defines the rewards accumulated in the current period of the
+	// validator.
+	Rewards ValidatorCurrentRewards `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards"`
 }
 
-func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolRequest{} }
-func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCommunityPoolRequest) ProtoMessage()    {}
-func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
+func (m *QueryValidatorCurrentRewardsResponse) Reset()         { *m = QueryValidatorCurrentRewardsResponse{} }
+func (m *QueryValidatorCurrentRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorCurrentRewardsResponse) ProtoMessage()    {}
+func (*QueryValidatorCurrentRewardsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bee02899ef89b167, []int{14}
 }
-func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorCurrentRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCommunityPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorCurrentRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCommunityPoolRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorCurrentRewardsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -692,37 +749,50 @@ func (m *QueryCommunityPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryCommunityPoolRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCommunityPoolRequest.Merge(m, src)
+func (m *QueryValidatorCurrentRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorCurrentRewardsResponse.Merge(m, src)
 }
-func (m *QueryCommunityPoolRequest) XXX_Size() int {
+func (m *QueryValidatorCurrentRewardsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCommunityPoolRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCommunityPoolRequest.DiscardUnknown(m)
+func (m *QueryValidatorCurrentRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorCurrentRewardsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCommunityPoolRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorCurrentRewardsResponse proto.InternalMessageInfo
 
-// QueryCommunityPoolResponse is the response type for the Query/CommunityPool
-// RPC method.
-type QueryCommunityPoolResponse struct {
-	// pool defines community pool's coins.
-	Pool github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=pool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"pool"`
+func (m *QueryValidatorCurrentRewardsResponse) GetRewards() ValidatorCurrentRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return ValidatorCurrentRewards{}
 }
 
-func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolResponse{} }
-func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCommunityPoolResponse) ProtoMessage()    {}
-func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bee02899ef89b167, []int{15}
+// QueryValidatorSlashesRequest is the request type for the
+// Query/ValidatorSlashes RPC method
+type QueryValidatorSlashesRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// starting_height defines the optional starting height to query the slashes.
+	StartingHeight uint64 `protobuf:"varint,2,opt,name=starting_height,json=startingHeight,proto3" json:"starting_height,omitempty"`
+	// starting_height defines the optional ending height to query the slashes.
+	EndingHeight uint64 `protobuf:"varint,3,opt,name=ending_height,json=endingHeight,proto3" json:"ending_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryValidatorSlashesRequest) Reset()         { *m = QueryValidatorSlashesRequest{} }
+func (m *QueryValidatorSlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSlashesRequest) ProtoMessage()    {}
+func (*QueryValidatorSlashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{15}
+}
+func (m *QueryValidatorSlashesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCommunityPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorSlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCommunityPoolResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorSlashesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -732,41 +802,93 @@ func (m *QueryCommunityPoolResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryCommunityPoolResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCommunityPoolResponse.Merge(m, src)
+func (m *QueryValidatorSlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSlashesRequest.Merge(m, src)
 }
-func (m *QueryCommunityPoolResponse) XXX_Size() int {
+func (m *QueryValidatorSlashesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCommunityPoolResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCommunityPoolResponse.DiscardUnknown(m)
+func (m *QueryValidatorSlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSlashesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCommunityPoolResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorSlashesRequest proto.InternalMessageInfo
 
-func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_types.DecCoins {
+// QueryValidatorSlashesResponse is the response type for the
+// Query/ValidatorSlashes RPC method.
+type QueryValidatorSlashesResponse struct {
+	// slashes defines the slashes the validator received.
+	Slashes []ValidatorSlashEvent `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorSlashesResponse) Reset()         { *m = QueryValidatorSlashesResponse{} }
+func (m *QueryValidatorSlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSlashesResponse) ProtoMessage()    {}
+func (*QueryValidatorSlashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{16}
+}
+func (m *QueryValidatorSlashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSlashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorSlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSlashesResponse.Merge(m, src)
+}
+func (m *QueryValidatorSlashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSlashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorSlashesResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorSlashesResponse) GetSlashes() []ValidatorSlashEvent {
 	if m != nil {
-		return m.Pool
+		return m.Slashes
 	}
 	return nil
 }
 
-type QueryTokenizeShareRecordRewardRequest struct {
-	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+func (m *QueryValidatorSlashesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryTokenizeShareRecordRewardRequest) Reset()         { *m = QueryTokenizeShareRecordRewardRequest{} }
-func (m *QueryTokenizeShareRecordRewardRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTokenizeShareRecordRewardRequest) ProtoMessage()    {}
-func (*QueryTokenizeShareRecordRewardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bee02899ef89b167, []int{16}
+// QueryDelegationTotalRewardsRequest is the request type for the
+// Query/DelegationTotalRewards RPC method.
+type QueryDelegationTotalRewardsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
-func (m *QueryTokenizeShareRecordRewardRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryDelegationTotalRewardsRequest) Reset()         { *m = QueryDelegationTotalRewardsRequest{} }
+func (m *QueryDelegationTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationTotalRewardsRequest) ProtoMessage()    {}
+func (*QueryDelegationTotalRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{17}
+}
+func (m *QueryDelegationTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTokenizeShareRecordRewardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegationTotalRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTokenizeShareRecordRewardRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegationTotalRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -776,39 +898,39 @@ func (m *QueryTokenizeShareRecordRewardRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryTokenizeShareRecordRewardRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTokenizeShareRecordRewardRequest.Merge(m, src)
+func (m *QueryDelegationTotalRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationTotalRewardsRequest.Merge(m, src)
 }
-func (m *QueryTokenizeShareRecordRewardRequest) XXX_Size() int {
+func (m *QueryDelegationTotalRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTokenizeShareRecordRewardRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTokenizeShareRecordRewardRequest.DiscardUnknown(m)
+func (m *QueryDelegationTotalRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationTotalRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTokenizeShareRecordRewardRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegationTotalRewardsRequest proto.InternalMessageInfo
 
-type QueryTokenizeShareRecordRewardResponse struct {
+// QueryDelegationTotalRewardsResponse is the response type for the
+// Query/DelegationTotalRewards RPC method.
+type QueryDelegationTotalRewardsResponse struct {
 	// rewards defines all the rewards accrued by a delegator.
-	Rewards []TokenizeShareRecordReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	Rewards []DelegationDelegatorReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
 	// total defines the sum of all the rewards.
 	Total github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total"`
 }
 
-func (m *QueryTokenizeShareRecordRewardResponse) Reset() {
-	*m = QueryTokenizeShareRecordRewardResponse{}
-}
-func (m *QueryTokenizeShareRecordRewardResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTokenizeShareRecordRewardResponse) ProtoMessage()    {}
-func (*QueryTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bee02899ef89b167, []int{17}
+func (m *QueryDelegationTotalRewardsResponse) Reset()         { *m = QueryDelegationTotalRewardsResponse{} }
+func (m *QueryDelegationTotalRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationTotalRewardsResponse) ProtoMessage()    {}
+func (*QueryDelegationTotalRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{18}
 }
-func (m *QueryTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegationTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegationTotalRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegationTotalRewardsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -818,1464 +940,6266 @@ func (m *QueryTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTokenizeShareRecordRewardResponse.Merge(m, src)
+func (m *QueryDelegationTotalRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationTotalRewardsResponse.Merge(m, src)
 }
-func (m *QueryTokenizeShareRecordRewardResponse) XXX_Size() int {
+func (m *QueryDelegationTotalRewardsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+func (m *QueryDelegationTotalRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationTotalRewardsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegationTotalRewardsResponse proto.InternalMessageInfo
 
-func (m *QueryTokenizeShareRecordRewardResponse) GetRewards() []TokenizeShareRecordReward {
+func (m *QueryDelegationTotalRewardsResponse) GetRewards() []DelegationDelegatorReward {
 	if m != nil {
 		return m.Rewards
 	}
 	return nil
 }
 
-func (m *QueryTokenizeShareRecordRewardResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.DecCoins {
+func (m *QueryDelegationTotalRewardsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.DecCoins {
 	if m != nil {
 		return m.Total
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryValidatorOutstandingRewardsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorOutstandingRewardsRequest")
-	proto.RegisterType((*QueryValidatorOutstandingRewardsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorOutstandingRewardsResponse")
-	proto.RegisterType((*QueryValidatorCommissionRequest)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorCommissionRequest")
-	proto.RegisterType((*QueryValidatorCommissionResponse)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorCommissionResponse")
-	proto.RegisterType((*QueryValidatorSlashesRequest)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorSlashesRequest")
-	proto.RegisterType((*QueryValidatorSlashesResponse)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorSlashesResponse")
-	proto.RegisterType((*QueryDelegationTotalRewardsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryDelegationTotalRewardsRequest")
-	proto.RegisterType((*QueryDelegationTotalRewardsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryDelegationTotalRewardsResponse")
-	proto.RegisterType((*QueryDelegatorValidatorsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorValidatorsRequest")
-	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorValidatorsResponse")
-	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
-	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
-	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "liquidstaking.distribution.v1beta1.QueryCommunityPoolRequest")
-	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "liquidstaking.distribution.v1beta1.QueryCommunityPoolResponse")
-	proto.RegisterType((*QueryTokenizeShareRecordRewardRequest)(nil), "liquidstaking.distribution.v1beta1.QueryTokenizeShareRecordRewardRequest")
-	proto.RegisterType((*QueryTokenizeShareRecordRewardResponse)(nil), "liquidstaking.distribution.v1beta1.QueryTokenizeShareRecordRewardResponse")
+// QueryDelegatorValidatorsRequest is the request type for the
+// Query/DelegatorValidators RPC method.
+type QueryDelegatorValidatorsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func init() { proto.RegisterFile("distribution/v1beta1/query.proto", fileDescriptor_bee02899ef89b167) }
-
-var fileDescriptor_bee02899ef89b167 = []byte{
-	// 1248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xba, 0x69, 0x4a, 0xa7, 0x0d, 0x4d, 0xa7, 0x11, 0x72, 0xb6, 0xc1, 0x8e, 0x36, 0xb4,
-	0x89, 0x1a, 0xc5, 0xab, 0x26, 0x12, 0x95, 0x82, 0x02, 0xe4, 0xb3, 0xa1, 0x8d, 0xfa, 0xe1, 0x44,
-	0x44, 0x20, 0x81, 0x35, 0xf1, 0x8e, 0xd6, 0xa3, 0xac, 0x77, 0x9c, 0x9d, 0xd9, 0x98, 0x10, 0xe5,
-	0x02, 0x2a, 0x42, 0x82, 0x03, 0x88, 0x0b, 0xc7, 0x9c, 0x38, 0x70, 0xe6, 0x1f, 0x40, 0x5c, 0x7a,
-	0x2c, 0x70, 0xe1, 0x54, 0x50, 0xd2, 0x03, 0x97, 0x4a, 0x15, 0x07, 0xce, 0x68, 0x67, 0x66, 0x6d,
-	0x6f, 0xfc, 0x5d, 0x3b, 0xe2, 0x14, 0xef, 0x9b, 0x79, 0xbf, 0xf7, 0x7e, 0xef, 0xcd, 0x9b, 0xf9,
-	0x29, 0x60, 0xd4, 0x22, 0x8c, 0x7b, 0x64, 0xcb, 0xe7, 0x84, 0xba, 0xe6, 0xee, 0xcd, 0x2d, 0xcc,
-	0xd1, 0x4d, 0x73, 0xc7, 0xc7, 0xde, 0x5e, 0xba, 0xe8, 0x51, 0x4e, 0xa1, 0xe1, 0x90, 0x1d, 0x9f,
-	0x58, 0x8c, 0xa3, 0x6d, 0xe2, 0xda, 0xe9, 0xea, 0xfd, 0x69, 0xb5, 0x5f, 0xbf, 0x91, 0xa3, 0xac,
-	0x40, 0x99, 0xb9, 0x85, 0x18, 0x96, 0xce, 0x65, 0xa8, 0x22, 0xb2, 0x89, 0x8b, 0xc4, 0x6e, 0x81,
-	0xa7, 0x0f, 0xd9, 0xd4, 0xa6, 0xe2, 0xa7, 0x19, 0xfc, 0x52, 0xd6, 0x11, 0x9b, 0x52, 0xdb, 0xc1,
-	0x26, 0x2a, 0x12, 0x13, 0xb9, 0x2e, 0xe5, 0xc2, 0x85, 0xa9, 0xd5, 0x64, 0x35, 0x7e, 0x88, 0x9c,
-	0xa3, 0x24, 0xc4, 0x1c, 0xaf, 0xcb, 0x22, 0x92, 0xaa, 0xda, 0xa8, 0x80, 0x5a, 0xb1, 0xd6, 0x87,
-	0xe5, 0xc6, 0xac, 0x4c, 0x54, 0x7e, 0xc8, 0x25, 0x63, 0x08, 0xc0, 0x87, 0xc1, 0xce, 0x07, 0xc8,
-	0x43, 0x05, 0x96, 0xc1, 0x3b, 0x3e, 0x66, 0xdc, 0xc8, 0x82, 0x2b, 0x11, 0x2b, 0x2b, 0x52, 0x97,
-	0x61, 0xb8, 0x0a, 0xfa, 0x8b, 0xc2, 0x92, 0xd0, 0x46, 0xb5, 0x89, 0x0b, 0xd3, 0x37, 0xd2, 0xad,
-	0xcb, 0x99, 0x96, 0x18, 0x0b, 0x7d, 0x8f, 0x9f, 0xa6, 0x62, 0x19, 0xe5, 0x6f, 0x14, 0xc1, 0xb8,
-	0x08, 0xf0, 0x3e, 0x72, 0x88, 0x85, 0x38, 0xf5, 0xee, 0xfb, 0x9c, 0x71, 0xe4, 0x5a, 0xc4, 0xb5,
-	0x33, 0xb8, 0x84, 0x3c, 0x2b, 0xcc, 0x05, 0x2e, 0x83, 0xcb, 0xbb, 0xe1, 0xae, 0x2c, 0xb2, 0x2c,
-	0x0f, 0x33, 0x19, 0xff, 0xfc, 0x42, 0xe2, 0xb7, 0x9f, 0xa6, 0x86, 0x14, 0x9d, 0x79, 0xb9, 0xb2,
-	0xce, 0xbd, 0x00, 0x62, 0xb0, 0xec, 0xa2, 0xec, 0xc6, 0x57, 0x1a, 0x98, 0x68, 0x1d, 0x52, 0x11,
-	0xcd, 0x82, 0x73, 0x9e, 0x34, 0x29, 0xa6, 0xef, 0xb4, 0xc3, 0xb4, 0x09, 0xb2, 0xa2, 0x1f, 0xa2,
-	0x1a, 0x79, 0x90, 0x8a, 0x26, 0xb3, 0x48, 0x0b, 0x05, 0xc2, 0x18, 0xa1, 0x6e, 0x8f, 0x79, 0x7f,
-	0xad, 0x81, 0xd1, 0xc6, 0xa1, 0x14, 0xdf, 0x3c, 0x00, 0xb9, 0xb2, 0x55, 0x51, 0x5e, 0xe8, 0x88,
-	0xf2, 0x7c, 0x2e, 0xe7, 0x17, 0x7c, 0x07, 0x71, 0x6c, 0x55, 0xf0, 0x15, 0xeb, 0x2a, 0x6c, 0xe3,
-	0x51, 0x1c, 0x8c, 0x44, 0xd3, 0x59, 0x77, 0x10, 0xcb, 0xe3, 0x1e, 0xb7, 0x1b, 0x8e, 0x83, 0x4b,
-	0x8c, 0x23, 0x8f, 0x13, 0xd7, 0xce, 0xe6, 0x31, 0xb1, 0xf3, 0x3c, 0x11, 0x1f, 0xd5, 0x26, 0xfa,
-	0x32, 0xaf, 0x86, 0xe6, 0x55, 0x61, 0x85, 0x63, 0x60, 0x00, 0x8b, 0x4e, 0x85, 0xdb, 0xce, 0x88,
-	0x6d, 0x17, 0xa5, 0x51, 0x6d, 0x5a, 0x01, 0xa0, 0x32, 0xfa, 0x89, 0x3e, 0x51, 0x9f, 0xeb, 0x69,
-	0x95, 0x4a, 0x30, 0xc7, 0x69, 0x39, 0x6e, 0x95, 0x33, 0x6f, 0x63, 0x45, 0x28, 0x53, 0xe5, 0x39,
-	0xfb, 0xca, 0x97, 0x87, 0xa9, 0xd8, 0xf7, 0x87, 0x29, 0xcd, 0xf8, 0x59, 0x03, 0xaf, 0x37, 0xa8,
-	0x83, 0xea, 0xc9, 0x26, 0x38, 0xc7, 0xa4, 0x29, 0xa1, 0x8d, 0x9e, 0x99, 0xb8, 0x30, 0x7d, 0xab,
-	0xa3, 0x86, 0x08, 0xb8, 0xe5, 0x5d, 0xec, 0xf2, 0xf0, 0xec, 0x29, 0x34, 0x78, 0x3b, 0x42, 0x26,
-	0x2e, 0xc8, 0x8c, 0xb7, 0x24, 0x23, 0xb3, 0xaa, 0x66, 0x63, 0xf8, 0xc0, 0x10, 0x14, 0x96, 0xb0,
-	0x83, 0x6d, 0x61, 0xda, 0xa0, 0x1c, 0x39, 0xb5, 0xf3, 0x6b, 0xc9, 0x0d, 0x9d, 0x34, 0xb4, 0xec,
-	0xa2, 0xec, 0xb2, 0x74, 0x7f, 0x1f, 0xa6, 0x62, 0xc6, 0x73, 0x0d, 0x8c, 0x35, 0x8d, 0xab, 0x0a,
-	0xf8, 0x51, 0xf5, 0x10, 0x07, 0x05, 0x9c, 0x6b, 0xa7, 0x80, 0x15, 0xd0, 0xa5, 0x30, 0x05, 0x09,
-	0x7c, 0x62, 0x84, 0xa1, 0x0d, 0xce, 0xf2, 0x20, 0x6c, 0x22, 0x2e, 0xc0, 0x47, 0x22, 0x15, 0xac,
-	0xa0, 0xe5, 0x16, 0x29, 0x71, 0x17, 0x66, 0x02, 0xdf, 0x1f, 0xff, 0x4c, 0x4d, 0xda, 0x84, 0xe7,
-	0xfd, 0xad, 0x74, 0x8e, 0x16, 0xd4, 0x3d, 0xac, 0xfe, 0x4c, 0x31, 0x6b, 0xdb, 0xe4, 0x7b, 0x45,
-	0xcc, 0x42, 0x1f, 0x96, 0x91, 0xf8, 0x86, 0xa7, 0xee, 0x8a, 0x72, 0x3e, 0xe5, 0x1e, 0x9f, 0x5e,
-	0x8d, 0xd7, 0xd4, 0xa5, 0x51, 0x37, 0xa6, 0xaa, 0x6f, 0x12, 0x80, 0xf2, 0xd8, 0xc9, 0x12, 0x9f,
-	0xcf, 0x54, 0x59, 0xaa, 0xd0, 0x4a, 0xe0, 0x8d, 0x28, 0xda, 0x26, 0xe1, 0x79, 0xcb, 0x43, 0x25,
-	0x15, 0xf8, 0xd4, 0x68, 0xec, 0x82, 0x6b, 0x2d, 0x02, 0x2b, 0x2e, 0x8b, 0x60, 0xb0, 0xa4, 0x96,
-	0xda, 0x0e, 0x7c, 0xa9, 0x14, 0x05, 0xab, 0x8a, 0x7b, 0x15, 0x0c, 0x8b, 0xb8, 0xc1, 0x55, 0xe8,
-	0xbb, 0x84, 0xef, 0x3d, 0xa0, 0xd4, 0x09, 0x1f, 0xd7, 0xcf, 0x35, 0xa0, 0xd7, 0x5b, 0x55, 0xa9,
-	0x60, 0xd0, 0x57, 0xa4, 0xd4, 0x51, 0x67, 0xf6, 0x14, 0x8e, 0x95, 0x80, 0x37, 0x8a, 0xaa, 0x34,
-	0x1b, 0x74, 0x1b, 0xbb, 0xe4, 0x53, 0xbc, 0x9e, 0x47, 0x1e, 0xce, 0xe0, 0x1c, 0xf5, 0x2c, 0x79,
-	0xde, 0xc3, 0xa6, 0xcc, 0x81, 0x01, 0x5a, 0x72, 0x71, 0x4d, 0x43, 0xfe, 0x79, 0x9a, 0x1a, 0xda,
-	0x43, 0x05, 0x67, 0xd6, 0x88, 0x2c, 0x1b, 0x99, 0x8b, 0xe2, 0xbb, 0xb6, 0x28, 0x2f, 0x34, 0x70,
-	0xbd, 0x55, 0xc8, 0xae, 0x46, 0xb7, 0x21, 0xee, 0xff, 0x35, 0xba, 0xd3, 0xbf, 0x42, 0x70, 0x56,
-	0x50, 0x86, 0x3f, 0x68, 0xa0, 0x5f, 0x2a, 0x21, 0xf8, 0x66, 0x3b, 0x5c, 0x6a, 0x45, 0x99, 0x7e,
-	0xab, 0x63, 0x3f, 0x59, 0x4d, 0x63, 0xf2, 0xb3, 0xdf, 0x9f, 0x7d, 0x17, 0xbf, 0x06, 0xc7, 0xcc,
-	0x66, 0x82, 0x51, 0x2a, 0x33, 0xf8, 0x6d, 0x1c, 0x5c, 0x6d, 0x22, 0x64, 0xe0, 0xdd, 0xb6, 0xb3,
-	0x68, 0xad, 0xed, 0xf4, 0xb5, 0xde, 0x80, 0x29, 0x9e, 0x9b, 0x82, 0xe7, 0x43, 0x78, 0xbf, 0x29,
-	0xcf, 0xca, 0x0d, 0x65, 0xee, 0xd7, 0x28, 0x8d, 0x03, 0x93, 0x56, 0xf0, 0xb3, 0xe1, 0x79, 0x79,
-	0xa1, 0x81, 0x2b, 0x75, 0xe4, 0x13, 0x5c, 0xec, 0x3c, 0xfd, 0x1a, 0x9d, 0xa7, 0x2f, 0x75, 0x07,
-	0xa2, 0xb8, 0xdf, 0x13, 0xdc, 0x57, 0xe1, 0x4a, 0x37, 0xdc, 0x2b, 0x3a, 0x0d, 0x3e, 0xd3, 0xc0,
-	0xe0, 0x49, 0x69, 0x02, 0xdf, 0xed, 0x3c, 0xd5, 0xa8, 0xba, 0xd3, 0xe7, 0xbb, 0x40, 0x50, 0x4c,
-	0xef, 0x0a, 0xa6, 0xcb, 0x70, 0xb1, 0x1b, 0xa6, 0xa1, 0x16, 0x7a, 0xae, 0x81, 0xcb, 0x95, 0x17,
-	0x3f, 0x3c, 0xe3, 0xb3, 0xe1, 0x85, 0xd0, 0x38, 0xbd, 0x1a, 0xa7, 0x90, 0xe1, 0x5b, 0x2f, 0xe5,
-	0xab, 0xb8, 0x65, 0x05, 0xb7, 0x0f, 0xe0, 0x66, 0x53, 0x6e, 0xe5, 0x07, 0x8f, 0x99, 0xfb, 0x35,
-	0xef, 0xe5, 0x81, 0xa9, 0x4e, 0x6d, 0x3d, 0xde, 0xf0, 0x5f, 0x0d, 0xbc, 0x56, 0x5f, 0x36, 0xc1,
-	0x95, 0xb6, 0x5b, 0xd3, 0x54, 0xef, 0xe9, 0xb7, 0xbb, 0xc6, 0xe9, 0xa8, 0xd1, 0xed, 0x15, 0x43,
-	0x8c, 0x70, 0x1d, 0x31, 0xd3, 0xc1, 0x08, 0x37, 0x96, 0x5f, 0x1d, 0x8c, 0x70, 0x13, 0x3d, 0xd5,
-	0xe6, 0x08, 0xb7, 0xe0, 0x5b, 0x39, 0xf7, 0xf0, 0x51, 0x1c, 0x24, 0x1a, 0x09, 0x1f, 0xb8, 0xda,
-	0x79, 0xca, 0xf5, 0x45, 0x9b, 0xfe, 0x5e, 0x0f, 0x90, 0x54, 0x05, 0x36, 0x44, 0x05, 0xee, 0xc1,
-	0xb5, 0x6e, 0x2a, 0x70, 0x52, 0xc7, 0xc1, 0x5f, 0x34, 0x30, 0x10, 0x91, 0x5a, 0x70, 0xae, 0xed,
-	0x94, 0xeb, 0x09, 0x38, 0xfd, 0xed, 0x97, 0x75, 0x57, 0x34, 0x67, 0x04, 0xcd, 0x29, 0x38, 0xd9,
-	0x94, 0x66, 0x2e, 0xf4, 0xcd, 0x06, 0x7a, 0x0d, 0x7e, 0x11, 0x07, 0xc3, 0x0d, 0x05, 0x0e, 0x6c,
-	0xbf, 0x09, 0xad, 0xf4, 0x9e, 0x7e, 0xa7, 0x17, 0x50, 0x8a, 0x69, 0x46, 0x30, 0x5d, 0x83, 0x77,
-	0x9a, 0x32, 0xdd, 0x8f, 0x08, 0xc8, 0x03, 0x93, 0x2b, 0xdc, 0x2c, 0x0b, 0x80, 0xb3, 0x9e, 0x40,
-	0x0e, 0x1f, 0xe3, 0x85, 0x8f, 0x1f, 0x1f, 0x25, 0xb5, 0x27, 0x47, 0x49, 0xed, 0xaf, 0xa3, 0xa4,
-	0xf6, 0xcd, 0x71, 0x32, 0xf6, 0xe4, 0x38, 0x19, 0xfb, 0xe3, 0x38, 0x19, 0xfb, 0x70, 0xa9, 0x4a,
-	0xa1, 0x91, 0x1d, 0xc7, 0x0f, 0x1e, 0x32, 0xe2, 0xe6, 0x4c, 0xc9, 0x87, 0xf0, 0xbd, 0x29, 0xc5,
-	0x69, 0xaa, 0x40, 0x2d, 0xdf, 0xc1, 0xe6, 0x27, 0xd1, 0x7c, 0x84, 0x86, 0xdb, 0xea, 0x17, 0xff,
-	0x18, 0x9b, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x77, 0xa6, 0xd8, 0x4d, 0x14, 0x00, 0x00,
+func (m *QueryDelegatorValidatorsRequest) Reset()         { *m = QueryDelegatorValidatorsRequest{} }
+func (m *QueryDelegatorValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorValidatorsRequest) ProtoMessage()    {}
+func (*QueryDelegatorValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{19}
+}
+func (m *QueryDelegatorValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorValidatorsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorValidatorsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryDelegatorValidatorsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries params of the distribution module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ValidatorOutstandingRewards queries rewards of a validator address.
-	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
-	// ValidatorCommission queries accumulated commission for a validator.
-	ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error)
-	// ValidatorSlashes queries slash events of a validator.
-	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
-	// DelegationRewards queries the total rewards accrued by a delegation.
-	DelegationRewards(ctx context.Context, in *types1.QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*types1.QueryDelegationRewardsResponse, error)
-	// DelegationTotalRewards queries the total rewards accrued by a each
-	// validator.
-	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
-	// DelegatorValidators queries the validators of a delegator.
-	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
-	// DelegatorWithdrawAddress queries withdraw address of a delegator.
-	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
-	// CommunityPool queries the community pool coins.
-	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
-	// TokenizeShareRecordReward queries the tokenize share record rewards
-	TokenizeShareRecordReward(ctx context.Context, in *QueryTokenizeShareRecordRewardRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordRewardResponse, error)
+// QueryDelegatorValidatorsResponse is the response type for the
+// Query/DelegatorValidators RPC method.
+type QueryDelegatorValidatorsResponse struct {
+	// validators defines the validators a delegator is delegating for.
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDelegatorValidatorsResponse) Reset()         { *m = QueryDelegatorValidatorsResponse{} }
+func (m *QueryDelegatorValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorValidatorsResponse) ProtoMessage()    {}
+func (*QueryDelegatorValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{20}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryDelegatorValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error) {
-	out := new(QueryValidatorOutstandingRewardsResponse)
-	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/ValidatorOutstandingRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorValidatorsResponse.Merge(m, src)
 }
-
-func (c *queryClient) ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error) {
-	out := new(QueryValidatorCommissionResponse)
-	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/ValidatorCommission", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorValidatorsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error) {
-	out := new(QueryValidatorSlashesResponse)
-	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/ValidatorSlashes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryDelegatorValidatorsResponse proto.InternalMessageInfo
+
+// QueryDelegatorStartingInfoRequest is the request type for the
+// Query/DelegatorStartingInfo RPC method.
+type QueryDelegatorStartingInfoRequest struct {
+	// delegator_address defines the delegator address to query for, the module
+	// account of a tokenize share record for the rewards of the record.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (c *queryClient) DelegationRewards(ctx context.Context, in *types1.QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*types1.QueryDelegationRewardsResponse, error) {
-	out := new(types1.QueryDelegationRewardsResponse)
-	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/DelegationRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorStartingInfoRequest) Reset()         { *m = QueryDelegatorStartingInfoRequest{} }
+func (m *QueryDelegatorStartingInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorStartingInfoRequest) ProtoMessage()    {}
+func (*QueryDelegatorStartingInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{21}
+}
+func (m *QueryDelegatorStartingInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorStartingInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorStartingInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDelegatorStartingInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorStartingInfoRequest.Merge(m, src)
+}
+func (m *QueryDelegatorStartingInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorStartingInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorStartingInfoRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error) {
-	out := new(QueryDelegationTotalRewardsResponse)
-	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/DelegationTotalRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryDelegatorStartingInfoRequest proto.InternalMessageInfo
+
+// QueryDelegatorStartingInfoResponse is the response type for the
+// Query/DelegatorStartingInfo RPC method.
+type QueryDelegatorStartingInfoResponse struct {
+	// starting_info defines the starting info of the delegation.
+	StartingInfo DelegatorStartingInfo `protobuf:"bytes,1,opt,name=starting_info,json=startingInfo,proto3" json:"starting_info"`
 }
 
-func (c *queryClient) DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error) {
-	out := new(QueryDelegatorValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/DelegatorValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorStartingInfoResponse) Reset()         { *m = QueryDelegatorStartingInfoResponse{} }
+func (m *QueryDelegatorStartingInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorStartingInfoResponse) ProtoMessage()    {}
+func (*QueryDelegatorStartingInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{22}
+}
+func (m *QueryDelegatorStartingInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorStartingInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorStartingInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDelegatorStartingInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorStartingInfoResponse.Merge(m, src)
+}
+func (m *QueryDelegatorStartingInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorStartingInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorStartingInfoResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error) {
-	out := new(QueryDelegatorWithdrawAddressResponse)
-	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/DelegatorWithdrawAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegatorStartingInfoResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorStartingInfoResponse) GetStartingInfo() DelegatorStartingInfo {
+	if m != nil {
+		return m.StartingInfo
 	}
-	return out, nil
+	return DelegatorStartingInfo{}
 }
 
-func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
-	out := new(QueryCommunityPoolResponse)
-	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/CommunityPool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDelegatorWithdrawAddressRequest is the request type for the
+// Query/DelegatorWithdrawAddress RPC method.
+type QueryDelegatorWithdrawAddressRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (c *queryClient) TokenizeShareRecordReward(ctx context.Context, in *QueryTokenizeShareRecordRewardRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordRewardResponse, error) {
-	out := new(QueryTokenizeShareRecordRewardResponse)
-	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/TokenizeShareRecordReward", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDelegatorWithdrawAddressRequest{} }
+func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
+func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{23}
+}
+func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorWithdrawAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorWithdrawAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the distribution module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ValidatorOutstandingRewards queries rewards of a validator address.
-	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
-	// ValidatorCommission queries accumulated commission for a validator.
-	ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error)
-	// ValidatorSlashes queries slash events of a validator.
-	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
-	// DelegationRewards queries the total rewards accrued by a delegation.
-	DelegationRewards(context.Context, *types1.QueryDelegationRewardsRequest) (*types1.QueryDelegationRewardsResponse, error)
-	// DelegationTotalRewards queries the total rewards accrued by a each
-	// validator.
-	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
-	// DelegatorValidators queries the validators of a delegator.
-	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
-	// DelegatorWithdrawAddress queries withdraw address of a delegator.
-	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
-	// CommunityPool queries the community pool coins.
-	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
-	// TokenizeShareRecordReward queries the tokenize share record rewards
-	TokenizeShareRecordReward(context.Context, *QueryTokenizeShareRecordRewardRequest) (*QueryTokenizeShareRecordRewardResponse, error)
+func (m *QueryDelegatorWithdrawAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorWithdrawAddressRequest.Merge(m, src)
+}
+func (m *QueryDelegatorWithdrawAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorWithdrawAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorWithdrawAddressRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryDelegatorWithdrawAddressRequest proto.InternalMessageInfo
+
+// QueryDelegatorWithdrawAddressResponse is the response type for the
+// Query/DelegatorWithdrawAddress RPC method.
+type QueryDelegatorWithdrawAddressResponse struct {
+	// withdraw_address defines the delegator address to query for.
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDelegatorWithdrawAddressResponse{} }
+func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
+func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{24}
 }
-func (*UnimplementedQueryServer) ValidatorOutstandingRewards(ctx context.Context, req *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOutstandingRewards not implemented")
+func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ValidatorCommission(ctx context.Context, req *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommission not implemented")
+func (m *QueryDelegatorWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ValidatorSlashes(ctx context.Context, req *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashes not implemented")
+func (m *QueryDelegatorWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorWithdrawAddressResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DelegationRewards(ctx context.Context, req *types1.QueryDelegationRewardsRequest) (*types1.QueryDelegationRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewards not implemented")
+func (m *QueryDelegatorWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DelegationTotalRewards(ctx context.Context, req *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
+func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorWithdrawAddressResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorValidators not implemented")
+
+var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
+
+// QueryDelegatorAutoRestakesRequest is the request type for the
+// Query/DelegatorAutoRestakes RPC method.
+type QueryDelegatorAutoRestakesRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
-func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
+
+func (m *QueryDelegatorAutoRestakesRequest) Reset()         { *m = QueryDelegatorAutoRestakesRequest{} }
+func (m *QueryDelegatorAutoRestakesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoRestakesRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoRestakesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{25}
 }
-func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
+func (m *QueryDelegatorAutoRestakesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) TokenizeShareRecordReward(ctx context.Context, req *QueryTokenizeShareRecordRewardRequest) (*QueryTokenizeShareRecordRewardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordReward not implemented")
+func (m *QueryDelegatorAutoRestakesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoRestakesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoRestakesRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoRestakesRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryDelegatorAutoRestakesRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoRestakesResponse is the response type for the
+// Query/DelegatorAutoRestakes RPC method.
+type QueryDelegatorAutoRestakesResponse struct {
+	// validators defines the validators the delegator auto restakes the rewards of.
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liquidstaking.distribution.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryDelegatorAutoRestakesResponse) Reset()         { *m = QueryDelegatorAutoRestakesResponse{} }
+func (m *QueryDelegatorAutoRestakesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoRestakesResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoRestakesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{26}
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoRestakesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoRestakesResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoRestakesResponse.DiscardUnknown(m)
 }
 
-func _Query_ValidatorOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorOutstandingRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorOutstandingRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liquidstaking.distribution.v1beta1.Query/ValidatorOutstandingRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorOutstandingRewards(ctx, req.(*QueryValidatorOutstandingRewardsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryDelegatorAutoRestakesResponse proto.InternalMessageInfo
+
+// QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
+// method.
+type QueryCommunityPoolRequest struct {
 }
 
-func _Query_ValidatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorCommissionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorCommission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liquidstaking.distribution.v1beta1.Query/ValidatorCommission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorCommission(ctx, req.(*QueryValidatorCommissionRequest))
+func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolRequest{} }
+func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityPoolRequest) ProtoMessage()    {}
+func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{27}
+}
+func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCommunityPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityPoolRequest.Merge(m, src)
+}
+func (m *QueryCommunityPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityPoolRequest.DiscardUnknown(m)
 }
 
-func _Query_ValidatorSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorSlashesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorSlashes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liquidstaking.distribution.v1beta1.Query/ValidatorSlashes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorSlashes(ctx, req.(*QueryValidatorSlashesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryCommunityPoolRequest proto.InternalMessageInfo
+
+// QueryCommunityPoolResponse is the response type for the Query/CommunityPool
+// RPC method.
+type QueryCommunityPoolResponse struct {
+	// pool defines community pool's coins.
+	Pool github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=pool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"pool"`
 }
 
-func _Query_DelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types1.QueryDelegationRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegationRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liquidstaking.distribution.v1beta1.Query/DelegationRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegationRewards(ctx, req.(*types1.QueryDelegationRewardsRequest))
+func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolResponse{} }
+func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityPoolResponse) ProtoMessage()    {}
+func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{28}
+}
+func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCommunityPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityPoolResponse.Merge(m, src)
+}
+func (m *QueryCommunityPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityPoolResponse.DiscardUnknown(m)
 }
 
-func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegationTotalRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegationTotalRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liquidstaking.distribution.v1beta1.Query/DelegationTotalRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegationTotalRewards(ctx, req.(*QueryDelegationTotalRewardsRequest))
+var xxx_messageInfo_QueryCommunityPoolResponse proto.InternalMessageInfo
+
+func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Pool
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DelegatorValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liquidstaking.distribution.v1beta1.Query/DelegatorValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorValidators(ctx, req.(*QueryDelegatorValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryLiquidStakingFeesCollectedRequest is the request type for the
+// Query/LiquidStakingFeesCollected RPC method.
+type QueryLiquidStakingFeesCollectedRequest struct {
 }
 
-func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorWithdrawAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorWithdrawAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liquidstaking.distribution.v1beta1.Query/DelegatorWithdrawAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorWithdrawAddress(ctx, req.(*QueryDelegatorWithdrawAddressRequest))
+func (m *QueryLiquidStakingFeesCollectedRequest) Reset() {
+	*m = QueryLiquidStakingFeesCollectedRequest{}
+}
+func (m *QueryLiquidStakingFeesCollectedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidStakingFeesCollectedRequest) ProtoMessage()    {}
+func (*QueryLiquidStakingFeesCollectedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{29}
+}
+func (m *QueryLiquidStakingFeesCollectedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidStakingFeesCollectedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidStakingFeesCollectedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLiquidStakingFeesCollectedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidStakingFeesCollectedRequest.Merge(m, src)
+}
+func (m *QueryLiquidStakingFeesCollectedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidStakingFeesCollectedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidStakingFeesCollectedRequest.DiscardUnknown(m)
 }
 
-func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCommunityPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CommunityPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liquidstaking.distribution.v1beta1.Query/CommunityPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CommunityPool(ctx, req.(*QueryCommunityPoolRequest))
+var xxx_messageInfo_QueryLiquidStakingFeesCollectedRequest proto.InternalMessageInfo
+
+// QueryLiquidStakingFeesCollectedResponse is the response type for the
+// Query/LiquidStakingFeesCollected RPC method.
+type QueryLiquidStakingFeesCollectedResponse struct {
+	// fees defines the cumulative liquid staking reward fees.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *QueryLiquidStakingFeesCollectedResponse) Reset() {
+	*m = QueryLiquidStakingFeesCollectedResponse{}
+}
+func (m *QueryLiquidStakingFeesCollectedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidStakingFeesCollectedResponse) ProtoMessage()    {}
+func (*QueryLiquidStakingFeesCollectedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{30}
+}
+func (m *QueryLiquidStakingFeesCollectedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidStakingFeesCollectedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidStakingFeesCollectedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLiquidStakingFeesCollectedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidStakingFeesCollectedResponse.Merge(m, src)
+}
+func (m *QueryLiquidStakingFeesCollectedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidStakingFeesCollectedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidStakingFeesCollectedResponse.DiscardUnknown(m)
 }
 
-func _Query_TokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokenizeShareRecordRewardRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLiquidStakingFeesCollectedResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidStakingFeesCollectedResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TokenizeShareRecordReward(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liquidstaking.distribution.v1beta1.Query/TokenizeShareRecordReward",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TokenizeShareRecordReward(ctx, req.(*QueryTokenizeShareRecordRewardRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "liquidstaking.distribution.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ValidatorOutstandingRewards",
-			Handler:    _Query_ValidatorOutstandingRewards_Handler,
-		},
-		{
-			MethodName: "ValidatorCommission",
-			Handler:    _Query_ValidatorCommission_Handler,
-		},
-		{
-			MethodName: "ValidatorSlashes",
-			Handler:    _Query_ValidatorSlashes_Handler,
-		},
-		{
-			MethodName: "DelegationRewards",
-			Handler:    _Query_DelegationRewards_Handler,
-		},
-		{
-			MethodName: "DelegationTotalRewards",
-			Handler:    _Query_DelegationTotalRewards_Handler,
-		},
-		{
-			MethodName: "DelegatorValidators",
-			Handler:    _Query_DelegatorValidators_Handler,
-		},
-		{
-			MethodName: "DelegatorWithdrawAddress",
-			Handler:    _Query_DelegatorWithdrawAddress_Handler,
-		},
-		{
-			MethodName: "CommunityPool",
-			Handler:    _Query_CommunityPool_Handler,
-		},
-		{
-			MethodName: "TokenizeShareRecordReward",
-			Handler:    _Query_TokenizeShareRecordReward_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "distribution/v1beta1/query.proto",
+// QueryCommunityPoolStreamsRequest is the request type for the
+// Query/CommunityPoolStreams RPC method.
+type QueryCommunityPoolStreamsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCommunityPoolStreamsRequest) Reset()         { *m = QueryCommunityPoolStreamsRequest{} }
+func (m *QueryCommunityPoolStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityPoolStreamsRequest) ProtoMessage()    {}
+func (*QueryCommunityPoolStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{31}
+}
+func (m *QueryCommunityPoolStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityPoolStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityPoolStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCommunityPoolStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityPoolStreamsRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCommunityPoolStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityPoolStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityPoolStreamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCommunityPoolStreamsRequest proto.InternalMessageInfo
+
+func (m *QueryCommunityPoolStreamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCommunityPoolStreamsResponse is the response type for the
+// Query/CommunityPoolStreams RPC method.
+type QueryCommunityPoolStreamsResponse struct {
+	// streams defines the active community pool streams.
+	Streams []CommunityPoolStream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCommunityPoolStreamsResponse) Reset()         { *m = QueryCommunityPoolStreamsResponse{} }
+func (m *QueryCommunityPoolStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityPoolStreamsResponse) ProtoMessage()    {}
+func (*QueryCommunityPoolStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{32}
+}
+func (m *QueryCommunityPoolStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityPoolStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityPoolStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryValidatorOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCommunityPoolStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityPoolStreamsResponse.Merge(m, src)
 }
-
-func (m *QueryValidatorOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCommunityPoolStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityPoolStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityPoolStreamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryCommunityPoolStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryCommunityPoolStreamsResponse) GetStreams() []CommunityPoolStream {
+	if m != nil {
+		return m.Streams
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCommunityPoolStreamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCommunityPoolStreamRequest is the request type for the
+// Query/CommunityPoolStream RPC method.
+type QueryCommunityPoolStreamRequest struct {
+	// stream_id defines the id of the stream to query for.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCommunityPoolStreamRequest) Reset()         { *m = QueryCommunityPoolStreamRequest{} }
+func (m *QueryCommunityPoolStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityPoolStreamRequest) ProtoMessage()    {}
+func (*QueryCommunityPoolStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{33}
+}
+func (m *QueryCommunityPoolStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityPoolStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityPoolStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCommunityPoolStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityPoolStreamRequest.Merge(m, src)
+}
+func (m *QueryCommunityPoolStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityPoolStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityPoolStreamRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorCommissionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCommunityPoolStreamRequest proto.InternalMessageInfo
+
+func (m *QueryCommunityPoolStreamRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryValidatorCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCommunityPoolStreamResponse is the response type for the
+// Query/CommunityPoolStream RPC method.
+type QueryCommunityPoolStreamResponse struct {
+	// stream defines the community pool stream.
+	Stream CommunityPoolStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
 }
 
-func (m *QueryValidatorCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCommunityPoolStreamResponse) Reset()         { *m = QueryCommunityPoolStreamResponse{} }
+func (m *QueryCommunityPoolStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityPoolStreamResponse) ProtoMessage()    {}
+func (*QueryCommunityPoolStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{34}
+}
+func (m *QueryCommunityPoolStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityPoolStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityPoolStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCommunityPoolStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityPoolStreamResponse.Merge(m, src)
+}
+func (m *QueryCommunityPoolStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityPoolStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityPoolStreamResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorCommissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCommunityPoolStreamResponse proto.InternalMessageInfo
+
+func (m *QueryCommunityPoolStreamResponse) GetStream() CommunityPoolStream {
+	if m != nil {
+		return m.Stream
 	}
-	return dAtA[:n], nil
+	return CommunityPoolStream{}
 }
 
-func (m *QueryValidatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTokenizeShareRecordRewardRequest struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
 }
 
-func (m *QueryValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTokenizeShareRecordRewardRequest) Reset()         { *m = QueryTokenizeShareRecordRewardRequest{} }
+func (m *QueryTokenizeShareRecordRewardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordRewardRequest) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordRewardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{35}
+}
+func (m *QueryTokenizeShareRecordRewardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordRewardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordRewardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryValidatorSlashesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTokenizeShareRecordRewardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordRewardRequest.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordRewardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordRewardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordRewardRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTokenizeShareRecordRewardRequest proto.InternalMessageInfo
+
+type QueryTokenizeShareRecordRewardResponse struct {
+	// rewards defines all the rewards accrued by a delegator.
+	Rewards []TokenizeShareRecordReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total defines the sum of all the rewards.
+	Total github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total"`
 }
 
-func (m *QueryValidatorSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTokenizeShareRecordRewardResponse) Reset() {
+	*m = QueryTokenizeShareRecordRewardResponse{}
+}
+func (m *QueryTokenizeShareRecordRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordRewardResponse) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{36}
+}
+func (m *QueryTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.EndingHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EndingHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.StartingHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartingHeight))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordRewardResponse) GetRewards() []TokenizeShareRecordReward {
+	if m != nil {
+		return m.Rewards
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryValidatorSlashesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTokenizeShareRecordRewardResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Total
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTokenizeShareRecordRewardByIdRequest is the request type for the
+// Query/TokenizeShareRecordRewardById RPC method.
+type QueryTokenizeShareRecordRewardByIdRequest struct {
+	// record_id defines the tokenize share record to query the rewards of.
+	RecordId uint64 `protobuf:"varint,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty" yaml:"record_id"`
 }
 
-func (m *QueryValidatorSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Slashes) > 0 {
-		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryTokenizeShareRecordRewardByIdRequest) Reset() {
+	*m = QueryTokenizeShareRecordRewardByIdRequest{}
+}
+func (m *QueryTokenizeShareRecordRewardByIdRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryTokenizeShareRecordRewardByIdRequest) ProtoMessage() {}
+func (*QueryTokenizeShareRecordRewardByIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{37}
+}
+func (m *QueryTokenizeShareRecordRewardByIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordRewardByIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordRewardByIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTokenizeShareRecordRewardByIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordRewardByIdRequest.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordRewardByIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordRewardByIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordRewardByIdRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDelegationTotalRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTokenizeShareRecordRewardByIdRequest proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordRewardByIdRequest) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryDelegationTotalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTokenizeShareRecordRewardByIdResponse is the response type for the
+// Query/TokenizeShareRecordRewardById RPC method.
+type QueryTokenizeShareRecordRewardByIdResponse struct {
+	// reward defines the rewards accrued by the tokenize share record.
+	Reward TokenizeShareRecordReward `protobuf:"bytes,1,opt,name=reward,proto3" json:"reward"`
 }
 
-func (m *QueryDelegationTotalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryTokenizeShareRecordRewardByIdResponse) Reset() {
+	*m = QueryTokenizeShareRecordRewardByIdResponse{}
+}
+func (m *QueryTokenizeShareRecordRewardByIdResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryTokenizeShareRecordRewardByIdResponse) ProtoMessage() {}
+func (*QueryTokenizeShareRecordRewardByIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{38}
+}
+func (m *QueryTokenizeShareRecordRewardByIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordRewardByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordRewardByIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTokenizeShareRecordRewardByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordRewardByIdResponse.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordRewardByIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordRewardByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordRewardByIdResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDelegationTotalRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTokenizeShareRecordRewardByIdResponse proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordRewardByIdResponse) GetReward() TokenizeShareRecordReward {
+	if m != nil {
+		return m.Reward
 	}
-	return dAtA[:n], nil
+	return TokenizeShareRecordReward{}
 }
 
-func (m *QueryDelegationTotalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorTokenizeShareRecordRewardsRequest is the request type for the
+// Query/ValidatorTokenizeShareRecordRewards RPC method.
+type QueryValidatorTokenizeShareRecordRewardsRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegationTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) Reset() {
+	*m = QueryValidatorTokenizeShareRecordRewardsRequest{}
+}
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorTokenizeShareRecordRewardsRequest) ProtoMessage() {}
+func (*QueryValidatorTokenizeShareRecordRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{39}
+}
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorTokenizeShareRecordRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDelegatorValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorTokenizeShareRecordRewardsRequest.Merge(m, src)
 }
-
-func (m *QueryDelegatorValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorTokenizeShareRecordRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDelegatorValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryValidatorTokenizeShareRecordRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDelegatorValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDelegatorValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorTokenizeShareRecordRewardsResponse is the response type for the
+// Query/ValidatorTokenizeShareRecordRewards RPC method.
+type QueryValidatorTokenizeShareRecordRewardsResponse struct {
+	// rewards defines the rewards accrued by the validator's tokenize share records.
+	Rewards []TokenizeShareRecordReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total defines the sum of the rewards in this page.
+	Total github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Validators[iNdEx])
-			copy(dAtA[i:], m.Validators[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) Reset() {
+	*m = QueryValidatorTokenizeShareRecordRewardsResponse{}
+}
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorTokenizeShareRecordRewardsResponse) ProtoMessage() {}
+func (*QueryValidatorTokenizeShareRecordRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{40}
+}
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorTokenizeShareRecordRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorTokenizeShareRecordRewardsResponse.Merge(m, src)
+}
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorTokenizeShareRecordRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorTokenizeShareRecordRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) GetRewards() []TokenizeShareRecordReward {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorOutstandingRewardsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorOutstandingRewardsRequest")
+	proto.RegisterType((*QueryValidatorOutstandingRewardsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorOutstandingRewardsResponse")
+	proto.RegisterType((*QueryValidatorCommissionRequest)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorCommissionRequest")
+	proto.RegisterType((*QueryValidatorCommissionResponse)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorCommissionResponse")
+	proto.RegisterType((*QueryValidatorCommissionRecipientsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorCommissionRecipientsRequest")
+	proto.RegisterType((*QueryValidatorCommissionRecipientsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorCommissionRecipientsResponse")
+	proto.RegisterType((*QueryValidatorRewardRateRequest)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorRewardRateRequest")
+	proto.RegisterType((*QueryValidatorRewardRateResponse)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorRewardRateResponse")
+	proto.RegisterType((*QueryValidatorHistoricalRewardsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorHistoricalRewardsRequest")
+	proto.RegisterType((*QueryValidatorHistoricalRewardsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorHistoricalRewardsResponse")
+	proto.RegisterType((*ValidatorHistoricalRewardsPeriod)(nil), "liquidstaking.distribution.v1beta1.ValidatorHistoricalRewardsPeriod")
+	proto.RegisterType((*QueryValidatorCurrentRewardsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorCurrentRewardsRequest")
+	proto.RegisterType((*QueryValidatorCurrentRewardsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorCurrentRewardsResponse")
+	proto.RegisterType((*QueryValidatorSlashesRequest)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorSlashesRequest")
+	proto.RegisterType((*QueryValidatorSlashesResponse)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorSlashesResponse")
+	proto.RegisterType((*QueryDelegationTotalRewardsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryDelegationTotalRewardsRequest")
+	proto.RegisterType((*QueryDelegationTotalRewardsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryDelegationTotalRewardsResponse")
+	proto.RegisterType((*QueryDelegatorValidatorsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorValidatorsRequest")
+	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorValidatorsResponse")
+	proto.RegisterType((*QueryDelegatorStartingInfoRequest)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorStartingInfoRequest")
+	proto.RegisterType((*QueryDelegatorStartingInfoResponse)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorStartingInfoResponse")
+	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
+	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryDelegatorAutoRestakesRequest)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorAutoRestakesRequest")
+	proto.RegisterType((*QueryDelegatorAutoRestakesResponse)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorAutoRestakesResponse")
+	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "liquidstaking.distribution.v1beta1.QueryCommunityPoolRequest")
+	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "liquidstaking.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryLiquidStakingFeesCollectedRequest)(nil), "liquidstaking.distribution.v1beta1.QueryLiquidStakingFeesCollectedRequest")
+	proto.RegisterType((*QueryLiquidStakingFeesCollectedResponse)(nil), "liquidstaking.distribution.v1beta1.QueryLiquidStakingFeesCollectedResponse")
+	proto.RegisterType((*QueryCommunityPoolStreamsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryCommunityPoolStreamsRequest")
+	proto.RegisterType((*QueryCommunityPoolStreamsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryCommunityPoolStreamsResponse")
+	proto.RegisterType((*QueryCommunityPoolStreamRequest)(nil), "liquidstaking.distribution.v1beta1.QueryCommunityPoolStreamRequest")
+	proto.RegisterType((*QueryCommunityPoolStreamResponse)(nil), "liquidstaking.distribution.v1beta1.QueryCommunityPoolStreamResponse")
+	proto.RegisterType((*QueryTokenizeShareRecordRewardRequest)(nil), "liquidstaking.distribution.v1beta1.QueryTokenizeShareRecordRewardRequest")
+	proto.RegisterType((*QueryTokenizeShareRecordRewardResponse)(nil), "liquidstaking.distribution.v1beta1.QueryTokenizeShareRecordRewardResponse")
+	proto.RegisterType((*QueryTokenizeShareRecordRewardByIdRequest)(nil), "liquidstaking.distribution.v1beta1.QueryTokenizeShareRecordRewardByIdRequest")
+	proto.RegisterType((*QueryTokenizeShareRecordRewardByIdResponse)(nil), "liquidstaking.distribution.v1beta1.QueryTokenizeShareRecordRewardByIdResponse")
+	proto.RegisterType((*QueryValidatorTokenizeShareRecordRewardsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorTokenizeShareRecordRewardsRequest")
+	proto.RegisterType((*QueryValidatorTokenizeShareRecordRewardsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryValidatorTokenizeShareRecordRewardsResponse")
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("distribution/v1beta1/query.proto", fileDescriptor_bee02899ef89b167) }
+
+var fileDescriptor_bee02899ef89b167 = []byte{
+	// 2202 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4f, 0x6c, 0xdb, 0xd6,
+	0x19, 0x37, 0x15, 0xd7, 0xb1, 0xbf, 0xc4, 0x6d, 0xf2, 0xe2, 0x76, 0x0e, 0x93, 0x58, 0x1e, 0xbd,
+	0xd4, 0x6e, 0x02, 0x4b, 0x4d, 0x82, 0xad, 0x5b, 0xb2, 0x24, 0xb3, 0x2d, 0xff, 0x8b, 0x5d, 0x27,
+	0x91, 0xbc, 0x06, 0x59, 0xd1, 0x10, 0xb4, 0xf8, 0x22, 0x71, 0x91, 0x48, 0x85, 0xa4, 0xec, 0x79,
+	0x5e, 0x0e, 0x5b, 0xd1, 0x61, 0xeb, 0xfe, 0x75, 0x28, 0x06, 0xac, 0xb7, 0x9c, 0x76, 0xd8, 0x75,
+	0xbb, 0xed, 0xb2, 0xa1, 0x18, 0xd0, 0x63, 0xb1, 0x1d, 0xb6, 0x53, 0x5a, 0x24, 0x3d, 0x6c, 0x87,
+	0x00, 0x5d, 0x0f, 0x03, 0x76, 0x1b, 0xf8, 0xde, 0x47, 0x4a, 0x94, 0x48, 0x4a, 0x14, 0x65, 0x6c,
+	0xe8, 0x29, 0xe2, 0xe3, 0x7b, 0xbf, 0xf7, 0xfd, 0x7e, 0xef, 0x7b, 0xdf, 0x7b, 0xfc, 0x39, 0x30,
+	0xa9, 0x6a, 0x96, 0x6d, 0x6a, 0x5b, 0x75, 0x5b, 0x33, 0xf4, 0xec, 0xf6, 0xb9, 0x2d, 0x6a, 0x2b,
+	0xe7, 0xb2, 0xf7, 0xeb, 0xd4, 0xdc, 0xcd, 0xd4, 0x4c, 0xc3, 0x36, 0x88, 0x54, 0xd1, 0xee, 0xd7,
+	0x35, 0xd5, 0xb2, 0x95, 0x7b, 0x9a, 0x5e, 0xca, 0x34, 0xf7, 0xcf, 0x60, 0x7f, 0xf1, 0x4c, 0xd1,
+	0xb0, 0xaa, 0x86, 0x95, 0xdd, 0x52, 0x2c, 0xca, 0x07, 0x7b, 0x50, 0x35, 0xa5, 0xa4, 0xe9, 0x0a,
+	0xeb, 0xcd, 0xf0, 0xc4, 0xb1, 0x92, 0x51, 0x32, 0xd8, 0xcf, 0xac, 0xf3, 0x0b, 0x5b, 0x4f, 0x96,
+	0x0c, 0xa3, 0x54, 0xa1, 0x59, 0xa5, 0xa6, 0x65, 0x15, 0x5d, 0x37, 0x6c, 0x36, 0xc4, 0xc2, 0xb7,
+	0x13, 0xcd, 0xf8, 0x2e, 0x72, 0xd1, 0xd0, 0x5c, 0xcc, 0xe9, 0x40, 0x16, 0xbe, 0x50, 0xb1, 0x23,
+	0x02, 0x75, 0x62, 0x2d, 0x1e, 0xe7, 0x1d, 0x65, 0x1e, 0x28, 0x7f, 0xc0, 0x57, 0x69, 0x0c, 0x95,
+	0x3d, 0x6d, 0xd5, 0xef, 0x66, 0x6d, 0xad, 0x4a, 0x2d, 0x5b, 0xa9, 0xd6, 0x78, 0x07, 0x69, 0x0c,
+	0xc8, 0x4d, 0x07, 0xea, 0x86, 0x62, 0x2a, 0x55, 0x2b, 0x4f, 0xef, 0xd7, 0xa9, 0x65, 0x4b, 0x32,
+	0x1c, 0xf3, 0xb5, 0x5a, 0x35, 0x43, 0xb7, 0x28, 0x59, 0x81, 0xa1, 0x1a, 0x6b, 0x19, 0x17, 0x26,
+	0x85, 0x99, 0x43, 0xe7, 0xcf, 0x64, 0x3a, 0xeb, 0x9d, 0xe1, 0x18, 0xf3, 0x83, 0x1f, 0x3c, 0x4a,
+	0x0f, 0xe4, 0x71, 0xbc, 0x54, 0x83, 0x69, 0x36, 0xc1, 0x6b, 0x4a, 0x45, 0x53, 0x15, 0xdb, 0x30,
+	0xaf, 0xd7, 0x6d, 0xcb, 0x56, 0x74, 0x55, 0xd3, 0x4b, 0x79, 0xba, 0xa3, 0x98, 0xaa, 0x1b, 0x0b,
+	0x59, 0x84, 0xa3, 0xdb, 0x6e, 0x2f, 0x59, 0x51, 0x55, 0x93, 0x5a, 0x7c, 0xfe, 0x91, 0xf9, 0xf1,
+	0xbf, 0xfc, 0x7e, 0x76, 0x0c, 0xf9, 0xce, 0xf1, 0x37, 0x05, 0xdb, 0x74, 0x20, 0x8e, 0x78, 0x43,
+	0xb0, 0x5d, 0xfa, 0x89, 0x00, 0x33, 0x9d, 0xa7, 0x44, 0xa2, 0x32, 0x1c, 0x34, 0x79, 0x13, 0x32,
+	0xbd, 0xda, 0x0d, 0xd3, 0x08, 0x64, 0xa4, 0xef, 0xa2, 0x4a, 0x65, 0x48, 0xfb, 0x83, 0x59, 0x30,
+	0xaa, 0x55, 0xcd, 0xb2, 0x34, 0x43, 0xef, 0x33, 0xef, 0x9f, 0x0a, 0x30, 0x19, 0x3e, 0x15, 0xf2,
+	0x2d, 0x03, 0x14, 0xbd, 0x56, 0xa4, 0x3c, 0x1f, 0x8b, 0xf2, 0x5c, 0xb1, 0x58, 0xaf, 0xd6, 0x2b,
+	0x8a, 0x4d, 0xd5, 0x06, 0x3e, 0xb2, 0x6e, 0xc2, 0x96, 0xbe, 0x07, 0x2f, 0x85, 0x47, 0x53, 0xd4,
+	0x6a, 0x1a, 0xd5, 0xed, 0x3e, 0x2f, 0xfd, 0xc5, 0xe1, 0x1f, 0x3d, 0x4c, 0x0f, 0xfc, 0xe3, 0x61,
+	0x7a, 0x40, 0xfa, 0x95, 0x00, 0x67, 0xba, 0x99, 0x1e, 0x65, 0x79, 0x03, 0xc0, 0xf4, 0x5a, 0xc7,
+	0x85, 0xc9, 0x03, 0x33, 0x87, 0xce, 0xbf, 0xd2, 0x8d, 0x2c, 0x01, 0xa8, 0xae, 0x16, 0x0d, 0xc0,
+	0xa6, 0xb8, 0xde, 0x13, 0x5a, 0xf3, 0x81, 0xe7, 0x4d, 0x5e, 0xb1, 0x69, 0x7f, 0xc5, 0x20, 0xa7,
+	0xe1, 0xd9, 0x1d, 0x4d, 0x57, 0x8d, 0x1d, 0xd9, 0xa2, 0x45, 0x43, 0x57, 0xad, 0xf1, 0xd4, 0xa4,
+	0x30, 0x33, 0x98, 0x1f, 0xe5, 0xad, 0x05, 0xde, 0xd8, 0x14, 0xdb, 0x2f, 0x52, 0x30, 0x19, 0x1e,
+	0x1b, 0x2a, 0x45, 0x61, 0xd0, 0x54, 0x6c, 0x8a, 0x1a, 0x9d, 0xcc, 0x60, 0x30, 0x4e, 0x0d, 0xf4,
+	0x44, 0xc9, 0xd1, 0xe2, 0x82, 0xa1, 0xe9, 0xf3, 0x17, 0x1c, 0x21, 0x7e, 0xfb, 0x51, 0xfa, 0x6c,
+	0x49, 0xb3, 0xcb, 0xf5, 0xad, 0x4c, 0xd1, 0xa8, 0x62, 0xd1, 0xc2, 0x7f, 0x66, 0x2d, 0xf5, 0x5e,
+	0xd6, 0xde, 0xad, 0x51, 0xcb, 0x1d, 0x63, 0xe5, 0x19, 0x3c, 0x59, 0x00, 0xb0, 0x6c, 0xc5, 0xb4,
+	0x65, 0xa7, 0x8c, 0xb1, 0xc0, 0x0f, 0x9d, 0x17, 0x33, 0xbc, 0xc6, 0x65, 0xdc, 0x1a, 0x97, 0xd9,
+	0x74, 0x6b, 0xdc, 0xfc, 0xb0, 0x33, 0xd5, 0x3b, 0x1f, 0xa5, 0x85, 0xfc, 0x08, 0x1b, 0xe7, 0xbc,
+	0x21, 0x57, 0x61, 0x98, 0xea, 0x2a, 0x87, 0x38, 0x10, 0x03, 0xe2, 0x20, 0xd5, 0x55, 0xa7, 0x5d,
+	0xfa, 0x93, 0x00, 0x2f, 0xfa, 0x15, 0x59, 0xd1, 0x2c, 0xdb, 0x30, 0xb5, 0xa2, 0x52, 0xd9, 0x97,
+	0xe2, 0x45, 0x96, 0x00, 0x1a, 0x67, 0x13, 0xf2, 0x7e, 0xd1, 0x27, 0x32, 0x3f, 0x0f, 0x1a, 0x35,
+	0xb7, 0xe4, 0xe6, 0x4d, 0xbe, 0x69, 0x64, 0xd3, 0xaa, 0x3e, 0x15, 0x60, 0xba, 0x23, 0x07, 0x5c,
+	0xdc, 0x5d, 0x20, 0x65, 0xef, 0xa5, 0xdc, 0x28, 0x8c, 0xce, 0x52, 0xe7, 0x62, 0x55, 0x89, 0xb6,
+	0x39, 0x6e, 0x50, 0x53, 0x33, 0x54, 0xdc, 0x1b, 0x47, 0xcb, 0xad, 0xaf, 0xc9, 0x72, 0x00, 0xf1,
+	0xe9, 0x8e, 0xc4, 0x79, 0xdc, 0xcd, 0xcc, 0xa5, 0xbf, 0x09, 0x30, 0xd9, 0x29, 0x0c, 0xf2, 0x02,
+	0x0c, 0xd5, 0xd8, 0x2f, 0xb6, 0x44, 0x83, 0x79, 0x7c, 0x22, 0x77, 0x1a, 0xc7, 0x01, 0x0f, 0xe1,
+	0x4a, 0x32, 0xd6, 0x2d, 0xa7, 0x01, 0xb9, 0x14, 0x2b, 0x23, 0x07, 0xfd, 0xd9, 0xb8, 0x0d, 0x53,
+	0x2d, 0x25, 0xad, 0x6e, 0x9a, 0x54, 0xb7, 0xf7, 0x25, 0x13, 0x9b, 0x32, 0xe8, 0x4d, 0x01, 0xbe,
+	0x14, 0x3d, 0x31, 0xa6, 0xcf, 0xeb, 0xad, 0x87, 0xe9, 0xa5, 0x58, 0xea, 0xf9, 0x51, 0x5b, 0x0f,
+	0xd2, 0xb7, 0x52, 0x70, 0xd2, 0x1f, 0x45, 0xa1, 0xa2, 0x58, 0x65, 0xda, 0xef, 0x1d, 0x38, 0x0d,
+	0xcf, 0xb1, 0x0a, 0xa2, 0xe9, 0x25, 0xb9, 0x4c, 0xb5, 0x52, 0xd9, 0xc6, 0xba, 0xf9, 0xac, 0xdb,
+	0xbc, 0xc2, 0x5a, 0xc9, 0x14, 0x8c, 0x52, 0x5d, 0x6d, 0xea, 0x76, 0x80, 0x75, 0x3b, 0xcc, 0x1b,
+	0xb1, 0x93, 0x7f, 0x3f, 0x0f, 0x26, 0xdb, 0xcf, 0xbf, 0x7e, 0x98, 0x16, 0x9c, 0x9a, 0x74, 0x2a,
+	0x44, 0x07, 0x5c, 0x86, 0x5b, 0x70, 0xd0, 0xe2, 0x4d, 0x71, 0x4e, 0x32, 0x3f, 0xdc, 0xe2, 0x76,
+	0xe3, 0x24, 0x73, 0xd1, 0xfa, 0xb7, 0x47, 0xeb, 0x20, 0x31, 0x0a, 0x39, 0x5a, 0xa1, 0x25, 0xd6,
+	0xb4, 0x69, 0xd8, 0x41, 0x25, 0x55, 0xe5, 0x1d, 0xe2, 0x2c, 0xa8, 0x37, 0xa4, 0x3d, 0x91, 0x9f,
+	0x0a, 0x30, 0x15, 0x39, 0xaf, 0x77, 0x1b, 0x38, 0xe8, 0xaf, 0x7d, 0x97, 0xbb, 0x11, 0xb0, 0x01,
+	0x9a, 0x73, 0x43, 0xe0, 0xc0, 0xad, 0x45, 0xa0, 0x04, 0xcf, 0xd8, 0xce, 0xb4, 0xe3, 0xa9, 0xfd,
+	0x3a, 0x43, 0x39, 0xbe, 0x64, 0xe2, 0x5d, 0xc3, 0x8b, 0xc7, 0x5b, 0xe3, 0xfd, 0xd3, 0x78, 0x1d,
+	0x26, 0xc3, 0xe7, 0x44, 0x7d, 0x27, 0x00, 0xbc, 0x6d, 0xc7, 0x25, 0x1e, 0xc9, 0x37, 0xb5, 0x34,
+	0xa1, 0xfd, 0x41, 0x80, 0x2f, 0xfa, 0xe1, 0x0a, 0xb8, 0x09, 0x57, 0xf5, 0xbb, 0x46, 0x7f, 0x49,
+	0x04, 0x17, 0x90, 0x54, 0x82, 0xc2, 0xf9, 0xb6, 0x00, 0x52, 0x54, 0xf4, 0x28, 0x87, 0x0a, 0xa3,
+	0x5e, 0xc5, 0xd1, 0xf4, 0xbb, 0x06, 0x16, 0xcf, 0xaf, 0xc5, 0x48, 0x3a, 0x3f, 0x32, 0x26, 0xdc,
+	0x61, 0xab, 0xa9, 0x4d, 0xda, 0xc1, 0x22, 0xee, 0x8d, 0xb8, 0xa5, 0xd9, 0x65, 0xd5, 0x54, 0x76,
+	0x30, 0xee, 0x7d, 0xcb, 0x88, 0x6d, 0x38, 0xdd, 0x61, 0x62, 0xd4, 0x61, 0x01, 0x8e, 0xec, 0xe0,
+	0xab, 0xae, 0x27, 0x7e, 0x6e, 0xc7, 0x0f, 0xd6, 0x34, 0xaf, 0xdd, 0x9a, 0x3a, 0x73, 0x75, 0xdb,
+	0x11, 0xdd, 0x56, 0xee, 0xd1, 0xfd, 0x63, 0x5b, 0x06, 0x29, 0x6a, 0x56, 0xa4, 0xfa, 0xd5, 0xf6,
+	0x1d, 0x10, 0x31, 0x5f, 0xf0, 0xde, 0x38, 0x01, 0xc7, 0xd9, 0x4c, 0xce, 0x27, 0x48, 0x5d, 0xd7,
+	0xec, 0xdd, 0x1b, 0x86, 0x51, 0x71, 0xbf, 0xeb, 0xdf, 0x14, 0x40, 0x0c, 0x7a, 0xdb, 0xb8, 0xc5,
+	0xd7, 0x0c, 0xa3, 0xb2, 0x8f, 0xb7, 0x78, 0x07, 0x5e, 0x9a, 0xc1, 0xeb, 0xf3, 0x3a, 0x4b, 0xe4,
+	0x02, 0x4f, 0xe4, 0x25, 0x4a, 0xad, 0x05, 0xa3, 0x52, 0xa1, 0x45, 0x9b, 0xaa, 0x6e, 0xbc, 0x6f,
+	0xbb, 0xb7, 0xd4, 0xa8, 0xae, 0xde, 0x37, 0xfb, 0xe0, 0x5d, 0xea, 0x1d, 0x6e, 0xc7, 0x03, 0x83,
+	0x67, 0x91, 0xbf, 0x8c, 0x91, 0xcf, 0x74, 0x11, 0x39, 0x86, 0xed, 0x00, 0x4b, 0xdf, 0x86, 0xc9,
+	0x76, 0xed, 0x0a, 0xb6, 0x49, 0x1b, 0xc6, 0x49, 0xcb, 0xc1, 0x2e, 0xf4, 0x7a, 0xb0, 0x4b, 0x7f,
+	0x76, 0x2b, 0x5c, 0xf0, 0x64, 0x4d, 0x47, 0x3a, 0x6f, 0x8a, 0xfb, 0x71, 0xda, 0x02, 0xe9, 0x1d,
+	0xe9, 0x1c, 0xad, 0x7f, 0x47, 0xfa, 0x15, 0x3c, 0x6b, 0x02, 0xe6, 0x74, 0x25, 0x3b, 0x01, 0x23,
+	0x7c, 0x5a, 0x59, 0x73, 0xef, 0xdd, 0xc3, 0xbc, 0x61, 0x55, 0x95, 0x76, 0xc3, 0x35, 0xf7, 0x54,
+	0xf8, 0x26, 0x0c, 0xf1, 0xfe, 0xa8, 0x77, 0x42, 0x11, 0x10, 0x4c, 0xaa, 0x61, 0x81, 0xda, 0x34,
+	0xee, 0x51, 0x5d, 0xfb, 0x2e, 0x2d, 0x94, 0x15, 0x93, 0xe6, 0x69, 0xd1, 0x30, 0x55, 0xfc, 0x00,
+	0x46, 0x02, 0x97, 0x61, 0xd4, 0xd8, 0xd1, 0x69, 0x5b, 0xa1, 0xf8, 0xec, 0x51, 0x7a, 0x6c, 0x57,
+	0xa9, 0x56, 0x2e, 0x4a, 0xbe, 0xd7, 0x52, 0xfe, 0x30, 0x7b, 0x6e, 0x2f, 0x12, 0x9f, 0xba, 0xdf,
+	0x95, 0x11, 0x53, 0x26, 0xba, 0x8b, 0x84, 0xe2, 0xfe, 0xcf, 0xee, 0x22, 0x77, 0xd0, 0x0e, 0x0a,
+	0x8f, 0x6c, 0x77, 0xd5, 0x13, 0xfa, 0x1c, 0x8c, 0x98, 0xec, 0x95, 0x97, 0x29, 0xf3, 0x63, 0x9f,
+	0x3d, 0x4a, 0x1f, 0xe1, 0x22, 0x7b, 0xaf, 0xa4, 0xfc, 0x30, 0xff, 0xbd, 0xaa, 0x4a, 0x3f, 0x76,
+	0x0d, 0x9f, 0x0e, 0x13, 0x78, 0x9f, 0x2a, 0x43, 0x5c, 0x02, 0x4c, 0xa5, 0xbe, 0xa8, 0x8a, 0x90,
+	0xd2, 0x1f, 0x05, 0xc8, 0xfa, 0xaf, 0xe8, 0xa1, 0x23, 0xff, 0x4f, 0xfd, 0x03, 0xe9, 0xfd, 0x14,
+	0xbc, 0xdc, 0x3d, 0x85, 0xcf, 0x57, 0xae, 0xb6, 0x14, 0xc5, 0x03, 0x3d, 0x17, 0xc5, 0xf3, 0xef,
+	0xbe, 0x04, 0xcf, 0x30, 0x15, 0xc9, 0x6f, 0x04, 0x18, 0xe2, 0xfe, 0x38, 0xf9, 0x4a, 0x37, 0xa2,
+	0xb4, 0x5b, 0xf5, 0xe2, 0x2b, 0xb1, 0xc7, 0xf1, 0x88, 0xa4, 0xb3, 0x3f, 0xf8, 0xeb, 0x27, 0xef,
+	0xa6, 0x4e, 0x93, 0xa9, 0x6c, 0xd4, 0xdf, 0x19, 0xb8, 0x5f, 0x4f, 0x7e, 0x99, 0x82, 0x13, 0x11,
+	0xf6, 0x36, 0x59, 0xeb, 0x3a, 0x8a, 0xce, 0x8e, 0xbf, 0xb8, 0xde, 0x1f, 0x30, 0xe4, 0x79, 0x8b,
+	0xf1, 0xbc, 0x49, 0xae, 0x47, 0xf2, 0x6c, 0xdc, 0xa5, 0xb2, 0x7b, 0x6d, 0x3b, 0xee, 0x41, 0xd6,
+	0x68, 0xe0, 0xbb, 0x06, 0x18, 0xf9, 0x54, 0x80, 0x63, 0x01, 0x3e, 0x32, 0x59, 0x88, 0x1f, 0x7e,
+	0x9b, 0xfb, 0x2f, 0xe6, 0x92, 0x81, 0x20, 0xf7, 0x0d, 0xc6, 0x7d, 0x85, 0x2c, 0x25, 0xe1, 0xde,
+	0x70, 0xef, 0xc9, 0x7b, 0x29, 0x38, 0x15, 0x69, 0x9d, 0x93, 0x57, 0x93, 0xc5, 0xdd, 0xf2, 0x17,
+	0x00, 0x71, 0xa3, 0x5f, 0x70, 0x28, 0xc8, 0x6d, 0x26, 0x48, 0x81, 0xdc, 0xec, 0x8f, 0x20, 0x72,
+	0xc3, 0xcd, 0x27, 0xff, 0x6a, 0x4e, 0x87, 0x86, 0x45, 0xde, 0x4b, 0x3a, 0xb4, 0x99, 0xff, 0x62,
+	0x2e, 0x19, 0x08, 0xb2, 0xbf, 0xce, 0xd8, 0xaf, 0x92, 0xe5, 0x24, 0xec, 0x79, 0xfa, 0xcb, 0xcc,
+	0x8f, 0xff, 0x59, 0x0a, 0xc4, 0x70, 0x9b, 0x93, 0x5c, 0x8b, 0x1f, 0x75, 0x98, 0x93, 0x2e, 0xae,
+	0xf5, 0x05, 0x0b, 0x85, 0x78, 0x8d, 0x09, 0x71, 0x83, 0x6c, 0x24, 0x11, 0xa2, 0xdd, 0x13, 0x27,
+	0xdf, 0x4f, 0xc1, 0x17, 0x42, 0x8c, 0x4b, 0xb2, 0xdc, 0x43, 0x2a, 0x07, 0x39, 0xb9, 0xe2, 0x4a,
+	0x72, 0x20, 0x94, 0xa1, 0xc0, 0x64, 0x78, 0x95, 0xac, 0x25, 0xda, 0x0d, 0x1c, 0xdb, 0xd3, 0xe0,
+	0x13, 0x01, 0x8e, 0xb4, 0x9a, 0x90, 0xe4, 0x1b, 0xf1, 0x63, 0xf6, 0xfb, 0xb8, 0xe2, 0x5c, 0x02,
+	0x04, 0xa4, 0xbb, 0xc6, 0xe8, 0x2e, 0x92, 0x85, 0x24, 0x74, 0x5d, 0xd7, 0xf3, 0xa9, 0x00, 0x47,
+	0x1b, 0xde, 0x9e, 0xbb, 0xc8, 0x17, 0xdd, 0xfb, 0x40, 0x78, 0x78, 0x6d, 0x83, 0x5c, 0x86, 0x97,
+	0x7a, 0x1a, 0x8b, 0xdc, 0x64, 0xc6, 0xed, 0x36, 0xb9, 0x15, 0xc9, 0xcd, 0x73, 0x28, 0xac, 0xec,
+	0x5e, 0x9b, 0xc1, 0xe1, 0x6e, 0xed, 0x40, 0xde, 0xe4, 0xdf, 0x02, 0xbc, 0x10, 0x6c, 0x90, 0x92,
+	0xa5, 0xae, 0x97, 0x26, 0xd2, 0xd9, 0x15, 0x97, 0x13, 0xe3, 0xc4, 0x5a, 0xe8, 0xee, 0xc4, 0x60,
+	0xc7, 0x7c, 0x80, 0x6d, 0x19, 0xa3, 0xae, 0x87, 0x1b, 0xad, 0x62, 0x2e, 0x19, 0x48, 0xac, 0x63,
+	0xbe, 0x03, 0xdf, 0x46, 0xde, 0x3b, 0x65, 0xfd, 0xf9, 0x40, 0x0b, 0x91, 0x2c, 0xc6, 0x8f, 0x37,
+	0xc0, 0x9a, 0x15, 0x97, 0x92, 0xc2, 0x20, 0x71, 0xca, 0x88, 0xcb, 0xe4, 0x8d, 0x24, 0xc4, 0x7d,
+	0x2e, 0x6b, 0x60, 0xee, 0xbf, 0x95, 0x82, 0xf1, 0x30, 0x9f, 0x92, 0xac, 0xc4, 0xe7, 0x12, 0xec,
+	0xb1, 0x8a, 0xab, 0x7d, 0x40, 0x42, 0x61, 0x36, 0x99, 0x30, 0x1b, 0x64, 0x3d, 0x89, 0x30, 0xad,
+	0xb6, 0x2b, 0xf9, 0x8f, 0x00, 0xcf, 0x07, 0x3a, 0x98, 0xbd, 0xe4, 0x45, 0x80, 0xef, 0x2a, 0x2e,
+	0x25, 0x85, 0x41, 0xfa, 0x37, 0x19, 0xfd, 0x35, 0xb2, 0x9a, 0x84, 0xbe, 0x52, 0xb7, 0x0d, 0xd9,
+	0x74, 0x19, 0xbe, 0x2f, 0xc0, 0xa8, 0xcf, 0x34, 0x22, 0x97, 0xbb, 0x0e, 0x36, 0xc8, 0x8b, 0x15,
+	0xaf, 0xf4, 0x3a, 0x1c, 0x39, 0x5e, 0x60, 0x1c, 0x67, 0xc9, 0xd9, 0x48, 0x8e, 0x45, 0x77, 0xac,
+	0xec, 0x58, 0xaf, 0xce, 0x0a, 0x8a, 0xe1, 0x5e, 0x6a, 0x8c, 0x0b, 0x5b, 0x47, 0xef, 0x56, 0x5c,
+	0xeb, 0x0b, 0x16, 0x92, 0x9d, 0x67, 0x64, 0xbf, 0x4e, 0x2e, 0x46, 0x92, 0xe5, 0x13, 0xca, 0x38,
+	0xa3, 0xec, 0xb8, 0xb6, 0x72, 0xd1, 0x23, 0xf7, 0xb1, 0x00, 0x63, 0x41, 0x76, 0x2a, 0xc9, 0xf5,
+	0xb6, 0x12, 0x7e, 0xeb, 0x57, 0x5c, 0x4c, 0x88, 0x82, 0x4c, 0x2f, 0x31, 0xa6, 0x5f, 0x26, 0x17,
+	0x62, 0x2c, 0xab, 0xec, 0xfa, 0xb6, 0xff, 0x14, 0xe0, 0x58, 0x00, 0x7a, 0x8c, 0xb3, 0x2a, 0xdc,
+	0xa8, 0x15, 0x73, 0xc9, 0x40, 0x90, 0xdf, 0x32, 0xe3, 0x37, 0x47, 0xae, 0xf6, 0xc0, 0x2f, 0xbb,
+	0xe7, 0x39, 0xc5, 0x0f, 0xc8, 0x0f, 0x53, 0x70, 0x3c, 0xd4, 0x24, 0x22, 0xdd, 0xd7, 0xd2, 0x4e,
+	0xfe, 0xae, 0x78, 0xad, 0x1f, 0x50, 0xc8, 0x3e, 0xcf, 0xd8, 0xaf, 0x93, 0x6b, 0x91, 0xec, 0xf7,
+	0x7c, 0x86, 0xf1, 0x83, 0xac, 0x8d, 0xb8, 0xb2, 0xe5, 0x00, 0xcb, 0xe8, 0x74, 0xba, 0x17, 0x94,
+	0x9f, 0xa7, 0xe0, 0x54, 0xa4, 0xbd, 0x19, 0xe3, 0xa3, 0xbc, 0x1b, 0x1f, 0x56, 0xdc, 0xe8, 0x17,
+	0x5c, 0xac, 0xeb, 0x4b, 0xa4, 0x08, 0xd9, 0x3d, 0x7c, 0x76, 0x32, 0xe3, 0x77, 0x29, 0x98, 0xea,
+	0xc2, 0xa0, 0x24, 0x85, 0xf8, 0x9f, 0x14, 0x1d, 0x1d, 0x5b, 0x71, 0xb3, 0xbf, 0xa0, 0x28, 0x91,
+	0xc2, 0x24, 0x7a, 0x9d, 0xdc, 0x4e, 0xf2, 0xe9, 0x12, 0xa9, 0xe0, 0xfc, 0x9d, 0x0f, 0x1e, 0x4f,
+	0x08, 0x1f, 0x3e, 0x9e, 0x10, 0x3e, 0x7e, 0x3c, 0x21, 0xbc, 0xf3, 0x64, 0x62, 0xe0, 0xc3, 0x27,
+	0x13, 0x03, 0x7f, 0x7f, 0x32, 0x31, 0xf0, 0xad, 0x5c, 0x93, 0x59, 0xaa, 0xdd, 0xaf, 0xd4, 0x2d,
+	0xcd, 0xd0, 0x35, 0xbd, 0x88, 0xe5, 0x56, 0xb3, 0x77, 0x67, 0x91, 0xec, 0x6c, 0xd5, 0x50, 0xeb,
+	0x15, 0x9a, 0xfd, 0x8e, 0x3f, 0x3c, 0x66, 0xa7, 0x6e, 0x0d, 0xb1, 0xff, 0xca, 0x74, 0xe1, 0xbf,
+	0x03, 0x00, 0x7f, 0x5e, 0x6d, 0x9a, 0xc6, 0x2d, 0x00, 0x00,
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WithdrawAddress) > 0 {
-		i -= len(m.WithdrawAddress)
-		copy(dAtA[i:], m.WithdrawAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the distribution module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorOutstandingRewards queries rewards of a validator address.
+	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
+	// ValidatorCommission queries accumulated commission for a validator.
+	ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error)
+	// ValidatorCommissionRecipients queries the recipients the commission of a
+	// validator is split across.
+	ValidatorCommissionRecipients(ctx context.Context, in *QueryValidatorCommissionRecipientsRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionRecipientsResponse, error)
+	// ValidatorRewardRate queries the estimated annualized reward per token of a
+	// validator, net of commission, over a trailing window.
+	ValidatorRewardRate(ctx context.Context, in *QueryValidatorRewardRateRequest, opts ...grpc.CallOption) (*QueryValidatorRewardRateResponse, error)
+	// ValidatorHistoricalRewards queries the historical rewards periods of a
+	// validator.
+	ValidatorHistoricalRewards(ctx context.Context, in *QueryValidatorHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorHistoricalRewardsResponse, error)
+	// ValidatorCurrentRewards queries the current rewards period of a validator.
+	ValidatorCurrentRewards(ctx context.Context, in *QueryValidatorCurrentRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorCurrentRewardsResponse, error)
+	// ValidatorSlashes queries slash events of a validator.
+	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
+	// DelegationRewards queries the total rewards accrued by a delegation.
+	DelegationRewards(ctx context.Context, in *types2.QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*types2.QueryDelegationRewardsResponse, error)
+	// DelegationTotalRewards queries the total rewards accrued by a each
+	// validator.
+	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
+	// DelegatorValidators queries the validators of a delegator.
+	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
+	// DelegatorStartingInfo queries the starting info of a delegation, the
+	// rewards of a delegation accrue from its starting period.
+	DelegatorStartingInfo(ctx context.Context, in *QueryDelegatorStartingInfoRequest, opts ...grpc.CallOption) (*QueryDelegatorStartingInfoResponse, error)
+	// DelegatorWithdrawAddress queries withdraw address of a delegator.
+	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoRestakes queries the validators a delegator auto restakes the
+	// rewards of.
+	DelegatorAutoRestakes(ctx context.Context, in *QueryDelegatorAutoRestakesRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoRestakesResponse, error)
+	// CommunityPool queries the community pool coins.
+	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// LiquidStakingFeesCollected queries the cumulative liquid staking reward fees
+	// sent to the community pool.
+	LiquidStakingFeesCollected(ctx context.Context, in *QueryLiquidStakingFeesCollectedRequest, opts ...grpc.CallOption) (*QueryLiquidStakingFeesCollectedResponse, error)
+	// CommunityPoolStreams queries the active community pool streams.
+	CommunityPoolStreams(ctx context.Context, in *QueryCommunityPoolStreamsRequest, opts ...grpc.CallOption) (*QueryCommunityPoolStreamsResponse, error)
+	// CommunityPoolStream queries an active community pool stream by id.
+	CommunityPoolStream(ctx context.Context, in *QueryCommunityPoolStreamRequest, opts ...grpc.CallOption) (*QueryCommunityPoolStreamResponse, error)
+	// TokenizeShareRecordReward queries the tokenize share record rewards
+	TokenizeShareRecordReward(ctx context.Context, in *QueryTokenizeShareRecordRewardRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordRewardResponse, error)
+	// TokenizeShareRecordRewardById queries the rewards of a tokenize share record
+	TokenizeShareRecordRewardById(ctx context.Context, in *QueryTokenizeShareRecordRewardByIdRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordRewardByIdResponse, error)
+	// ValidatorTokenizeShareRecordRewards queries the rewards of the tokenize
+	// share records of a validator
+	ValidatorTokenizeShareRecordRewards(ctx context.Context, in *QueryValidatorTokenizeShareRecordRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorTokenizeShareRecordRewardsResponse, error)
 }
 
-func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCommunityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error) {
+	out := new(QueryValidatorOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/ValidatorOutstandingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCommunityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error) {
+	out := new(QueryValidatorCommissionResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/ValidatorCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorCommissionRecipients(ctx context.Context, in *QueryValidatorCommissionRecipientsRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionRecipientsResponse, error) {
+	out := new(QueryValidatorCommissionRecipientsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/ValidatorCommissionRecipients", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorRewardRate(ctx context.Context, in *QueryValidatorRewardRateRequest, opts ...grpc.CallOption) (*QueryValidatorRewardRateResponse, error) {
+	out := new(QueryValidatorRewardRateResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/ValidatorRewardRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pool) > 0 {
-		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ValidatorHistoricalRewards(ctx context.Context, in *QueryValidatorHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorHistoricalRewardsResponse, error) {
+	out := new(QueryValidatorHistoricalRewardsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/ValidatorHistoricalRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTokenizeShareRecordRewardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorCurrentRewards(ctx context.Context, in *QueryValidatorCurrentRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorCurrentRewardsResponse, error) {
+	out := new(QueryValidatorCurrentRewardsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/ValidatorCurrentRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTokenizeShareRecordRewardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error) {
+	out := new(QueryValidatorSlashesResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/ValidatorSlashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTokenizeShareRecordRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) DelegationRewards(ctx context.Context, in *types2.QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*types2.QueryDelegationRewardsResponse, error) {
+	out := new(types2.QueryDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/DelegationRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error) {
+	out := new(QueryDelegationTotalRewardsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/DelegationTotalRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error) {
+	out := new(QueryDelegatorValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/DelegatorValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) DelegatorStartingInfo(ctx context.Context, in *QueryDelegatorStartingInfoRequest, opts ...grpc.CallOption) (*QueryDelegatorStartingInfoResponse, error) {
+	out := new(QueryDelegatorStartingInfoResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/DelegatorStartingInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error) {
+	out := new(QueryDelegatorWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/DelegatorWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) DelegatorAutoRestakes(ctx context.Context, in *QueryDelegatorAutoRestakesRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoRestakesResponse, error) {
+	out := new(QueryDelegatorAutoRestakesResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/DelegatorAutoRestakes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
+	out := new(QueryCommunityPoolResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/CommunityPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) LiquidStakingFeesCollected(ctx context.Context, in *QueryLiquidStakingFeesCollectedRequest, opts ...grpc.CallOption) (*QueryLiquidStakingFeesCollectedResponse, error) {
+	out := new(QueryLiquidStakingFeesCollectedResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/LiquidStakingFeesCollected", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CommunityPoolStreams(ctx context.Context, in *QueryCommunityPoolStreamsRequest, opts ...grpc.CallOption) (*QueryCommunityPoolStreamsResponse, error) {
+	out := new(QueryCommunityPoolStreamsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/CommunityPoolStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorCommissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) CommunityPoolStream(ctx context.Context, in *QueryCommunityPoolStreamRequest, opts ...grpc.CallOption) (*QueryCommunityPoolStreamResponse, error) {
+	out := new(QueryCommunityPoolStreamResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/CommunityPoolStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorCommissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) TokenizeShareRecordReward(ctx context.Context, in *QueryTokenizeShareRecordRewardRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordRewardResponse, error) {
+	out := new(QueryTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/TokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Commission.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorSlashesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.StartingHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartingHeight))
-	}
-	if m.EndingHeight != 0 {
-		n += 1 + sovQuery(uint64(m.EndingHeight))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) TokenizeShareRecordRewardById(ctx context.Context, in *QueryTokenizeShareRecordRewardByIdRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordRewardByIdResponse, error) {
+	out := new(QueryTokenizeShareRecordRewardByIdResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/TokenizeShareRecordRewardById", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorSlashesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Slashes) > 0 {
-		for _, e := range m.Slashes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ValidatorTokenizeShareRecordRewards(ctx context.Context, in *QueryValidatorTokenizeShareRecordRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorTokenizeShareRecordRewardsResponse, error) {
+	out := new(QueryValidatorTokenizeShareRecordRewardsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/ValidatorTokenizeShareRecordRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDelegationTotalRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the distribution module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorOutstandingRewards queries rewards of a validator address.
+	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
+	// ValidatorCommission queries accumulated commission for a validator.
+	ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error)
+	// ValidatorCommissionRecipients queries the recipients the commission of a
+	// validator is split across.
+	ValidatorCommissionRecipients(context.Context, *QueryValidatorCommissionRecipientsRequest) (*QueryValidatorCommissionRecipientsResponse, error)
+	// ValidatorRewardRate queries the estimated annualized reward per token of a
+	// validator, net of commission, over a trailing window.
+	ValidatorRewardRate(context.Context, *QueryValidatorRewardRateRequest) (*QueryValidatorRewardRateResponse, error)
+	// ValidatorHistoricalRewards queries the historical rewards periods of a
+	// validator.
+	ValidatorHistoricalRewards(context.Context, *QueryValidatorHistoricalRewardsRequest) (*QueryValidatorHistoricalRewardsResponse, error)
+	// ValidatorCurrentRewards queries the current rewards period of a validator.
+	ValidatorCurrentRewards(context.Context, *QueryValidatorCurrentRewardsRequest) (*QueryValidatorCurrentRewardsResponse, error)
+	// ValidatorSlashes queries slash events of a validator.
+	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
+	// DelegationRewards queries the total rewards accrued by a delegation.
+	DelegationRewards(context.Context, *types2.QueryDelegationRewardsRequest) (*types2.QueryDelegationRewardsResponse, error)
+	// DelegationTotalRewards queries the total rewards accrued by a each
+	// validator.
+	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
+	// DelegatorValidators queries the validators of a delegator.
+	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
+	// DelegatorStartingInfo queries the starting info of a delegation, the
+	// rewards of a delegation accrue from its starting period.
+	DelegatorStartingInfo(context.Context, *QueryDelegatorStartingInfoRequest) (*QueryDelegatorStartingInfoResponse, error)
+	// DelegatorWithdrawAddress queries withdraw address of a delegator.
+	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoRestakes queries the validators a delegator auto restakes the
+	// rewards of.
+	DelegatorAutoRestakes(context.Context, *QueryDelegatorAutoRestakesRequest) (*QueryDelegatorAutoRestakesResponse, error)
+	// CommunityPool queries the community pool coins.
+	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// LiquidStakingFeesCollected queries the cumulative liquid staking reward fees
+	// sent to the community pool.
+	LiquidStakingFeesCollected(context.Context, *QueryLiquidStakingFeesCollectedRequest) (*QueryLiquidStakingFeesCollectedResponse, error)
+	// CommunityPoolStreams queries the active community pool streams.
+	CommunityPoolStreams(context.Context, *QueryCommunityPoolStreamsRequest) (*QueryCommunityPoolStreamsResponse, error)
+	// CommunityPoolStream queries an active community pool stream by id.
+	CommunityPoolStream(context.Context, *QueryCommunityPoolStreamRequest) (*QueryCommunityPoolStreamResponse, error)
+	// TokenizeShareRecordReward queries the tokenize share record rewards
+	TokenizeShareRecordReward(context.Context, *QueryTokenizeShareRecordRewardRequest) (*QueryTokenizeShareRecordRewardResponse, error)
+	// TokenizeShareRecordRewardById queries the rewards of a tokenize share record
+	TokenizeShareRecordRewardById(context.Context, *QueryTokenizeShareRecordRewardByIdRequest) (*QueryTokenizeShareRecordRewardByIdResponse, error)
+	// ValidatorTokenizeShareRecordRewards queries the rewards of the tokenize
+	// share records of a validator
+	ValidatorTokenizeShareRecordRewards(context.Context, *QueryValidatorTokenizeShareRecordRewardsRequest) (*QueryValidatorTokenizeShareRecordRewardsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorOutstandingRewards(ctx context.Context, req *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOutstandingRewards not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorCommission(ctx context.Context, req *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommission not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorCommissionRecipients(ctx context.Context, req *QueryValidatorCommissionRecipientsRequest) (*QueryValidatorCommissionRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommissionRecipients not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorRewardRate(ctx context.Context, req *QueryValidatorRewardRateRequest) (*QueryValidatorRewardRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewardRate not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorHistoricalRewards(ctx context.Context, req *QueryValidatorHistoricalRewardsRequest) (*QueryValidatorHistoricalRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorHistoricalRewards not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorCurrentRewards(ctx context.Context, req *QueryValidatorCurrentRewardsRequest) (*QueryValidatorCurrentRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCurrentRewards not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSlashes(ctx context.Context, req *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashes not implemented")
+}
+func (*UnimplementedQueryServer) DelegationRewards(ctx context.Context, req *types2.QueryDelegationRewardsRequest) (*types2.QueryDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewards not implemented")
+}
+func (*UnimplementedQueryServer) DelegationTotalRewards(ctx context.Context, req *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorValidators not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorStartingInfo(ctx context.Context, req *QueryDelegatorStartingInfoRequest) (*QueryDelegatorStartingInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorStartingInfo not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorAutoRestakes(ctx context.Context, req *QueryDelegatorAutoRestakesRequest) (*QueryDelegatorAutoRestakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoRestakes not implemented")
+}
+func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
+}
+func (*UnimplementedQueryServer) LiquidStakingFeesCollected(ctx context.Context, req *QueryLiquidStakingFeesCollectedRequest) (*QueryLiquidStakingFeesCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidStakingFeesCollected not implemented")
+}
+func (*UnimplementedQueryServer) CommunityPoolStreams(ctx context.Context, req *QueryCommunityPoolStreamsRequest) (*QueryCommunityPoolStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolStreams not implemented")
+}
+func (*UnimplementedQueryServer) CommunityPoolStream(ctx context.Context, req *QueryCommunityPoolStreamRequest) (*QueryCommunityPoolStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolStream not implemented")
+}
+func (*UnimplementedQueryServer) TokenizeShareRecordReward(ctx context.Context, req *QueryTokenizeShareRecordRewardRequest) (*QueryTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordReward not implemented")
+}
+func (*UnimplementedQueryServer) TokenizeShareRecordRewardById(ctx context.Context, req *QueryTokenizeShareRecordRewardByIdRequest) (*QueryTokenizeShareRecordRewardByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordRewardById not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorTokenizeShareRecordRewards(ctx context.Context, req *QueryValidatorTokenizeShareRecordRewardsRequest) (*QueryValidatorTokenizeShareRecordRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorTokenizeShareRecordRewards not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ValidatorOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOutstandingRewards(ctx, in)
 	}
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/ValidatorOutstandingRewards",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOutstandingRewards(ctx, req.(*QueryValidatorOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ValidatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorCommission(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/ValidatorCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorCommission(ctx, req.(*QueryValidatorCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ValidatorCommissionRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCommissionRecipientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, s := range m.Validators {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorCommissionRecipients(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/ValidatorCommissionRecipients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorCommissionRecipients(ctx, req.(*QueryValidatorCommissionRecipientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ValidatorRewardRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRewardRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRewardRate(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/ValidatorRewardRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRewardRate(ctx, req.(*QueryValidatorRewardRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ValidatorHistoricalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorHistoricalRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorHistoricalRewards(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/ValidatorHistoricalRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorHistoricalRewards(ctx, req.(*QueryValidatorHistoricalRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCommunityPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ValidatorCurrentRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCurrentRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorCurrentRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/ValidatorCurrentRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorCurrentRewards(ctx, req.(*QueryValidatorCurrentRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCommunityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ValidatorSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Pool) > 0 {
-		for _, e := range m.Pool {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/ValidatorSlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSlashes(ctx, req.(*QueryValidatorSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types2.QueryDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/DelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationRewards(ctx, req.(*types2.QueryDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationTotalRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationTotalRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/DelegationTotalRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationTotalRewards(ctx, req.(*QueryDelegationTotalRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/DelegatorValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorValidators(ctx, req.(*QueryDelegatorValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorStartingInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorStartingInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorStartingInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/DelegatorStartingInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorStartingInfo(ctx, req.(*QueryDelegatorStartingInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/DelegatorWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorWithdrawAddress(ctx, req.(*QueryDelegatorWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorAutoRestakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoRestakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoRestakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/DelegatorAutoRestakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoRestakes(ctx, req.(*QueryDelegatorAutoRestakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/CommunityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityPool(ctx, req.(*QueryCommunityPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidStakingFeesCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidStakingFeesCollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidStakingFeesCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/LiquidStakingFeesCollected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidStakingFeesCollected(ctx, req.(*QueryLiquidStakingFeesCollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityPoolStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityPoolStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPoolStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/CommunityPoolStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityPoolStreams(ctx, req.(*QueryCommunityPoolStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityPoolStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityPoolStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPoolStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/CommunityPoolStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityPoolStream(ctx, req.(*QueryCommunityPoolStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/TokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordReward(ctx, req.(*QueryTokenizeShareRecordRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordRewardById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordRewardByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordRewardById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/TokenizeShareRecordRewardById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordRewardById(ctx, req.(*QueryTokenizeShareRecordRewardByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorTokenizeShareRecordRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorTokenizeShareRecordRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorTokenizeShareRecordRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/ValidatorTokenizeShareRecordRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorTokenizeShareRecordRewards(ctx, req.(*QueryValidatorTokenizeShareRecordRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "liquidstaking.distribution.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ValidatorOutstandingRewards",
+			Handler:    _Query_ValidatorOutstandingRewards_Handler,
+		},
+		{
+			MethodName: "ValidatorCommission",
+			Handler:    _Query_ValidatorCommission_Handler,
+		},
+		{
+			MethodName: "ValidatorCommissionRecipients",
+			Handler:    _Query_ValidatorCommissionRecipients_Handler,
+		},
+		{
+			MethodName: "ValidatorRewardRate",
+			Handler:    _Query_ValidatorRewardRate_Handler,
+		},
+		{
+			MethodName: "ValidatorHistoricalRewards",
+			Handler:    _Query_ValidatorHistoricalRewards_Handler,
+		},
+		{
+			MethodName: "ValidatorCurrentRewards",
+			Handler:    _Query_ValidatorCurrentRewards_Handler,
+		},
+		{
+			MethodName: "ValidatorSlashes",
+			Handler:    _Query_ValidatorSlashes_Handler,
+		},
+		{
+			MethodName: "DelegationRewards",
+			Handler:    _Query_DelegationRewards_Handler,
+		},
+		{
+			MethodName: "DelegationTotalRewards",
+			Handler:    _Query_DelegationTotalRewards_Handler,
+		},
+		{
+			MethodName: "DelegatorValidators",
+			Handler:    _Query_DelegatorValidators_Handler,
+		},
+		{
+			MethodName: "DelegatorStartingInfo",
+			Handler:    _Query_DelegatorStartingInfo_Handler,
+		},
+		{
+			MethodName: "DelegatorWithdrawAddress",
+			Handler:    _Query_DelegatorWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "DelegatorAutoRestakes",
+			Handler:    _Query_DelegatorAutoRestakes_Handler,
+		},
+		{
+			MethodName: "CommunityPool",
+			Handler:    _Query_CommunityPool_Handler,
+		},
+		{
+			MethodName: "LiquidStakingFeesCollected",
+			Handler:    _Query_LiquidStakingFeesCollected_Handler,
+		},
+		{
+			MethodName: "CommunityPoolStreams",
+			Handler:    _Query_CommunityPoolStreams_Handler,
+		},
+		{
+			MethodName: "CommunityPoolStream",
+			Handler:    _Query_CommunityPoolStream_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordReward",
+			Handler:    _Query_TokenizeShareRecordReward_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordRewardById",
+			Handler:    _Query_TokenizeShareRecordRewardById_Handler,
+		},
+		{
+			MethodName: "ValidatorTokenizeShareRecordRewards",
+			Handler:    _Query_ValidatorTokenizeShareRecordRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "distribution/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCommissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCommissionRecipientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCommissionRecipientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCommissionRecipientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCommissionRecipientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCommissionRecipientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCommissionRecipientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRewardRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRewardRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRewardRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRewardRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRewardRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRewardRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Rate) > 0 {
+		for iNdEx := len(m.Rate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorHistoricalRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorHistoricalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorHistoricalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorHistoricalRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorHistoricalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorHistoricalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HistoricalRewards) > 0 {
+		for iNdEx := len(m.HistoricalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorHistoricalRewardsPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorHistoricalRewardsPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorHistoricalRewardsPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCurrentRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCurrentRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCurrentRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCurrentRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCurrentRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCurrentRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndingHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndingHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartingHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartingHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationTotalRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationTotalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationTotalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationTotalRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationTotalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorStartingInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorStartingInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorStartingInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorStartingInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorStartingInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorStartingInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StartingInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoRestakesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoRestakesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoRestakesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidStakingFeesCollectedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidStakingFeesCollectedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidStakingFeesCollectedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidStakingFeesCollectedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidStakingFeesCollectedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidStakingFeesCollectedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareRecordRewardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordRewardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareRecordRewardByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordRewardByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordRewardByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareRecordRewardByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordRewardByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordRewardByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorCommissionRecipientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorCommissionRecipientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorRewardRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.WindowSeconds))
+	}
+	return n
+}
+
+func (m *QueryValidatorRewardRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rate) > 0 {
+		for _, e := range m.Rate {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorHistoricalRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorHistoricalRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HistoricalRewards) > 0 {
+		for _, e := range m.HistoricalRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorHistoricalRewardsPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorCurrentRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorCurrentRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorSlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartingHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartingHeight))
+	}
+	if m.EndingHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndingHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorSlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationTotalRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorStartingInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorStartingInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StartingInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoRestakesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCommunityPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLiquidStakingFeesCollectedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLiquidStakingFeesCollectedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCommunityPoolStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommunityPoolStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommunityPoolStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryCommunityPoolStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenizeShareRecordRewardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTokenizeShareRecordRewardByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		n += 1 + sovQuery(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *QueryTokenizeShareRecordRewardByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionRecipientsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRecipientsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRecipientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionRecipientsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRecipientsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRecipientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, CommissionRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRewardRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRewardRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRewardRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRewardRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRewardRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRewardRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = append(m.Rate, types.DecCoin{})
+			if err := m.Rate[len(m.Rate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorHistoricalRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorHistoricalRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricalRewards = append(m.HistoricalRewards, ValidatorHistoricalRewardsPeriod{})
+			if err := m.HistoricalRewards[len(m.HistoricalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorHistoricalRewardsPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewardsPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewardsPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCurrentRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCurrentRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCurrentRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCurrentRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCurrentRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCurrentRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
+			}
+			m.StartingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
+			}
+			m.EndingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, DelegationDelegatorReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.DecCoin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryTokenizeShareRecordRewardRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryTokenizeShareRecordRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorStartingInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2298,12 +7222,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorStartingInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorStartingInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2325,7 +7313,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorStartingInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2348,15 +7336,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorStartingInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorStartingInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2383,7 +7371,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2408,7 +7396,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2431,15 +7419,15 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2467,7 +7455,7 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2490,7 +7478,7 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2513,17 +7501,17 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2533,24 +7521,23 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2573,7 +7560,7 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorAutoRestakesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2596,15 +7583,15 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCommissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorAutoRestakesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorAutoRestakesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2632,7 +7619,7 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2655,7 +7642,7 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorAutoRestakesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2678,17 +7665,17 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorAutoRestakesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorAutoRestakesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2698,24 +7685,23 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2738,7 +7724,7 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2761,85 +7747,65 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommunityPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommunityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
-			}
-			m.StartingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EndingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2866,10 +7832,8 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pool = append(m.Pool, types.DecCoin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2894,7 +7858,7 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidStakingFeesCollectedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2917,49 +7881,65 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidStakingFeesCollectedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidStakingFeesCollectedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidStakingFeesCollectedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
-			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidStakingFeesCollectedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidStakingFeesCollectedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2986,10 +7966,8 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3014,7 +7992,7 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCommunityPoolStreamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3037,17 +8015,17 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommunityPoolStreamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommunityPoolStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3057,23 +8035,27 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3096,7 +8078,7 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCommunityPoolStreamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3119,15 +8101,15 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommunityPoolStreamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommunityPoolStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3154,14 +8136,14 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, DelegationDelegatorReward{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Streams = append(m.Streams, CommunityPoolStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3188,8 +8170,10 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.DecCoin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3214,7 +8198,7 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCommunityPoolStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3237,17 +8221,17 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommunityPoolStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommunityPoolStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var stringLen uint64
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3257,24 +8241,11 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3296,7 +8267,7 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCommunityPoolStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3319,17 +8290,17 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommunityPoolStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommunityPoolStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3339,23 +8310,24 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3378,7 +8350,7 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenizeShareRecordRewardRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3401,15 +8373,15 @@ func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenizeShareRecordRewardRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenizeShareRecordRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3437,7 +8409,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3460,7 +8432,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3483,17 +8455,17 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenizeShareRecordRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3503,23 +8475,59 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.Rewards = append(m.Rewards, TokenizeShareRecordReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.DecCoin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3542,7 +8550,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenizeShareRecordRewardByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3565,12 +8573,31 @@ func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommunityPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenizeShareRecordRewardByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommunityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenizeShareRecordRewardByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3592,7 +8619,7 @@ func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenizeShareRecordRewardByIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3615,15 +8642,15 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenizeShareRecordRewardByIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenizeShareRecordRewardByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3650,8 +8677,7 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = append(m.Pool, types.DecCoin{})
-			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3676,7 +8702,7 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenizeShareRecordRewardRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorTokenizeShareRecordRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3699,15 +8725,15 @@ func (m *QueryTokenizeShareRecordRewardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenizeShareRecordRewardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorTokenizeShareRecordRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenizeShareRecordRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorTokenizeShareRecordRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3735,7 +8761,43 @@ func (m *QueryTokenizeShareRecordRewardRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3758,7 +8820,7 @@ func (m *QueryTokenizeShareRecordRewardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorTokenizeShareRecordRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3781,10 +8843,10 @@ func (m *QueryTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorTokenizeShareRecordRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorTokenizeShareRecordRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3855,6 +8917,42 @@ func (m *QueryTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -366,6 +366,13 @@ var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalM
 // records owned by the designated owner
 type MsgWithdrawAllTokenizeShareRecordReward struct {
 	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+	// record_ids optionally restricts the withdrawal to the given records.
+	RecordIds []uint64 `protobuf:"varint,2,rep,packed,name=record_ids,json=recordIds,proto3" json:"record_ids,omitempty"`
+	// start_record_id is the id the owned records are withdrawn from when no
+	// record ids are given.
+	StartRecordId uint64 `protobuf:"varint,3,opt,name=start_record_id,json=startRecordId,proto3" json:"start_record_id,omitempty"`
+	// limit bounds the number of records withdrawn, 0 uses the maximum.
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
 func (m *MsgWithdrawAllTokenizeShareRecordReward) Reset() {
@@ -405,6 +412,11 @@ var xxx_messageInfo_MsgWithdrawAllTokenizeShareRecordReward proto.InternalMessag
 
 // MsgWithdrawAllTokenizeShareRecordRewardResponse defines the Msg/WithdrawTokenizeShareRecordReward response type.
 type MsgWithdrawAllTokenizeShareRecordRewardResponse struct {
+	// results defines the result of the withdrawal of each record.
+	Results []TokenizeShareRecordWithdrawal `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// next_record_id is the start_record_id to continue with, 0 if all the
+	// owned records were withdrawn.
+	NextRecordId uint64 `protobuf:"varint,2,opt,name=next_record_id,json=nextRecordId,proto3" json:"next_record_id,omitempty"`
 }
 
 func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Reset() {
@@ -444,6 +456,20 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawAllTokenizeShareRecordRewardResponse proto.InternalMessageInfo
 
+func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) GetResults() []TokenizeShareRecordWithdrawal {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) GetNextRecordId() uint64 {
+	if m != nil {
+		return m.NextRecordId
+	}
+	return 0
+}
+
 // MsgFundCommunityPool allows an account to directly
 // fund the community pool.
 type MsgFundCommunityPool struct {
@@ -521,6 +547,249 @@ func (m *MsgFundCommunityPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundCommunityPoolResponse proto.InternalMessageInfo
 
+// MsgSetAutoRestake enables or disables the automatic restaking of the rewards
+// of a delegation.
+type MsgSetAutoRestake struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Enabled          bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoRestake) Reset()         { *m = MsgSetAutoRestake{} }
+func (m *MsgSetAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRestake) ProtoMessage()    {}
+func (*MsgSetAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{12}
+}
+func (m *MsgSetAutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRestake.Merge(m, src)
+}
+func (m *MsgSetAutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRestake proto.InternalMessageInfo
+
+// MsgSetAutoRestakeResponse defines the Msg/SetAutoRestake response type.
+type MsgSetAutoRestakeResponse struct {
+}
+
+func (m *MsgSetAutoRestakeResponse) Reset()         { *m = MsgSetAutoRestakeResponse{} }
+func (m *MsgSetAutoRestakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRestakeResponse) ProtoMessage()    {}
+func (*MsgSetAutoRestakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{13}
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRestakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRestakeResponse.Merge(m, src)
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRestakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRestakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRestakeResponse proto.InternalMessageInfo
+
+// MsgSetCommissionRecipients sets the weighted list of recipients the commission
+// of a validator is split across. An empty list sends the commission to the
+// withdraw address of the validator operator again.
+type MsgSetCommissionRecipients struct {
+	ValidatorAddress string                `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Recipients       []CommissionRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *MsgSetCommissionRecipients) Reset()         { *m = MsgSetCommissionRecipients{} }
+func (m *MsgSetCommissionRecipients) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCommissionRecipients) ProtoMessage()    {}
+func (*MsgSetCommissionRecipients) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{14}
+}
+func (m *MsgSetCommissionRecipients) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCommissionRecipients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCommissionRecipients.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCommissionRecipients) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCommissionRecipients.Merge(m, src)
+}
+func (m *MsgSetCommissionRecipients) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCommissionRecipients) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCommissionRecipients.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCommissionRecipients proto.InternalMessageInfo
+
+// MsgSetCommissionRecipientsResponse defines the Msg/SetCommissionRecipients response type.
+type MsgSetCommissionRecipientsResponse struct {
+}
+
+func (m *MsgSetCommissionRecipientsResponse) Reset()         { *m = MsgSetCommissionRecipientsResponse{} }
+func (m *MsgSetCommissionRecipientsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCommissionRecipientsResponse) ProtoMessage()    {}
+func (*MsgSetCommissionRecipientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{15}
+}
+func (m *MsgSetCommissionRecipientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCommissionRecipientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCommissionRecipientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCommissionRecipientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCommissionRecipientsResponse.Merge(m, src)
+}
+func (m *MsgSetCommissionRecipientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCommissionRecipientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCommissionRecipientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCommissionRecipientsResponse proto.InternalMessageInfo
+
+// MsgSweepTokenizeShareRecord sends the balance left in the module account of a
+// tokenize share record that is no longer delegated to its owner, and removes
+// the record.
+type MsgSweepTokenizeShareRecord struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	RecordId     uint64 `protobuf:"varint,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+}
+
+func (m *MsgSweepTokenizeShareRecord) Reset()         { *m = MsgSweepTokenizeShareRecord{} }
+func (m *MsgSweepTokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepTokenizeShareRecord) ProtoMessage()    {}
+func (*MsgSweepTokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{16}
+}
+func (m *MsgSweepTokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepTokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepTokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepTokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepTokenizeShareRecord.Merge(m, src)
+}
+func (m *MsgSweepTokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepTokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepTokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepTokenizeShareRecord proto.InternalMessageInfo
+
+// MsgSweepTokenizeShareRecordResponse defines the Msg/SweepTokenizeShareRecord response type.
+type MsgSweepTokenizeShareRecordResponse struct {
+	// amount defines the balance sent to the record owner.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgSweepTokenizeShareRecordResponse) Reset()         { *m = MsgSweepTokenizeShareRecordResponse{} }
+func (m *MsgSweepTokenizeShareRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepTokenizeShareRecordResponse) ProtoMessage()    {}
+func (*MsgSweepTokenizeShareRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{17}
+}
+func (m *MsgSweepTokenizeShareRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepTokenizeShareRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepTokenizeShareRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepTokenizeShareRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepTokenizeShareRecordResponse.Merge(m, src)
+}
+func (m *MsgSweepTokenizeShareRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepTokenizeShareRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepTokenizeShareRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepTokenizeShareRecordResponse proto.InternalMessageInfo
+
+func (m *MsgSweepTokenizeShareRecordResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "liquidstaking.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "liquidstaking.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -534,60 +803,83 @@ func init() {
 	proto.RegisterType((*MsgWithdrawAllTokenizeShareRecordRewardResponse)(nil), "liquidstaking.distribution.v1beta1.MsgWithdrawAllTokenizeShareRecordRewardResponse")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "liquidstaking.distribution.v1beta1.MsgFundCommunityPool")
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "liquidstaking.distribution.v1beta1.MsgFundCommunityPoolResponse")
+	proto.RegisterType((*MsgSetAutoRestake)(nil), "liquidstaking.distribution.v1beta1.MsgSetAutoRestake")
+	proto.RegisterType((*MsgSetAutoRestakeResponse)(nil), "liquidstaking.distribution.v1beta1.MsgSetAutoRestakeResponse")
+	proto.RegisterType((*MsgSetCommissionRecipients)(nil), "liquidstaking.distribution.v1beta1.MsgSetCommissionRecipients")
+	proto.RegisterType((*MsgSetCommissionRecipientsResponse)(nil), "liquidstaking.distribution.v1beta1.MsgSetCommissionRecipientsResponse")
+	proto.RegisterType((*MsgSweepTokenizeShareRecord)(nil), "liquidstaking.distribution.v1beta1.MsgSweepTokenizeShareRecord")
+	proto.RegisterType((*MsgSweepTokenizeShareRecordResponse)(nil), "liquidstaking.distribution.v1beta1.MsgSweepTokenizeShareRecordResponse")
 }
 
 func init() { proto.RegisterFile("distribution/v1beta1/tx.proto", fileDescriptor_f0452d52deb0ca76) }
 
 var fileDescriptor_f0452d52deb0ca76 = []byte{
-	// 760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x58, 0x29, 0xed, 0xa8, 0xd8, 0x86, 0x68, 0xdb, 0xad, 0xdd, 0xd4, 0xa5, 0x68, 0x11,
-	0xb3, 0x6b, 0x2a, 0x88, 0x06, 0x14, 0x9b, 0xfe, 0xc0, 0x1f, 0x04, 0xca, 0x46, 0x14, 0xbc, 0x94,
-	0x4d, 0x66, 0xd8, 0x0e, 0xdd, 0xdd, 0x49, 0x77, 0x66, 0x9b, 0xc6, 0xa3, 0x17, 0x15, 0x2c, 0x8a,
-	0x7f, 0x41, 0xbd, 0x89, 0x20, 0x78, 0xf0, 0xee, 0xc1, 0x4b, 0xd1, 0x4b, 0xf1, 0xe4, 0xa9, 0x4a,
-	0x7a, 0xd0, 0xb3, 0x7f, 0x81, 0x64, 0x7f, 0x75, 0x43, 0x92, 0x26, 0x6d, 0x63, 0x4f, 0x9b, 0x9d,
-	0xf7, 0xbe, 0xef, 0x7d, 0xdf, 0xcc, 0x9b, 0x97, 0x85, 0x63, 0x88, 0x30, 0x6e, 0x93, 0x82, 0xc3,
-	0x09, 0xb5, 0x94, 0xd5, 0x74, 0x01, 0x73, 0x2d, 0xad, 0xf0, 0x35, 0xb9, 0x64, 0x53, 0x4e, 0xe3,
-	0x92, 0x41, 0x56, 0x1c, 0x82, 0x18, 0xd7, 0x96, 0x89, 0xa5, 0xcb, 0xd1, 0x64, 0xd9, 0x4f, 0x16,
-	0x12, 0x3a, 0xd5, 0xa9, 0x9b, 0xae, 0xd4, 0x7e, 0x79, 0x48, 0x41, 0x2c, 0x52, 0x66, 0x52, 0xa6,
-	0x14, 0x34, 0x86, 0x43, 0xde, 0x22, 0x25, 0x96, 0x1f, 0x1f, 0xf1, 0xe2, 0x8b, 0x1e, 0xd0, 0x7b,
-	0xf1, 0x43, 0x43, 0x3e, 0xd4, 0x64, 0xba, 0xb2, 0x9a, 0xae, 0x3d, 0xbc, 0x80, 0xf4, 0x05, 0xc0,
-	0x33, 0x39, 0xa6, 0xe7, 0x31, 0x7f, 0x44, 0xf8, 0x12, 0xb2, 0xb5, 0xf2, 0x34, 0x42, 0x36, 0x66,
-	0x2c, 0x3e, 0x07, 0x07, 0x11, 0x36, 0xb0, 0xae, 0x71, 0x6a, 0x2f, 0x6a, 0xde, 0xe2, 0x30, 0x18,
-	0x07, 0x93, 0xfd, 0xd9, 0xe1, 0xef, 0x9f, 0x52, 0x09, 0x9f, 0xdf, 0x4f, 0xcf, 0x73, 0x9b, 0x58,
-	0xba, 0x3a, 0x10, 0x42, 0x02, 0x9a, 0x19, 0x38, 0x50, 0xf6, 0x99, 0x43, 0x96, 0x63, 0x6d, 0x58,
-	0x4e, 0x97, 0xeb, 0xb5, 0x64, 0xc4, 0xe7, 0x1b, 0xc9, 0xd8, 0x9f, 0x8d, 0x64, 0xec, 0xe9, 0xef,
-	0x8f, 0x97, 0x1a, 0x65, 0x49, 0x49, 0x38, 0xd6, 0xd4, 0x84, 0x8a, 0x59, 0x89, 0x5a, 0x0c, 0x4b,
-	0x5f, 0x01, 0x14, 0x72, 0x4c, 0x0f, 0xc2, 0xb3, 0x01, 0x83, 0x8a, 0xcb, 0x9a, 0x8d, 0xba, 0xe5,
-	0x75, 0x0e, 0x0e, 0xae, 0x6a, 0x06, 0x41, 0x75, 0x34, 0xed, 0xcc, 0x0e, 0x84, 0x90, 0x4e, 0xdd,
-	0xbe, 0x00, 0x50, 0x6a, 0x6d, 0x26, 0xf0, 0x1c, 0x2f, 0xc2, 0x5e, 0xcd, 0xa4, 0x8e, 0xc5, 0x87,
-	0xc1, 0x78, 0xcf, 0xe4, 0x89, 0xa9, 0x11, 0xd9, 0xaf, 0x5f, 0xeb, 0x9f, 0xa0, 0xd5, 0xe4, 0x19,
-	0x4a, 0xac, 0xec, 0x95, 0xcd, 0xed, 0x64, 0xec, 0xfd, 0xcf, 0xe4, 0xa4, 0x4e, 0xf8, 0x92, 0x53,
-	0x90, 0x8b, 0xd4, 0xf4, 0xfb, 0xc7, 0x7f, 0xa4, 0x18, 0x5a, 0x56, 0x78, 0xa5, 0x84, 0x99, 0x0b,
-	0x60, 0xaa, 0x4f, 0x2d, 0x3d, 0x03, 0x50, 0x8c, 0x68, 0x79, 0x18, 0x78, 0x99, 0xa1, 0xa6, 0x49,
-	0x18, 0x23, 0xd4, 0x6a, 0xbe, 0x2b, 0xe0, 0x90, 0xbb, 0xd2, 0xc0, 0x28, 0xad, 0x03, 0x78, 0x61,
-	0x6f, 0x25, 0x47, 0xbb, 0x33, 0x2f, 0x01, 0x9c, 0x88, 0xe8, 0x79, 0x40, 0x97, 0xb1, 0x45, 0x9e,
-	0xe0, 0xfc, 0x92, 0x66, 0x63, 0x15, 0x17, 0x69, 0xed, 0xa4, 0xdc, 0xe6, 0xbb, 0x09, 0x4f, 0xd1,
-	0xb2, 0x85, 0x1b, 0xf6, 0xe6, 0xef, 0x76, 0x32, 0x51, 0xd1, 0x4c, 0x23, 0x23, 0xd5, 0x85, 0x25,
-	0xf5, 0xa4, 0xfb, 0x1e, 0x34, 0xdd, 0x28, 0xec, 0xb7, 0x5d, 0xba, 0x45, 0x82, 0xdc, 0x66, 0x3b,
-	0xae, 0xf6, 0x79, 0x0b, 0x77, 0x51, 0xa6, 0x2f, 0xd8, 0x34, 0x49, 0x86, 0x97, 0x3b, 0x51, 0x13,
-	0xde, 0x18, 0x1b, 0x5e, 0x8c, 0xe4, 0x4f, 0x1b, 0xc6, 0xff, 0x32, 0x10, 0xd1, 0x98, 0x86, 0x4a,
-	0x87, 0x35, 0x43, 0x99, 0xdf, 0x00, 0x4c, 0xe4, 0x98, 0x3e, 0xef, 0x58, 0xa8, 0x76, 0xd0, 0x8e,
-	0x45, 0x78, 0x65, 0x81, 0x52, 0xe3, 0x48, 0xce, 0x38, 0x7e, 0x0d, 0xf6, 0x23, 0x5c, 0xa2, 0x8c,
-	0x70, 0x6a, 0xb7, 0xbd, 0xe8, 0xbb, 0xa9, 0x99, 0xb3, 0xd1, 0x5e, 0xde, 0x5d, 0x97, 0x44, 0x78,
-	0xae, 0x99, 0x99, 0xc0, 0xed, 0xd4, 0x7a, 0x1f, 0xec, 0xc9, 0x31, 0x3d, 0xfe, 0x06, 0xc0, 0x78,
-	0x93, 0x91, 0x7d, 0x43, 0x6e, 0xff, 0xdf, 0x22, 0x37, 0x1d, 0x94, 0xc2, 0xf4, 0x81, 0xa1, 0xe1,
-	0xad, 0x7a, 0x0b, 0xe0, 0x50, 0xab, 0x01, 0x7b, 0xab, 0x43, 0xfa, 0x16, 0x78, 0x61, 0xfe, 0x70,
-	0xf8, 0x50, 0xe3, 0x07, 0x00, 0x47, 0xf7, 0x9a, 0x55, 0xd9, 0x7d, 0xd6, 0x69, 0xc2, 0x21, 0xdc,
-	0x3b, 0x3c, 0x47, 0xa8, 0xf7, 0x33, 0x80, 0xe7, 0xdb, 0x4f, 0x90, 0x3b, 0xfb, 0xac, 0xd8, 0x92,
-	0x49, 0x58, 0xe8, 0x16, 0x53, 0xe8, 0x60, 0x13, 0xc0, 0x89, 0x8e, 0xa6, 0xc8, 0xfd, 0x7d, 0x96,
-	0xde, 0x8b, 0x4c, 0xc8, 0x77, 0x91, 0x2c, 0xb4, 0xf2, 0x0a, 0xc0, 0xc1, 0xc6, 0x41, 0x73, 0xbd,
-	0xc3, 0x52, 0x0d, 0x48, 0xe1, 0xf6, 0x41, 0x91, 0x81, 0xa2, 0x6c, 0xe1, 0x5d, 0x55, 0x04, 0x9b,
-	0x55, 0x11, 0x6c, 0x55, 0x45, 0xf0, 0xab, 0x2a, 0x82, 0xd7, 0x3b, 0x62, 0x6c, 0x6b, 0x47, 0x8c,
-	0xfd, 0xd8, 0x11, 0x63, 0x8f, 0x67, 0x23, 0xf3, 0x8c, 0xac, 0x18, 0x4e, 0xad, 0xb3, 0x88, 0x55,
-	0x54, 0xbc, 0xaa, 0x84, 0x57, 0x52, 0x7e, 0xe5, 0x94, 0x49, 0x91, 0x63, 0x60, 0x65, 0x4d, 0xa9,
-	0xfb, 0x74, 0x75, 0x27, 0x5e, 0xa1, 0xd7, 0xfd, 0x50, 0xbc, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff,
-	0x7e, 0x76, 0x14, 0x5b, 0xd7, 0x0a, 0x00, 0x00,
+	// 1039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcb, 0x6f, 0xe3, 0x44,
+	0x18, 0xcf, 0xd0, 0xb2, 0xbb, 0xfd, 0xba, 0x8f, 0xd6, 0x0a, 0x6c, 0xea, 0x52, 0x67, 0x31, 0xd5,
+	0x6e, 0x85, 0xa8, 0x4d, 0x8b, 0x78, 0x55, 0xda, 0x85, 0xa4, 0xbb, 0xe5, 0xa5, 0x4a, 0x2b, 0x07,
+	0x81, 0x84, 0x84, 0x22, 0x27, 0x1e, 0xb9, 0xa3, 0xda, 0x9e, 0xac, 0x67, 0xdc, 0xb4, 0x1c, 0x39,
+	0xf0, 0x12, 0x08, 0x84, 0xc4, 0x7d, 0x91, 0x38, 0x20, 0x24, 0x24, 0x0e, 0x9c, 0xb8, 0x70, 0xe0,
+	0x52, 0xc1, 0x81, 0x15, 0x27, 0x24, 0xa4, 0x05, 0xd2, 0x03, 0x9c, 0xf9, 0x0b, 0x90, 0x9f, 0x75,
+	0x36, 0x76, 0xe3, 0x36, 0xa5, 0xe2, 0x94, 0x78, 0x66, 0x7e, 0xbf, 0xef, 0xf7, 0xfb, 0xfc, 0xcd,
+	0x37, 0x23, 0xc3, 0x9c, 0x41, 0x18, 0x77, 0x49, 0xcb, 0xe3, 0x84, 0x3a, 0xea, 0xd6, 0x52, 0x0b,
+	0x73, 0x7d, 0x49, 0xe5, 0xdb, 0x4a, 0xc7, 0xa5, 0x9c, 0x0a, 0xb2, 0x45, 0x6e, 0x79, 0xc4, 0x60,
+	0x5c, 0xdf, 0x24, 0x8e, 0xa9, 0xa4, 0x17, 0x2b, 0xd1, 0x62, 0xb1, 0x6c, 0x52, 0x93, 0x06, 0xcb,
+	0x55, 0xff, 0x5f, 0x88, 0x14, 0xa5, 0x36, 0x65, 0x36, 0x65, 0x6a, 0x4b, 0x67, 0x38, 0xe1, 0x6d,
+	0x53, 0xe2, 0x44, 0xf3, 0x33, 0xe1, 0x7c, 0x33, 0x04, 0x86, 0x0f, 0xd1, 0xd4, 0xc5, 0x08, 0x6a,
+	0x33, 0x53, 0xdd, 0x5a, 0xf2, 0x7f, 0xa2, 0x89, 0x2b, 0x99, 0x62, 0xfb, 0x44, 0x05, 0x0b, 0xe5,
+	0x1f, 0x10, 0x3c, 0xb0, 0xce, 0xcc, 0x06, 0xe6, 0xaf, 0x13, 0xbe, 0x61, 0xb8, 0x7a, 0xb7, 0x66,
+	0x18, 0x2e, 0x66, 0x4c, 0xb8, 0x01, 0xd3, 0x06, 0xb6, 0xb0, 0xa9, 0x73, 0xea, 0x36, 0xf5, 0x70,
+	0xb0, 0x82, 0x2e, 0xa1, 0x85, 0x89, 0x7a, 0xe5, 0x97, 0x6f, 0x17, 0xcb, 0x91, 0x90, 0x68, 0x79,
+	0x83, 0xbb, 0xc4, 0x31, 0xb5, 0xa9, 0x04, 0x12, 0xd3, 0xac, 0xc2, 0x54, 0x37, 0x62, 0x4e, 0x58,
+	0xee, 0x1b, 0xc2, 0x72, 0xa1, 0xdb, 0xaf, 0x65, 0x45, 0x7a, 0xef, 0x76, 0xb5, 0xf4, 0xf7, 0xed,
+	0x6a, 0xe9, 0xed, 0xbf, 0xbe, 0x79, 0x74, 0x50, 0x96, 0x5c, 0x85, 0xb9, 0x4c, 0x13, 0x1a, 0x66,
+	0x1d, 0xea, 0x30, 0x2c, 0xff, 0x88, 0x40, 0x5c, 0x67, 0x66, 0x3c, 0x7d, 0x3d, 0x66, 0xd0, 0x70,
+	0x57, 0x77, 0x8d, 0xe3, 0xf2, 0x7a, 0x03, 0xa6, 0xb7, 0x74, 0x8b, 0x18, 0x7d, 0x34, 0xc3, 0xcc,
+	0x4e, 0x25, 0x90, 0xa2, 0x6e, 0xdf, 0x47, 0x20, 0xe7, 0x9b, 0x89, 0x3d, 0x0b, 0x6d, 0x38, 0xa5,
+	0xdb, 0xd4, 0x73, 0x78, 0x05, 0x5d, 0x1a, 0x5b, 0x98, 0x5c, 0x9e, 0x51, 0xa2, 0xf8, 0x7e, 0xa1,
+	0xc5, 0x35, 0xa9, 0xac, 0x52, 0xe2, 0xd4, 0x1f, 0xdf, 0xbd, 0x5b, 0x2d, 0x7d, 0xf5, 0x7b, 0x75,
+	0xc1, 0x24, 0x7c, 0xc3, 0x6b, 0x29, 0x6d, 0x6a, 0x47, 0x85, 0x16, 0xfd, 0x2c, 0x32, 0x63, 0x53,
+	0xe5, 0x3b, 0x1d, 0xcc, 0x02, 0x00, 0xd3, 0x22, 0x6a, 0xf9, 0x5d, 0x04, 0x52, 0x4a, 0xcb, 0x6b,
+	0xb1, 0x97, 0x55, 0x6a, 0xdb, 0x84, 0x31, 0x42, 0x9d, 0xec, 0xac, 0xa0, 0x11, 0xb3, 0x32, 0xc0,
+	0x28, 0x7f, 0x84, 0xe0, 0xf2, 0xc1, 0x4a, 0x4e, 0x36, 0x33, 0x1f, 0x22, 0x98, 0x4f, 0xe9, 0x79,
+	0x95, 0x6e, 0x62, 0x87, 0xbc, 0x85, 0x1b, 0x1b, 0xba, 0x8b, 0x35, 0xdc, 0xa6, 0xae, 0x11, 0xbe,
+	0x2f, 0xe1, 0x2a, 0x9c, 0xa3, 0x5d, 0x07, 0x0f, 0xe4, 0xe6, 0x9f, 0xbb, 0xd5, 0xf2, 0x8e, 0x6e,
+	0x5b, 0x2b, 0x72, 0xdf, 0xb4, 0xac, 0x9d, 0x0d, 0x9e, 0xe3, 0xa2, 0x9b, 0x85, 0x09, 0x37, 0xa0,
+	0x6b, 0x12, 0x23, 0x28, 0xb6, 0x71, 0xed, 0x4c, 0x38, 0xf0, 0x92, 0xb1, 0x72, 0x26, 0x4e, 0x9a,
+	0xac, 0xc0, 0x63, 0x45, 0xd4, 0x24, 0x3b, 0xe6, 0x67, 0x04, 0x57, 0x52, 0x80, 0x9a, 0x65, 0xfd,
+	0x67, 0x0e, 0xe6, 0x00, 0x12, 0x07, 0xfe, 0x7e, 0x19, 0x5b, 0x18, 0xd7, 0x26, 0x62, 0x0b, 0x4c,
+	0xb8, 0x0c, 0x17, 0x18, 0xd7, 0x5d, 0xde, 0xdc, 0xb7, 0x39, 0x16, 0xd8, 0x3c, 0x17, 0x0c, 0x6b,
+	0xd1, 0x42, 0xa1, 0x0c, 0xf7, 0x5b, 0xc4, 0x26, 0xbc, 0x32, 0x1e, 0xcc, 0x86, 0x0f, 0xa9, 0x0c,
+	0x7c, 0x87, 0x40, 0x2d, 0xe8, 0x28, 0xa9, 0x14, 0x1d, 0x4e, 0xbb, 0x98, 0x79, 0x16, 0x67, 0x51,
+	0xa9, 0xd4, 0x94, 0xe1, 0x7d, 0x5e, 0xc9, 0xe0, 0x8d, 0xa3, 0xea, 0x56, 0x7d, 0xdc, 0x2f, 0x29,
+	0x2d, 0xe6, 0x15, 0xe6, 0xe1, 0xbc, 0x83, 0xb7, 0x79, 0xf3, 0xde, 0x97, 0x78, 0xd6, 0x1f, 0x8d,
+	0xcd, 0xc9, 0x3f, 0x21, 0x28, 0xaf, 0x33, 0x73, 0xcd, 0x73, 0x0c, 0xbf, 0xa0, 0x3d, 0x87, 0xf0,
+	0x9d, 0x9b, 0x94, 0x5a, 0x27, 0x52, 0xcb, 0xc2, 0x53, 0x30, 0x61, 0xe0, 0x0e, 0x65, 0x84, 0x53,
+	0x77, 0x68, 0x43, 0xdb, 0x5f, 0xba, 0xf2, 0x60, 0x7a, 0xcf, 0xee, 0x8f, 0xcb, 0x12, 0x3c, 0x94,
+	0x65, 0x26, 0x29, 0xbe, 0xdf, 0x10, 0x4c, 0x87, 0x0d, 0xbd, 0xe6, 0x71, 0xaa, 0x61, 0x3f, 0xdb,
+	0xf8, 0xff, 0xd5, 0xa5, 0x85, 0x0a, 0x9c, 0xc6, 0x8e, 0xde, 0xb2, 0x70, 0x58, 0x8e, 0x67, 0xb4,
+	0xf8, 0x71, 0x68, 0xff, 0x9e, 0x85, 0x99, 0x01, 0x73, 0x89, 0xf5, 0x5e, 0x78, 0x52, 0x35, 0x30,
+	0x4f, 0x37, 0xae, 0x36, 0xe9, 0x10, 0xec, 0x70, 0x76, 0x4c, 0xcd, 0x54, 0x78, 0x33, 0xd8, 0x72,
+	0x11, 0x69, 0xb0, 0xe5, 0x26, 0x97, 0x9f, 0x2e, 0x52, 0xda, 0x19, 0xa2, 0xa2, 0x82, 0x4e, 0x11,
+	0x0e, 0xed, 0xd5, 0xf3, 0x20, 0xe7, 0x7b, 0x4c, 0x52, 0xf1, 0x19, 0x82, 0x59, 0x7f, 0x59, 0x17,
+	0xe3, 0x4e, 0xc6, 0x96, 0xca, 0x6d, 0x3b, 0xb9, 0x79, 0x38, 0x44, 0xe3, 0x14, 0xd3, 0x0e, 0xfa,
+	0xc3, 0xc8, 0x1f, 0x20, 0x78, 0xe4, 0x00, 0x5d, 0x27, 0x7a, 0xcc, 0x2c, 0xff, 0x39, 0x09, 0x63,
+	0xeb, 0xcc, 0x14, 0x3e, 0x45, 0x20, 0x64, 0xdc, 0xe2, 0x9e, 0x2d, 0xf2, 0x52, 0x33, 0xef, 0x4e,
+	0x62, 0xed, 0xc8, 0xd0, 0x24, 0x03, 0x9f, 0x23, 0xb8, 0x98, 0x77, 0xe7, 0xba, 0x56, 0x90, 0x3e,
+	0x07, 0x2f, 0xae, 0x8d, 0x86, 0x4f, 0x34, 0x7e, 0x8d, 0x60, 0xf6, 0xa0, 0xeb, 0x4b, 0xfd, 0x90,
+	0x71, 0x32, 0x38, 0xc4, 0x97, 0x47, 0xe7, 0x48, 0xf4, 0x7e, 0x8f, 0xe0, 0xe1, 0xe1, 0x97, 0x8a,
+	0x17, 0x0f, 0x19, 0x31, 0x97, 0x49, 0xbc, 0x79, 0x5c, 0x4c, 0x89, 0x83, 0x5d, 0x04, 0xf3, 0x85,
+	0xee, 0x15, 0xaf, 0x1c, 0x32, 0xf4, 0x41, 0x64, 0x62, 0xe3, 0x18, 0xc9, 0x12, 0x2b, 0x1f, 0x23,
+	0x98, 0x1e, 0x3c, 0x93, 0x9f, 0x29, 0x18, 0x6a, 0x00, 0x29, 0x3e, 0x7f, 0x54, 0x64, 0xa2, 0xe8,
+	0x1d, 0x04, 0xe7, 0xef, 0x39, 0x37, 0x9f, 0x2c, 0xbe, 0x91, 0x53, 0x30, 0xf1, 0xea, 0x91, 0x60,
+	0x7d, 0x7b, 0x3f, 0xef, 0x14, 0xbb, 0x56, 0x9c, 0x3a, 0x0b, 0x2f, 0xae, 0x8d, 0x86, 0x4f, 0x34,
+	0x7e, 0x81, 0xa0, 0x92, 0x7b, 0xbc, 0x3c, 0x57, 0x34, 0x48, 0x0e, 0x81, 0xf8, 0xc2, 0x88, 0x04,
+	0xb1, 0xcc, 0x7a, 0xeb, 0xcb, 0x9e, 0x84, 0x76, 0x7b, 0x12, 0xba, 0xd3, 0x93, 0xd0, 0x1f, 0x3d,
+	0x09, 0x7d, 0xb2, 0x27, 0x95, 0xee, 0xec, 0x49, 0xa5, 0x5f, 0xf7, 0xa4, 0xd2, 0x1b, 0xd7, 0x53,
+	0x67, 0x06, 0xb9, 0x65, 0x79, 0xbe, 0x57, 0xe2, 0xb4, 0xd5, 0x30, 0x38, 0xe1, 0x3b, 0x8b, 0x91,
+	0x80, 0x45, 0x9b, 0x1a, 0x9e, 0x85, 0xd5, 0xed, 0xbe, 0x0f, 0x01, 0xe1, 0xa9, 0xd2, 0x3a, 0x15,
+	0x7c, 0x0f, 0x78, 0xe2, 0xdf, 0x01, 0x00, 0x46, 0xea, 0x6d, 0x9c, 0xe7, 0x10, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -709,6 +1001,17 @@ func (this *MsgWithdrawAllTokenizeShareRecordRewardResponse) Equal(that interfac
 	} else if this == nil {
 		return false
 	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if !this.Results[i].Equal(&that1.Results[i]) {
+			return false
+		}
+	}
+	if this.NextRecordId != that1.NextRecordId {
+		return false
+	}
 	return true
 }
 func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
@@ -732,6 +1035,77 @@ func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSetAutoRestakeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoRestakeResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoRestakeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgSetCommissionRecipientsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetCommissionRecipientsResponse)
+	if !ok {
+		that2, ok := that.(MsgSetCommissionRecipientsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgSweepTokenizeShareRecordResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSweepTokenizeShareRecordResponse)
+	if !ok {
+		that2, ok := that.(MsgSweepTokenizeShareRecordResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -761,6 +1135,16 @@ type MsgClient interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
+	// SetAutoRestake defines a method to enable or disable the automatic
+	// restaking of the rewards of a delegation.
+	SetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error)
+	// SetCommissionRecipients defines a method to set the weighted list of
+	// recipients the commission of a validator is split across.
+	SetCommissionRecipients(ctx context.Context, in *MsgSetCommissionRecipients, opts ...grpc.CallOption) (*MsgSetCommissionRecipientsResponse, error)
+	// SweepTokenizeShareRecord defines a method to send the balance left in the
+	// module account of a tokenize share record that is no longer delegated, e.g.
+	// because its validator was removed, to the record owner and remove the record.
+	SweepTokenizeShareRecord(ctx context.Context, in *MsgSweepTokenizeShareRecord, opts ...grpc.CallOption) (*MsgSweepTokenizeShareRecordResponse, error)
 }
 
 type msgClient struct {
@@ -825,6 +1209,33 @@ func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityP
 	return out, nil
 }
 
+func (c *msgClient) SetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error) {
+	out := new(MsgSetAutoRestakeResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Msg/SetAutoRestake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCommissionRecipients(ctx context.Context, in *MsgSetCommissionRecipients, opts ...grpc.CallOption) (*MsgSetCommissionRecipientsResponse, error) {
+	out := new(MsgSetCommissionRecipientsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Msg/SetCommissionRecipients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepTokenizeShareRecord(ctx context.Context, in *MsgSweepTokenizeShareRecord, opts ...grpc.CallOption) (*MsgSweepTokenizeShareRecordResponse, error) {
+	out := new(MsgSweepTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Msg/SweepTokenizeShareRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -843,6 +1254,16 @@ type MsgServer interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
+	// SetAutoRestake defines a method to enable or disable the automatic
+	// restaking of the rewards of a delegation.
+	SetAutoRestake(context.Context, *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error)
+	// SetCommissionRecipients defines a method to set the weighted list of
+	// recipients the commission of a validator is split across.
+	SetCommissionRecipients(context.Context, *MsgSetCommissionRecipients) (*MsgSetCommissionRecipientsResponse, error)
+	// SweepTokenizeShareRecord defines a method to send the balance left in the
+	// module account of a tokenize share record that is no longer delegated, e.g.
+	// because its validator was removed, to the record owner and remove the record.
+	SweepTokenizeShareRecord(context.Context, *MsgSweepTokenizeShareRecord) (*MsgSweepTokenizeShareRecordResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -867,6 +1288,15 @@ func (*UnimplementedMsgServer) WithdrawAllTokenizeShareRecordReward(ctx context.
 func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoRestake(ctx context.Context, req *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRestake not implemented")
+}
+func (*UnimplementedMsgServer) SetCommissionRecipients(ctx context.Context, req *MsgSetCommissionRecipients) (*MsgSetCommissionRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommissionRecipients not implemented")
+}
+func (*UnimplementedMsgServer) SweepTokenizeShareRecord(ctx context.Context, req *MsgSweepTokenizeShareRecord) (*MsgSweepTokenizeShareRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepTokenizeShareRecord not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -980,27 +1410,81 @@ func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "liquidstaking.distribution.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetWithdrawAddress",
-			Handler:    _Msg_SetWithdrawAddress_Handler,
-		},
-		{
-			MethodName: "WithdrawDelegatorReward",
-			Handler:    _Msg_WithdrawDelegatorReward_Handler,
-		},
-		{
-			MethodName: "WithdrawValidatorCommission",
-			Handler:    _Msg_WithdrawValidatorCommission_Handler,
-		},
-		{
-			MethodName: "WithdrawTokenizeShareRecordReward",
-			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
-		},
-		{
+func _Msg_SetAutoRestake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRestake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoRestake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Msg/SetAutoRestake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoRestake(ctx, req.(*MsgSetAutoRestake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCommissionRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCommissionRecipients)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCommissionRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Msg/SetCommissionRecipients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCommissionRecipients(ctx, req.(*MsgSetCommissionRecipients))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepTokenizeShareRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepTokenizeShareRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepTokenizeShareRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Msg/SweepTokenizeShareRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepTokenizeShareRecord(ctx, req.(*MsgSweepTokenizeShareRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "liquidstaking.distribution.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetWithdrawAddress",
+			Handler:    _Msg_SetWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawDelegatorReward",
+			Handler:    _Msg_WithdrawDelegatorReward_Handler,
+		},
+		{
+			MethodName: "WithdrawValidatorCommission",
+			Handler:    _Msg_WithdrawValidatorCommission_Handler,
+		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
+		{
 			MethodName: "WithdrawAllTokenizeShareRecordReward",
 			Handler:    _Msg_WithdrawAllTokenizeShareRecordReward_Handler,
 		},
@@ -1008,6 +1492,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FundCommunityPool",
 			Handler:    _Msg_FundCommunityPool_Handler,
 		},
+		{
+			MethodName: "SetAutoRestake",
+			Handler:    _Msg_SetAutoRestake_Handler,
+		},
+		{
+			MethodName: "SetCommissionRecipients",
+			Handler:    _Msg_SetCommissionRecipients_Handler,
+		},
+		{
+			MethodName: "SweepTokenizeShareRecord",
+			Handler:    _Msg_SweepTokenizeShareRecord_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "distribution/v1beta1/tx.proto",
@@ -1292,6 +1788,34 @@ func (m *MsgWithdrawAllTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []by
 	_ = i
 	var l int
 	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartRecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartRecordId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecordIds) > 0 {
+		dAtA2 := make([]byte, len(m.RecordIds)*10)
+		var j1 int
+		for _, num := range m.RecordIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.OwnerAddress) > 0 {
 		i -= len(m.OwnerAddress)
 		copy(dAtA[i:], m.OwnerAddress)
@@ -1322,6 +1846,25 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(d
 	_ = i
 	var l int
 	_ = l
+	if m.NextRecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NextRecordId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1392,138 +1935,360 @@ func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetAutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetWithdrawAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetAutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAutoRestakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawDelegatorReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetAutoRestakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetAutoRestakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawValidatorCommission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetCommissionRecipients) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawValidatorCommissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgSetCommissionRecipients) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetCommissionRecipients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.RecordId != 0 {
-		n += 1 + sovTx(uint64(m.RecordId))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetCommissionRecipientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawAllTokenizeShareRecordReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetCommissionRecipientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCommissionRecipientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepTokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepTokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepTokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepTokenizeShareRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepTokenizeShareRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepTokenizeShareRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawValidatorCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawValidatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovTx(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawAllTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.OwnerAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.RecordIds) > 0 {
+		l = 0
+		for _, e := range m.RecordIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.StartRecordId != 0 {
+		n += 1 + sovTx(uint64(m.StartRecordId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
 	return n
 }
 
@@ -1533,6 +2298,15 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.NextRecordId != 0 {
+		n += 1 + sovTx(uint64(m.NextRecordId))
+	}
 	return n
 }
 
@@ -1564,13 +2338,463 @@ func (m *MsgFundCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgSetAutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoRestakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCommissionRecipients) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetCommissionRecipientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSweepTokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovTx(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *MsgSweepTokenizeShareRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1593,15 +2817,15 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1629,11 +2853,145 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1661,8 +3019,27 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1684,7 +3061,7 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1707,10 +3084,10 @@ func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1734,7 +3111,7 @@ func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAllTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1757,15 +3134,15 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1793,13 +3170,89 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RecordIds = append(m.RecordIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RecordIds) == 0 {
+					m.RecordIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RecordIds = append(m.RecordIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIds", wireType)
 			}
-			var stringLen uint64
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRecordId", wireType)
+			}
+			m.StartRecordId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1809,24 +3262,30 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartRecordId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1848,7 +3307,7 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1871,15 +3330,15 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1906,11 +3365,30 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, TokenizeShareRecordWithdrawal{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRecordId", wireType)
+			}
+			m.NextRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1932,7 +3410,7 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
+func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1955,15 +3433,49 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1991,7 +3503,7 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2014,7 +3526,7 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2037,46 +3549,12 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2098,7 +3576,7 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoRestake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2121,15 +3599,15 @@ func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoRestake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2157,13 +3635,45 @@ func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.RecordId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2173,11 +3683,12 @@ func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecordId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2199,7 +3710,7 @@ func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoRestakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2222,10 +3733,10 @@ func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoRestakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoRestakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2249,7 +3760,7 @@ func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *MsgWithdrawAllTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCommissionRecipients) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2272,15 +3783,15 @@ func (m *MsgWithdrawAllTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCommissionRecipients: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCommissionRecipients: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2308,7 +3819,41 @@ func (m *MsgWithdrawAllTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, CommissionRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2331,7 +3876,7 @@ func (m *MsgWithdrawAllTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCommissionRecipientsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2354,10 +3899,10 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCommissionRecipientsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCommissionRecipientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2381,7 +3926,7 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepTokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2404,17 +3949,17 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepTokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepTokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2424,31 +3969,29 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
-			var stringLen uint64
+			m.RecordId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2458,24 +4001,11 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RecordId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2497,7 +4027,7 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepTokenizeShareRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2520,12 +4050,46 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepTokenizeShareRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepTokenizeShareRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
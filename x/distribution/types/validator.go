@@ -3,10 +3,16 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultRewardRateWindow is the trailing window validator reward rates are measured over by default.
+const DefaultRewardRateWindow = 7 * 24 * time.Hour
+
 // create a new ValidatorHistoricalRewards
 func NewValidatorHistoricalRewards(cumulativeRewardRatio sdk.DecCoins, referenceCount uint32) ValidatorHistoricalRewards {
 	return ValidatorHistoricalRewards{
@@ -46,3 +52,48 @@ func (vs ValidatorSlashEvents) String() string {
 	}
 	return strings.TrimSpace(out)
 }
+
+// MaxCommissionRecipients is the maximum number of recipients the commission of a
+// validator can be split across.
+const MaxCommissionRecipients = 10
+
+// NewCommissionRecipient creates a new CommissionRecipient
+func NewCommissionRecipient(addr sdk.AccAddress, weight sdk.Dec) CommissionRecipient {
+	return CommissionRecipient{
+		Address: addr.String(),
+		Weight:  weight,
+	}
+}
+
+// ValidateCommissionRecipients checks that the recipients have valid and distinct
+// addresses and positive weights summing to one. An empty list is valid.
+func ValidateCommissionRecipients(recipients []CommissionRecipient) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+	if len(recipients) > MaxCommissionRecipients {
+		return errorsmod.Wrapf(ErrInvalidCommissionRecipients, "at most %d recipients allowed, got %d", MaxCommissionRecipients, len(recipients))
+	}
+
+	seen := make(map[string]bool, len(recipients))
+	total := sdk.ZeroDec()
+	for _, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidCommissionRecipients, "invalid recipient address %s: %s", recipient.Address, err)
+		}
+		if seen[recipient.Address] {
+			return errorsmod.Wrapf(ErrInvalidCommissionRecipients, "duplicate recipient %s", recipient.Address)
+		}
+		seen[recipient.Address] = true
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidCommissionRecipients, "weight of %s must be positive", recipient.Address)
+		}
+		total = total.Add(recipient.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidCommissionRecipients, "weights must sum to one, got %s", total)
+	}
+	return nil
+}
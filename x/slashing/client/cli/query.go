@@ -27,6 +27,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryMissedBlocks(),
+		GetCmdQueryInfractionHistory(),
+		GetCmdQueryValidatorsAtRisk(),
 	)
 
 	return slashingQueryCmd
@@ -70,6 +73,128 @@ $ <appd> query slashing signing-info '{"@type":"/cosmos.crypto.ed25519.PubKey","
 	return cmd
 }
 
+// GetCmdQueryMissedBlocks implements the command to query a validator's missed blocks.
+func GetCmdQueryMissedBlocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "missed-blocks [validator-conspub]",
+		Short: "Query the blocks a validator missed in the current signed blocks window",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the blocks that validator missed in the current window
+and the number of further blocks it can miss before being jailed for downtime:
+
+$ <appd> query slashing missed-blocks '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}'
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryMissedBlocksRequest{ConsAddress: consAddr.String()}
+			res, err := queryClient.MissedBlocks(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInfractionHistory implements the command to query a validator's infraction history.
+func GetCmdQueryInfractionHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "infraction-history [validator-conspub]",
+		Short: "Query the recent infractions a validator was punished for",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the recent infractions that validator was
+slashed, jailed or tombstoned for, from oldest to newest:
+
+$ <appd> query slashing infraction-history '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}'
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryInfractionHistoryRequest{ConsAddress: consAddr.String(), Pagination: pageReq}
+			res, err := queryClient.InfractionHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "infraction history")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorsAtRisk implements the command to query the validators near the downtime threshold.
+func GetCmdQueryValidatorsAtRisk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators-at-risk",
+		Short: "Query the validators near the downtime threshold",
+		Long: strings.TrimSpace(`validators that have missed at least the downtime warning threshold of the blocks they may miss before being jailed:
+
+$ <appd> query slashing validators-at-risk
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorsAtRiskRequest{Pagination: pageReq}
+			res, err := queryClient.ValidatorsAtRisk(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validators at risk")
+
+	return cmd
+}
+
 // GetCmdQuerySigningInfos implements the command to query signing infos.
 func GetCmdQuerySigningInfos() *cobra.Command {
 	cmd := &cobra.Command{
@@ -20,7 +20,10 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	slashingTxCmd.AddCommand(NewUnjailTxCmd())
+	slashingTxCmd.AddCommand(
+		NewUnjailTxCmd(),
+		NewSetUnjailerTxCmd(),
+	)
 	return slashingTxCmd
 }
 
@@ -32,15 +35,68 @@ func NewUnjailTxCmd() *cobra.Command {
 		Long: `unjail a jailed validator:
 
 $ <appd> tx slashing unjail --from mykey
+
+If unjailing requires a validator bond, a validator bond holder can unjail the validator on its behalf:
+
+$ <appd> tx slashing unjail --validator cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddrStr, err := cmd.Flags().GetString(FlagAddressValidator)
+			if err != nil {
+				return err
+			}
+
+			var msg *types.MsgUnjail
+			if valAddrStr != "" {
+				valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgUnjailByValidatorBondHolder(valAddr, clientCtx.GetFromAddress())
+			} else {
+				msg = types.NewMsgUnjail(sdk.ValAddress(clientCtx.GetFromAddress()))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAddressValidator, "", "The validator to unjail as one of its validator bond holders")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetUnjailerTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-unjailer [unjailer-address]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "designate the validator bond holder allowed to unjail your validator",
+		Long: `designate the validator bond holder allowed to unjail your validator on its behalf,
+or remove the designated unjailer if no address is provided:
+
+$ <appd> tx slashing set-unjailer cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			valAddr := clientCtx.GetFromAddress()
 
-			msg := types.NewMsgUnjail(sdk.ValAddress(valAddr))
+			var unjailer sdk.AccAddress
+			if len(args) > 0 {
+				unjailer, err = sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetUnjailer(sdk.ValAddress(clientCtx.GetFromAddress()), unjailer)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+)
+
+// EvidenceKeeper wraps the slashing keeper for the evidence module so that
+// equivocation handling honors the double sign infraction params. The evidence
+// module always jails double signers forever and tombstones them; with double
+// sign infraction params configured, the jail duration is taken from the params
+// and the validator is only tombstoned if the params require it.
+type EvidenceKeeper struct {
+	Keeper
+}
+
+// NewEvidenceKeeper creates an evidence module adapter around the slashing keeper
+func NewEvidenceKeeper(k Keeper) EvidenceKeeper {
+	return EvidenceKeeper{Keeper: k}
+}
+
+// JailUntil jails the validator until the end of the double sign jail duration
+// if one is configured, or until the given time otherwise.
+func (k EvidenceKeeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time) {
+	if params, found := k.GetInfractionParams(ctx, types.InfractionDoubleSign); found {
+		jailTime = ctx.BlockHeader().Time.Add(params.JailDuration)
+	}
+
+	k.Keeper.JailUntil(ctx, consAddr, jailTime)
+}
+
+// Tombstone tombstones the validator unless the double sign infraction params
+// disable tombstoning.
+func (k EvidenceKeeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	if params, found := k.GetInfractionParams(ctx, types.InfractionDoubleSign); found && !params.Tombstone {
+		return
+	}
+
+	k.Keeper.Tombstone(ctx, consAddr)
+}
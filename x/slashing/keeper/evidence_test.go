@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+)
+
+func TestEvidenceKeeperDoubleSignInfractionParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1_000_000, 0)})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	power := int64(100)
+	amt := tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	app.SlashingKeeper.AfterValidatorBonded(ctx, consAddr, nil)
+
+	doubleSignParams := types.InfractionParams{
+		InfractionType: types.InfractionDoubleSign,
+		SlashFraction:  sdk.NewDecWithPrec(1, 1),
+		JailDuration:   time.Hour,
+		Tombstone:      false,
+	}
+	slashingParams := app.SlashingKeeper.GetParams(ctx)
+	slashingParams.InfractionParams = []types.InfractionParams{doubleSignParams}
+	app.SlashingKeeper.SetParams(ctx, slashingParams)
+
+	// handle the equivocation as the evidence module does
+	ek := keeper.NewEvidenceKeeper(app.SlashingKeeper)
+	ek.Slash(ctx, consAddr, ek.SlashFractionDoubleSign(ctx), power, ctx.BlockHeight(), sdkstaking.DoubleSign)
+	ek.Jail(ctx, consAddr)
+	ek.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime)
+	ek.Tombstone(ctx, consAddr)
+
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.False(t, info.Tombstoned)
+	require.Equal(t, ctx.BlockTime().Add(doubleSignParams.JailDuration), info.JailedUntil)
+
+	expTokens := amt.Sub(amt.ToDec().Mul(doubleSignParams.SlashFraction).TruncateInt())
+	validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, validator.IsJailed())
+	require.Equal(t, expTokens, validator.GetTokens())
+
+	// the infraction record is completed by the jailing
+	require.Equal(t, []types.InfractionRecord{types.NewInfractionRecord(
+		consAddr, ctx.BlockHeight(), types.InfractionDoubleSign, doubleSignParams.SlashFraction, info.JailedUntil, false,
+	)}, app.SlashingKeeper.GetInfractionRecords(ctx, consAddr))
+
+	// without double sign infraction params, the evidence module behavior is unchanged
+	slashingParams.InfractionParams = []types.InfractionParams{}
+	app.SlashingKeeper.SetParams(ctx, slashingParams)
+	ek.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime)
+	ek.Tombstone(ctx, consAddr)
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.True(t, info.Tombstoned)
+	require.True(t, evidencetypes.DoubleSignJailEndTime.Equal(info.JailedUntil))
+
+	records := app.SlashingKeeper.GetInfractionRecords(ctx, consAddr)
+	require.Len(t, records, 1)
+	require.True(t, records[0].Tombstoned)
+}
@@ -41,6 +41,29 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	// records are numbered from oldest to newest for each validator
+	sequences := make(map[string]uint64)
+	for _, record := range data.InfractionRecords {
+		address, err := sdk.ConsAddressFromBech32(record.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetInfractionRecord(ctx, address, sequences[record.Address], record)
+		sequences[record.Address]++
+	}
+
+	for _, unjailer := range data.Unjailers {
+		valAddr, err := sdk.ValAddressFromBech32(unjailer.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		unjailerAddr, err := sdk.AccAddressFromBech32(unjailer.UnjailerAddress)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetUnjailer(ctx, valAddr, unjailerAddr)
+	}
+
 	keeper.SetParams(ctx, data.Params)
 }
 
@@ -68,5 +91,20 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	infractionRecords := make([]types.InfractionRecord, 0)
+	keeper.IterateInfractionRecords(ctx, func(record types.InfractionRecord) (stop bool) {
+		infractionRecords = append(infractionRecords, record)
+		return false
+	})
+
+	unjailers := make([]types.ValidatorUnjailer, 0)
+	keeper.IterateUnjailers(ctx, func(valAddr sdk.ValAddress, unjailer sdk.AccAddress) (stop bool) {
+		unjailers = append(unjailers, types.ValidatorUnjailer{
+			ValidatorAddress: valAddr.String(),
+			UnjailerAddress:  unjailer.String(),
+		})
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, infractionRecords, unjailers)
 }
@@ -28,15 +28,46 @@ func TestExportAndInitGenesis(t *testing.T) {
 
 	app.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrDels[0]), info1)
 	app.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrDels[1]), info2)
+
+	record1 := types.NewInfractionRecord(sdk.ConsAddress(addrDels[0]), 2, types.InfractionDowntime,
+		sdk.NewDecWithPrec(1, 2), time.Now().UTC().Add(100000000000), false)
+	record2 := types.NewInfractionRecord(sdk.ConsAddress(addrDels[0]), 3, types.InfractionDoubleSign,
+		sdk.NewDecWithPrec(5, 2), time.Now().UTC().Add(100000000000), true)
+	app.SlashingKeeper.RecordInfraction(ctx, sdk.ConsAddress(addrDels[0]), record1)
+	app.SlashingKeeper.RecordInfraction(ctx, sdk.ConsAddress(addrDels[0]), record2)
+	app.SlashingKeeper.SetUnjailer(ctx, sdk.ValAddress(addrDels[0]), addrDels[1])
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), 1, true)
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), 2, false)
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), 3, true)
 	genesisState := app.SlashingKeeper.ExportGenesis(ctx)
 
 	require.Equal(t, genesisState.Params, testslashing.TestParams())
 	require.Len(t, genesisState.SigningInfos, 2)
 	require.Equal(t, genesisState.SigningInfos[0].ValidatorSigningInfo, info1)
+	require.Equal(t, []types.InfractionRecord{record1, record2}, genesisState.InfractionRecords)
+	require.Equal(t, []types.ValidatorUnjailer{{
+		ValidatorAddress: sdk.ValAddress(addrDels[0]).String(),
+		UnjailerAddress:  addrDels[1].String(),
+	}}, genesisState.Unjailers)
+
+	// only the missed blocks are exported, signed blocks are omitted
+	require.Equal(t, []types.ValidatorMissedBlocks{
+		{
+			Address:      sdk.ConsAddress(addrDels[0]).String(),
+			MissedBlocks: []types.MissedBlock{types.NewMissedBlock(1, true), types.NewMissedBlock(3, true)},
+		},
+		{
+			Address:      sdk.ConsAddress(addrDels[1]).String(),
+			MissedBlocks: []types.MissedBlock{},
+		},
+	}, genesisState.MissedBlocks)
 
 	// Tombstone validators after genesis shouldn't effect genesis state
 	app.SlashingKeeper.Tombstone(ctx, sdk.ConsAddress(addrDels[0]))
 	app.SlashingKeeper.Tombstone(ctx, sdk.ConsAddress(addrDels[1]))
+	app.SlashingKeeper.RemoveUnjailer(ctx, sdk.ValAddress(addrDels[0]))
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), 1, false)
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), 3, false)
 
 	ok := app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(addrDels[0]))
 	require.True(t, ok)
@@ -57,4 +88,16 @@ func TestExportAndInitGenesis(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, info1, newInfo1)
 	require.Equal(t, info2, newInfo2)
+
+	// the infraction history is restored
+	require.Equal(t, []types.InfractionRecord{record1, record2}, app.SlashingKeeper.GetInfractionRecords(ctx, sdk.ConsAddress(addrDels[0])))
+	require.Empty(t, app.SlashingKeeper.GetInfractionRecords(ctx, sdk.ConsAddress(addrDels[1])))
+
+	// the missed blocks are restored
+	require.Equal(t, genesisState.MissedBlocks[0].MissedBlocks, app.SlashingKeeper.GetValidatorMissedBlocks(ctx, sdk.ConsAddress(addrDels[0])))
+
+	// the designated unjailer is restored
+	unjailer, found := app.SlashingKeeper.GetUnjailer(ctx, sdk.ValAddress(addrDels[0]))
+	require.True(t, found)
+	require.Equal(t, addrDels[1], unjailer)
 }
@@ -45,7 +45,7 @@ func (k Keeper) SigningInfo(c context.Context, req *types.QuerySigningInfoReques
 		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
 	}
 
-	return &types.QuerySigningInfoResponse{ValSigningInfo: signingInfo}, nil
+	return &types.QuerySigningInfoResponse{ValSigningInfo: k.withDecayedDowntimeOffenseCount(ctx, signingInfo)}, nil
 }
 
 func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequest) (*types.QuerySigningInfosResponse, error) {
@@ -64,7 +64,7 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 		if err != nil {
 			return err
 		}
-		signInfos = append(signInfos, info)
+		signInfos = append(signInfos, k.withDecayedDowntimeOffenseCount(ctx, info))
 		return nil
 	})
 	if err != nil {
@@ -72,3 +72,123 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+func (k Keeper) InfractionHistory(c context.Context, req *types.QueryInfractionHistoryRequest) (*types.QueryInfractionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	var records []types.InfractionRecord
+
+	recordStore := prefix.NewStore(store, types.InfractionRecordPrefixKey(consAddr))
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.InfractionRecord
+		err := k.cdc.Unmarshal(value, &record)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryInfractionHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ValidatorsAtRisk(c context.Context, req *types.QueryValidatorsAtRiskRequest) (*types.QueryValidatorsAtRiskResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	maxMissed := k.SignedBlocksWindow(ctx) - k.MinSignedPerWindow(ctx)
+	warningMissed := k.DowntimeWarningMissedBlocks(ctx)
+	var validators []types.ValidatorAtRisk
+
+	sigInfoStore := prefix.NewStore(store, types.ValidatorSigningInfoKeyPrefix)
+	pageRes, err := query.FilteredPaginate(sigInfoStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var info types.ValidatorSigningInfo
+		err := k.cdc.Unmarshal(value, &info)
+		if err != nil {
+			return false, err
+		}
+
+		if info.Tombstoned || warningMissed <= 0 || info.MissedBlocksCounter < warningMissed {
+			return false, nil
+		}
+
+		if accumulate {
+			blocksRemaining := maxMissed - info.MissedBlocksCounter
+			if blocksRemaining < 0 {
+				blocksRemaining = 0
+			}
+			validators = append(validators, types.ValidatorAtRisk{
+				Address:             info.Address,
+				MissedBlocksCounter: info.MissedBlocksCounter,
+				BlocksRemaining:     blocksRemaining,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryValidatorsAtRiskResponse{Validators: validators, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MissedBlocks(c context.Context, req *types.QueryMissedBlocksRequest) (*types.QueryMissedBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	// the last processed signature was for the block before the current one and
+	// was recorded at index (IndexOffset - 1) % window
+	window := k.SignedBlocksWindow(ctx)
+	lastIndex := signingInfo.IndexOffset - 1
+	missedBlocks := k.GetValidatorMissedBlocks(ctx, consAddr)
+	missedOffsets := make([]int64, 0, len(missedBlocks))
+	for _, missedBlock := range missedBlocks {
+		missedOffsets = append(missedOffsets, ((lastIndex-missedBlock.Index)%window+window)%window)
+	}
+
+	maxMissed := window - k.MinSignedPerWindow(ctx)
+	blocksRemaining := maxMissed - signingInfo.MissedBlocksCounter
+	if blocksRemaining < 0 {
+		blocksRemaining = 0
+	}
+
+	return &types.QueryMissedBlocksResponse{
+		MissedBlocks:        missedBlocks,
+		MissedOffsets:       missedOffsets,
+		MissedBlocksCounter: signingInfo.MissedBlocksCounter,
+		BlocksRemaining:     blocksRemaining,
+	}, nil
+}
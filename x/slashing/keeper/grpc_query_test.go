@@ -105,6 +105,89 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCMissedBlocks() {
+	queryClient := suite.queryClient
+
+	missedResp, err := queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ConsAddress: ""})
+	suite.Error(err)
+	suite.Nil(missedResp)
+
+	_, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: sdk.ConsAddress([]byte("unknown")).String()})
+	suite.Error(err)
+
+	// the validator has signed three blocks and missed the first and last
+	ctx := suite.ctx.WithBlockHeight(20)
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+	info, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	suite.True(found)
+	info.MissedBlocksCounter = 2
+	suite.app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	suite.app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 0, true)
+	suite.app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 2, true)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.SlashingKeeper)
+	queryClient = types.NewQueryClient(queryHelper)
+
+	missedResp, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: consAddr.String()})
+	suite.NoError(err)
+	suite.Equal([]types.MissedBlock{types.NewMissedBlock(0, true), types.NewMissedBlock(2, true)}, missedResp.MissedBlocks)
+	suite.Equal([]int64{2, 0}, missedResp.MissedOffsets)
+	suite.Equal(int64(2), missedResp.MissedBlocksCounter)
+
+	// 1000 block window with 50% min signed allows 500 missed blocks
+	suite.Equal(int64(498), missedResp.BlocksRemaining)
+}
+
+func (suite *SlashingTestSuite) TestGRPCInfractionHistory() {
+	queryClient := suite.queryClient
+
+	historyResp, err := queryClient.InfractionHistory(gocontext.Background(), &types.QueryInfractionHistoryRequest{ConsAddress: ""})
+	suite.Error(err)
+	suite.Nil(historyResp)
+
+	// only the most recent records are kept
+	params := suite.app.SlashingKeeper.GetParams(suite.ctx)
+	params.InfractionHistoryLength = 3
+	suite.app.SlashingKeeper.SetParams(suite.ctx, params)
+
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+	var records []types.InfractionRecord
+	for height := int64(1); height <= 5; height++ {
+		record := types.NewInfractionRecord(consAddr, height, types.InfractionDowntime,
+			sdk.NewDecWithPrec(1, 2), time.Unix(height, 0).UTC(), false)
+		suite.app.SlashingKeeper.RecordInfraction(suite.ctx, consAddr, record)
+		records = append(records, record)
+	}
+	suite.Equal(records[2:], suite.app.SlashingKeeper.GetInfractionRecords(suite.ctx, consAddr))
+
+	historyResp, err = queryClient.InfractionHistory(gocontext.Background(),
+		&types.QueryInfractionHistoryRequest{ConsAddress: consAddr.String()})
+	suite.NoError(err)
+	suite.Equal(records[2:], historyResp.Records)
+
+	historyResp, err = queryClient.InfractionHistory(gocontext.Background(),
+		&types.QueryInfractionHistoryRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.NoError(err)
+	suite.Equal(records[2:4], historyResp.Records)
+	suite.NotNil(historyResp.Pagination.NextKey)
+	suite.Equal(uint64(3), historyResp.Pagination.Total)
+
+	// other validators have no history
+	historyResp, err = queryClient.InfractionHistory(gocontext.Background(),
+		&types.QueryInfractionHistoryRequest{ConsAddress: sdk.ConsAddress(suite.addrDels[1]).String()})
+	suite.NoError(err)
+	suite.Empty(historyResp.Records)
+
+	// a zero history length disables recording
+	params.InfractionHistoryLength = 0
+	suite.app.SlashingKeeper.SetParams(suite.ctx, params)
+	suite.app.SlashingKeeper.RecordInfraction(suite.ctx, consAddr, records[0])
+	suite.Equal(records[2:], suite.app.SlashingKeeper.GetInfractionRecords(suite.ctx, consAddr))
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
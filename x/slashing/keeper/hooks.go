@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
 func (k Keeper) AfterValidatorBonded(ctx sdk.Context, address sdk.ConsAddress, _ sdk.ValAddress) error {
@@ -41,9 +42,11 @@ func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) e
 	return k.AddPubkey(ctx, consPk)
 }
 
-// AfterValidatorRemoved deletes the address-pubkey relation when a validator is removed,
-func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress) error {
+// AfterValidatorRemoved deletes the address-pubkey relation and the designated
+// unjailer when a validator is removed
+func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
+	k.RemoveUnjailer(ctx, valAddr)
 	return nil
 }
 
@@ -65,8 +68,8 @@ func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, v
 }
 
 // Implements sdk.ValidatorHooks
-func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, _ sdk.ValAddress) error {
-	return h.k.AfterValidatorRemoved(ctx, consAddr)
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.k.AfterValidatorRemoved(ctx, consAddr, valAddr)
 }
 
 // Implements sdk.ValidatorHooks
@@ -79,6 +82,26 @@ func (h Hooks) BeforeTokenizeShareRecordRemoved(_ sdk.Context, _ uint64) error {
 	return nil
 }
 
+func (h Hooks) AfterTokenizeShareRecordCreated(_ sdk.Context, _ uint64) error {
+	return nil
+}
+
+func (h Hooks) AfterTokenizeShareRecordTransferred(_ sdk.Context, _ uint64, _, _ sdk.AccAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterSharesRedeemed(_ sdk.Context, _ uint64, _ sdk.AccAddress, _ sdk.ValAddress, _ sdk.Int) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBondChanged(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterTokenizeShareLockChanged(_ sdk.Context, _ sdk.AccAddress, _ stakingtypes.TokenizeShareLockStatus) error {
+	return nil
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
 	return nil
 }
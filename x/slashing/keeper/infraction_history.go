@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+)
+
+// RecordInfraction appends a record to a validator's infraction history and
+// prunes the history down to the most recent InfractionHistoryLength records.
+func (k Keeper) RecordInfraction(ctx sdk.Context, consAddr sdk.ConsAddress, record types.InfractionRecord) {
+	historyLength := k.InfractionHistoryLength(ctx)
+	if historyLength <= 0 {
+		return
+	}
+
+	k.AppendInfractionRecord(ctx, consAddr, record)
+	k.pruneInfractionRecords(ctx, consAddr, historyLength)
+}
+
+// AppendInfractionRecord stores a record after the newest record in a
+// validator's infraction history, without pruning the history.
+func (k Keeper) AppendInfractionRecord(ctx sdk.Context, consAddr sdk.ConsAddress, record types.InfractionRecord) {
+	sequence := uint64(0)
+	if key, _, found := k.lastInfractionRecord(ctx, consAddr); found {
+		sequence = infractionRecordSequence(key) + 1
+	}
+
+	k.SetInfractionRecord(ctx, consAddr, sequence, record)
+}
+
+// SetInfractionRecord stores a record at the given position of a validator's infraction history
+func (k Keeper) SetInfractionRecord(ctx sdk.Context, consAddr sdk.ConsAddress, sequence uint64, record types.InfractionRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.InfractionRecordKey(consAddr, sequence), k.cdc.MustMarshal(&record))
+}
+
+// GetInfractionRecords returns a validator's infraction history, from oldest to newest
+func (k Keeper) GetInfractionRecords(ctx sdk.Context, consAddr sdk.ConsAddress) []types.InfractionRecord {
+	records := []types.InfractionRecord{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.InfractionRecordPrefixKey(consAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.InfractionRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// IterateInfractionRecords iterates over the infraction histories of all
+// validators, from oldest to newest for each validator
+func (k Keeper) IterateInfractionRecords(ctx sdk.Context, handler func(record types.InfractionRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.InfractionRecordKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.InfractionRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
+
+// updateCurrentInfractionRecord applies an update to a validator's newest
+// infraction record if it was recorded in the current block. This completes the
+// record of an infraction whose punishment is applied over several calls, like
+// equivocation evidence which is slashed, jailed and tombstoned separately.
+func (k Keeper) updateCurrentInfractionRecord(ctx sdk.Context, consAddr sdk.ConsAddress, update func(record *types.InfractionRecord)) {
+	key, record, found := k.lastInfractionRecord(ctx, consAddr)
+	if !found || record.Height != ctx.BlockHeight() {
+		return
+	}
+
+	update(&record)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshal(&record))
+}
+
+// lastInfractionRecord returns the key and value of a validator's newest infraction record
+func (k Keeper) lastInfractionRecord(ctx sdk.Context, consAddr sdk.ConsAddress) ([]byte, types.InfractionRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.InfractionRecordPrefixKey(consAddr))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil, types.InfractionRecord{}, false
+	}
+
+	var record types.InfractionRecord
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return iterator.Key(), record, true
+}
+
+// pruneInfractionRecords deletes a validator's oldest infraction records until
+// at most historyLength remain
+func (k Keeper) pruneInfractionRecords(ctx sdk.Context, consAddr sdk.ConsAddress, historyLength int64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.InfractionRecordPrefixKey(consAddr))
+	defer iterator.Close()
+
+	var expired [][]byte
+	for kept := int64(0); iterator.Valid(); iterator.Next() {
+		if kept < historyLength {
+			kept++
+			continue
+		}
+		expired = append(expired, iterator.Key())
+	}
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
+
+// infractionRecordSequence extracts the sequence from an infraction record key
+func infractionRecordSequence(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
@@ -2,9 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"math"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkslashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 )
@@ -51,6 +55,13 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 
 	minSignedPerWindow := k.MinSignedPerWindow(ctx)
 
+	// re-arm the downtime warning once the missed blocks drop to half the warning threshold,
+	// so that a counter hovering around the threshold does not warn on every crossing
+	warningMissed := k.DowntimeWarningMissedBlocks(ctx)
+	if signInfo.DowntimeWarned && signInfo.MissedBlocksCounter <= warningMissed/2 {
+		signInfo.DowntimeWarned = false
+	}
+
 	if missed {
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -68,6 +79,29 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			"missed", signInfo.MissedBlocksCounter,
 			"threshold", minSignedPerWindow,
 		)
+
+		// warn once when the validator reaches the warning threshold
+		if !signInfo.DowntimeWarned && warningMissed > 0 && signInfo.MissedBlocksCounter >= warningMissed {
+			signInfo.DowntimeWarned = true
+			blocksRemaining := k.SignedBlocksWindow(ctx) - minSignedPerWindow - signInfo.MissedBlocksCounter
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeDowntimeWarning,
+					sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyMissedBlocks, fmt.Sprintf("%d", signInfo.MissedBlocksCounter)),
+					sdk.NewAttribute(types.AttributeKeyBlocksRemaining, fmt.Sprintf("%d", blocksRemaining)),
+					sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
+				),
+			)
+
+			logger.Info(
+				"validator is approaching the downtime threshold",
+				"height", height,
+				"validator", consAddr.String(),
+				"missed", signInfo.MissedBlocksCounter,
+				"blocks_remaining", blocksRemaining,
+			)
+		}
 	}
 
 	minHeight := signInfo.StartHeight + k.SignedBlocksWindow(ctx)
@@ -85,6 +119,12 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
+			// Repeat offenders are penalized more heavily, based on their offenses that have not yet decayed
+			priorOffenses := signInfo.DecayedDowntimeOffenseCount(ctx.BlockHeader().Time, k.DowntimeOffenseDecayPeriod(ctx))
+			slashFraction, jailDuration := k.downtimePenalty(ctx, priorOffenses)
+			signInfo.DowntimeOffenseCount = priorOffenses + 1
+			signInfo.LastDowntimeOffenseTime = ctx.BlockHeader().Time
+
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -92,17 +132,27 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyDowntimeOffenseCount, fmt.Sprintf("%d", signInfo.DowntimeOffenseCount)),
+					sdk.NewAttribute(types.AttributeKeySlashFraction, slashFraction.String()),
+					sdk.NewAttribute(types.AttributeKeyJailDuration, jailDuration.String()),
 				),
 			)
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx), sdkstaking.Downtime)
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction, sdkstaking.Downtime)
 
-			k.sk.Jail(ctx, consAddr)
+			k.jailIfNotJailed(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
+			if params, found := k.GetInfractionParams(ctx, types.InfractionDowntime); found && params.Tombstone {
+				signInfo.Tombstoned = true
+			}
+			k.RecordInfraction(ctx, consAddr, types.NewInfractionRecord(
+				consAddr, height, types.InfractionDowntime, slashFraction, signInfo.JailedUntil, signInfo.Tombstoned,
+			))
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
 			signInfo.IndexOffset = 0
+			signInfo.DowntimeWarned = false
 			k.clearValidatorMissedBlockBitArray(ctx, consAddr)
 
 			logger.Info(
@@ -111,8 +161,10 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", k.SlashFractionDowntime(ctx).String(),
+				"slashed", slashFraction.String(),
 				"jailed_until", signInfo.JailedUntil,
+				"downtime_offenses", signInfo.DowntimeOffenseCount,
+				"tombstoned", signInfo.Tombstoned,
 			)
 		} else {
 			// validator was (a) not found or (b) already jailed so we do not slash
@@ -126,3 +178,99 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	// Set the updated signing info
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
+
+// downtimePenalty returns the slash fraction and jail duration for a downtime
+// offense, escalated by the validator's number of prior offenses. The downtime
+// infraction params, if configured, replace the base penalty before escalation.
+func (k Keeper) downtimePenalty(ctx sdk.Context, priorOffenses int64) (sdk.Dec, time.Duration) {
+	baseSlashFraction, baseJailDuration := k.SlashFractionDowntime(ctx), k.DowntimeJailDuration(ctx)
+	if params, found := k.GetInfractionParams(ctx, types.InfractionDowntime); found {
+		baseSlashFraction, baseJailDuration = params.SlashFraction, params.JailDuration
+	}
+
+	slashFraction := types.EscalateDowntimePenalty(
+		baseSlashFraction, k.SlashFractionDowntimeMultiplier(ctx), priorOffenses, sdk.OneDec(),
+	)
+	jailDuration := types.EscalateDowntimePenalty(
+		sdk.NewDec(int64(baseJailDuration)), k.DowntimeJailDurationMultiplier(ctx), priorOffenses, sdk.NewDec(math.MaxInt64),
+	)
+
+	return slashFraction, time.Duration(jailDuration.TruncateInt64())
+}
+
+// HandleConsumerInfraction slashes and jails a validator for an infraction
+// reported by a consumer chain, using the consumer infraction params. If none
+// are configured, the validator is punished as for downtime without escalation.
+// It is the entry point for a consumer chain integration, which calls it once
+// the infraction has been verified.
+func (k Keeper) HandleConsumerInfraction(ctx sdk.Context, consAddr sdk.ConsAddress, power, distributionHeight int64) error {
+	logger := k.Logger(ctx)
+
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return errorsmod.Wrap(sdkslashingtypes.ErrNoValidatorForAddress, consAddr.String())
+	}
+
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return errorsmod.Wrap(sdkslashingtypes.ErrNoSigningInfoFound, consAddr.String())
+	}
+
+	// a tombstoned validator has already received its final punishment
+	if signInfo.Tombstoned {
+		logger.Info(
+			"ignored consumer infraction; validator already tombstoned",
+			"validator", consAddr.String(),
+		)
+		return nil
+	}
+
+	params, found := k.GetInfractionParams(ctx, types.InfractionConsumer)
+	if !found {
+		params = types.InfractionParams{
+			InfractionType: types.InfractionConsumer,
+			SlashFraction:  k.SlashFractionDowntime(ctx),
+			JailDuration:   k.DowntimeJailDuration(ctx),
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueConsumer),
+			sdk.NewAttribute(types.AttributeKeyInfractionType, types.InfractionConsumer.String()),
+			sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, params.SlashFraction.String()),
+			sdk.NewAttribute(types.AttributeKeyJailDuration, params.JailDuration.String()),
+			sdk.NewAttribute(types.AttributeKeyTombstoned, fmt.Sprintf("%t", params.Tombstone)),
+		),
+	)
+	// the staking module has no consumer infraction type and only logs the type
+	k.sk.Slash(ctx, consAddr, distributionHeight, power, params.SlashFraction, sdkstaking.InfractionEmpty)
+
+	k.jailIfNotJailed(ctx, consAddr)
+
+	// never shorten a jailing already in effect
+	if jailedUntil := ctx.BlockHeader().Time.Add(params.JailDuration); jailedUntil.After(signInfo.JailedUntil) {
+		signInfo.JailedUntil = jailedUntil
+	}
+	if params.Tombstone {
+		signInfo.Tombstoned = true
+	}
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	k.RecordInfraction(ctx, consAddr, types.NewInfractionRecord(
+		consAddr, ctx.BlockHeight(), types.InfractionConsumer, params.SlashFraction, signInfo.JailedUntil, signInfo.Tombstoned,
+	))
+
+	logger.Info(
+		"slashing and jailing validator due to consumer infraction",
+		"validator", consAddr.String(),
+		"slashed", params.SlashFraction.String(),
+		"jailed_until", signInfo.JailedUntil,
+		"tombstoned", signInfo.Tombstoned,
+	)
+
+	return nil
+}
@@ -65,24 +65,38 @@ func (k Keeper) GetPubkey(ctx sdk.Context, a cryptotypes.Address) (cryptotypes.P
 }
 
 // Slash attempts to slash a validator. The slash is delegated to the staking
-// module to make the necessary validator changes.
+// module to make the necessary validator changes. If infraction params are
+// configured for the infraction type, their slash fraction is used instead of
+// the given fraction. The slash is added to the validator's infraction history,
+// and a subsequent JailUntil or Tombstone in the same block completes the record.
 func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64, infractionType stakingtypes.InfractionType) {
+	if params, found := k.GetInfractionParams(ctx, types.InfractionType(infractionType)); found {
+		fraction = params.SlashFraction
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
 			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
 			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
-			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
+			sdk.NewAttribute(types.AttributeKeyReason, slashReason(types.InfractionType(infractionType))),
+			sdk.NewAttribute(types.AttributeKeyInfractionType, types.InfractionType(infractionType).String()),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, fraction.String()),
 		),
 	)
 
 	k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction, infractionType)
+
+	signInfo, _ := k.GetValidatorSigningInfo(ctx, consAddr)
+	k.RecordInfraction(ctx, consAddr, types.NewInfractionRecord(
+		consAddr, ctx.BlockHeight(), types.InfractionType(infractionType), fraction, signInfo.JailedUntil, signInfo.Tombstoned,
+	))
 }
 
 // Jail attempts to jail a validator. The slash is delegated to the staking module
 // to make the necessary validator changes.
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
-	k.sk.Jail(ctx, consAddr)
+	k.jailIfNotJailed(ctx, consAddr)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
@@ -91,6 +105,29 @@ func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	)
 }
 
+// jailIfNotJailed jails a validator unless it is already jailed. The staking module
+// jails a validator while slashing it if the slash of its validator bond removes its
+// operator's self-delegation, so callers jailing after a slash must not jail it again.
+func (k Keeper) jailIfNotJailed(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	if validator := k.sk.ValidatorByConsAddr(ctx, consAddr); validator != nil && validator.IsJailed() {
+		return
+	}
+
+	k.sk.Jail(ctx, consAddr)
+}
+
+// slashReason returns the slash event reason for an infraction type
+func slashReason(infractionType types.InfractionType) string {
+	switch infractionType {
+	case types.InfractionDowntime:
+		return types.AttributeValueMissingSignature
+	case types.InfractionConsumer:
+		return types.AttributeValueConsumer
+	default:
+		return types.AttributeValueDoubleSign
+	}
+}
+
 func (k Keeper) deleteAddrPubkeyRelation(ctx sdk.Context, addr cryptotypes.Address) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.AddrPubkeyRelationKey(addr))
@@ -1,18 +1,25 @@
 package keeper_test
 
 import (
+	gocontext "context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/testslashing"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
 // Test a new validator entering the validator set
@@ -112,6 +119,58 @@ func TestHandleAlreadyJailed(t *testing.T) {
 	require.Equal(t, resultingTokens, validator.GetTokens())
 }
 
+// Test a validator jailed by the staking module while being slashed for downtime,
+// because the slash of its validator bond removed its operator's self-delegation
+func TestHandleDowntimeJailedByValidatorBondSlash(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// the whole slash is absorbed by the operator's validator bond
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	stakingParams.ValidatorBondFirstLossFraction = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, stakingParams)
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.SlashFractionDowntime = sdk.OneDec()
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	_, err := stakingkeeper.NewMsgServerImpl(app.StakingKeeper).ValidatorBond(sdk.WrapSDKContext(ctx), &stakingtypes.MsgValidatorBond{
+		DelegatorAddress: addrDels[0].String(),
+		ValidatorAddress: addr.String(),
+	})
+	require.NoError(t, err)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	height := int64(0)
+	for ; height < app.SlashingKeeper.SignedBlocksWindow(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	// missing too many blocks slashes the validator bond away without jailing the validator twice
+	for ; height < app.SlashingKeeper.SignedBlocksWindow(ctx)+(app.SlashingKeeper.SignedBlocksWindow(ctx)-app.SlashingKeeper.MinSignedPerWindow(ctx))+1; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+
+	_, found := app.StakingKeeper.GetLiquidDelegation(ctx, addrDels[0], addr)
+	require.False(t, found, "the self-delegation should be removed")
+	validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
+	require.True(t, validator.IsJailed())
+
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.GetConsAddress(val))
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeader().Time.Add(params.DowntimeJailDuration), info.JailedUntil)
+}
+
 // Test a validator dipping in and out of the validator set
 // Ensure that missed blocks are tracked correctly and that
 // the start height of the signing info is reset correctly
@@ -220,3 +279,331 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	tstaking.CheckValidator(valAddr, sdkstaking.Unbonding, true)
 }
+
+// Test a validator going down repeatedly
+// Ensure that repeat offenses escalate the jail duration and slash fraction
+func TestHandleRepeatDowntimeOffenses(t *testing.T) {
+	// initial setup
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1_000_000, 0)})
+
+	slashingParams := app.SlashingKeeper.GetParams(ctx)
+	slashingParams.DowntimeOffenseDecayPeriod = time.Hour
+	slashingParams.DowntimeJailDurationMultiplier = sdk.NewDec(2)
+	slashingParams.SlashFractionDowntimeMultiplier = sdk.NewDec(2)
+	app.SlashingKeeper.SetParams(ctx, slashingParams)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	amt := tstaking.CreateValidatorWithValPower(addr, val, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// goDown signs a full window and then misses enough blocks to be jailed
+	height := int64(0)
+	goDown := func(power int64) {
+		window := app.SlashingKeeper.SignedBlocksWindow(ctx)
+		maxMissed := window - app.SlashingKeeper.MinSignedPerWindow(ctx)
+		for end := height + window; height < end; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+		}
+		for end := height + maxMissed + 1; height < end; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		}
+		staking.EndBlocker(ctx, app.StakingKeeper)
+		tstaking.CheckValidator(addr, sdkstaking.Unbonding, true)
+	}
+
+	// first offense uses the base penalty
+	goDown(100)
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(1), info.DowntimeOffenseCount)
+	require.Equal(t, ctx.BlockTime(), info.LastDowntimeOffenseTime)
+	require.Equal(t, ctx.BlockTime().Add(slashingParams.DowntimeJailDuration), info.JailedUntil)
+
+	expTokens := amt.Sub(amt.ToDec().Mul(slashingParams.SlashFractionDowntime).TruncateInt())
+	validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.Equal(t, expTokens, validator.GetTokens())
+
+	// the validator rejoins and goes down again before the offense decays
+	ctx = ctx.WithBlockTime(info.JailedUntil)
+	app.StakingKeeper.Unjail(ctx, consAddr)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	tstaking.CheckValidator(addr, sdkstaking.Bonded, false)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	goDown(99)
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(2), info.DowntimeOffenseCount)
+	require.Equal(t, ctx.BlockTime().Add(2*slashingParams.DowntimeJailDuration), info.JailedUntil)
+
+	escalatedFraction := slashingParams.SlashFractionDowntime.MulInt64(2)
+	expTokens = expTokens.Sub(expTokens.ToDec().Mul(escalatedFraction).TruncateInt())
+	validator, _ = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.Equal(t, expTokens, validator.GetTokens())
+
+	var slashEvent sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSlash {
+			slashEvent = event
+		}
+	}
+	require.Contains(t, slashEvent.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyDowntimeOffenseCount), Value: []byte("2")})
+	require.Contains(t, slashEvent.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeySlashFraction), Value: []byte(escalatedFraction.String())})
+
+	// once the decay period has passed twice, the count reported by queries is reset
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * slashingParams.DowntimeOffenseDecayPeriod))
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.SlashingKeeper)
+	infoResp, err := types.NewQueryClient(queryHelper).SigningInfo(gocontext.Background(),
+		&types.QuerySigningInfoRequest{ConsAddress: consAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), infoResp.ValSigningInfo.DowntimeOffenseCount)
+}
+
+// Test that downtime infraction params replace the base downtime penalty
+func TestHandleDowntimeInfractionParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1_000_000, 0)})
+
+	downtimeParams := types.InfractionParams{
+		InfractionType: types.InfractionDowntime,
+		SlashFraction:  sdk.NewDecWithPrec(1, 1),
+		JailDuration:   time.Hour,
+		Tombstone:      true,
+	}
+	slashingParams := app.SlashingKeeper.GetParams(ctx)
+	slashingParams.InfractionParams = []types.InfractionParams{downtimeParams}
+	app.SlashingKeeper.SetParams(ctx, slashingParams)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	power := int64(100)
+	amt := tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	window := app.SlashingKeeper.SignedBlocksWindow(ctx)
+	maxMissed := window - app.SlashingKeeper.MinSignedPerWindow(ctx)
+	height := int64(0)
+	for ; height < window; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+	for end := height + maxMissed + 1; height < end; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	tstaking.CheckValidator(addr, sdkstaking.Unbonding, true)
+
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.True(t, info.Tombstoned)
+	require.Equal(t, ctx.BlockTime().Add(downtimeParams.JailDuration), info.JailedUntil)
+	require.Equal(t, []types.InfractionRecord{types.NewInfractionRecord(
+		consAddr, height-1, types.InfractionDowntime, downtimeParams.SlashFraction, info.JailedUntil, true,
+	)}, app.SlashingKeeper.GetInfractionRecords(ctx, consAddr))
+
+	expTokens := amt.Sub(amt.ToDec().Mul(downtimeParams.SlashFraction).TruncateInt())
+	validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.Equal(t, expTokens, validator.GetTokens())
+}
+
+// Test that consumer infractions are punished with the consumer infraction
+// params, falling back to the downtime penalty
+func TestHandleConsumerInfraction(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1_000_000, 0)})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	power := int64(100)
+	amt := tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], power, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], pks[1], power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	for _, pk := range pks {
+		app.SlashingKeeper.AfterValidatorBonded(ctx, sdk.ConsAddress(pk.Address()), nil)
+	}
+
+	// without consumer infraction params, the downtime penalty applies
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	require.NoError(t, app.SlashingKeeper.HandleConsumerInfraction(ctx, consAddr, power, ctx.BlockHeight()))
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.False(t, info.Tombstoned)
+	require.Equal(t, ctx.BlockTime().Add(app.SlashingKeeper.DowntimeJailDuration(ctx)), info.JailedUntil)
+
+	expTokens := amt.Sub(amt.ToDec().Mul(app.SlashingKeeper.SlashFractionDowntime(ctx)).TruncateInt())
+	validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, validator.IsJailed())
+	require.Equal(t, expTokens, validator.GetTokens())
+
+	// with consumer infraction params, they determine the penalty
+	consumerParams := types.InfractionParams{
+		InfractionType: types.InfractionConsumer,
+		SlashFraction:  sdk.NewDecWithPrec(2, 1),
+		JailDuration:   time.Hour,
+		Tombstone:      true,
+	}
+	slashingParams := app.SlashingKeeper.GetParams(ctx)
+	slashingParams.InfractionParams = []types.InfractionParams{consumerParams}
+	app.SlashingKeeper.SetParams(ctx, slashingParams)
+
+	consAddr = sdk.ConsAddress(pks[1].Address())
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.SlashingKeeper.HandleConsumerInfraction(ctx, consAddr, power, ctx.BlockHeight()))
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.True(t, info.Tombstoned)
+	require.Equal(t, ctx.BlockTime().Add(consumerParams.JailDuration), info.JailedUntil)
+
+	expTokens = amt.Sub(amt.ToDec().Mul(consumerParams.SlashFraction).TruncateInt())
+	validator, _ = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, validator.IsJailed())
+	require.Equal(t, expTokens, validator.GetTokens())
+
+	var slashEvent sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSlash {
+			slashEvent = event
+		}
+	}
+	require.Contains(t, slashEvent.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyReason), Value: []byte(types.AttributeValueConsumer)})
+
+	// the infraction is recorded in the validator's history
+	require.Equal(t, []types.InfractionRecord{types.NewInfractionRecord(
+		consAddr, ctx.BlockHeight(), types.InfractionConsumer, consumerParams.SlashFraction, info.JailedUntil, true,
+	)}, app.SlashingKeeper.GetInfractionRecords(ctx, consAddr))
+
+	// a tombstoned validator is not punished again
+	require.NoError(t, app.SlashingKeeper.HandleConsumerInfraction(ctx, consAddr, power, ctx.BlockHeight()))
+	validator, _ = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.Equal(t, expTokens, validator.GetTokens())
+
+	// unknown validators are rejected
+	require.Error(t, app.SlashingKeeper.HandleConsumerInfraction(ctx, sdk.ConsAddress(addrDels[0]), power, ctx.BlockHeight()))
+}
+
+// Test that a validator approaching the downtime threshold is warned once and
+// reported as at risk
+func TestDowntimeWarning(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1_000_000, 0)})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	power := int64(100)
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	window := app.SlashingKeeper.SignedBlocksWindow(ctx)
+	maxMissed := window - app.SlashingKeeper.MinSignedPerWindow(ctx)
+	warningMissed := app.SlashingKeeper.DowntimeWarningMissedBlocks(ctx)
+	require.Equal(t, app.SlashingKeeper.DowntimeWarningThreshold(ctx).MulInt64(maxMissed).Ceil().TruncateInt64(), warningMissed)
+	require.True(t, warningMissed > 0 && warningMissed <= maxMissed)
+
+	height := int64(0)
+	for ; height < window; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	atRisk := func() []types.ValidatorAtRisk {
+		res, err := app.SlashingKeeper.ValidatorsAtRisk(sdk.WrapSDKContext(ctx), &types.QueryValidatorsAtRiskRequest{})
+		require.NoError(t, err)
+		return res.Validators
+	}
+
+	// miss blocks up to the warning threshold, collecting the warnings
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	for end := height + warningMissed - 1; height < end; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+	require.Empty(t, atRisk())
+
+	countWarnings := func() (warnings int, last sdk.Event) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeDowntimeWarning {
+				warnings++
+				last = event
+			}
+		}
+		return warnings, last
+	}
+	warnings, _ := countWarnings()
+	require.Zero(t, warnings)
+
+	ctx = ctx.WithBlockHeight(height)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	height++
+
+	warnings, warning := countWarnings()
+	require.Equal(t, 1, warnings)
+	blocksRemaining := maxMissed - warningMissed
+	require.Contains(t, warning.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyMissedBlocks), Value: []byte(fmt.Sprintf("%d", warningMissed))})
+	require.Contains(t, warning.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyBlocksRemaining), Value: []byte(fmt.Sprintf("%d", blocksRemaining))})
+	require.Equal(t, []types.ValidatorAtRisk{{
+		Address:             consAddr.String(),
+		MissedBlocksCounter: warningMissed,
+		BlocksRemaining:     blocksRemaining,
+	}}, atRisk())
+
+	// further missed blocks do not warn again
+	if blocksRemaining > 0 {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		height++
+		warnings, _ = countWarnings()
+		require.Equal(t, 1, warnings)
+	}
+
+	// sign or miss blocks until the missed blocks counter reaches the target
+	moveCounterTo := func(target int64) types.ValidatorSigningInfo {
+		for i := int64(0); i < 2*window; i++ {
+			info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+			require.True(t, found)
+			if info.MissedBlocksCounter == target {
+				return info
+			}
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, info.MissedBlocksCounter > target)
+			height++
+		}
+		require.FailNow(t, "missed blocks counter did not reach the target", "target %d", target)
+		return types.ValidatorSigningInfo{}
+	}
+
+	// dropping just below the threshold and crossing it again does not warn again
+	require.True(t, moveCounterTo(warningMissed-1).DowntimeWarned)
+	require.True(t, moveCounterTo(warningMissed).DowntimeWarned)
+	warnings, _ = countWarnings()
+	require.Equal(t, 1, warnings)
+
+	// once the counter drops to half the threshold, the next crossing warns again
+	require.False(t, moveCounterTo(warningMissed/2).DowntimeWarned)
+	require.True(t, moveCounterTo(warningMissed).DowntimeWarned)
+	warnings, _ = countWarnings()
+	require.Equal(t, 2, warnings)
+}
@@ -1,7 +1,12 @@
 package keeper
 
 import (
+	"encoding/binary"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -18,3 +23,59 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(_ sdk.Context) error {
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+// The legacy missed block bit array, which stored one entry per block, is
+// moved into the chunked missed block bitmap.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	type legacyEntry struct {
+		key     []byte
+		address sdk.ConsAddress
+		index   int64
+		missed  bool
+	}
+
+	// Read all legacy entries before modifying the store
+	entries := []legacyEntry{}
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayKeyPrefix)
+	for ; iter.Valid(); iter.Next() {
+		// Key format: prefix | address length | address | index (8 bytes, little endian)
+		key := iter.Key()
+		addrLen := int(key[1])
+		address := sdk.ConsAddress(key[2 : 2+addrLen])
+		index := int64(binary.LittleEndian.Uint64(key[2+addrLen:]))
+
+		var missed gogotypes.BoolValue
+		if err := m.keeper.cdc.Unmarshal(iter.Value(), &missed); err != nil {
+			iter.Close()
+			return err
+		}
+
+		entries = append(entries, legacyEntry{key: key, address: address, index: index, missed: missed.Value})
+	}
+	iter.Close()
+
+	for _, entry := range entries {
+		if entry.missed {
+			m.keeper.SetValidatorMissedBlockBitArray(ctx, entry.address, entry.index, true)
+		}
+		store.Delete(entry.key)
+	}
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// The params added since version 3 are set to their defaults, as reading a
+// param missing from the store panics.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !m.keeper.paramspace.Has(ctx, pair.Key) {
+			m.keeper.paramspace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	consAddrA, consAddrB := sdk.ConsAddress(addrDels[0]), sdk.ConsAddress(addrDels[1])
+
+	// write the legacy missed block bit array, one entry per block
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	legacyEntries := []struct {
+		address sdk.ConsAddress
+		index   int64
+		missed  bool
+	}{
+		{consAddrA, 0, true},
+		{consAddrA, 1, false},
+		{consAddrA, 50, true},
+		{consAddrB, 7, false},
+		{consAddrB, 99, true},
+	}
+	for _, entry := range legacyEntries {
+		bz := app.AppCodec().MustMarshal(&gogotypes.BoolValue{Value: entry.missed})
+		store.Set(types.ValidatorMissedBlockBitArrayKey(entry.address, entry.index), bz)
+	}
+
+	migrator := keeper.NewMigrator(app.SlashingKeeper)
+	require.NoError(t, migrator.Migrate2to3(ctx))
+
+	// every legacy entry should be removed and the missed blocks moved to the bitmap
+	for _, entry := range legacyEntries {
+		require.False(t, store.Has(types.ValidatorMissedBlockBitArrayKey(entry.address, entry.index)))
+		require.Equal(t, entry.missed, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, entry.address, entry.index))
+	}
+
+	require.Equal(t, []types.MissedBlock{types.NewMissedBlock(0, true), types.NewMissedBlock(50, true)},
+		app.SlashingKeeper.GetValidatorMissedBlocks(ctx, consAddrA))
+	require.Equal(t, []types.MissedBlock{types.NewMissedBlock(99, true)},
+		app.SlashingKeeper.GetValidatorMissedBlocks(ctx, consAddrB))
+}
+
+func TestMigrate3to4(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.SignedBlocksWindow = 1000
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	// remove the params added since version 3, as on a chain upgraded from it
+	newKeys := [][]byte{
+		types.KeyDowntimeOffenseDecayPeriod,
+		types.KeyDowntimeJailDurationMultiplier,
+		types.KeySlashFractionDowntimeMultiplier,
+		types.KeyUnjailRequiresValidatorBond,
+		types.KeyInfractionParams,
+		types.KeyInfractionHistoryLength,
+		types.KeyDowntimeWarningThreshold,
+	}
+	subspace := app.GetSubspace(types.ModuleName)
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+	for _, key := range newKeys {
+		store.Delete(key)
+		require.False(t, subspace.Has(ctx, key))
+	}
+
+	migrator := keeper.NewMigrator(app.SlashingKeeper)
+	require.NoError(t, migrator.Migrate3to4(ctx))
+
+	// the removed params are set to their defaults and the others are kept
+	defaults := types.DefaultParams()
+	params.DowntimeOffenseDecayPeriod = defaults.DowntimeOffenseDecayPeriod
+	params.DowntimeJailDurationMultiplier = defaults.DowntimeJailDurationMultiplier
+	params.SlashFractionDowntimeMultiplier = defaults.SlashFractionDowntimeMultiplier
+	params.UnjailRequiresValidatorBond = defaults.UnjailRequiresValidatorBond
+	params.InfractionParams = defaults.InfractionParams
+	params.InfractionHistoryLength = defaults.InfractionHistoryLength
+	params.DowntimeWarningThreshold = defaults.DowntimeWarningThreshold
+	require.Equal(t, params, app.SlashingKeeper.GetParams(ctx))
+}
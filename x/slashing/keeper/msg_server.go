@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkslashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 )
 
@@ -21,7 +22,9 @@ var _ types.MsgServer = msgServer{}
 
 // Unjail implements MsgServer.Unjail method.
 // Validators must submit a transaction to unjail itself after
-// having been jailed (and thus unbonded) for downtime
+// having been jailed (and thus unbonded) for downtime.
+// If unjailing requires a validator bond, a validator bond holder
+// may submit the transaction on behalf of the validator instead
 func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -29,8 +32,18 @@ func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.M
 	if valErr != nil {
 		return nil, valErr
 	}
-	err := k.Keeper.Unjail(ctx, valAddr)
-	if err != nil {
+
+	sender := msg.ValidatorAddr
+	if msg.DelegatorAddress != "" {
+		delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.Keeper.UnjailByValidatorBondHolder(ctx, valAddr, delAddr); err != nil {
+			return nil, err
+		}
+		sender = msg.DelegatorAddress
+	} else if err := k.Keeper.Unjail(ctx, valAddr); err != nil {
 		return nil, err
 	}
 
@@ -38,9 +51,50 @@ func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.M
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddr),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
 		),
 	)
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// SetUnjailer implements MsgServer.SetUnjailer method.
+// A validator operator designates the validator bond holder allowed to
+// unjail the validator on its behalf, or removes the designation
+func (k msgServer) SetUnjailer(goCtx context.Context, msg *types.MsgSetUnjailer) (*types.MsgSetUnjailerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	if valErr != nil {
+		return nil, valErr
+	}
+
+	if k.sk.Validator(ctx, valAddr) == nil {
+		return nil, sdkslashingtypes.ErrNoValidatorForAddress
+	}
+
+	if msg.UnjailerAddress == "" {
+		k.Keeper.RemoveUnjailer(ctx, valAddr)
+	} else {
+		unjailer, err := sdk.AccAddressFromBech32(msg.UnjailerAddress)
+		if err != nil {
+			return nil, err
+		}
+		k.Keeper.SetUnjailer(ctx, valAddr, unjailer)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetUnjailer,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddr),
+			sdk.NewAttribute(types.AttributeKeyUnjailer, msg.UnjailerAddress),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddr),
+		),
+	})
+
+	return &types.MsgSetUnjailerResponse{}, nil
+}
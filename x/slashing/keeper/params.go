@@ -42,6 +42,68 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DowntimeOffenseDecayPeriod - period after which a validator's downtime offense count is decremented
+func (k Keeper) DowntimeOffenseDecayPeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDowntimeOffenseDecayPeriod, &res)
+	return
+}
+
+// DowntimeJailDurationMultiplier - jail duration multiplier applied per prior downtime offense
+func (k Keeper) DowntimeJailDurationMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyDowntimeJailDurationMultiplier, &res)
+	return
+}
+
+// SlashFractionDowntimeMultiplier - slash fraction multiplier applied per prior downtime offense
+func (k Keeper) SlashFractionDowntimeMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeySlashFractionDowntimeMultiplier, &res)
+	return
+}
+
+// UnjailRequiresValidatorBond - whether unjailing requires a validator bond rather than a self-delegation
+func (k Keeper) UnjailRequiresValidatorBond(ctx sdk.Context) (res bool) {
+	k.paramspace.Get(ctx, types.KeyUnjailRequiresValidatorBond, &res)
+	return
+}
+
+// InfractionParams - penalty overrides applied to specific infraction types
+func (k Keeper) InfractionParams(ctx sdk.Context) (res []types.InfractionParams) {
+	k.paramspace.Get(ctx, types.KeyInfractionParams, &res)
+	return
+}
+
+// GetInfractionParams returns the penalty overrides for the given infraction
+// type, if any are configured
+func (k Keeper) GetInfractionParams(ctx sdk.Context, infractionType types.InfractionType) (types.InfractionParams, bool) {
+	for _, params := range k.InfractionParams(ctx) {
+		if params.InfractionType == infractionType {
+			return params, true
+		}
+	}
+	return types.InfractionParams{}, false
+}
+
+// InfractionHistoryLength - number of most recent infraction records kept for each validator
+func (k Keeper) InfractionHistoryLength(ctx sdk.Context) (res int64) {
+	k.paramspace.Get(ctx, types.KeyInfractionHistoryLength, &res)
+	return
+}
+
+// DowntimeWarningThreshold - fraction of the allowed missed blocks at which a downtime warning is emitted
+func (k Keeper) DowntimeWarningThreshold(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyDowntimeWarningThreshold, &res)
+	return
+}
+
+// DowntimeWarningMissedBlocks - number of missed blocks in the window at which a downtime warning is emitted
+func (k Keeper) DowntimeWarningMissedBlocks(ctx sdk.Context) int64 {
+	maxMissed := k.SignedBlocksWindow(ctx) - k.MinSignedPerWindow(ctx)
+
+	// NOTE: the warning is never later than the last block that can be
+	//       missed without being jailed, as the threshold is at most one.
+	return k.DowntimeWarningThreshold(ctx).MulInt64(maxMissed).Ceil().TruncateInt64()
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
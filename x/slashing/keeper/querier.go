@@ -55,7 +55,7 @@ func querySigningInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQu
 		return nil, errorsmod.Wrap(sdkslashingtypes.ErrNoSigningInfoFound, params.ConsAddress)
 	}
 
-	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, signingInfo)
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, k.withDecayedDowntimeOffenseCount(ctx, signingInfo))
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -74,7 +74,7 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQ
 	var signingInfos []types.ValidatorSigningInfo
 
 	k.IterateValidatorSigningInfos(ctx, func(consAddr sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
-		signingInfos = append(signingInfos, info)
+		signingInfos = append(signingInfos, k.withDecayedDowntimeOffenseCount(ctx, info))
 		return false
 	})
 
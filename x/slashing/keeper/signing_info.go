@@ -1,10 +1,9 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"time"
 
-	gogotypes "github.com/gogo/protobuf/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 )
@@ -37,6 +36,13 @@ func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	store.Set(types.ValidatorSigningInfoKey(address), bz)
 }
 
+// withDecayedDowntimeOffenseCount returns the signing info with its downtime
+// offense count decayed to the current block time, as reported by queries
+func (k Keeper) withDecayedDowntimeOffenseCount(ctx sdk.Context, info types.ValidatorSigningInfo) types.ValidatorSigningInfo {
+	info.DowntimeOffenseCount = info.DecayedDowntimeOffenseCount(ctx.BlockTime(), k.DowntimeOffenseDecayPeriod(ctx))
+	return info
+}
+
 // IterateValidatorSigningInfos iterates over the stored ValidatorSigningInfo
 func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 	handler func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool),
@@ -54,18 +60,40 @@ func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 	}
 }
 
-// GetValidatorMissedBlockBitArray gets the bit for the missed blocks array
-func (k Keeper) GetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) bool {
+// getMissedBlockBitmapChunk gets the bitmap chunk at the given chunk index for
+// a validator. An empty chunk is returned if it has not been written yet.
+func (k Keeper) getMissedBlockBitmapChunk(ctx sdk.Context, address sdk.ConsAddress, chunkIndex int64) []byte {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ValidatorMissedBlockBitArrayKey(address, index))
-	var missed gogotypes.BoolValue
-	if bz == nil {
-		// lazy: treat empty key as not missed
-		return false
+	chunk := make([]byte, types.MissedBlockBitmapChunkSize/8)
+	copy(chunk, store.Get(types.ValidatorMissedBlockBitmapKey(address, chunkIndex)))
+
+	return chunk
+}
+
+// setMissedBlockBitmapChunk sets the bitmap chunk at the given chunk index for
+// a validator. Chunks without any missed blocks are removed from the store.
+func (k Keeper) setMissedBlockBitmapChunk(ctx sdk.Context, address sdk.ConsAddress, chunkIndex int64, chunk []byte) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ValidatorMissedBlockBitmapKey(address, chunkIndex)
+
+	for _, b := range chunk {
+		if b != 0 {
+			store.Set(key, chunk)
+			return
+		}
 	}
-	k.cdc.MustUnmarshal(bz, &missed)
+	store.Delete(key)
+}
 
-	return missed.Value
+// GetValidatorMissedBlockBitArray gets the bit for the missed blocks array
+func (k Keeper) GetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) bool {
+	chunkIndex := index / types.MissedBlockBitmapChunkSize
+	bitIndex := index % types.MissedBlockBitmapChunkSize
+
+	// lazy: treat an empty chunk as not missed
+	chunk := k.getMissedBlockBitmapChunk(ctx, address, chunkIndex)
+
+	return chunk[bitIndex/8]&(1<<uint(bitIndex%8)) != 0
 }
 
 // IterateValidatorMissedBlockBitArray iterates over the signed blocks window
@@ -74,18 +102,25 @@ func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context,
 	address sdk.ConsAddress, handler func(index int64, missed bool) (stop bool),
 ) {
 	store := ctx.KVStore(k.storeKey)
-	index := int64(0)
-	// Array may be sparse
-	for ; index < k.SignedBlocksWindow(ctx); index++ {
-		var missed gogotypes.BoolValue
-		bz := store.Get(types.ValidatorMissedBlockBitArrayKey(address, index))
-		if bz == nil {
-			continue
-		}
+	signedBlocksWindow := k.SignedBlocksWindow(ctx)
 
-		k.cdc.MustUnmarshal(bz, &missed)
-		if handler(index, missed.Value) {
-			break
+	// Bitmap may be sparse, only the chunks that have been written are iterated
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitmapPrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		chunkIndex := int64(binary.BigEndian.Uint64(iter.Key()[len(iter.Key())-8:]))
+		chunk := iter.Value()
+
+		for bitIndex := int64(0); bitIndex < types.MissedBlockBitmapChunkSize; bitIndex++ {
+			index := chunkIndex*types.MissedBlockBitmapChunkSize + bitIndex
+			if index >= signedBlocksWindow {
+				return
+			}
+
+			missed := chunk[bitIndex/8]&(1<<uint(bitIndex%8)) != 0
+			if handler(index, missed) {
+				return
+			}
 		}
 	}
 }
@@ -94,7 +129,9 @@ func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context,
 func (k Keeper) GetValidatorMissedBlocks(ctx sdk.Context, address sdk.ConsAddress) []types.MissedBlock {
 	missedBlocks := []types.MissedBlock{}
 	k.IterateValidatorMissedBlockBitArray(ctx, address, func(index int64, missed bool) (stop bool) {
-		missedBlocks = append(missedBlocks, types.NewMissedBlock(index, missed))
+		if missed {
+			missedBlocks = append(missedBlocks, types.NewMissedBlock(index, missed))
+		}
 		return false
 	})
 
@@ -111,6 +148,9 @@ func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime ti
 
 	signInfo.JailedUntil = jailTime
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	k.updateCurrentInfractionRecord(ctx, consAddr, func(record *types.InfractionRecord) {
+		record.JailedUntil = jailTime
+	})
 }
 
 // Tombstone attempts to tombstone a validator. It will panic if signing info for
@@ -127,6 +167,9 @@ func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 
 	signInfo.Tombstoned = true
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	k.updateCurrentInfractionRecord(ctx, consAddr, func(record *types.InfractionRecord) {
+		record.Tombstoned = true
+	})
 }
 
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
@@ -142,15 +185,23 @@ func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
 // SetValidatorMissedBlockBitArray sets the bit that checks if the validator has
 // missed a block in the current window
 func (k Keeper) SetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64, missed bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&gogotypes.BoolValue{Value: missed})
-	store.Set(types.ValidatorMissedBlockBitArrayKey(address, index), bz)
+	chunkIndex := index / types.MissedBlockBitmapChunkSize
+	bitIndex := index % types.MissedBlockBitmapChunkSize
+
+	chunk := k.getMissedBlockBitmapChunk(ctx, address, chunkIndex)
+	if missed {
+		chunk[bitIndex/8] |= 1 << uint(bitIndex%8)
+	} else {
+		chunk[bitIndex/8] &^= 1 << uint(bitIndex%8)
+	}
+
+	k.setMissedBlockBitmapChunk(ctx, address, chunkIndex, chunk)
 }
 
-// clearValidatorMissedBlockBitArray deletes every instance of ValidatorMissedBlockBitArray in the store
+// clearValidatorMissedBlockBitArray deletes every chunk of the validator's missed block bitmap in the store
 func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayPrefixKey(address))
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitmapPrefixKey(address))
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		store.Delete(iter.Key())
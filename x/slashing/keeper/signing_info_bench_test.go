@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+)
+
+const benchmarkSignedBlocksWindow = int64(10_000)
+
+// storeWriteCounter counts the write and delete operations traced by a store
+type storeWriteCounter struct {
+	writes int
+}
+
+func (c *storeWriteCounter) Write(p []byte) (int, error) {
+	if bytes.Contains(p, []byte(`"operation":"write"`)) || bytes.Contains(p, []byte(`"operation":"delete"`)) {
+		c.writes++
+	}
+	return len(p), nil
+}
+
+// withStoreWriteCounter branches the context's multistore with tracing enabled
+// so that every write to the parent stores is counted once the branch is written
+func withStoreWriteCounter(ctx sdk.Context, counter *storeWriteCounter) (sdk.Context, sdk.CacheMultiStore) {
+	ms := ctx.MultiStore().SetTracer(counter).CacheMultiStore()
+	return ctx.WithMultiStore(ms), ms
+}
+
+// setupBenchmarkValidator creates a bonded validator with a large signed blocks window
+func setupBenchmarkValidator(minSignedPerWindow sdk.Dec) (*simapp.SimApp, sdk.Context, sdk.ConsAddress) {
+	t := &testing.T{}
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.SignedBlocksWindow = benchmarkSignedBlocksWindow
+	params.MinSignedPerWindow = minSignedPerWindow
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	return app, ctx, sdk.ConsAddress(pks[0].Address())
+}
+
+// BenchmarkHandleValidatorSignature measures the store writes per block for a
+// validator that misses every other block
+func BenchmarkHandleValidatorSignature(b *testing.B) {
+	app, ctx, consAddr := setupBenchmarkValidator(sdk.ZeroDec())
+
+	counter := &storeWriteCounter{}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		blockCtx, ms := withStoreWriteCounter(ctx.WithBlockHeight(int64(n)+1), counter)
+		app.SlashingKeeper.HandleValidatorSignature(blockCtx, consAddr.Bytes(), 100, n%2 == 0)
+		ms.Write()
+	}
+	b.ReportMetric(float64(counter.writes)/float64(b.N), "writes/op")
+}
+
+// BenchmarkHandleValidatorSignatureDowntime measures the store writes in the block
+// in which a validator that missed the entire window is jailed, which clears the
+// validator's missed block bitmap (one delete per chunk rather than one per block)
+func BenchmarkHandleValidatorSignatureDowntime(b *testing.B) {
+	app, ctx, consAddr := setupBenchmarkValidator(sdk.NewDecWithPrec(5, 1))
+	ctx = ctx.WithBlockHeight(2*benchmarkSignedBlocksWindow + 1)
+
+	counter := &storeWriteCounter{}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		if app.StakingKeeper.ValidatorByConsAddr(ctx, consAddr).IsJailed() {
+			app.StakingKeeper.Unjail(ctx, consAddr)
+		}
+
+		signInfo, _ := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		signInfo.StartHeight = 0
+		signInfo.IndexOffset = 0
+		signInfo.MissedBlocksCounter = benchmarkSignedBlocksWindow
+		app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+		for index := int64(0); index < benchmarkSignedBlocksWindow; index++ {
+			app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, index, true)
+		}
+
+		downtimeCtx, ms := withStoreWriteCounter(ctx, counter)
+		b.StartTimer()
+
+		app.SlashingKeeper.HandleValidatorSignature(downtimeCtx, consAddr.Bytes(), 100, false)
+		ms.Write()
+	}
+	b.ReportMetric(float64(counter.writes)/float64(b.N), "writes/op")
+}
@@ -48,6 +48,40 @@ func TestGetSetValidatorMissedBlockBitArray(t *testing.T) {
 	require.True(t, missed) // now should be missed
 }
 
+func TestValidatorMissedBlockBitmapChunks(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	consAddr := sdk.ConsAddress(addrDels[0])
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.SignedBlocksWindow = 3 * types.MissedBlockBitmapChunkSize
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	// set bits on either side of the chunk boundaries
+	missedIndexes := []int64{0, types.MissedBlockBitmapChunkSize - 1, types.MissedBlockBitmapChunkSize, 2*types.MissedBlockBitmapChunkSize + 5}
+	for _, index := range missedIndexes {
+		app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, index, true)
+	}
+	for _, index := range missedIndexes {
+		require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, index), "index %d", index)
+	}
+	require.False(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 1))
+
+	missedBlocks := app.SlashingKeeper.GetValidatorMissedBlocks(ctx, consAddr)
+	require.Len(t, missedBlocks, len(missedIndexes))
+	for i, missedBlock := range missedBlocks {
+		require.Equal(t, types.NewMissedBlock(missedIndexes[i], true), missedBlock)
+	}
+
+	// unsetting every bit of a chunk removes it from the store
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 2*types.MissedBlockBitmapChunkSize+5, false)
+	require.False(t, store.Has(types.ValidatorMissedBlockBitmapKey(consAddr, 2)))
+	require.True(t, store.Has(types.ValidatorMissedBlockBitmapKey(consAddr, 1)))
+	require.Len(t, app.SlashingKeeper.GetValidatorMissedBlocks(ctx, consAddr), len(missedIndexes)-1)
+}
+
 func TestTombstoned(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkslashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 )
 
 // Unjail calls the staking Unjail function to unjail a validator if the
@@ -13,10 +14,18 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 		return sdkslashingtypes.ErrNoValidatorForAddress
 	}
 
-	// cannot be unjailed if no self-delegation exists
-	selfDel := k.sk.Delegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr)
-	if selfDel == nil {
-		return sdkslashingtypes.ErrMissingSelfDelegation
+	if k.UnjailRequiresValidatorBond(ctx) {
+		// cannot be unjailed if the validator has no validator bond
+		liquidValidator, found := k.sk.GetLiquidValidator(ctx, validatorAddr)
+		if !found || !liquidValidator.TotalValidatorBondShares.IsPositive() {
+			return types.ErrMissingValidatorBond
+		}
+	} else {
+		// cannot be unjailed if no self-delegation exists
+		selfDel := k.sk.Delegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr)
+		if selfDel == nil {
+			return sdkslashingtypes.ErrMissingSelfDelegation
+		}
 	}
 
 	// cannot be unjailed if not jailed
@@ -52,3 +61,27 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 	k.sk.Unjail(ctx, consAddr)
 	return nil
 }
+
+// UnjailByValidatorBondHolder unjails a validator on behalf of an account
+// holding a validator bond delegation to it. This is only permitted when
+// unjailing requires a validator bond, and the account must be either the
+// validator operator's account or the unjailer designated by the operator.
+func (k Keeper) UnjailByValidatorBondHolder(ctx sdk.Context, validatorAddr sdk.ValAddress, delegatorAddr sdk.AccAddress) error {
+	if !k.UnjailRequiresValidatorBond(ctx) {
+		return types.ErrValidatorBondUnjailDisabled
+	}
+
+	if !delegatorAddr.Equals(sdk.AccAddress(validatorAddr)) {
+		unjailer, found := k.GetUnjailer(ctx, validatorAddr)
+		if !found || !delegatorAddr.Equals(unjailer) {
+			return types.ErrNotDesignatedUnjailer
+		}
+	}
+
+	delegation, found := k.sk.GetLiquidDelegation(ctx, delegatorAddr, validatorAddr)
+	if !found || !delegation.ValidatorBond {
+		return types.ErrNotValidatorBondHolder
+	}
+
+	return k.Unjail(ctx, validatorAddr)
+}
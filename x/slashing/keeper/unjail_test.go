@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// Test unjailing a validator when a validator bond is required
+// Ensure the validator bond, rather than the self-delegation, is checked
+// and that validator bond holders can unjail on the validator's behalf
+func TestUnjailRequiresValidatorBond(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(0, 0)})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	valAddr, val, holder := valAddrs[0], pks[0], addrDels[1]
+	consAddr := sdk.ConsAddress(val.Address())
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, val, 100, true)
+	tstaking.Delegate(holder, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	app.StakingKeeper.Jail(ctx, consAddr)
+
+	slashingMsgServer := keeper.NewMsgServerImpl(app.SlashingKeeper)
+	unjailByHolder := types.NewMsgUnjailByValidatorBondHolder(valAddr, holder)
+
+	// by default, validator bond holders cannot unjail the validator
+	_, err := slashingMsgServer.Unjail(sdk.WrapSDKContext(ctx), unjailByHolder)
+	require.ErrorIs(t, err, types.ErrValidatorBondUnjailDisabled)
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.UnjailRequiresValidatorBond = true
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	// the self-delegation is not sufficient without a validator bond
+	err = app.SlashingKeeper.Unjail(ctx, valAddr)
+	require.ErrorIs(t, err, types.ErrMissingValidatorBond)
+
+	// only the operator or the unjailer it designated can unjail the validator
+	_, err = slashingMsgServer.Unjail(sdk.WrapSDKContext(ctx), unjailByHolder)
+	require.ErrorIs(t, err, types.ErrNotDesignatedUnjailer)
+
+	_, err = slashingMsgServer.SetUnjailer(sdk.WrapSDKContext(ctx), types.NewMsgSetUnjailer(valAddr, holder))
+	require.NoError(t, err, "no error expected when designating the unjailer")
+	unjailer, found := app.SlashingKeeper.GetUnjailer(ctx, valAddr)
+	require.True(t, found, "unjailer should be stored")
+	require.Equal(t, holder, unjailer)
+
+	// a designated unjailer without a validator bond cannot unjail the validator
+	_, err = slashingMsgServer.Unjail(sdk.WrapSDKContext(ctx), unjailByHolder)
+	require.ErrorIs(t, err, types.ErrNotValidatorBondHolder)
+
+	// once the delegation is a validator bond, the designated unjailer can unjail the validator
+	_, err = stakingkeeper.NewMsgServerImpl(app.StakingKeeper).ValidatorBond(sdk.WrapSDKContext(ctx), &stakingtypes.MsgValidatorBond{
+		DelegatorAddress: holder.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	require.NoError(t, err, "no error expected when validator bonding")
+
+	_, err = slashingMsgServer.Unjail(sdk.WrapSDKContext(ctx), unjailByHolder)
+	require.NoError(t, err, "no error expected when unjailing as the designated unjailer")
+	tstaking.CheckValidator(valAddr, -1, false)
+
+	// removing the designation revokes the holder's permission
+	_, err = slashingMsgServer.SetUnjailer(sdk.WrapSDKContext(ctx), types.NewMsgSetUnjailer(valAddr, nil))
+	require.NoError(t, err, "no error expected when removing the unjailer")
+	_, found = app.SlashingKeeper.GetUnjailer(ctx, valAddr)
+	require.False(t, found, "unjailer should be removed")
+
+	app.StakingKeeper.Jail(ctx, consAddr)
+	_, err = slashingMsgServer.Unjail(sdk.WrapSDKContext(ctx), unjailByHolder)
+	require.ErrorIs(t, err, types.ErrNotDesignatedUnjailer)
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+)
+
+// SetUnjailer stores the account designated by a validator operator to unjail
+// the validator on its behalf
+func (k Keeper) SetUnjailer(ctx sdk.Context, valAddr sdk.ValAddress, unjailer sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UnjailerKey(valAddr), unjailer.Bytes())
+}
+
+// GetUnjailer returns the account designated to unjail a validator, if any
+func (k Keeper) GetUnjailer(ctx sdk.Context, valAddr sdk.ValAddress) (unjailer sdk.AccAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UnjailerKey(valAddr))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// RemoveUnjailer removes the account designated to unjail a validator
+func (k Keeper) RemoveUnjailer(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UnjailerKey(valAddr))
+}
+
+// IterateUnjailers iterates over the designated unjailers of all validators
+func (k Keeper) IterateUnjailers(ctx sdk.Context, handler func(valAddr sdk.ValAddress, unjailer sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnjailerKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := types.UnjailerValidatorAddress(iterator.Key())
+		if handler(valAddr, sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
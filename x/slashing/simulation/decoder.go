@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 )
@@ -29,6 +30,18 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &missedB)
 			return fmt.Sprintf("missedA: %v\nmissedB: %v", missedA.Value, missedB.Value)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorMissedBlockBitmapKeyPrefix):
+			return fmt.Sprintf("missedBitmapChunkA: %X\nmissedBitmapChunkB: %X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.InfractionRecordKeyPrefix):
+			var recordA, recordB types.InfractionRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.UnjailerKeyPrefix):
+			return fmt.Sprintf("unjailerA: %s\nunjailerB: %s", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
 		case bytes.Equal(kvA.Key[:1], types.AddrPubkeyRelationKeyPrefix):
 			var pubKeyA, pubKeyB cryptotypes.PubKey
 			if err := cdc.UnmarshalInterface(kvA.Value, &pubKeyA); err != nil {
@@ -30,6 +30,7 @@ func TestDecodeStore(t *testing.T) {
 
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	missed := gogotypes.BoolValue{Value: true}
+	record := types.NewInfractionRecord(consAddr1, 5, types.InfractionDowntime, sdk.NewDecWithPrec(1, 2), time.Now().UTC(), false)
 	bz, err := cdc.MarshalInterface(delPk1)
 	require.NoError(t, err)
 
@@ -38,6 +39,9 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshal(&info)},
 			{Key: types.ValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshal(&missed)},
 			{Key: types.AddrPubkeyRelationKey(delAddr1), Value: bz},
+			{Key: types.ValidatorMissedBlockBitmapKey(consAddr1, 2), Value: []byte{0x01, 0x80}},
+			{Key: types.InfractionRecordKey(consAddr1, 0), Value: cdc.MustMarshal(&record)},
+			{Key: types.UnjailerKey(valAddr1), Value: delAddr1.Bytes()},
 			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
 		},
 	}
@@ -50,6 +54,9 @@ func TestDecodeStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info), false},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value), false},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", delPk1, delPk1), false},
+		{"ValidatorMissedBlockBitmap", "missedBitmapChunkA: 0180\nmissedBitmapChunkB: 0180", false},
+		{"InfractionRecord", fmt.Sprintf("%v\n%v", record, record), false},
+		{"Unjailer", fmt.Sprintf("unjailerA: %s\nunjailerB: %s", delAddr1, delAddr1), false},
 		{"other", "", true},
 	}
 	for i, tt := range tests {
@@ -21,6 +21,11 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	DowntimeOffenseDecayPeriod      = "downtime_offense_decay_period"
+	DowntimeJailDurationMultiplier  = "downtime_jail_duration_multiplier"
+	SlashFractionDowntimeMultiplier = "slash_fraction_downtime_multiplier"
+	DowntimeWarningThreshold        = "downtime_warning_threshold"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -48,6 +53,26 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeOffenseDecayPeriod randomized DowntimeOffenseDecayPeriod
+func GenDowntimeOffenseDecayPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60*60, 60*60*24*30)) * time.Second
+}
+
+// GenDowntimeJailDurationMultiplier randomized DowntimeJailDurationMultiplier
+func GenDowntimeJailDurationMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 10, 40)), 1)
+}
+
+// GenSlashFractionDowntimeMultiplier randomized SlashFractionDowntimeMultiplier
+func GenSlashFractionDowntimeMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 10, 20)), 1)
+}
+
+// GenDowntimeWarningThreshold randomized DowntimeWarningThreshold
+func GenDowntimeWarningThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 5, 11)), 1)
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -80,12 +105,38 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeOffenseDecayPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeOffenseDecayPeriod, &downtimeOffenseDecayPeriod, simState.Rand,
+		func(r *rand.Rand) { downtimeOffenseDecayPeriod = GenDowntimeOffenseDecayPeriod(r) },
+	)
+
+	var downtimeJailDurationMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeJailDurationMultiplier, &downtimeJailDurationMultiplier, simState.Rand,
+		func(r *rand.Rand) { downtimeJailDurationMultiplier = GenDowntimeJailDurationMultiplier(r) },
+	)
+
+	var slashFractionDowntimeMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionDowntimeMultiplier, &slashFractionDowntimeMultiplier, simState.Rand,
+		func(r *rand.Rand) { slashFractionDowntimeMultiplier = GenSlashFractionDowntimeMultiplier(r) },
+	)
+
+	var downtimeWarningThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeWarningThreshold, &downtimeWarningThreshold, simState.Rand,
+		func(r *rand.Rand) { downtimeWarningThreshold = GenDowntimeWarningThreshold(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, downtimeOffenseDecayPeriod,
+		downtimeJailDurationMultiplier, slashFractionDowntimeMultiplier, types.DefaultUnjailRequiresValidatorBond,
+		types.DefaultInfractionParams, types.DefaultInfractionHistoryLength, downtimeWarningThreshold,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
+	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{}, []types.InfractionRecord{}, []types.ValidatorUnjailer{})
 
 	bz, err := json.MarshalIndent(&slashingGenesis, "", " ")
 	if err != nil {
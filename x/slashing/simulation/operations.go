@@ -6,38 +6,84 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+const (
+	DefaultWeightDoubleSignEvidence int = 1
+	DefaultWeightDowntime           int = 5
+
+	// Operation names of the simulated infractions, which are not submitted as messages
+	TypeDoubleSignEvidence = "double_sign_evidence"
+	TypeDowntime           = "downtime"
+
+	// Maximum age, in blocks, of the simulated double sign infractions
+	maxDoubleSignEvidenceAge int64 = 10
+	// Infractions are only simulated while this many unjailed validators would remain bonded
+	minRemainingBondedValidators = 2
 )
 
 // Simulation operation weights constants
+//
+//nolint:gosec // these are just the weights of the operations, not hard coded credentials
 const (
-	OpWeightMsgUnjail = "op_weight_msg_unjail" //nolint:gosec // this is just the weight for MsgUnjail, not a hard coded credential
+	OpWeightMsgUnjail          = "op_weight_msg_unjail"
+	OpWeightDoubleSignEvidence = "op_weight_double_sign_evidence"
+	OpWeightDowntime           = "op_weight_downtime"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
 	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
-	bk types.BankKeeper, k keeper.Keeper, sk types.StakingKeeper,
+	bk types.BankKeeper, k keeper.Keeper, sk types.StakingKeeper, ek types.EvidenceKeeper,
 ) simulation.WeightedOperations {
-	var weightMsgUnjail int
+	var (
+		weightMsgUnjail          int
+		weightDoubleSignEvidence int
+		weightDowntime           int
+	)
+
 	appParams.GetOrGenerate(cdc, OpWeightMsgUnjail, &weightMsgUnjail, nil,
 		func(_ *rand.Rand) {
 			weightMsgUnjail = simappparams.DefaultWeightMsgUnjail
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightDoubleSignEvidence, &weightDoubleSignEvidence, nil,
+		func(_ *rand.Rand) {
+			weightDoubleSignEvidence = DefaultWeightDoubleSignEvidence
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightDowntime, &weightDowntime, nil,
+		func(_ *rand.Rand) {
+			weightDowntime = DefaultWeightDowntime
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgUnjail,
 			SimulateMsgUnjail(ak, bk, k, sk.(stakingkeeper.Keeper)),
 		),
+		simulation.NewWeightedOperation(
+			weightDoubleSignEvidence,
+			SimulateDoubleSignEvidence(k, sk.(stakingkeeper.Keeper), ek),
+		),
+		simulation.NewWeightedOperation(
+			weightDowntime,
+			SimulateDowntime(k, sk.(stakingkeeper.Keeper)),
+		),
 	}
 }
 
@@ -129,3 +175,110 @@ func SimulateMsgUnjail(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Kee
 		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
 	}
 }
+
+// SimulateDoubleSignEvidence submits double sign evidence against a random validator
+// with liquid stake to the evidence keeper
+func SimulateDoubleSignEvidence(k keeper.Keeper, sk stakingkeeper.Keeper, ek types.EvidenceKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validator, ok := randomLiquidStakedValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeDoubleSignEvidence, "no bonded validator with liquid stake"), nil, nil // skip
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeDoubleSignEvidence, "unable to get validator consensus key"), nil, err
+		}
+		if _, found := k.GetValidatorSigningInfo(ctx, consAddr); !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeDoubleSignEvidence, "unable to find validator signing info"), nil, nil // skip
+		}
+		if k.IsTombstoned(ctx, consAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeDoubleSignEvidence, "validator is already tombstoned"), nil, nil // skip
+		}
+
+		// the infraction happened in one of the most recent blocks, so that any
+		// unbonding delegations and redelegations started since are slashed as well
+		maxAge := maxDoubleSignEvidenceAge
+		if ctx.BlockHeight() < maxAge {
+			maxAge = ctx.BlockHeight()
+		}
+		evidence := &evidencetypes.Equivocation{
+			Height:           ctx.BlockHeight() - r.Int63n(maxAge+1),
+			Time:             ctx.BlockHeader().Time,
+			Power:            validator.GetConsensusPower(sk.PowerReduction(ctx)),
+			ConsensusAddress: consAddr.String(),
+		}
+		ek.HandleEquivocationEvidence(ctx, evidence)
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, TypeDoubleSignEvidence, "", true, nil), nil, nil
+	}
+}
+
+// SimulateDowntime makes a random validator with liquid stake miss enough blocks to be
+// slashed and jailed for downtime
+func SimulateDowntime(k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validator, ok := randomLiquidStakedValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeDowntime, "no bonded validator with liquid stake"), nil, nil // skip
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeDowntime, "unable to get validator consensus key"), nil, err
+		}
+		info, found := k.GetValidatorSigningInfo(ctx, consAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeDowntime, "unable to find validator signing info"), nil, nil // skip
+		}
+
+		// a validator is only punished for downtime once its first signing window is over
+		window := k.SignedBlocksWindow(ctx)
+		if ctx.BlockHeight() <= info.StartHeight+window {
+			return simtypes.NoOpMsg(types.ModuleName, TypeDowntime, "validator signing window is not over"), nil, nil // skip
+		}
+
+		// the validator misses votes until it is jailed, which takes at most a full window
+		power := validator.GetConsensusPower(sk.PowerReduction(ctx))
+		for i := int64(0); i < window && !sk.ValidatorByConsAddr(ctx, consAddr).IsJailed(); i++ {
+			k.HandleValidatorSignature(ctx, cryptotypes.Address(consAddr), power, false)
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, TypeDowntime, "", true, nil), nil, nil
+	}
+}
+
+// randomLiquidStakedValidator returns a random bonded, unjailed validator that has
+// either an active tokenize share record or a validator bond. No validator is returned
+// if punishing it would leave too few validators in the bonded set.
+func randomLiquidStakedValidator(r *rand.Rand, sk stakingkeeper.Keeper, ctx sdk.Context) (stakingtypes.Validator, bool) {
+	tokenizedValidators := make(map[string]bool)
+	for _, record := range sk.GetAllTokenizeShareRecords(ctx) {
+		tokenizedValidators[record.Validator] = true
+	}
+
+	bondedValidators := 0
+	var candidates []stakingtypes.Validator
+	for _, validator := range sk.GetLiquidBondedValidatorsByPower(ctx) {
+		if validator.IsJailed() {
+			continue
+		}
+		bondedValidators++
+
+		if tokenizedValidators[validator.OperatorAddress] || validator.TotalValidatorBondShares.IsPositive() {
+			candidates = append(candidates, validator)
+		}
+	}
+
+	if len(candidates) == 0 || bondedValidators <= minRemainingBondedValidators {
+		return stakingtypes.Validator{}, false
+	}
+
+	return candidates[r.Intn(len(candidates))], true
+}
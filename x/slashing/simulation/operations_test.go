@@ -25,6 +25,8 @@ import (
 	distrtypes "github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/simulation"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
 	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -42,9 +44,13 @@ func TestWeightedOperations(t *testing.T) {
 		weight     int
 		opMsgRoute string
 		opMsgName  string
-	}{{simappparams.DefaultWeightMsgUnjail, types.ModuleName, types.TypeMsgUnjail}}
+	}{
+		{simappparams.DefaultWeightMsgUnjail, types.ModuleName, types.TypeMsgUnjail},
+		{simulation.DefaultWeightDoubleSignEvidence, types.ModuleName, simulation.TypeDoubleSignEvidence},
+		{simulation.DefaultWeightDowntime, types.ModuleName, simulation.TypeDowntime},
+	}
 
-	weightesOps := simulation.WeightedOperations(appParams, cdc, app.AccountKeeper, app.BankKeeper, app.SlashingKeeper, app.StakingKeeper)
+	weightesOps := simulation.WeightedOperations(appParams, cdc, app.AccountKeeper, app.BankKeeper, app.SlashingKeeper, app.StakingKeeper, app.EvidenceKeeper)
 	for i, w := range weightesOps {
 		operationMsg, _, _ := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
 		// the following checks are very much dependent from the ordering of the output given
@@ -110,6 +116,87 @@ func TestSimulateMsgUnjail(t *testing.T) {
 	require.Len(t, futureOperations, 0)
 }
 
+// TestSimulateDoubleSignEvidence tests that double sign evidence is handled against a
+// validator with tokenized shares
+func TestSimulateDoubleSignEvidence(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	app, ctx, accounts := createTestApp(t, false, r, 4)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Now().UTC())
+
+	liquidValidator := createLiquidStakedValidators(t, app, ctx, accounts[1:])
+
+	// execute operation
+	op := simulation.SimulateDoubleSignEvidence(app.SlashingKeeper, app.StakingKeeper, app.EvidenceKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+	require.Equal(t, simulation.TypeDoubleSignEvidence, operationMsg.Name)
+	require.Len(t, futureOperations, 0)
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, liquidValidator)
+	require.True(t, found)
+	require.True(t, validator.IsJailed())
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	require.True(t, app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+	require.Len(t, app.EvidenceKeeper.GetAllEvidence(ctx), 1)
+}
+
+// TestSimulateDowntime tests that a validator with tokenized shares is slashed and
+// jailed for downtime
+func TestSimulateDowntime(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	app, ctx, accounts := createTestApp(t, false, r, 4)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Now().UTC())
+
+	liquidValidator := createLiquidStakedValidators(t, app, ctx, accounts[1:])
+
+	// the validator is not punished before its first signing window is over
+	op := simulation.SimulateDowntime(app.SlashingKeeper, app.StakingKeeper)
+	operationMsg, _, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+
+	// execute operation
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + app.SlashingKeeper.SignedBlocksWindow(ctx) + 1)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+	require.Equal(t, simulation.TypeDowntime, operationMsg.Name)
+	require.Len(t, futureOperations, 0)
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, liquidValidator)
+	require.True(t, found)
+	require.True(t, validator.IsJailed())
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.False(t, info.Tombstoned)
+	require.Equal(t, int64(1), info.DowntimeOffenseCount)
+	require.Equal(t, int64(0), info.MissedBlocksCounter)
+}
+
+// createLiquidStakedValidators bonds a validator for each account and tokenizes part
+// of the first validator's self delegation, returning the first validator's address
+func createLiquidStakedValidators(t *testing.T, app *simapp.SimApp, ctx sdk.Context, accounts []simtypes.Account) sdk.ValAddress {
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	for _, account := range accounts {
+		tstaking.CreateValidatorWithValPower(sdk.ValAddress(account.Address), account.ConsKey.PubKey(), 10, true)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	liquidValidator := sdk.ValAddress(accounts[0].Address)
+	tokenizeAmount := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), app.StakingKeeper.TokensFromConsensusPower(ctx, 5))
+	tstaking.TokenizeShares(accounts[0].Address, liquidValidator, tokenizeAmount, accounts[0].Address, true)
+
+	return liquidValidator
+}
+
 // returns context and an app with updated mint keeper
 func createTestApp(t *testing.T, isCheckTx bool, r *rand.Rand, n int) (*simapp.SimApp, sdk.Context, []simtypes.Account) {
 	accounts := simtypes.RandomAccounts(r, n)
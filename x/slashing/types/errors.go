@@ -1,5 +1,9 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 // import (
 // 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 // )
@@ -14,3 +18,11 @@ package types
 // 	ErrSelfDelegationTooLowToUnjail = sdkerrors.Register(ModuleName, 7, "validator's self delegation less than minimum; cannot be unjailed")
 // 	ErrNoSigningInfoFound           = sdkerrors.Register(ModuleName, 8, "no validator signing info found")
 // )
+
+// x/slashing module sentinel errors not defined by the SDK slashing module
+var (
+	ErrMissingValidatorBond        = errorsmod.Register(ModuleName, 9, "validator has no validator bond; cannot be unjailed")
+	ErrNotValidatorBondHolder      = errorsmod.Register(ModuleName, 10, "delegator does not hold a validator bond delegation to the validator")
+	ErrValidatorBondUnjailDisabled = errorsmod.Register(ModuleName, 11, "unjailing by validator bond holders is disabled")
+	ErrNotDesignatedUnjailer       = errorsmod.Register(ModuleName, 12, "delegator is neither the validator operator nor its designated unjailer")
+)
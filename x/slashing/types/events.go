@@ -5,6 +5,9 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
+	EventTypeDowntimeWarning = "downtime_warning"
+	EventTypeSetUnjailer     = "set_unjailer"
+
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
 	AttributeKeyPower        = "power"
@@ -13,7 +16,17 @@ const (
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
 
+	AttributeKeyDowntimeOffenseCount = "downtime_offense_count"
+	AttributeKeySlashFraction        = "slash_fraction"
+	AttributeKeyJailDuration         = "jail_duration"
+	AttributeKeyInfractionType       = "infraction_type"
+	AttributeKeyTombstoned           = "tombstoned"
+	AttributeKeyBlocksRemaining      = "blocks_remaining"
+	AttributeKeyValidator            = "validator"
+	AttributeKeyUnjailer             = "unjailer"
+
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
+	AttributeValueConsumer         = "consumer_infraction"
 	AttributeValueCategory         = ModuleName
 )
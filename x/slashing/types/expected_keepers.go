@@ -5,8 +5,10 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
 // AccountKeeper expected account keeper
@@ -28,6 +30,8 @@ type ParamSubspace interface {
 	HasKeyTable() bool
 	WithKeyTable(table paramtypes.KeyTable) paramtypes.Subspace
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	Has(ctx sdk.Context, key []byte) bool
+	Set(ctx sdk.Context, key []byte, value interface{})
 	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
 	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
 }
@@ -50,10 +54,21 @@ type StakingKeeper interface {
 	// and delegator outside the scope of the staking module.
 	Delegation(sdk.Context, sdk.AccAddress, sdk.ValAddress) sdkstaking.DelegationI
 
+	// GetLiquidValidator and GetLiquidDelegation return the liquid staking
+	// validator and delegation, which track validator bonds
+	GetLiquidValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetLiquidDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation stakingtypes.Delegation, found bool)
+
 	// MaxValidators returns the maximum amount of bonded validators
 	MaxValidators(sdk.Context) uint32
 }
 
+// EvidenceKeeper expected evidence keeper, used by the simulation to submit
+// double sign evidence
+type EvidenceKeeper interface {
+	HandleEquivocationEvidence(ctx sdk.Context, evidence *evidencetypes.Equivocation)
+}
+
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
 	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error                           // Must be called when a validator is created
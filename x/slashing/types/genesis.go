@@ -10,11 +10,14 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	infractionRecords []InfractionRecord, unjailers []ValidatorUnjailer,
 ) *GenesisState {
 	return &GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:            params,
+		SigningInfos:      signingInfos,
+		MissedBlocks:      missedBlocks,
+		InfractionRecords: infractionRecords,
+		Unjailers:         unjailers,
 	}
 }
 
@@ -62,5 +65,31 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateInfractionParams(data.Params.InfractionParams); err != nil {
+		return err
+	}
+
+	if err := validateInfractionHistoryLength(data.Params.InfractionHistoryLength); err != nil {
+		return err
+	}
+
+	for _, record := range data.InfractionRecords {
+		if _, err := sdk.ConsAddressFromBech32(record.Address); err != nil {
+			return fmt.Errorf("invalid infraction record address %s: %w", record.Address, err)
+		}
+		if record.SlashFraction.IsNil() || record.SlashFraction.IsNegative() || record.SlashFraction.GT(sdk.OneDec()) {
+			return fmt.Errorf("invalid infraction record slash fraction for %s: %s", record.Address, record.SlashFraction)
+		}
+	}
+
+	for _, unjailer := range data.Unjailers {
+		if _, err := sdk.ValAddressFromBech32(unjailer.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid unjailer validator address %s: %w", unjailer.ValidatorAddress, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(unjailer.UnjailerAddress); err != nil {
+			return fmt.Errorf("invalid unjailer address %s: %w", unjailer.UnjailerAddress, err)
+		}
+	}
+
 	return nil
 }
@@ -1,11 +1,11 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: cosmos/slashing/v1beta1/genesis.proto
+// source: slashing/v1beta1/genesis.proto
 
 package types
 
 import (
 	fmt "fmt"
-	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -33,13 +33,19 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	// infraction_records are the infraction histories of all validators, ordered
+	// from oldest to newest for each validator.
+	InfractionRecords []InfractionRecord `protobuf:"bytes,4,rep,name=infraction_records,json=infractionRecords,proto3" json:"infraction_records" yaml:"infraction_records"`
+	// unjailers are the validator bond holders designated by validator operators
+	// to unjail their validators.
+	Unjailers []ValidatorUnjailer `protobuf:"bytes,5,rep,name=unjailers,proto3" json:"unjailers" yaml:"unjailers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{0}
+	return fileDescriptor_1d12eeaa856153e6, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -89,6 +95,20 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetInfractionRecords() []InfractionRecord {
+	if m != nil {
+		return m.InfractionRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnjailers() []ValidatorUnjailer {
+	if m != nil {
+		return m.Unjailers
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -101,7 +121,7 @@ func (m *SigningInfo) Reset()         { *m = SigningInfo{} }
 func (m *SigningInfo) String() string { return proto.CompactTextString(m) }
 func (*SigningInfo) ProtoMessage()    {}
 func (*SigningInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{1}
+	return fileDescriptor_1d12eeaa856153e6, []int{1}
 }
 func (m *SigningInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,7 +169,8 @@ func (m *SigningInfo) GetValidatorSigningInfo() ValidatorSigningInfo {
 type ValidatorMissedBlocks struct {
 	// address is the validator address.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// missed_blocks is an array of missed blocks by the validator.
+	// missed_blocks is an array of missed blocks by the validator. Only the
+	// blocks the validator missed are exported; signed blocks are omitted.
 	MissedBlocks []MissedBlock `protobuf:"bytes,2,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
 }
 
@@ -157,7 +178,7 @@ func (m *ValidatorMissedBlocks) Reset()         { *m = ValidatorMissedBlocks{} }
 func (m *ValidatorMissedBlocks) String() string { return proto.CompactTextString(m) }
 func (*ValidatorMissedBlocks) ProtoMessage()    {}
 func (*ValidatorMissedBlocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{2}
+	return fileDescriptor_1d12eeaa856153e6, []int{2}
 }
 func (m *ValidatorMissedBlocks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +233,7 @@ func (m *MissedBlock) Reset()         { *m = MissedBlock{} }
 func (m *MissedBlock) String() string { return proto.CompactTextString(m) }
 func (*MissedBlock) ProtoMessage()    {}
 func (*MissedBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{3}
+	return fileDescriptor_1d12eeaa856153e6, []int{3}
 }
 func (m *MissedBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -255,46 +276,108 @@ func (m *MissedBlock) GetMissed() bool {
 	return false
 }
 
+// ValidatorUnjailer contains the unjailer designated by a validator operator.
+type ValidatorUnjailer struct {
+	// validator_address is the validator operator address.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// unjailer_address is the account allowed to unjail the validator.
+	UnjailerAddress string `protobuf:"bytes,2,opt,name=unjailer_address,json=unjailerAddress,proto3" json:"unjailer_address,omitempty"`
+}
+
+func (m *ValidatorUnjailer) Reset()         { *m = ValidatorUnjailer{} }
+func (m *ValidatorUnjailer) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUnjailer) ProtoMessage()    {}
+func (*ValidatorUnjailer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d12eeaa856153e6, []int{4}
+}
+func (m *ValidatorUnjailer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUnjailer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUnjailer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorUnjailer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUnjailer.Merge(m, src)
+}
+func (m *ValidatorUnjailer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUnjailer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUnjailer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUnjailer proto.InternalMessageInfo
+
+func (m *ValidatorUnjailer) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorUnjailer) GetUnjailerAddress() string {
+	if m != nil {
+		return m.UnjailerAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "liquidstaking.slashing.v1beta1.GenesisState")
 	proto.RegisterType((*SigningInfo)(nil), "liquidstaking.slashing.v1beta1.SigningInfo")
 	proto.RegisterType((*ValidatorMissedBlocks)(nil), "liquidstaking.slashing.v1beta1.ValidatorMissedBlocks")
 	proto.RegisterType((*MissedBlock)(nil), "liquidstaking.slashing.v1beta1.MissedBlock")
+	proto.RegisterType((*ValidatorUnjailer)(nil), "liquidstaking.slashing.v1beta1.ValidatorUnjailer")
 }
 
-func init() {
-	proto.RegisterFile("cosmos/slashing/v1beta1/genesis.proto", fileDescriptor_1923b9188b635394)
-}
+func init() { proto.RegisterFile("slashing/v1beta1/genesis.proto", fileDescriptor_1d12eeaa856153e6) }
 
-var fileDescriptor_1923b9188b635394 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3d, 0x8e, 0xd3, 0x40,
-	0x14, 0xf6, 0x24, 0x10, 0x60, 0x9c, 0x6d, 0x46, 0x66, 0xb1, 0x56, 0xe0, 0xac, 0x2c, 0x16, 0x6d,
-	0x13, 0x5b, 0xbb, 0x74, 0x20, 0x1a, 0x37, 0x2b, 0x0a, 0x24, 0xe4, 0x95, 0x28, 0x68, 0xa2, 0x71,
-	0x3c, 0x3b, 0x19, 0xc5, 0xf6, 0x04, 0x3f, 0x13, 0x25, 0x57, 0xa0, 0xa2, 0xe6, 0x06, 0xf4, 0x1c,
-	0x22, 0x65, 0x4a, 0xaa, 0x08, 0x25, 0x37, 0xe0, 0x04, 0x28, 0x33, 0x0e, 0x71, 0xa2, 0x98, 0x88,
-	0xca, 0x7e, 0xd2, 0xf7, 0xf7, 0xbe, 0xd1, 0xc3, 0x17, 0x7d, 0x09, 0xa9, 0x04, 0x1f, 0x12, 0x0a,
-	0x03, 0x91, 0x71, 0x7f, 0x7c, 0x15, 0xb1, 0x82, 0x5e, 0xf9, 0x9c, 0x65, 0x0c, 0x04, 0x78, 0xa3,
-	0x5c, 0x16, 0x92, 0x3c, 0xd1, 0x30, 0x6f, 0x03, 0xf3, 0x4a, 0xd8, 0x99, 0xc5, 0x25, 0x97, 0x0a,
-	0xe3, 0xaf, 0xff, 0x34, 0xfc, 0xec, 0x45, 0x9d, 0xea, 0x5f, 0xbe, 0xc2, 0xb9, 0xdf, 0x1b, 0xb8,
-	0x7d, 0xa3, 0x8d, 0x6e, 0x0b, 0x5a, 0x30, 0xf2, 0x06, 0xb7, 0x46, 0x34, 0xa7, 0x29, 0xd8, 0xe8,
-	0x1c, 0x5d, 0x9a, 0xd7, 0x1d, 0xaf, 0xc6, 0xd8, 0x7b, 0xaf, 0x60, 0xc1, 0xbd, 0xd9, 0xa2, 0x63,
-	0x84, 0x25, 0x89, 0x70, 0x7c, 0x02, 0x82, 0x67, 0x22, 0xe3, 0x3d, 0x91, 0xdd, 0x49, 0xb0, 0x1b,
-	0xe7, 0xcd, 0x4b, 0xf3, 0xfa, 0x79, 0xad, 0xca, 0xad, 0x46, 0xbf, 0xcd, 0xee, 0x64, 0xf0, 0x74,
-	0x2d, 0xf5, 0x7b, 0xd1, 0xb1, 0xa6, 0x34, 0x4d, 0x5e, 0xb9, 0x3b, 0x42, 0x6e, 0xd8, 0x86, 0x2d,
-	0x14, 0xc8, 0x27, 0x7c, 0x92, 0x0a, 0x00, 0x16, 0xf7, 0xa2, 0x44, 0xf6, 0x87, 0x60, 0x37, 0x95,
-	0x91, 0x57, 0x6b, 0xf4, 0x81, 0x26, 0x22, 0xa6, 0x85, 0xcc, 0xdf, 0x29, 0x5a, 0xa0, 0x58, 0xfb,
-	0x96, 0x3b, 0x92, 0x6e, 0xd8, 0x4e, 0x2b, 0x58, 0xf7, 0x07, 0xc2, 0x66, 0x25, 0x2e, 0xb1, 0xf1,
-	0x03, 0x1a, 0xc7, 0x39, 0x03, 0xdd, 0xd5, 0xa3, 0x70, 0x33, 0x92, 0x2f, 0x08, 0x9f, 0x8e, 0x37,
-	0x7e, 0xbd, 0xea, 0x1e, 0x76, 0x43, 0xb5, 0xda, 0x3d, 0x1e, 0xb3, 0x5a, 0xcc, 0x45, 0x99, 0xf2,
-	0x99, 0x4e, 0x79, 0x58, 0xda, 0x0d, 0xad, 0xf1, 0x01, 0xb2, 0xfb, 0x0d, 0xe1, 0xc7, 0x07, 0x97,
-	0xff, 0xc7, 0x02, 0x7c, 0xbf, 0xdd, 0x63, 0xcf, 0x58, 0xd1, 0xfd, 0xaf, 0x4e, 0x5f, 0x63, 0xb3,
-	0x42, 0x25, 0x16, 0xbe, 0x2f, 0xb2, 0x98, 0x4d, 0x54, 0x9e, 0x66, 0xa8, 0x07, 0x72, 0x8a, 0x5b,
-	0x9a, 0xa4, 0xda, 0x7b, 0x18, 0x96, 0x53, 0x70, 0x33, 0x5b, 0x3a, 0x68, 0xbe, 0x74, 0xd0, 0xaf,
-	0xa5, 0x83, 0xbe, 0xae, 0x1c, 0x63, 0xbe, 0x72, 0x8c, 0x9f, 0x2b, 0xc7, 0xf8, 0xd8, 0xe5, 0xa2,
-	0x18, 0x7c, 0x8e, 0xbc, 0xbe, 0x4c, 0xfd, 0xf2, 0x12, 0xf4, 0xa7, 0x0b, 0xf1, 0xd0, 0x9f, 0x6c,
-	0xcf, 0xa2, 0x98, 0x8e, 0x18, 0x44, 0x2d, 0x75, 0x0c, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x03, 0x3c, 0xa8, 0xf6, 0x8c, 0x03, 0x00, 0x00,
+var fileDescriptor_1d12eeaa856153e6 = []byte{
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x6e, 0xd3, 0x40,
+	0x14, 0xc6, 0xe3, 0x24, 0x0d, 0x74, 0x92, 0x8a, 0x64, 0x14, 0x8a, 0xa9, 0xc0, 0x29, 0x16, 0x48,
+	0x2d, 0x28, 0xb6, 0x5a, 0x76, 0x20, 0x24, 0xf0, 0x06, 0x75, 0x81, 0x84, 0x5c, 0xc1, 0xa2, 0x9b,
+	0x68, 0x62, 0x4f, 0xdc, 0x21, 0xf6, 0x4c, 0xe2, 0xe7, 0x44, 0x8d, 0xb8, 0x41, 0x57, 0xac, 0x39,
+	0x07, 0x87, 0xe8, 0xb2, 0x4b, 0x56, 0x15, 0x4a, 0x6e, 0xc0, 0x09, 0x50, 0xc7, 0x8e, 0xe3, 0xfc,
+	0x23, 0xea, 0x2e, 0x13, 0x7f, 0xdf, 0xf7, 0x7b, 0x6f, 0xde, 0xd3, 0x20, 0x0d, 0x7c, 0x02, 0xe7,
+	0x8c, 0x7b, 0xe6, 0xf0, 0xa8, 0x4d, 0x23, 0x72, 0x64, 0x7a, 0x94, 0x53, 0x60, 0x60, 0xf4, 0x42,
+	0x11, 0x09, 0xfc, 0xc8, 0x11, 0x10, 0x08, 0x30, 0xa6, 0x32, 0x23, 0x91, 0xed, 0xd5, 0x3d, 0xe1,
+	0x09, 0xa9, 0x31, 0x6f, 0x7f, 0xc5, 0xf2, 0xbd, 0xc6, 0x52, 0x5c, 0x6a, 0x94, 0x02, 0xfd, 0xb2,
+	0x88, 0x2a, 0x1f, 0x63, 0xc2, 0x69, 0x44, 0x22, 0x8a, 0xdf, 0xa1, 0x52, 0x8f, 0x84, 0x24, 0x00,
+	0x55, 0xd9, 0x57, 0x0e, 0xca, 0xc7, 0x0d, 0x63, 0x0d, 0xd1, 0xf8, 0x2c, 0x65, 0x56, 0xf1, 0xea,
+	0xa6, 0x91, 0xb3, 0x13, 0x13, 0xf6, 0xd0, 0x0e, 0x30, 0x8f, 0x33, 0xee, 0xb5, 0x18, 0xef, 0x08,
+	0x50, 0xf3, 0xfb, 0x85, 0x83, 0xf2, 0xf1, 0xf3, 0xb5, 0x29, 0xa7, 0xb1, 0xfa, 0x84, 0x77, 0x84,
+	0xf5, 0xe4, 0x36, 0xea, 0xef, 0x4d, 0xa3, 0x3e, 0x22, 0x81, 0xff, 0x46, 0x9f, 0x0b, 0xd2, 0xed,
+	0x0a, 0xcc, 0xa4, 0x80, 0xfb, 0x68, 0x27, 0x60, 0x00, 0xd4, 0x6d, 0xb5, 0x7d, 0xe1, 0x74, 0x41,
+	0x2d, 0x48, 0x90, 0xb1, 0x16, 0xf4, 0x95, 0xf8, 0xcc, 0x25, 0x91, 0x08, 0x3f, 0x49, 0x9b, 0x25,
+	0x5d, 0x8b, 0xc8, 0xb9, 0x48, 0xdd, 0xae, 0x04, 0x19, 0x2d, 0xfe, 0x8e, 0x30, 0xe3, 0x9d, 0x90,
+	0x38, 0x11, 0x13, 0xbc, 0x15, 0x52, 0x47, 0x84, 0x2e, 0xa8, 0x45, 0xc9, 0x3d, 0x5c, 0xcb, 0x3d,
+	0x49, 0x2d, 0xb6, 0x74, 0x58, 0xcf, 0x12, 0xe4, 0xe3, 0x18, 0xb9, 0x1c, 0xa9, 0xdb, 0x35, 0xb6,
+	0x60, 0x02, 0xdc, 0x46, 0xdb, 0x03, 0xfe, 0x8d, 0x30, 0x9f, 0x86, 0xa0, 0x6e, 0x49, 0xe6, 0xcb,
+	0xcd, 0xbd, 0x7e, 0x49, 0x2c, 0x96, 0x9a, 0x40, 0xab, 0x31, 0x34, 0x8d, 0xd2, 0xed, 0x59, 0xac,
+	0xfe, 0x4b, 0x41, 0xe5, 0xcc, 0x3c, 0xb0, 0x8a, 0xee, 0x11, 0xd7, 0x0d, 0x29, 0xc4, 0xcb, 0xb0,
+	0x6d, 0x4f, 0x8f, 0xf8, 0x52, 0x41, 0xbb, 0xc3, 0x29, 0xa4, 0x95, 0x1d, 0x94, 0x9a, 0x97, 0x6b,
+	0xd3, 0xdc, 0x5c, 0x5b, 0x76, 0xf2, 0x2f, 0x92, 0xf2, 0x9e, 0xc6, 0xe5, 0xad, 0x8e, 0xd6, 0xed,
+	0xfa, 0x70, 0x85, 0x59, 0xff, 0xa9, 0xa0, 0x87, 0x2b, 0xa7, 0xfb, 0x9f, 0x06, 0xbc, 0xc5, 0xf5,
+	0xd9, 0xb4, 0xa7, 0x99, 0xdc, 0xbb, 0x2c, 0x8d, 0xfe, 0x16, 0x95, 0x33, 0x56, 0x5c, 0x47, 0x5b,
+	0x8c, 0xbb, 0xf4, 0x42, 0xd6, 0x53, 0xb0, 0xe3, 0x03, 0xde, 0x45, 0xa5, 0xd8, 0x24, 0x6f, 0xef,
+	0xbe, 0x9d, 0x9c, 0xf4, 0x2e, 0xaa, 0x2d, 0x8d, 0x12, 0xbf, 0x42, 0xb5, 0xd9, 0xfd, 0xcc, 0xb7,
+	0x57, 0x4d, 0x3f, 0x7c, 0x48, 0xfa, 0x3c, 0x44, 0xd5, 0xe9, 0x7c, 0x53, 0x6d, 0x5e, 0x6a, 0x1f,
+	0x4c, 0xff, 0x4f, 0xa4, 0xd6, 0xd9, 0xd5, 0x58, 0x53, 0xae, 0xc7, 0x9a, 0xf2, 0x67, 0xac, 0x29,
+	0x3f, 0x26, 0x5a, 0xee, 0x7a, 0xa2, 0xe5, 0x7e, 0x4f, 0xb4, 0xdc, 0xd9, 0x7b, 0x8f, 0x45, 0xe7,
+	0x83, 0xb6, 0xe1, 0x88, 0xc0, 0x64, 0x7d, 0x7f, 0x00, 0x4c, 0x70, 0xc6, 0x1d, 0xd3, 0x67, 0xfd,
+	0x01, 0x73, 0x59, 0x34, 0x6a, 0x42, 0x44, 0xba, 0x8c, 0x7b, 0xcd, 0x40, 0xb8, 0x03, 0x9f, 0x9a,
+	0x17, 0xe9, 0x33, 0x63, 0x46, 0xa3, 0x1e, 0x85, 0x76, 0x49, 0xbe, 0x36, 0xaf, 0xff, 0x0d, 0x00,
+	0xa3, 0x9b, 0x1c, 0x82, 0xdf, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -317,6 +400,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Unjailers) > 0 {
+		for iNdEx := len(m.Unjailers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unjailers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.InfractionRecords) > 0 {
+		for iNdEx := len(m.InfractionRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfractionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -480,6 +591,43 @@ func (m *MissedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorUnjailer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUnjailer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUnjailer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnjailerAddress) > 0 {
+		i -= len(m.UnjailerAddress)
+		copy(dAtA[i:], m.UnjailerAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.UnjailerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -511,6 +659,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.InfractionRecords) > 0 {
+		for _, e := range m.InfractionRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Unjailers) > 0 {
+		for _, e := range m.Unjailers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -563,6 +723,23 @@ func (m *MissedBlock) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorUnjailer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.UnjailerAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -699,6 +876,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfractionRecords = append(m.InfractionRecords, InfractionRecord{})
+			if err := m.InfractionRecords[len(m.InfractionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unjailers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unjailers = append(m.Unjailers, ValidatorUnjailer{})
+			if err := m.Unjailers[len(m.Unjailers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1040,6 +1285,120 @@ func (m *MissedBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorUnjailer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUnjailer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUnjailer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnjailerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -20,6 +20,16 @@ const (
 
 	// QuerierRoute is the querier route for slashing
 	QuerierRoute = ModuleName
+
+	// MissedBlockBitmapChunkSize defines the chunk size, in number of bits, of a
+	// validator missed block bitmap. Chunks are used to reduce the storage and
+	// write overhead of IAVL nodes. The total size of the bitmap is roughly in
+	// the range [0, SignedBlocksWindow) where each bit represents a block. A
+	// validator's IndexOffset modulo the SignedBlocksWindow is used to retrieve
+	// the chunk in that bitmap range. Once the chunk is retrieved, the same index
+	// is used to check or flip a bit, where if a bit is set, it indicates the
+	// validator missed that block.
+	MissedBlockBitmapChunkSize = 1024 // 2^10 bits
 )
 
 // Keys for slashing store
@@ -27,13 +37,25 @@ const (
 //
 // - 0x01<consAddrLen (1 Byte)><consAddress_Bytes>: ValidatorSigningInfo
 //
-// - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
+// - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool (legacy, see ValidatorMissedBlockBitArrayKeyPrefix)
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index_Bytes>: []byte (missed block bitmap chunk)
+//
+// - 0x05<consAddrLen (1 Byte)><consAddress_Bytes><sequence_Bytes>: InfractionRecord
+//
+// - 0x06<valAddrLen (1 Byte)><valAddress_Bytes>: sdk.AccAddress (designated unjailer)
 var (
-	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
-	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
+	ValidatorSigningInfoKeyPrefix = []byte{0x01} // Prefix for signing info
+	// ValidatorMissedBlockBitArrayKeyPrefix is the prefix of the legacy missed block
+	// bit array, which stored one entry per block. It is only kept for the store
+	// migration to the chunked missed block bitmap.
+	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02}
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorMissedBlockBitmapKeyPrefix   = []byte{0x04} // Prefix for missed block bitmap chunks
+	InfractionRecordKeyPrefix             = []byte{0x05} // Prefix for validator infraction history
+	UnjailerKeyPrefix                     = []byte{0x06} // Prefix for designated unjailers
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -63,6 +85,43 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 	return append(ValidatorMissedBlockBitArrayPrefixKey(v), b...)
 }
 
+// ValidatorMissedBlockBitmapPrefixKey - stored by *Consensus* address (not operator address)
+func ValidatorMissedBlockBitmapPrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorMissedBlockBitmapKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// ValidatorMissedBlockBitmapKey - stored by *Consensus* address (not operator address)
+// The chunk index is big endian encoded so that chunks are iterated in order
+func ValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(chunkIndex))
+
+	return append(ValidatorMissedBlockBitmapPrefixKey(v), b...)
+}
+
+// InfractionRecordPrefixKey - stored by *Consensus* address (not operator address)
+func InfractionRecordPrefixKey(v sdk.ConsAddress) []byte {
+	return append(InfractionRecordKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// InfractionRecordKey - stored by *Consensus* address (not operator address)
+// The sequence is big endian encoded so that records are iterated from oldest to newest
+func InfractionRecordKey(v sdk.ConsAddress, sequence uint64) []byte {
+	return append(InfractionRecordPrefixKey(v), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// UnjailerKey - stored by *Operator* address
+func UnjailerKey(v sdk.ValAddress) []byte {
+	return append(UnjailerKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// UnjailerValidatorAddress - extract the validator address from a designated unjailer key
+func UnjailerValidatorAddress(key []byte) sdk.ValAddress {
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	return sdk.ValAddress(key[2:])
+}
+
 // AddrPubkeyRelationKey gets pubkey relation key used to get the pubkey from the address
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
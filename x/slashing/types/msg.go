@@ -8,11 +8,15 @@ import (
 
 // slashing message types
 const (
-	TypeMsgUnjail = "unjail"
+	TypeMsgUnjail      = "unjail"
+	TypeMsgSetUnjailer = "set_unjailer"
 )
 
 // verify interface at compile time
-var _ sdk.Msg = &MsgUnjail{}
+var (
+	_ sdk.Msg = &MsgUnjail{}
+	_ sdk.Msg = &MsgSetUnjailer{}
+)
 
 // NewMsgUnjail creates a new MsgUnjail instance
 //
@@ -23,9 +27,24 @@ func NewMsgUnjail(validatorAddr sdk.ValAddress) *MsgUnjail {
 	}
 }
 
+// NewMsgUnjailByValidatorBondHolder creates a new MsgUnjail instance submitted
+// by a validator bond holder on behalf of the validator
+//
+//nolint:interfacer
+func NewMsgUnjailByValidatorBondHolder(validatorAddr sdk.ValAddress, delegatorAddr sdk.AccAddress) *MsgUnjail {
+	return &MsgUnjail{
+		ValidatorAddr:    validatorAddr.String(),
+		DelegatorAddress: delegatorAddr.String(),
+	}
+}
+
 func (msg MsgUnjail) Route() string { return RouterKey }
 func (msg MsgUnjail) Type() string  { return TypeMsgUnjail }
 func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	if msg.DelegatorAddress != "" {
+		delAddr, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+		return []sdk.AccAddress{delAddr}
+	}
 	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddr)
 	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
 }
@@ -41,5 +60,50 @@ func (msg MsgUnjail) ValidateBasic() error {
 	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddr); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
 	}
+	if msg.DelegatorAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("delegator input address: %s", err)
+		}
+	}
+	return nil
+}
+
+// NewMsgSetUnjailer creates a new MsgSetUnjailer instance, an empty unjailer
+// removes the designated unjailer
+//
+//nolint:interfacer
+func NewMsgSetUnjailer(validatorAddr sdk.ValAddress, unjailer sdk.AccAddress) *MsgSetUnjailer {
+	msg := &MsgSetUnjailer{
+		ValidatorAddr: validatorAddr.String(),
+	}
+	if !unjailer.Empty() {
+		msg.UnjailerAddress = unjailer.String()
+	}
+	return msg
+}
+
+func (msg MsgSetUnjailer) Route() string { return RouterKey }
+func (msg MsgSetUnjailer) Type() string  { return TypeMsgSetUnjailer }
+func (msg MsgSetUnjailer) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgSetUnjailer) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgSetUnjailer) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddr); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+	if msg.UnjailerAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.UnjailerAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("unjailer input address: %s", err)
+		}
+	}
 	return nil
 }
@@ -19,3 +19,15 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgSetUnjailerValidateBasic(t *testing.T) {
+	valAddr := sdk.ValAddress("abcd")
+	unjailer := sdk.AccAddress("efgh")
+
+	require.NoError(t, NewMsgSetUnjailer(valAddr, unjailer).ValidateBasic())
+	require.NoError(t, NewMsgSetUnjailer(valAddr, nil).ValidateBasic(), "an empty unjailer removes the designation")
+	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(valAddr)}, NewMsgSetUnjailer(valAddr, unjailer).GetSigners())
+
+	msg := MsgSetUnjailer{ValidatorAddr: valAddr.String(), UnjailerAddress: "invalid"}
+	require.Error(t, msg.ValidateBasic())
+}
@@ -10,14 +10,27 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow         = int64(100)
+	DefaultDowntimeJailDuration       = 60 * 10 * time.Second
+	DefaultDowntimeOffenseDecayPeriod = 60 * 60 * 24 * 30 * time.Second
+
+	// By default unjailing requires an operator self-delegation
+	DefaultUnjailRequiresValidatorBond = false
+
+	DefaultInfractionHistoryLength = int64(20)
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	// Multipliers of one apply the same downtime penalty regardless of a validator's history
+	DefaultDowntimeJailDurationMultiplier  = sdk.OneDec()
+	DefaultSlashFractionDowntimeMultiplier = sdk.OneDec()
+	// Warn once a validator has missed 80% of the blocks it may miss before being jailed
+	DefaultDowntimeWarningThreshold = sdk.NewDecWithPrec(8, 1)
+	// No overrides by default, so every infraction type uses the base parameters
+	DefaultInfractionParams []InfractionParams
 )
 
 // Parameter store keys
@@ -27,6 +40,14 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+
+	KeyDowntimeOffenseDecayPeriod      = []byte("DowntimeOffenseDecayPeriod")
+	KeyDowntimeJailDurationMultiplier  = []byte("DowntimeJailDurationMultiplier")
+	KeySlashFractionDowntimeMultiplier = []byte("SlashFractionDowntimeMultiplier")
+	KeyUnjailRequiresValidatorBond     = []byte("UnjailRequiresValidatorBond")
+	KeyInfractionParams                = []byte("InfractionParams")
+	KeyInfractionHistoryLength         = []byte("InfractionHistoryLength")
+	KeyDowntimeWarningThreshold        = []byte("DowntimeWarningThreshold")
 )
 
 // ParamKeyTable for slashing module
@@ -37,14 +58,23 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, downtimeOffenseDecayPeriod time.Duration,
+	downtimeJailDurationMultiplier, slashFractionDowntimeMultiplier sdk.Dec, unjailRequiresValidatorBond bool,
+	infractionParams []InfractionParams, infractionHistoryLength int64, downtimeWarningThreshold sdk.Dec,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:              signedBlocksWindow,
+		MinSignedPerWindow:              minSignedPerWindow,
+		DowntimeJailDuration:            downtimeJailDuration,
+		SlashFractionDoubleSign:         slashFractionDoubleSign,
+		SlashFractionDowntime:           slashFractionDowntime,
+		DowntimeOffenseDecayPeriod:      downtimeOffenseDecayPeriod,
+		DowntimeJailDurationMultiplier:  downtimeJailDurationMultiplier,
+		SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+		UnjailRequiresValidatorBond:     unjailRequiresValidatorBond,
+		InfractionParams:                infractionParams,
+		InfractionHistoryLength:         infractionHistoryLength,
+		DowntimeWarningThreshold:        downtimeWarningThreshold,
 	}
 }
 
@@ -56,6 +86,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyDowntimeOffenseDecayPeriod, &p.DowntimeOffenseDecayPeriod, validateDowntimeOffenseDecayPeriod),
+		paramtypes.NewParamSetPair(KeyDowntimeJailDurationMultiplier, &p.DowntimeJailDurationMultiplier, validateDowntimeJailDurationMultiplier),
+		paramtypes.NewParamSetPair(KeySlashFractionDowntimeMultiplier, &p.SlashFractionDowntimeMultiplier, validateSlashFractionDowntimeMultiplier),
+		paramtypes.NewParamSetPair(KeyUnjailRequiresValidatorBond, &p.UnjailRequiresValidatorBond, validateUnjailRequiresValidatorBond),
+		paramtypes.NewParamSetPair(KeyInfractionParams, &p.InfractionParams, validateInfractionParams),
+		paramtypes.NewParamSetPair(KeyInfractionHistoryLength, &p.InfractionHistoryLength, validateInfractionHistoryLength),
+		paramtypes.NewParamSetPair(KeyDowntimeWarningThreshold, &p.DowntimeWarningThreshold, validateDowntimeWarningThreshold),
 	}
 }
 
@@ -63,7 +100,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
-		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultDowntimeOffenseDecayPeriod,
+		DefaultDowntimeJailDurationMultiplier, DefaultSlashFractionDowntimeMultiplier, DefaultUnjailRequiresValidatorBond,
+		DefaultInfractionParams, DefaultInfractionHistoryLength, DefaultDowntimeWarningThreshold,
 	)
 }
 
@@ -140,3 +179,123 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeOffenseDecayPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("downtime offense decay period must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeJailDurationMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime jail duration multiplier must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionDowntimeMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime slash fraction multiplier must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+func validateUnjailRequiresValidatorBond(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateInfractionParams(i interface{}) error {
+	v, ok := i.([]InfractionParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[InfractionType]bool, len(v))
+	for _, p := range v {
+		if _, ok := InfractionType_name[int32(p.InfractionType)]; !ok || p.InfractionType == InfractionEmpty {
+			return fmt.Errorf("invalid infraction type: %s", p.InfractionType)
+		}
+		if seen[p.InfractionType] {
+			return fmt.Errorf("duplicate infraction params for %s", p.InfractionType)
+		}
+		seen[p.InfractionType] = true
+
+		if p.SlashFraction.IsNil() || p.SlashFraction.IsNegative() {
+			return fmt.Errorf("%s slash fraction cannot be negative: %s", p.InfractionType, p.SlashFraction)
+		}
+		if p.SlashFraction.GT(sdk.OneDec()) {
+			return fmt.Errorf("%s slash fraction too large: %s", p.InfractionType, p.SlashFraction)
+		}
+		if p.JailDuration < 0 {
+			return fmt.Errorf("%s jail duration cannot be negative: %s", p.InfractionType, p.JailDuration)
+		}
+	}
+
+	return nil
+}
+
+func validateInfractionHistoryLength(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("infraction history length cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeWarningThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("downtime warning threshold must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("downtime warning threshold too large: %s", v)
+	}
+
+	return nil
+}
+
+// EscalateDowntimePenalty scales a downtime penalty by the multiplier once for
+// every prior offense, capping the result at max
+func EscalateDowntimePenalty(base, multiplier sdk.Dec, priorOffenses int64, max sdk.Dec) sdk.Dec {
+	penalty := base
+	for i := int64(0); i < priorOffenses && multiplier.GT(sdk.OneDec()); i++ {
+		penalty = penalty.Mul(multiplier)
+		if penalty.GTE(max) {
+			return max
+		}
+	}
+	return sdk.MinDec(penalty, max)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEscalateDowntimePenalty(t *testing.T) {
+	base := sdk.NewDecWithPrec(1, 2)
+
+	require.Equal(t, base, EscalateDowntimePenalty(base, sdk.NewDec(2), 0, sdk.OneDec()))
+	require.Equal(t, base, EscalateDowntimePenalty(base, sdk.OneDec(), 5, sdk.OneDec()))
+	require.Equal(t, sdk.NewDecWithPrec(8, 2), EscalateDowntimePenalty(base, sdk.NewDec(2), 3, sdk.OneDec()))
+	require.Equal(t, sdk.NewDecWithPrec(9, 2), EscalateDowntimePenalty(base, sdk.NewDec(3), 2, sdk.OneDec()))
+
+	// the penalty is capped, even for a very large number of prior offenses
+	require.Equal(t, sdk.OneDec(), EscalateDowntimePenalty(base, sdk.NewDec(2), 7, sdk.OneDec()))
+	require.Equal(t, sdk.OneDec(), EscalateDowntimePenalty(base, sdk.NewDec(10), 1_000_000, sdk.OneDec()))
+}
+
+func TestValidateInfractionParams(t *testing.T) {
+	valid := InfractionParams{
+		InfractionType: InfractionConsumer,
+		SlashFraction:  sdk.NewDecWithPrec(1, 2),
+		JailDuration:   time.Hour,
+	}
+	require.NoError(t, validateInfractionParams([]InfractionParams{}))
+	require.NoError(t, validateInfractionParams([]InfractionParams{valid}))
+
+	withType := func(p InfractionParams, infractionType InfractionType) InfractionParams {
+		p.InfractionType = infractionType
+		return p
+	}
+	require.NoError(t, validateInfractionParams([]InfractionParams{valid, withType(valid, InfractionDoubleSign)}))
+	require.Error(t, validateInfractionParams([]InfractionParams{valid, valid}))
+	require.Error(t, validateInfractionParams([]InfractionParams{withType(valid, InfractionEmpty)}))
+	require.Error(t, validateInfractionParams([]InfractionParams{withType(valid, InfractionType(4))}))
+
+	invalid := valid
+	invalid.SlashFraction = sdk.NewDec(2)
+	require.Error(t, validateInfractionParams([]InfractionParams{invalid}))
+	invalid.SlashFraction = sdk.NewDec(-1)
+	require.Error(t, validateInfractionParams([]InfractionParams{invalid}))
+
+	invalid = valid
+	invalid.JailDuration = -time.Second
+	require.Error(t, validateInfractionParams([]InfractionParams{invalid}))
+}
+
+func TestValidateDowntimeWarningThreshold(t *testing.T) {
+	require.NoError(t, validateDowntimeWarningThreshold(DefaultDowntimeWarningThreshold))
+	require.NoError(t, validateDowntimeWarningThreshold(sdk.OneDec()))
+	require.Error(t, validateDowntimeWarningThreshold(sdk.ZeroDec()))
+	require.Error(t, validateDowntimeWarningThreshold(sdk.NewDecWithPrec(11, 1)))
+	require.Error(t, validateDowntimeWarningThreshold(sdk.Dec{}))
+}
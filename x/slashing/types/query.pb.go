@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: cosmos/slashing/v1beta1/query.proto
+// source: slashing/v1beta1/query.proto
 
 package types
 
@@ -7,7 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
-	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -38,7 +38,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{0}
+	return fileDescriptor_edfe1dd4e275002d, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +76,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{1}
+	return fileDescriptor_edfe1dd4e275002d, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +123,7 @@ func (m *QuerySigningInfoRequest) Reset()         { *m = QuerySigningInfoRequest
 func (m *QuerySigningInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfoRequest) ProtoMessage()    {}
 func (*QuerySigningInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{2}
+	return fileDescriptor_edfe1dd4e275002d, []int{2}
 }
 func (m *QuerySigningInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -170,7 +170,7 @@ func (m *QuerySigningInfoResponse) Reset()         { *m = QuerySigningInfoRespon
 func (m *QuerySigningInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfoResponse) ProtoMessage()    {}
 func (*QuerySigningInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{3}
+	return fileDescriptor_edfe1dd4e275002d, []int{3}
 }
 func (m *QuerySigningInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,7 +216,7 @@ func (m *QuerySigningInfosRequest) Reset()         { *m = QuerySigningInfosReque
 func (m *QuerySigningInfosRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfosRequest) ProtoMessage()    {}
 func (*QuerySigningInfosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{4}
+	return fileDescriptor_edfe1dd4e275002d, []int{4}
 }
 func (m *QuerySigningInfosRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +264,7 @@ func (m *QuerySigningInfosResponse) Reset()         { *m = QuerySigningInfosResp
 func (m *QuerySigningInfosResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfosResponse) ProtoMessage()    {}
 func (*QuerySigningInfosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{5}
+	return fileDescriptor_edfe1dd4e275002d, []int{5}
 }
 func (m *QuerySigningInfosResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,6 +307,407 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryMissedBlocksRequest is the request type for the Query/MissedBlocks RPC
+// method
+type QueryMissedBlocksRequest struct {
+	// cons_address is the address to query missed blocks of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryMissedBlocksRequest) Reset()         { *m = QueryMissedBlocksRequest{} }
+func (m *QueryMissedBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksRequest) ProtoMessage()    {}
+func (*QueryMissedBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edfe1dd4e275002d, []int{6}
+}
+func (m *QueryMissedBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksRequest.Merge(m, src)
+}
+func (m *QueryMissedBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksRequest proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QueryMissedBlocksResponse is the response type for the Query/MissedBlocks RPC
+// method
+type QueryMissedBlocksResponse struct {
+	// missed_blocks are the window indexes the validator missed
+	MissedBlocks []MissedBlock `protobuf:"bytes,1,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// missed_offsets are, for each missed block, the number of signatures of the
+	// validator processed since, 0 being the last one. Only the blocks the
+	// validator was expected to sign are counted, so the offsets do not map to
+	// heights if it left the active set in the window.
+	MissedOffsets []int64 `protobuf:"varint,2,rep,packed,name=missed_offsets,json=missedOffsets,proto3" json:"missed_offsets,omitempty"`
+	// missed_blocks_counter is the number of blocks missed in the current window
+	MissedBlocksCounter int64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// blocks_remaining is the number of additional blocks the validator can
+	// miss in the current window before falling below min_signed_per_window
+	BlocksRemaining int64 `protobuf:"varint,4,opt,name=blocks_remaining,json=blocksRemaining,proto3" json:"blocks_remaining,omitempty"`
+}
+
+func (m *QueryMissedBlocksResponse) Reset()         { *m = QueryMissedBlocksResponse{} }
+func (m *QueryMissedBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksResponse) ProtoMessage()    {}
+func (*QueryMissedBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edfe1dd4e275002d, []int{7}
+}
+func (m *QueryMissedBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksResponse.Merge(m, src)
+}
+func (m *QueryMissedBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksResponse proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksResponse) GetMissedBlocks() []MissedBlock {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+func (m *QueryMissedBlocksResponse) GetMissedOffsets() []int64 {
+	if m != nil {
+		return m.MissedOffsets
+	}
+	return nil
+}
+
+func (m *QueryMissedBlocksResponse) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *QueryMissedBlocksResponse) GetBlocksRemaining() int64 {
+	if m != nil {
+		return m.BlocksRemaining
+	}
+	return 0
+}
+
+// QueryInfractionHistoryRequest is the request type for the
+// Query/InfractionHistory RPC method
+type QueryInfractionHistoryRequest struct {
+	// cons_address is the address to query the infraction history of
+	ConsAddress string             `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInfractionHistoryRequest) Reset()         { *m = QueryInfractionHistoryRequest{} }
+func (m *QueryInfractionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInfractionHistoryRequest) ProtoMessage()    {}
+func (*QueryInfractionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edfe1dd4e275002d, []int{8}
+}
+func (m *QueryInfractionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInfractionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInfractionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInfractionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInfractionHistoryRequest.Merge(m, src)
+}
+func (m *QueryInfractionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInfractionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInfractionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInfractionHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryInfractionHistoryRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryInfractionHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInfractionHistoryResponse is the response type for the
+// Query/InfractionHistory RPC method
+type QueryInfractionHistoryResponse struct {
+	// records are the validator's infraction records, from oldest to newest
+	Records    []InfractionRecord  `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInfractionHistoryResponse) Reset()         { *m = QueryInfractionHistoryResponse{} }
+func (m *QueryInfractionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInfractionHistoryResponse) ProtoMessage()    {}
+func (*QueryInfractionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edfe1dd4e275002d, []int{9}
+}
+func (m *QueryInfractionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInfractionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInfractionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInfractionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInfractionHistoryResponse.Merge(m, src)
+}
+func (m *QueryInfractionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInfractionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInfractionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInfractionHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryInfractionHistoryResponse) GetRecords() []InfractionRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryInfractionHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorsAtRiskRequest is the request type for the
+// Query/ValidatorsAtRisk RPC method
+type QueryValidatorsAtRiskRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorsAtRiskRequest) Reset()         { *m = QueryValidatorsAtRiskRequest{} }
+func (m *QueryValidatorsAtRiskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsAtRiskRequest) ProtoMessage()    {}
+func (*QueryValidatorsAtRiskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edfe1dd4e275002d, []int{10}
+}
+func (m *QueryValidatorsAtRiskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsAtRiskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsAtRiskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsAtRiskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsAtRiskRequest.Merge(m, src)
+}
+func (m *QueryValidatorsAtRiskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsAtRiskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsAtRiskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsAtRiskRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorsAtRiskRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorsAtRiskResponse is the response type for the
+// Query/ValidatorsAtRisk RPC method
+type QueryValidatorsAtRiskResponse struct {
+	// validators are the validators near the downtime threshold
+	Validators []ValidatorAtRisk   `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorsAtRiskResponse) Reset()         { *m = QueryValidatorsAtRiskResponse{} }
+func (m *QueryValidatorsAtRiskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsAtRiskResponse) ProtoMessage()    {}
+func (*QueryValidatorsAtRiskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edfe1dd4e275002d, []int{11}
+}
+func (m *QueryValidatorsAtRiskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsAtRiskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsAtRiskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsAtRiskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsAtRiskResponse.Merge(m, src)
+}
+func (m *QueryValidatorsAtRiskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsAtRiskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsAtRiskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsAtRiskResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorsAtRiskResponse) GetValidators() []ValidatorAtRisk {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *QueryValidatorsAtRiskResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ValidatorAtRisk describes a validator near the downtime threshold
+type ValidatorAtRisk struct {
+	// address is the validator consensus address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// missed_blocks_counter is the number of blocks missed in the current window
+	MissedBlocksCounter int64 `protobuf:"varint,2,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// blocks_remaining is the number of additional blocks the validator can
+	// miss in the current window before being jailed for downtime
+	BlocksRemaining int64 `protobuf:"varint,3,opt,name=blocks_remaining,json=blocksRemaining,proto3" json:"blocks_remaining,omitempty"`
+}
+
+func (m *ValidatorAtRisk) Reset()         { *m = ValidatorAtRisk{} }
+func (m *ValidatorAtRisk) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAtRisk) ProtoMessage()    {}
+func (*ValidatorAtRisk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edfe1dd4e275002d, []int{12}
+}
+func (m *ValidatorAtRisk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAtRisk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAtRisk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorAtRisk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAtRisk.Merge(m, src)
+}
+func (m *ValidatorAtRisk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAtRisk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAtRisk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAtRisk proto.InternalMessageInfo
+
+func (m *ValidatorAtRisk) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorAtRisk) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *ValidatorAtRisk) GetBlocksRemaining() int64 {
+	if m != nil {
+		return m.BlocksRemaining
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "liquidstaking.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "liquidstaking.slashing.v1beta1.QueryParamsResponse")
@@ -314,47 +715,76 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "liquidstaking.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "liquidstaking.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "liquidstaking.slashing.v1beta1.QuerySigningInfosResponse")
-}
-
-func init() {
-	proto.RegisterFile("cosmos/slashing/v1beta1/query.proto", fileDescriptor_791b11d41a861ed0)
-}
-
-var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x6d, 0x0d, 0x38, 0x29, 0x22, 0x63, 0xa1, 0x35, 0xc8, 0xc6, 0xae, 0x90, 0x16,
-	0x35, 0x3b, 0x26, 0x22, 0x5e, 0xec, 0xc1, 0x1e, 0x0c, 0xde, 0x34, 0x8a, 0x07, 0x41, 0xc2, 0x6c,
-	0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0xfb, 0x36, 0xc1, 0x20, 0x5e, 0x3c, 0x7b, 0x10, 0xfc, 0x0c,
-	0x1e, 0x3d, 0xf8, 0x2d, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0xf8, 0x09, 0xfc, 0x04, 0x92, 0x99,
-	0x49, 0xb2, 0x25, 0xae, 0xa6, 0x3d, 0xed, 0xf0, 0xe6, 0xfd, 0xdf, 0xff, 0xf7, 0xe6, 0x3d, 0x16,
-	0xdf, 0xe8, 0x68, 0xe8, 0x69, 0xa0, 0x10, 0x31, 0x38, 0x94, 0x4a, 0xd0, 0x41, 0x3d, 0xe4, 0x29,
-	0xab, 0xd3, 0xa3, 0x3e, 0x4f, 0x86, 0x41, 0x9c, 0xe8, 0x54, 0x93, 0x4d, 0x9b, 0x14, 0x4c, 0x93,
-	0x02, 0x97, 0x54, 0xbe, 0xe9, 0xd4, 0x21, 0x03, 0x6e, 0x15, 0x33, 0x7d, 0xcc, 0x84, 0x54, 0x2c,
-	0x95, 0x5a, 0xd9, 0x22, 0xe5, 0x0d, 0xa1, 0x85, 0x36, 0x47, 0x3a, 0x39, 0xb9, 0xe8, 0x35, 0xa1,
-	0xb5, 0x88, 0x38, 0x65, 0xb1, 0xa4, 0x4c, 0x29, 0x9d, 0x1a, 0x09, 0xb8, 0xdb, 0x6a, 0x1e, 0xdd,
-	0x8c, 0xc4, 0xe4, 0xf9, 0x1b, 0x98, 0x3c, 0x9d, 0xb8, 0x3f, 0x61, 0x09, 0xeb, 0x41, 0x8b, 0x1f,
-	0xf5, 0x39, 0xa4, 0xfe, 0x73, 0x7c, 0xe5, 0x54, 0x14, 0x62, 0xad, 0x80, 0x93, 0x3d, 0x5c, 0x8c,
-	0x4d, 0x64, 0x0b, 0x5d, 0x47, 0xbb, 0xa5, 0x46, 0x25, 0xc8, 0x69, 0x2f, 0xb0, 0xc2, 0xfd, 0xb5,
-	0xe3, 0x1f, 0x95, 0x42, 0xcb, 0x89, 0xfc, 0x07, 0x78, 0xd3, 0x54, 0x7d, 0x26, 0x85, 0x92, 0x4a,
-	0x3c, 0x56, 0x07, 0xda, 0x19, 0x92, 0x6d, 0xbc, 0xde, 0xd1, 0x0a, 0xda, 0xac, 0xdb, 0x4d, 0x38,
-	0xd8, 0xfa, 0x17, 0x5b, 0xa5, 0x49, 0xec, 0xa1, 0x0d, 0xf9, 0x43, 0xbc, 0xb5, 0xa8, 0x76, 0x60,
-	0xaf, 0xf0, 0xe5, 0x01, 0x8b, 0xda, 0x60, 0xaf, 0xda, 0x52, 0x1d, 0x68, 0x87, 0x58, 0xcb, 0x45,
-	0x7c, 0xc1, 0x22, 0xd9, 0x65, 0xa9, 0x4e, 0x32, 0x05, 0x1d, 0xf0, 0xa5, 0x01, 0x8b, 0x32, 0x51,
-	0x3f, 0x5c, 0xb4, 0x9e, 0x3e, 0x15, 0x79, 0x84, 0xf1, 0x7c, 0x60, 0xce, 0xb4, 0x3a, 0x35, 0x9d,
-	0x4c, 0x37, 0xb0, 0xfb, 0x30, 0x7f, 0x19, 0xc1, 0x9d, 0xb6, 0x95, 0x51, 0xfa, 0x5f, 0x10, 0xbe,
-	0xfa, 0x17, 0x13, 0xd7, 0x60, 0x13, 0xaf, 0xb9, 0xa6, 0x56, 0xcf, 0xdb, 0x94, 0x29, 0x40, 0x9a,
-	0xa7, 0x70, 0x57, 0x0c, 0xee, 0xce, 0x7f, 0x71, 0x2d, 0x45, 0x96, 0xb7, 0xf1, 0x7b, 0x15, 0x5f,
-	0x30, 0xbc, 0xe4, 0x03, 0xc2, 0x45, 0x3b, 0x6f, 0x72, 0x2b, 0x17, 0x6c, 0x71, 0xc9, 0xca, 0xb7,
-	0x97, 0x4b, 0xb6, 0xde, 0xfe, 0xce, 0xfb, 0x6f, 0xbf, 0x3e, 0xad, 0x6c, 0x93, 0x0a, 0xcd, 0xdb,
-	0x6c, 0xbb, 0x65, 0xe4, 0x2b, 0xc2, 0xa5, 0x4c, 0xf7, 0xe4, 0xce, 0xbf, 0x6d, 0x16, 0x97, 0xb1,
-	0x5c, 0x3f, 0x83, 0xc2, 0xd1, 0xed, 0x19, 0xba, 0xfb, 0xe4, 0x5e, 0x2e, 0x5d, 0x76, 0x37, 0x81,
-	0xbe, 0xcd, 0x6e, 0xfb, 0x3b, 0xf2, 0x19, 0xe1, 0xf5, 0xec, 0xdc, 0xc9, 0xf2, 0x08, 0xb3, 0xe7,
-	0x6c, 0x9c, 0x45, 0xe2, 0xb0, 0x03, 0x83, 0xbd, 0x4b, 0xaa, 0xcb, 0x61, 0xef, 0x37, 0x8f, 0x47,
-	0x1e, 0x3a, 0x19, 0x79, 0xe8, 0xe7, 0xc8, 0x43, 0x1f, 0xc7, 0x5e, 0xe1, 0x64, 0xec, 0x15, 0xbe,
-	0x8f, 0xbd, 0xc2, 0xcb, 0x9a, 0x90, 0xe9, 0x61, 0x3f, 0x0c, 0x3a, 0xba, 0x37, 0xad, 0x65, 0x3f,
-	0x35, 0xe8, 0xbe, 0xa6, 0x6f, 0xe6, 0x85, 0xd3, 0x61, 0xcc, 0x21, 0x2c, 0x9a, 0xbf, 0xcf, 0xdd,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x0f, 0xc0, 0xe6, 0x45, 0x05, 0x00, 0x00,
+	proto.RegisterType((*QueryMissedBlocksRequest)(nil), "liquidstaking.slashing.v1beta1.QueryMissedBlocksRequest")
+	proto.RegisterType((*QueryMissedBlocksResponse)(nil), "liquidstaking.slashing.v1beta1.QueryMissedBlocksResponse")
+	proto.RegisterType((*QueryInfractionHistoryRequest)(nil), "liquidstaking.slashing.v1beta1.QueryInfractionHistoryRequest")
+	proto.RegisterType((*QueryInfractionHistoryResponse)(nil), "liquidstaking.slashing.v1beta1.QueryInfractionHistoryResponse")
+	proto.RegisterType((*QueryValidatorsAtRiskRequest)(nil), "liquidstaking.slashing.v1beta1.QueryValidatorsAtRiskRequest")
+	proto.RegisterType((*QueryValidatorsAtRiskResponse)(nil), "liquidstaking.slashing.v1beta1.QueryValidatorsAtRiskResponse")
+	proto.RegisterType((*ValidatorAtRisk)(nil), "liquidstaking.slashing.v1beta1.ValidatorAtRisk")
+}
+
+func init() { proto.RegisterFile("slashing/v1beta1/query.proto", fileDescriptor_edfe1dd4e275002d) }
+
+var fileDescriptor_edfe1dd4e275002d = []byte{
+	// 899 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc4, 0x21, 0x85, 0x49, 0x68, 0xc3, 0xb4, 0xa8, 0xc6, 0x0a, 0x9b, 0x74, 0x05, 0xad,
+	0x4b, 0xf1, 0x2e, 0x71, 0xd5, 0x56, 0x42, 0x8d, 0x44, 0x83, 0xd4, 0x92, 0x03, 0x14, 0x16, 0xc4,
+	0xa1, 0x12, 0x5a, 0x8d, 0xd7, 0xe3, 0xcd, 0x28, 0xeb, 0x19, 0x7b, 0x67, 0x6d, 0x61, 0x21, 0x2e,
+	0x1c, 0x2b, 0x0e, 0x48, 0xfc, 0x06, 0x8e, 0x3d, 0x20, 0x55, 0x88, 0x9f, 0x90, 0x63, 0x25, 0x2e,
+	0x9c, 0x10, 0x4a, 0xf8, 0x05, 0xfc, 0x82, 0xca, 0x33, 0x6f, 0xed, 0xb5, 0xd7, 0x9b, 0xd8, 0x55,
+	0x6e, 0xce, 0x9b, 0xf9, 0xde, 0xfb, 0xbe, 0xef, 0xbd, 0x7d, 0x13, 0xbc, 0xa9, 0x22, 0xaa, 0x0e,
+	0xb8, 0x08, 0xdd, 0xfe, 0x4e, 0x83, 0x25, 0x74, 0xc7, 0xed, 0xf6, 0x58, 0x3c, 0x70, 0x3a, 0xb1,
+	0x4c, 0x24, 0xb9, 0x1a, 0x48, 0xd5, 0x96, 0xca, 0x49, 0x2f, 0x39, 0x70, 0xa9, 0xf2, 0x81, 0x39,
+	0x70, 0x1b, 0x54, 0x31, 0x83, 0x18, 0xe1, 0x3b, 0x34, 0xe4, 0x82, 0x26, 0x5c, 0x0a, 0x93, 0xa4,
+	0x72, 0x25, 0x94, 0xa1, 0xd4, 0x3f, 0xdd, 0xe1, 0x2f, 0x88, 0x6e, 0x86, 0x52, 0x86, 0x11, 0x73,
+	0x69, 0x87, 0xbb, 0x54, 0x08, 0x99, 0x68, 0x88, 0x82, 0xd3, 0xad, 0x1c, 0xad, 0x11, 0x05, 0x73,
+	0xc1, 0xca, 0x5d, 0x08, 0x99, 0x60, 0x8a, 0x43, 0x02, 0xfb, 0x0a, 0x26, 0x5f, 0x0d, 0x69, 0x7d,
+	0x49, 0x63, 0xda, 0x56, 0x1e, 0xeb, 0xf6, 0x98, 0x4a, 0xec, 0x6f, 0xf0, 0xe5, 0x89, 0xa8, 0xea,
+	0x48, 0xa1, 0x18, 0xd9, 0xc5, 0xab, 0x1d, 0x1d, 0x29, 0xa3, 0x6d, 0x54, 0x5d, 0xab, 0x6f, 0x39,
+	0x05, 0xba, 0x1d, 0x03, 0xdc, 0x5b, 0x39, 0xfa, 0x67, 0x6b, 0xc9, 0x03, 0x90, 0x7d, 0x1f, 0x5f,
+	0xd5, 0x59, 0xbf, 0xe6, 0xa1, 0xe0, 0x22, 0xdc, 0x17, 0x2d, 0x09, 0x05, 0xc9, 0x35, 0xbc, 0x1e,
+	0x48, 0xa1, 0x7c, 0xda, 0x6c, 0xc6, 0x4c, 0x99, 0xfc, 0x6f, 0x78, 0x6b, 0xc3, 0xd8, 0x03, 0x13,
+	0xb2, 0x07, 0xb8, 0x9c, 0x47, 0x03, 0xb1, 0xef, 0xf0, 0x46, 0x9f, 0x46, 0xbe, 0x32, 0x47, 0x3e,
+	0x17, 0x2d, 0x09, 0x14, 0x6b, 0x85, 0x14, 0xbf, 0xa5, 0x11, 0x6f, 0xd2, 0x44, 0xc6, 0x99, 0x84,
+	0x40, 0xf8, 0x62, 0x9f, 0x46, 0x99, 0xa8, 0xdd, 0xc8, 0x97, 0x4e, 0xad, 0x22, 0x0f, 0x31, 0x1e,
+	0x77, 0x12, 0x8a, 0x5e, 0x4f, 0x8b, 0x0e, 0xdb, 0xee, 0x98, 0x41, 0x19, 0x3b, 0x13, 0x32, 0xc0,
+	0x7a, 0x19, 0xa4, 0xfd, 0x0c, 0xe1, 0x77, 0x66, 0x14, 0x01, 0x81, 0x8f, 0xf0, 0x0a, 0x88, 0x2a,
+	0xbd, 0xaa, 0x28, 0x9d, 0x80, 0x3c, 0x9a, 0xa0, 0xbb, 0xac, 0xe9, 0xde, 0x38, 0x93, 0xae, 0x61,
+	0x31, 0xc1, 0x77, 0x17, 0x3c, 0xf9, 0x9c, 0x2b, 0xc5, 0x9a, 0x7b, 0x91, 0x0c, 0x0e, 0xd5, 0x02,
+	0xdd, 0xfc, 0x3f, 0x95, 0x3b, 0x89, 0x07, 0xb9, 0x8f, 0xf1, 0x9b, 0x6d, 0x1d, 0xf7, 0x1b, 0xfa,
+	0x00, 0x74, 0xbf, 0x57, 0xa8, 0x3b, 0x93, 0x05, 0xe4, 0xae, 0xb7, 0xc7, 0x21, 0x45, 0xde, 0xc7,
+	0x17, 0x21, 0xa1, 0x6c, 0xb5, 0x14, 0x4b, 0x54, 0x79, 0x79, 0xbb, 0x54, 0x2d, 0x79, 0x50, 0xe6,
+	0xb1, 0x09, 0x92, 0x3a, 0x7e, 0x7b, 0xa2, 0xae, 0x1f, 0xc8, 0x9e, 0x48, 0x58, 0x5c, 0x2e, 0x6d,
+	0xa3, 0x6a, 0xc9, 0xbb, 0x9c, 0xcd, 0xf9, 0xa9, 0x39, 0x22, 0x37, 0xf1, 0x06, 0x5c, 0x8e, 0x59,
+	0x9b, 0xf2, 0xa1, 0xeb, 0xe5, 0x15, 0x7d, 0xfd, 0x52, 0x03, 0x54, 0x41, 0xd8, 0x7e, 0x8a, 0xf0,
+	0xbb, 0x5a, 0xf4, 0xbe, 0x68, 0xc5, 0x34, 0x18, 0xfa, 0xf8, 0x19, 0x57, 0x89, 0x8c, 0x07, 0xf3,
+	0x3b, 0x47, 0x1e, 0xce, 0xe8, 0xe0, 0xab, 0x0c, 0xdc, 0x73, 0x84, 0xad, 0x22, 0x32, 0xd0, 0x86,
+	0x7d, 0x7c, 0x21, 0x66, 0x81, 0x8c, 0x9b, 0x69, 0x03, 0x6e, 0x16, 0x36, 0x60, 0x9c, 0xc4, 0xd3,
+	0x08, 0xe8, 0x42, 0x8a, 0x3f, 0xbf, 0xb9, 0x6b, 0xe1, 0x4d, 0xcd, 0x7a, 0x34, 0xe9, 0xea, 0x41,
+	0xe2, 0x71, 0x75, 0x78, 0xde, 0xdf, 0xe3, 0x9f, 0x69, 0xaf, 0xf2, 0x85, 0xc0, 0x9d, 0x2f, 0x30,
+	0xee, 0x8f, 0xce, 0xc0, 0xa0, 0xea, 0xd9, 0x5f, 0xa6, 0xc9, 0x02, 0xfe, 0x64, 0x32, 0x9c, 0x9f,
+	0x45, 0x4f, 0x11, 0xbe, 0x34, 0x55, 0x8e, 0x94, 0xf1, 0x85, 0xc9, 0x99, 0x4a, 0xff, 0x2c, 0x9e,
+	0xf9, 0xe5, 0xc5, 0x66, 0xbe, 0x34, 0x73, 0xe6, 0xeb, 0xcf, 0x5e, 0xc7, 0xaf, 0x69, 0x1f, 0xc9,
+	0xcf, 0x08, 0xaf, 0x9a, 0x77, 0x81, 0xdc, 0x2a, 0xb4, 0x29, 0xff, 0x18, 0x55, 0x3e, 0x9c, 0xef,
+	0xb2, 0x31, 0xc2, 0xbe, 0xf1, 0xd3, 0x5f, 0xff, 0xfd, 0xba, 0x7c, 0x8d, 0x6c, 0xb9, 0xf0, 0xf4,
+	0xe6, 0x1e, 0x40, 0xf3, 0x1a, 0x91, 0xdf, 0x11, 0x5e, 0xcb, 0x6c, 0x49, 0xf2, 0xd1, 0xe9, 0x65,
+	0xf2, 0x8f, 0x56, 0x65, 0x67, 0x01, 0x04, 0xb0, 0xdb, 0xd5, 0xec, 0xee, 0x91, 0x3b, 0x85, 0xec,
+	0xb2, 0x6f, 0x98, 0x72, 0x7f, 0xc8, 0x6e, 0x83, 0x1f, 0xc9, 0x6f, 0x08, 0xaf, 0x67, 0xd2, 0x2a,
+	0x32, 0x3f, 0x85, 0x91, 0x9d, 0xf5, 0x45, 0x20, 0x40, 0xdb, 0xd1, 0xb4, 0xab, 0xe4, 0xfa, 0x7c,
+	0xb4, 0xc9, 0x73, 0x84, 0xd7, 0xb3, 0x8b, 0xfd, 0x2c, 0x9e, 0x33, 0x1e, 0x91, 0x4a, 0x7d, 0x11,
+	0xc8, 0xdc, 0xf6, 0x4e, 0x8c, 0xfa, 0xb4, 0xbd, 0x47, 0x08, 0xbf, 0x95, 0xdb, 0x86, 0xe4, 0xee,
+	0xe9, 0x44, 0x8a, 0x76, 0x79, 0xe5, 0xde, 0xc2, 0x38, 0x50, 0xb1, 0xa7, 0x55, 0xdc, 0x27, 0x1f,
+	0x17, 0xaa, 0xe0, 0x23, 0xac, 0x7f, 0x60, 0xc0, 0xd3, 0x52, 0xfe, 0x40, 0x78, 0x63, 0x7a, 0x73,
+	0x91, 0x3b, 0xa7, 0x33, 0x2a, 0x58, 0xa9, 0x95, 0xbb, 0x8b, 0xc2, 0x40, 0xc7, 0x6d, 0xad, 0xa3,
+	0x46, 0x6e, 0x15, 0xea, 0x18, 0x6f, 0x3f, 0x9f, 0x26, 0x7e, 0x3c, 0xdc, 0x8b, 0x4f, 0x8e, 0x8e,
+	0x2d, 0xf4, 0xe2, 0xd8, 0x42, 0xff, 0x1e, 0x5b, 0xe8, 0x97, 0x13, 0x6b, 0xe9, 0xc5, 0x89, 0xb5,
+	0xf4, 0xf7, 0x89, 0xb5, 0xf4, 0xe4, 0x93, 0x90, 0x27, 0x07, 0xbd, 0x86, 0x13, 0xc8, 0xb6, 0xcb,
+	0xbb, 0x51, 0x4f, 0x71, 0x29, 0xb8, 0x08, 0xdc, 0x88, 0x77, 0x7b, 0xbc, 0xc9, 0x93, 0x41, 0x4d,
+	0x25, 0xf4, 0x90, 0x8b, 0xb0, 0xd6, 0x96, 0xcd, 0x5e, 0xc4, 0xdc, 0xef, 0xc7, 0x05, 0x93, 0x41,
+	0x87, 0xa9, 0xc6, 0xaa, 0xfe, 0x9f, 0xf7, 0xf6, 0xcb, 0x01, 0x00, 0x1f, 0x03, 0xf7, 0xb7, 0xcd,
+	0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -375,6 +805,15 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the blocks missed by a validator in the current
+	// signed blocks window
+	MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error)
+	// InfractionHistory queries the recent infractions a validator was punished
+	// for, from oldest to newest
+	InfractionHistory(ctx context.Context, in *QueryInfractionHistoryRequest, opts ...grpc.CallOption) (*QueryInfractionHistoryResponse, error)
+	// ValidatorsAtRisk queries the validators that have missed at least the
+	// downtime warning threshold of the blocks they may miss before being jailed
+	ValidatorsAtRisk(ctx context.Context, in *QueryValidatorsAtRiskRequest, opts ...grpc.CallOption) (*QueryValidatorsAtRiskResponse, error)
 }
 
 type queryClient struct {
@@ -412,6 +851,33 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error) {
+	out := new(QueryMissedBlocksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/MissedBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InfractionHistory(ctx context.Context, in *QueryInfractionHistoryRequest, opts ...grpc.CallOption) (*QueryInfractionHistoryResponse, error) {
+	out := new(QueryInfractionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/InfractionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsAtRisk(ctx context.Context, in *QueryValidatorsAtRiskRequest, opts ...grpc.CallOption) (*QueryValidatorsAtRiskResponse, error) {
+	out := new(QueryValidatorsAtRiskResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/ValidatorsAtRisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -420,6 +886,15 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the blocks missed by a validator in the current
+	// signed blocks window
+	MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error)
+	// InfractionHistory queries the recent infractions a validator was punished
+	// for, from oldest to newest
+	InfractionHistory(context.Context, *QueryInfractionHistoryRequest) (*QueryInfractionHistoryResponse, error)
+	// ValidatorsAtRisk queries the validators that have missed at least the
+	// downtime warning threshold of the blocks they may miss before being jailed
+	ValidatorsAtRisk(context.Context, *QueryValidatorsAtRiskRequest) (*QueryValidatorsAtRiskResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -435,6 +910,15 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) MissedBlocks(ctx context.Context, req *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlocks not implemented")
+}
+func (*UnimplementedQueryServer) InfractionHistory(ctx context.Context, req *QueryInfractionHistoryRequest) (*QueryInfractionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InfractionHistory not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorsAtRisk(ctx context.Context, req *QueryValidatorsAtRiskRequest) (*QueryValidatorsAtRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsAtRisk not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -494,13 +978,67 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.slashing.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
+func _Query_MissedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/MissedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlocks(ctx, req.(*QueryMissedBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InfractionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInfractionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InfractionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/InfractionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InfractionHistory(ctx, req.(*QueryInfractionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsAtRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsAtRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsAtRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/ValidatorsAtRisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsAtRisk(ctx, req.(*QueryValidatorsAtRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.slashing.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
 		},
 		{
 			MethodName: "SigningInfo",
@@ -510,9 +1048,21 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "MissedBlocks",
+			Handler:    _Query_MissedBlocks_Handler,
+		},
+		{
+			MethodName: "InfractionHistory",
+			Handler:    _Query_InfractionHistory_Handler,
+		},
+		{
+			MethodName: "ValidatorsAtRisk",
+			Handler:    _Query_ValidatorsAtRisk_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/slashing/v1beta1/query.proto",
+	Metadata: "slashing/v1beta1/query.proto",
 }
 
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
@@ -718,128 +1268,1103 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryMissedBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryMissedBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMissedBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMissedBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BlocksRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksRemaining))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MissedOffsets) > 0 {
+		dAtA6 := make([]byte, len(m.MissedOffsets)*10)
+		var j5 int
+		for _, num1 := range m.MissedOffsets {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInfractionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ValSigningInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySigningInfosRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryInfractionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInfractionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfosResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInfractionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInfractionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInfractionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Info) > 0 {
-		for _, e := range m.Info {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryValidatorsAtRiskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryValidatorsAtRiskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
+
+func (m *QueryValidatorsAtRiskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsAtRiskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsAtRiskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsAtRiskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorAtRisk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAtRisk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorAtRisk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksRemaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValSigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		for _, e := range m.Info {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MissedOffsets) > 0 {
+		l = 0
+		for _, e := range m.MissedOffsets {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	}
+	if m.BlocksRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksRemaining))
+	}
+	return n
+}
+
+func (m *QueryInfractionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInfractionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsAtRiskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsAtRiskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorAtRisk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	}
+	if m.BlocksRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksRemaining))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, ValidatorSigningInfo{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissedBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -861,7 +2386,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMissedBlocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -884,15 +2409,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -919,10 +2444,125 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MissedBlocks = append(m.MissedBlocks, MissedBlock{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissedOffsets = append(m.MissedOffsets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissedOffsets) == 0 {
+					m.MissedOffsets = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissedOffsets = append(m.MissedOffsets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedOffsets", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksRemaining", wireType)
+			}
+			m.BlocksRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -944,7 +2584,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInfractionHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -967,10 +2607,10 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInfractionHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInfractionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1005,6 +2645,42 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ConsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1026,7 +2702,7 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInfractionHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1049,15 +2725,15 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInfractionHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInfractionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1084,7 +2760,44 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, InfractionRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1109,7 +2822,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorsAtRiskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1132,10 +2845,10 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorsAtRiskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorsAtRiskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1195,7 +2908,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorsAtRiskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1218,15 +2931,15 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorsAtRiskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorsAtRiskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1253,8 +2966,8 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, ValidatorSigningInfo{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, ValidatorAtRisk{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1315,6 +3028,126 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorAtRisk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAtRisk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAtRisk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksRemaining", wireType)
+			}
+			m.BlocksRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25,6 +25,23 @@ func NewValidatorSigningInfo(
 	}
 }
 
+// NewInfractionRecord creates a new InfractionRecord instance
+//
+//nolint:interfacer
+func NewInfractionRecord(
+	consAddr sdk.ConsAddress, height int64, infractionType InfractionType,
+	slashFraction sdk.Dec, jailedUntil time.Time, tombstoned bool,
+) InfractionRecord {
+	return InfractionRecord{
+		Address:        consAddr.String(),
+		Height:         height,
+		InfractionType: infractionType,
+		SlashFraction:  slashFraction,
+		JailedUntil:    jailedUntil,
+		Tombstoned:     tombstoned,
+	}
+}
+
 // String implements the stringer interface for ValidatorSigningInfo
 func (i ValidatorSigningInfo) String() string {
 	return fmt.Sprintf(`Validator Signing Info:
@@ -33,9 +50,27 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Downtime Offenses:     %d
+  Last Downtime Offense: %v
+  Downtime Warned:       %t`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.DowntimeOffenseCount, i.LastDowntimeOffenseTime,
+		i.DowntimeWarned)
+}
+
+// DecayedDowntimeOffenseCount returns the downtime offense count at the given
+// time, decremented once for every decay period elapsed since the last offense
+func (i ValidatorSigningInfo) DecayedDowntimeOffenseCount(blockTime time.Time, decayPeriod time.Duration) int64 {
+	if i.DowntimeOffenseCount <= 0 || decayPeriod <= 0 || !blockTime.After(i.LastDowntimeOffenseTime) {
+		return i.DowntimeOffenseCount
+	}
+
+	decayed := int64(blockTime.Sub(i.LastDowntimeOffenseTime) / decayPeriod)
+	if decayed >= i.DowntimeOffenseCount {
+		return 0
+	}
+	return i.DowntimeOffenseCount - decayed
 }
 
 // unmarshal a validator signing info from a store value
@@ -1,16 +1,15 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: cosmos/slashing/v1beta1/slashing.proto
+// source: slashing/v1beta1/slashing.proto
 
 package types
 
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	_ "google.golang.org/protobuf/types/known/durationpb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -29,6 +28,43 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InfractionType defines the source of an infraction that a validator is
+// punished for.
+type InfractionType int32
+
+const (
+	// INFRACTION_TYPE_UNSPECIFIED defines an empty infraction type.
+	InfractionEmpty InfractionType = 0
+	// INFRACTION_TYPE_DOUBLE_SIGN defines a validator that double-signs a block.
+	InfractionDoubleSign InfractionType = 1
+	// INFRACTION_TYPE_DOWNTIME defines a validator that missed signing too many blocks.
+	InfractionDowntime InfractionType = 2
+	// INFRACTION_TYPE_CONSUMER defines an infraction reported by a consumer chain.
+	InfractionConsumer InfractionType = 3
+)
+
+var InfractionType_name = map[int32]string{
+	0: "INFRACTION_TYPE_UNSPECIFIED",
+	1: "INFRACTION_TYPE_DOUBLE_SIGN",
+	2: "INFRACTION_TYPE_DOWNTIME",
+	3: "INFRACTION_TYPE_CONSUMER",
+}
+
+var InfractionType_value = map[string]int32{
+	"INFRACTION_TYPE_UNSPECIFIED": 0,
+	"INFRACTION_TYPE_DOUBLE_SIGN": 1,
+	"INFRACTION_TYPE_DOWNTIME":    2,
+	"INFRACTION_TYPE_CONSUMER":    3,
+}
+
+func (x InfractionType) String() string {
+	return proto.EnumName(InfractionType_name, int32(x))
+}
+
+func (InfractionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -47,12 +83,21 @@ type ValidatorSigningInfo struct {
 	// A counter kept to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty" yaml:"missed_blocks_counter"`
+	// Number of downtime offenses the validator has committed, decremented once
+	// for every `DowntimeOffenseDecayPeriod` elapsed since the last offense.
+	DowntimeOffenseCount int64 `protobuf:"varint,7,opt,name=downtime_offense_count,json=downtimeOffenseCount,proto3" json:"downtime_offense_count,omitempty" yaml:"downtime_offense_count"`
+	// Timestamp of the validator's last downtime offense, used to decay
+	// `DowntimeOffenseCount`.
+	LastDowntimeOffenseTime time.Time `protobuf:"bytes,8,opt,name=last_downtime_offense_time,json=lastDowntimeOffenseTime,proto3,stdtime" json:"last_downtime_offense_time" yaml:"last_downtime_offense_time"`
+	// Whether the downtime warning has been emitted since the validator's missed
+	// blocks last dropped well below the warning threshold or it was last jailed.
+	DowntimeWarned bool `protobuf:"varint,9,opt,name=downtime_warned,json=downtimeWarned,proto3" json:"downtime_warned,omitempty" yaml:"downtime_warned"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
 func (*ValidatorSigningInfo) ProtoMessage() {}
 func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{0}
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{0}
 }
 func (m *ValidatorSigningInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,6 +168,27 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeOffenseCount() int64 {
+	if m != nil {
+		return m.DowntimeOffenseCount
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetLastDowntimeOffenseTime() time.Time {
+	if m != nil {
+		return m.LastDowntimeOffenseTime
+	}
+	return time.Time{}
+}
+
+func (m *ValidatorSigningInfo) GetDowntimeWarned() bool {
+	if m != nil {
+		return m.DowntimeWarned
+	}
+	return false
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
@@ -130,13 +196,35 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	// downtime_offense_decay_period is the period after which a validator's
+	// downtime offense count is decremented if it commits no new offense.
+	DowntimeOffenseDecayPeriod time.Duration `protobuf:"bytes,6,opt,name=downtime_offense_decay_period,json=downtimeOffenseDecayPeriod,proto3,stdduration" json:"downtime_offense_decay_period" yaml:"downtime_offense_decay_period"`
+	// downtime_jail_duration_multiplier scales the downtime jail duration once
+	// for every prior downtime offense of the validator.
+	DowntimeJailDurationMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=downtime_jail_duration_multiplier,json=downtimeJailDurationMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_jail_duration_multiplier" yaml:"downtime_jail_duration_multiplier"`
+	// slash_fraction_downtime_multiplier scales the downtime slash fraction once
+	// for every prior downtime offense of the validator.
+	SlashFractionDowntimeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=slash_fraction_downtime_multiplier,json=slashFractionDowntimeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime_multiplier" yaml:"slash_fraction_downtime_multiplier"`
+	// unjail_requires_validator_bond requires a jailed validator to have a
+	// positive validator bond, rather than an operator self-delegation, to be
+	// unjailed, and allows validator bond holders to submit the unjail.
+	UnjailRequiresValidatorBond bool `protobuf:"varint,9,opt,name=unjail_requires_validator_bond,json=unjailRequiresValidatorBond,proto3" json:"unjail_requires_validator_bond,omitempty" yaml:"unjail_requires_validator_bond"`
+	// infraction_params overrides the slash fraction, jail duration and
+	// tombstone behavior for specific infraction types.
+	InfractionParams []InfractionParams `protobuf:"bytes,10,rep,name=infraction_params,json=infractionParams,proto3" json:"infraction_params" yaml:"infraction_params"`
+	// infraction_history_length is the number of most recent infraction records
+	// kept for each validator. Zero disables the infraction history.
+	InfractionHistoryLength int64 `protobuf:"varint,11,opt,name=infraction_history_length,json=infractionHistoryLength,proto3" json:"infraction_history_length,omitempty" yaml:"infraction_history_length"`
+	// downtime_warning_threshold is the fraction of the blocks a validator may
+	// miss in the signed blocks window at which a downtime warning is emitted.
+	DowntimeWarningThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=downtime_warning_threshold,json=downtimeWarningThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_warning_threshold" yaml:"downtime_warning_threshold"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{1}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,57 +267,275 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeOffenseDecayPeriod() time.Duration {
+	if m != nil {
+		return m.DowntimeOffenseDecayPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetUnjailRequiresValidatorBond() bool {
+	if m != nil {
+		return m.UnjailRequiresValidatorBond
+	}
+	return false
+}
+
+func (m *Params) GetInfractionParams() []InfractionParams {
+	if m != nil {
+		return m.InfractionParams
+	}
+	return nil
+}
+
+func (m *Params) GetInfractionHistoryLength() int64 {
+	if m != nil {
+		return m.InfractionHistoryLength
+	}
+	return 0
+}
+
+// InfractionParams defines the penalties applied to a validator for a single
+// infraction type.
+type InfractionParams struct {
+	InfractionType InfractionType                         `protobuf:"varint,1,opt,name=infraction_type,json=infractionType,proto3,enum=cosmos.slashing.v1beta1.InfractionType" json:"infraction_type,omitempty" yaml:"infraction_type"`
+	SlashFraction  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	JailDuration   time.Duration                          `protobuf:"bytes,3,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration" yaml:"jail_duration"`
+	// tombstone permanently removes the validator from the validator set.
+	Tombstone bool `protobuf:"varint,4,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+}
+
+func (m *InfractionParams) Reset()         { *m = InfractionParams{} }
+func (m *InfractionParams) String() string { return proto.CompactTextString(m) }
+func (*InfractionParams) ProtoMessage()    {}
+func (*InfractionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{2}
+}
+func (m *InfractionParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InfractionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InfractionParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InfractionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfractionParams.Merge(m, src)
+}
+func (m *InfractionParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *InfractionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfractionParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfractionParams proto.InternalMessageInfo
+
+func (m *InfractionParams) GetInfractionType() InfractionType {
+	if m != nil {
+		return m.InfractionType
+	}
+	return InfractionEmpty
+}
+
+func (m *InfractionParams) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *InfractionParams) GetTombstone() bool {
+	if m != nil {
+		return m.Tombstone
+	}
+	return false
+}
+
+// InfractionRecord records a punishment of a validator for an infraction.
+type InfractionRecord struct {
+	// address is the validator consensus address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height is the block height at which the validator was punished.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// infraction_type is the type of the infraction.
+	InfractionType InfractionType `protobuf:"varint,3,opt,name=infraction_type,json=infractionType,proto3,enum=cosmos.slashing.v1beta1.InfractionType" json:"infraction_type,omitempty" yaml:"infraction_type"`
+	// slash_fraction is the fraction of the validator's stake that was slashed.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	// jailed_until is the time until which the validator was jailed.
+	JailedUntil time.Time `protobuf:"bytes,5,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until" yaml:"jailed_until"`
+	// tombstoned is whether the validator was tombstoned for the infraction.
+	Tombstoned bool `protobuf:"varint,6,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+}
+
+func (m *InfractionRecord) Reset()         { *m = InfractionRecord{} }
+func (m *InfractionRecord) String() string { return proto.CompactTextString(m) }
+func (*InfractionRecord) ProtoMessage()    {}
+func (*InfractionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{3}
+}
+func (m *InfractionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InfractionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InfractionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InfractionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfractionRecord.Merge(m, src)
+}
+func (m *InfractionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *InfractionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfractionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfractionRecord proto.InternalMessageInfo
+
+func (m *InfractionRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *InfractionRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *InfractionRecord) GetInfractionType() InfractionType {
+	if m != nil {
+		return m.InfractionType
+	}
+	return InfractionEmpty
+}
+
+func (m *InfractionRecord) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+func (m *InfractionRecord) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("liquidstaking.slashing.v1beta1.InfractionType", InfractionType_name, InfractionType_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "liquidstaking.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "liquidstaking.slashing.v1beta1.Params")
+	proto.RegisterType((*InfractionParams)(nil), "liquidstaking.slashing.v1beta1.InfractionParams")
+	proto.RegisterType((*InfractionRecord)(nil), "liquidstaking.slashing.v1beta1.InfractionRecord")
 }
 
-func init() {
-	proto.RegisterFile("cosmos/slashing/v1beta1/slashing.proto", fileDescriptor_1078e5d96a74cc52)
-}
-
-var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
-	0x18, 0xce, 0xfd, 0xd2, 0x5f, 0x29, 0x97, 0x4c, 0x6e, 0x4a, 0x4c, 0x00, 0x3b, 0x78, 0xa8, 0xc2,
-	0x50, 0x5b, 0x2d, 0x5b, 0x47, 0x53, 0x21, 0x3e, 0x24, 0x28, 0x6e, 0x01, 0x89, 0x01, 0xeb, 0x9c,
-	0xbb, 0x38, 0x47, 0xed, 0xbb, 0xc8, 0x77, 0xa1, 0x2d, 0x1b, 0x5b, 0xc7, 0x8e, 0x1d, 0x3b, 0xf2,
-	0xa7, 0x74, 0xec, 0x88, 0x18, 0x02, 0x4a, 0x17, 0xe6, 0x6e, 0x6c, 0xc8, 0x77, 0x76, 0x1b, 0xa5,
-	0x29, 0x52, 0xa7, 0xe4, 0x7d, 0xde, 0xe7, 0x7d, 0xee, 0x79, 0x3f, 0x12, 0xb8, 0xdc, 0xe5, 0x22,
-	0xe5, 0xc2, 0x13, 0x09, 0x12, 0x7d, 0xca, 0x62, 0xef, 0xf3, 0x6a, 0x44, 0x24, 0x5a, 0xbd, 0x00,
-	0xdc, 0x41, 0xc6, 0x25, 0x37, 0x9a, 0x9a, 0xe7, 0x5e, 0xc0, 0x05, 0xaf, 0xd5, 0x88, 0x79, 0xcc,
-	0x15, 0xc7, 0xcb, 0xbf, 0x69, 0x7a, 0xcb, 0x8a, 0x39, 0x8f, 0x13, 0xe2, 0xa9, 0x28, 0x1a, 0xf6,
-	0x3c, 0x3c, 0xcc, 0x90, 0xa4, 0x9c, 0x15, 0x79, 0x7b, 0x3a, 0x2f, 0x69, 0x4a, 0x84, 0x44, 0xe9,
-	0x40, 0x13, 0x9c, 0x83, 0x2a, 0x6c, 0xbc, 0x43, 0x09, 0xc5, 0x48, 0xf2, 0x6c, 0x8b, 0xc6, 0x8c,
-	0xb2, 0xf8, 0x39, 0xeb, 0x71, 0xc3, 0x84, 0xb7, 0x10, 0xc6, 0x19, 0x11, 0xc2, 0x04, 0x6d, 0xd0,
-	0xb9, 0x1d, 0x94, 0xa1, 0xb1, 0x0e, 0xeb, 0x42, 0xa2, 0x4c, 0x86, 0x7d, 0x42, 0xe3, 0xbe, 0x34,
-	0xff, 0x6b, 0x83, 0x4e, 0xd5, 0x6f, 0x9e, 0x8f, 0xec, 0xc5, 0x7d, 0x94, 0x26, 0xeb, 0xce, 0x64,
-	0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xa6, 0xa2, 0xbc, 0x96, 0x32, 0x4c, 0xf6, 0x42, 0xde, 0xeb, 0x09,
-	0x22, 0xcd, 0xea, 0x74, 0xed, 0x64, 0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xad, 0x22, 0xe3, 0x23, 0xac,
-	0x7f, 0x42, 0x34, 0x21, 0x38, 0x1c, 0x32, 0x49, 0x13, 0x73, 0xae, 0x0d, 0x3a, 0xb5, 0xb5, 0x96,
-	0xab, 0x5b, 0x74, 0xcb, 0x16, 0xdd, 0xed, 0xb2, 0x45, 0xdf, 0x3e, 0x19, 0xd9, 0x95, 0x4b, 0xed,
-	0xc9, 0x6a, 0xe7, 0xf0, 0xa7, 0x0d, 0x82, 0x9a, 0x86, 0xde, 0xe6, 0x88, 0x61, 0x41, 0x28, 0x79,
-	0x1a, 0x09, 0xc9, 0x19, 0xc1, 0xe6, 0xff, 0x6d, 0xd0, 0x59, 0x08, 0x26, 0x10, 0x63, 0x1b, 0x2e,
-	0xa5, 0x54, 0x08, 0x82, 0xc3, 0x28, 0xe1, 0xdd, 0x1d, 0x11, 0x76, 0xf9, 0x90, 0x49, 0x92, 0x99,
-	0xf3, 0xaa, 0x89, 0xf6, 0xf9, 0xc8, 0xbe, 0xaf, 0x1f, 0x9a, 0x49, 0x73, 0x82, 0x45, 0x8d, 0xfb,
-	0x0a, 0x7e, 0xa2, 0xd1, 0xf5, 0x85, 0xa3, 0x63, 0xbb, 0xf2, 0xfb, 0xd8, 0x06, 0xce, 0x9f, 0x39,
-	0x38, 0xbf, 0x89, 0x32, 0x94, 0x0a, 0xe3, 0x0d, 0x6c, 0x08, 0x1a, 0xb3, 0x4b, 0x8d, 0x5d, 0xca,
-	0x30, 0xdf, 0x55, 0x9b, 0xa8, 0xfa, 0xf6, 0xf9, 0xc8, 0xbe, 0x57, 0x8c, 0x7a, 0x06, 0xcb, 0x09,
-	0x0c, 0x0d, 0xeb, 0x87, 0xde, 0x2b, 0xd0, 0xf8, 0x0a, 0x72, 0xfb, 0x2c, 0x2c, 0x2a, 0x06, 0x24,
-	0x2b, 0x45, 0xf3, 0xfd, 0xd5, 0xfd, 0x57, 0xf9, 0xac, 0x7e, 0x8c, 0xec, 0xe5, 0x98, 0xca, 0xfe,
-	0x30, 0x72, 0xbb, 0x3c, 0xf5, 0x8a, 0x9b, 0xd5, 0x1f, 0x2b, 0x02, 0xef, 0x78, 0x72, 0x7f, 0x40,
-	0x84, 0xbb, 0x41, 0xba, 0x93, 0xcd, 0xce, 0x10, 0x75, 0x02, 0x23, 0xa5, 0x6c, 0x4b, 0xc1, 0x9b,
-	0x24, 0x2b, 0x3c, 0x7c, 0x81, 0x77, 0x30, 0xdf, 0x65, 0xf9, 0x0d, 0x86, 0xf9, 0xe4, 0xc3, 0xf2,
-	0x5a, 0xd5, 0x1d, 0xd4, 0xd6, 0xee, 0x5e, 0xd9, 0xe5, 0x46, 0x41, 0xf0, 0x1f, 0x15, 0xab, 0x7c,
-	0xa0, 0x1f, 0x9d, 0x2d, 0xe3, 0x1c, 0xe5, 0x4b, 0x6d, 0x94, 0xc9, 0x17, 0x88, 0x26, 0xa5, 0x80,
-	0x71, 0x08, 0x60, 0x4b, 0xfd, 0xa8, 0xc2, 0x5e, 0x86, 0xba, 0x39, 0x14, 0x62, 0x3e, 0x8c, 0x12,
-	0xa2, 0xcc, 0xab, 0x63, 0xaa, 0xfb, 0x5b, 0x37, 0x1e, 0xc2, 0xc3, 0x62, 0x0f, 0xd7, 0x2a, 0x3b,
-	0x41, 0x53, 0x25, 0x9f, 0x16, 0xb9, 0x0d, 0x95, 0xca, 0x27, 0x63, 0x1c, 0x00, 0xd8, 0xbc, 0x52,
-	0xa8, 0xad, 0xab, 0xf3, 0xab, 0xfb, 0x9b, 0x37, 0xf6, 0x63, 0x5d, 0xe3, 0x47, 0xcb, 0x3a, 0xc1,
-	0xd2, 0x94, 0x19, 0x8d, 0xfb, 0x2f, 0xbf, 0x8d, 0x2d, 0x70, 0x32, 0xb6, 0xc0, 0xe9, 0xd8, 0x02,
-	0xbf, 0xc6, 0x16, 0x38, 0x3c, 0xb3, 0x2a, 0xa7, 0x67, 0x56, 0xe5, 0xfb, 0x99, 0x55, 0xf9, 0xb0,
-	0xf2, 0xcf, 0xe7, 0xf7, 0x2e, 0xff, 0xd4, 0x94, 0x93, 0x68, 0x5e, 0xad, 0xef, 0xf1, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0x78, 0xfd, 0x40, 0xf4, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("slashing/v1beta1/slashing.proto", fileDescriptor_2ddc33c0f1f4d4ab) }
+
+var fileDescriptor_2ddc33c0f1f4d4ab = []byte{
+	// 1293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xf7, 0x62, 0x08, 0x61, 0x6c, 0x42, 0xbe, 0x43, 0x48, 0x16, 0x03, 0xbb, 0x66, 0xc5, 0xb7,
+	0x4d, 0x2a, 0xc5, 0x2e, 0x29, 0x97, 0x72, 0x6a, 0x37, 0x36, 0xe0, 0x0a, 0x9c, 0x74, 0xe3, 0x34,
+	0x2a, 0x95, 0x58, 0xd6, 0xde, 0xc9, 0x7a, 0xca, 0xee, 0x8c, 0xd9, 0x1f, 0x04, 0xf7, 0xd6, 0x4b,
+	0x85, 0x38, 0x54, 0xf4, 0xc6, 0x05, 0x09, 0xb5, 0xaa, 0xc4, 0xad, 0x7f, 0x41, 0xef, 0x1c, 0x39,
+	0x56, 0x3d, 0xb8, 0x55, 0xb8, 0xf4, 0x58, 0xb9, 0xff, 0x40, 0xb5, 0x33, 0xbb, 0xf6, 0x7a, 0x6d,
+	0x87, 0x46, 0x15, 0x3d, 0x25, 0xf3, 0xde, 0xe7, 0xbd, 0x7d, 0xf3, 0x3e, 0x9f, 0x99, 0x37, 0x06,
+	0xb2, 0x67, 0x1b, 0x5e, 0x1b, 0x13, 0xab, 0xfc, 0xe0, 0x72, 0x13, 0xf9, 0xc6, 0xe5, 0x72, 0x6c,
+	0x28, 0x75, 0x5c, 0xea, 0x53, 0xb8, 0xd4, 0xa2, 0x9e, 0x43, 0xbd, 0xd2, 0xc0, 0x1c, 0xe1, 0x0a,
+	0x0b, 0x16, 0xb5, 0x28, 0xc3, 0x94, 0xc3, 0xff, 0x38, 0xbc, 0x20, 0x59, 0x94, 0x5a, 0x36, 0x2a,
+	0xb3, 0x55, 0x33, 0xd8, 0x2d, 0x9b, 0x81, 0x6b, 0xf8, 0x98, 0x92, 0xc8, 0x2f, 0xa7, 0xfd, 0x3e,
+	0x76, 0x90, 0xe7, 0x1b, 0x4e, 0x87, 0x03, 0x94, 0x9f, 0x8e, 0x81, 0x85, 0xcf, 0x0c, 0x1b, 0x9b,
+	0x86, 0x4f, 0xdd, 0x2d, 0x6c, 0x11, 0x4c, 0xac, 0x1a, 0xd9, 0xa5, 0x50, 0x04, 0xc7, 0x0d, 0xd3,
+	0x74, 0x91, 0xe7, 0x89, 0x42, 0x51, 0x58, 0x3e, 0xa1, 0xc5, 0x4b, 0x78, 0x15, 0xe4, 0x3d, 0xdf,
+	0x70, 0x7d, 0xbd, 0x8d, 0xb0, 0xd5, 0xf6, 0xc5, 0x23, 0x45, 0x61, 0x39, 0xab, 0x2e, 0xf5, 0x7b,
+	0xf2, 0xe9, 0xae, 0xe1, 0xd8, 0x57, 0x95, 0xa4, 0x57, 0xd1, 0x72, 0x6c, 0x79, 0x83, 0xad, 0xc2,
+	0x58, 0x4c, 0x4c, 0xf4, 0x50, 0xa7, 0xbb, 0xbb, 0x1e, 0xf2, 0xc5, 0x6c, 0x3a, 0x36, 0xe9, 0x55,
+	0xb4, 0x1c, 0x5b, 0x6e, 0xb0, 0x15, 0xbc, 0x03, 0xf2, 0x5f, 0x1a, 0xd8, 0x46, 0xa6, 0x1e, 0x10,
+	0x1f, 0xdb, 0xe2, 0xd1, 0xa2, 0xb0, 0x9c, 0x5b, 0x2b, 0x94, 0xf8, 0x16, 0x4b, 0xf1, 0x16, 0x4b,
+	0x8d, 0x78, 0x8b, 0xaa, 0xfc, 0xb2, 0x27, 0x67, 0x86, 0xb9, 0x93, 0xd1, 0xca, 0x93, 0xdf, 0x64,
+	0x41, 0xcb, 0x71, 0xd3, 0x76, 0x68, 0x81, 0x12, 0x00, 0x3e, 0x75, 0x9a, 0x9e, 0x4f, 0x09, 0x32,
+	0xc5, 0x63, 0x45, 0x61, 0x79, 0x56, 0x4b, 0x58, 0x60, 0x03, 0x9c, 0x71, 0xb0, 0xe7, 0x21, 0x53,
+	0x6f, 0xda, 0xb4, 0x75, 0xcf, 0xd3, 0x5b, 0x34, 0x20, 0x3e, 0x72, 0xc5, 0x19, 0xb6, 0x89, 0x62,
+	0xbf, 0x27, 0x9f, 0xe7, 0x1f, 0x9a, 0x08, 0x53, 0xb4, 0xd3, 0xdc, 0xae, 0x32, 0xf3, 0x3a, 0xb7,
+	0xc2, 0x1d, 0xb0, 0x68, 0xd2, 0x3d, 0x12, 0xf2, 0x12, 0x6e, 0x1b, 0x11, 0x0f, 0xf1, 0x08, 0xf1,
+	0x38, 0x4b, 0x7b, 0xb1, 0xdf, 0x93, 0x2f, 0xf0, 0xb4, 0x93, 0x71, 0x8a, 0xb6, 0x10, 0x3b, 0x36,
+	0xb8, 0x9d, 0xa5, 0x86, 0xdf, 0x08, 0xa0, 0x60, 0x1b, 0x9e, 0xaf, 0x8f, 0x85, 0x85, 0x0b, 0x71,
+	0xf6, 0x8d, 0xdd, 0x5b, 0x8d, 0xba, 0x77, 0x91, 0x7f, 0x7d, 0x7a, 0x2e, 0xde, 0xcb, 0xa5, 0x10,
+	0x50, 0x19, 0xad, 0x24, 0x4c, 0x06, 0xd7, 0xc1, 0xa9, 0x41, 0xd8, 0x9e, 0xe1, 0x86, 0xcd, 0x3d,
+	0x11, 0x36, 0x57, 0x2d, 0xf4, 0x7b, 0xf2, 0x62, 0x6a, 0x6b, 0x1c, 0xa0, 0x68, 0x73, 0xb1, 0x65,
+	0x87, 0x19, 0xae, 0xce, 0x3e, 0x7d, 0x2e, 0x67, 0xfe, 0x78, 0x2e, 0x0b, 0xca, 0xcf, 0x79, 0x30,
+	0xb3, 0x69, 0xb8, 0x86, 0xe3, 0xc1, 0x4f, 0xc1, 0x82, 0x87, 0x2d, 0x32, 0x6c, 0xf5, 0x1e, 0x26,
+	0x26, 0xdd, 0x63, 0x82, 0xcd, 0xaa, 0x72, 0xbf, 0x27, 0x9f, 0x8b, 0x14, 0x39, 0x01, 0xa5, 0x68,
+	0x90, 0x9b, 0x39, 0x1f, 0x3b, 0xcc, 0x08, 0xbf, 0x16, 0x42, 0x96, 0x89, 0x1e, 0x45, 0x74, 0x90,
+	0x1b, 0x27, 0x0d, 0x65, 0x9e, 0x57, 0xeb, 0x61, 0x53, 0x7e, 0xed, 0xc9, 0xef, 0x58, 0xd8, 0x6f,
+	0x07, 0xcd, 0x52, 0x8b, 0x3a, 0x65, 0x7e, 0x64, 0xa3, 0x3f, 0xab, 0x9e, 0x79, 0xaf, 0xec, 0x77,
+	0x3b, 0xc8, 0x2b, 0x55, 0x50, 0x2b, 0xa9, 0x89, 0x09, 0x49, 0x15, 0x0d, 0x3a, 0x98, 0x6c, 0x31,
+	0xf3, 0x26, 0x72, 0xa3, 0x1a, 0xbe, 0x4a, 0x48, 0x22, 0x14, 0xa8, 0x1e, 0x1f, 0x6a, 0x76, 0x5c,
+	0x72, 0x6b, 0x67, 0xc7, 0x48, 0xab, 0x44, 0x00, 0x75, 0x25, 0xe2, 0x2c, 0xad, 0x98, 0x91, 0x34,
+	0xca, 0xd3, 0x90, 0xaf, 0x81, 0x6a, 0x3e, 0x31, 0xb0, 0x1d, 0x27, 0x80, 0x4f, 0x04, 0x50, 0x60,
+	0x77, 0x8f, 0xbe, 0xeb, 0x1a, 0xad, 0xd0, 0xa4, 0x9b, 0x34, 0x68, 0xda, 0x88, 0x15, 0xcf, 0xce,
+	0x5c, 0x5e, 0xdd, 0x3a, 0x74, 0x13, 0x22, 0x0d, 0x4d, 0xcf, 0xac, 0x68, 0x4b, 0xcc, 0x79, 0x2d,
+	0xf2, 0x55, 0x98, 0x2b, 0xec, 0x0c, 0x7c, 0x24, 0x80, 0xa5, 0xb1, 0x40, 0x5e, 0x3a, 0x3b, 0xa5,
+	0x79, 0x75, 0xf3, 0xd0, 0xf5, 0x48, 0x53, 0xea, 0xe1, 0x69, 0x15, 0xed, 0x4c, 0xaa, 0x18, 0x6e,
+	0x87, 0xdf, 0x0a, 0xe0, 0xc2, 0xd8, 0x11, 0x30, 0x51, 0xcb, 0xe8, 0x86, 0xa4, 0x62, 0x6a, 0x8a,
+	0x33, 0x6f, 0x62, 0xe8, 0xfd, 0x88, 0xa1, 0x4b, 0x53, 0xce, 0x74, 0x32, 0x1b, 0x27, 0xaa, 0x90,
+	0x3a, 0xde, 0x95, 0x10, 0xb1, 0xc9, 0x00, 0xf0, 0x47, 0x01, 0x5c, 0x9c, 0x4c, 0xb2, 0xee, 0x04,
+	0xb6, 0x8f, 0x3b, 0x36, 0x46, 0x2e, 0xbb, 0x49, 0xf2, 0xea, 0xed, 0x43, 0x77, 0x69, 0xf9, 0x20,
+	0x15, 0x25, 0x3e, 0xa0, 0x68, 0xd2, 0x24, 0x31, 0xdd, 0x1a, 0x00, 0xe0, 0x0b, 0x01, 0x4c, 0x6b,
+	0x76, 0xb2, 0xd0, 0x59, 0x56, 0xe8, 0x17, 0x87, 0x2e, 0x74, 0xe5, 0x40, 0x3a, 0x47, 0x2a, 0x95,
+	0x27, 0x32, 0x9b, 0x28, 0x95, 0x00, 0x29, 0x20, 0x6c, 0xa7, 0x2e, 0xba, 0x1f, 0x60, 0x17, 0x79,
+	0xfa, 0x83, 0x78, 0x40, 0xea, 0x4d, 0x4a, 0xe2, 0xdb, 0x6b, 0xa5, 0xdf, 0x93, 0xff, 0xcf, 0xbf,
+	0x7b, 0x30, 0x5e, 0xd1, 0xce, 0x71, 0x80, 0x16, 0xf9, 0x07, 0xf3, 0x56, 0xa5, 0xc4, 0x84, 0x0f,
+	0xc1, 0xff, 0x30, 0x19, 0xd4, 0xdc, 0x61, 0x37, 0x9b, 0x08, 0x8a, 0xd9, 0xe5, 0xdc, 0xda, 0x4a,
+	0x69, 0xca, 0x6b, 0xa0, 0x54, 0x1b, 0x44, 0xf0, 0xab, 0x50, 0x2d, 0x46, 0xb2, 0x12, 0xe3, 0x31,
+	0x9a, 0xca, 0xa8, 0x68, 0xf3, 0x38, 0x15, 0x03, 0xef, 0x82, 0xb3, 0x09, 0x5c, 0x1b, 0x7b, 0x3e,
+	0x75, 0xbb, 0xba, 0x8d, 0x88, 0xe5, 0xb7, 0xc5, 0x1c, 0xbb, 0x43, 0x2f, 0xf5, 0x7b, 0x72, 0x71,
+	0x2c, 0xe5, 0x28, 0x54, 0xd1, 0x96, 0x86, 0xbe, 0x1b, 0xdc, 0x75, 0x93, 0x79, 0xe0, 0x77, 0x02,
+	0x28, 0x8c, 0x5c, 0xed, 0x98, 0x58, 0xba, 0xdf, 0x76, 0x91, 0xd7, 0xa6, 0xb6, 0x29, 0xe6, 0xff,
+	0xdd, 0x6d, 0x32, 0x3d, 0xb3, 0xa2, 0x89, 0xc9, 0xf9, 0x81, 0x89, 0xd5, 0x18, 0xb8, 0xfe, 0x3c,
+	0x02, 0xe6, 0xd3, 0xed, 0x83, 0x36, 0x38, 0x95, 0xd8, 0x5f, 0xf8, 0x2d, 0x36, 0x44, 0xe6, 0xd6,
+	0xde, 0xfd, 0x07, 0x14, 0x34, 0xba, 0x1d, 0x94, 0x1c, 0x66, 0xa9, 0x4c, 0x8a, 0x36, 0x87, 0x47,
+	0xb0, 0x90, 0x80, 0xb9, 0x51, 0xa9, 0x46, 0xc3, 0xe5, 0xfa, 0xa1, 0x3b, 0x71, 0x66, 0x92, 0xf0,
+	0x15, 0xed, 0xe4, 0x88, 0xc8, 0xe1, 0x5d, 0x70, 0xf2, 0x90, 0x73, 0x24, 0x96, 0xd3, 0xc2, 0xf0,
+	0xe5, 0x94, 0x1a, 0x1f, 0xec, 0x2d, 0x36, 0x18, 0x1b, 0xe7, 0xc1, 0x89, 0xc1, 0x4b, 0x89, 0x0d,
+	0x89, 0x59, 0x6d, 0x68, 0x50, 0xbe, 0xcf, 0x26, 0x5b, 0xae, 0xa1, 0x16, 0x75, 0xcd, 0x03, 0x1e,
+	0x98, 0x8b, 0x60, 0x26, 0xf9, 0xb4, 0xd4, 0xa2, 0xd5, 0x24, 0x92, 0xb2, 0xff, 0x25, 0x49, 0x47,
+	0xdf, 0x2a, 0x49, 0xe9, 0xe7, 0xed, 0xb1, 0xb7, 0xfa, 0xbc, 0x9d, 0x49, 0x3f, 0x6f, 0xdf, 0xfb,
+	0x4b, 0x00, 0x73, 0xa3, 0xed, 0x82, 0x57, 0xc0, 0xb9, 0x5a, 0xfd, 0x9a, 0xf6, 0xf1, 0x7a, 0xa3,
+	0xb6, 0x51, 0xd7, 0x1b, 0x9f, 0x6f, 0x56, 0xf5, 0xed, 0xfa, 0xd6, 0x66, 0x75, 0xbd, 0x76, 0xad,
+	0x56, 0xad, 0xcc, 0x67, 0x0a, 0xa7, 0x1f, 0x3f, 0x2b, 0x9e, 0x1a, 0x06, 0x55, 0x9d, 0x8e, 0xdf,
+	0x85, 0x1f, 0x8e, 0x47, 0x55, 0x36, 0xb6, 0xd5, 0x9b, 0x55, 0x7d, 0xab, 0x76, 0xbd, 0x3e, 0x2f,
+	0x14, 0xc4, 0xc7, 0xcf, 0x8a, 0x0b, 0xc3, 0xa8, 0xc4, 0xa8, 0xbf, 0x02, 0xc4, 0xf1, 0xd0, 0x9d,
+	0x7a, 0xa3, 0x76, 0xab, 0x3a, 0x7f, 0xa4, 0xb0, 0xf8, 0xf8, 0x59, 0x11, 0x26, 0xe3, 0xa2, 0xa9,
+	0x3c, 0x21, 0x6a, 0x7d, 0xa3, 0xbe, 0xb5, 0x7d, 0xab, 0xaa, 0xcd, 0x67, 0xd3, 0x51, 0xeb, 0x94,
+	0x78, 0x81, 0x83, 0xdc, 0xc2, 0xd1, 0x47, 0x3f, 0x48, 0x19, 0xf5, 0xce, 0x8b, 0x7d, 0x49, 0x78,
+	0xb9, 0x2f, 0x09, 0xaf, 0xf6, 0x25, 0xe1, 0xf7, 0x7d, 0x49, 0x78, 0xf2, 0x5a, 0xca, 0xbc, 0x7a,
+	0x2d, 0x65, 0x7e, 0x79, 0x2d, 0x65, 0x6e, 0x7f, 0x94, 0xe0, 0x18, 0xdf, 0xb7, 0x03, 0x0f, 0x53,
+	0x82, 0x49, 0xab, 0x6c, 0xe3, 0xfb, 0x01, 0x36, 0xb1, 0xdf, 0x5d, 0xf5, 0x7c, 0xe3, 0x1e, 0x26,
+	0xd6, 0xaa, 0x43, 0xcd, 0xc0, 0x46, 0xe5, 0x87, 0x83, 0xdf, 0x73, 0x5c, 0x01, 0xcd, 0x19, 0xc6,
+	0xdb, 0x07, 0x7f, 0x0f, 0x00, 0xc5, 0x1c, 0x32, 0x7a, 0xf9, 0x0d, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -269,6 +575,15 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.DowntimeOffenseCount != that1.DowntimeOffenseCount {
+		return false
+	}
+	if !this.LastDowntimeOffenseTime.Equal(that1.LastDowntimeOffenseTime) {
+		return false
+	}
+	if this.DowntimeWarned != that1.DowntimeWarned {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -305,6 +620,104 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.DowntimeOffenseDecayPeriod != that1.DowntimeOffenseDecayPeriod {
+		return false
+	}
+	if !this.DowntimeJailDurationMultiplier.Equal(that1.DowntimeJailDurationMultiplier) {
+		return false
+	}
+	if !this.SlashFractionDowntimeMultiplier.Equal(that1.SlashFractionDowntimeMultiplier) {
+		return false
+	}
+	if this.UnjailRequiresValidatorBond != that1.UnjailRequiresValidatorBond {
+		return false
+	}
+	if len(this.InfractionParams) != len(that1.InfractionParams) {
+		return false
+	}
+	for i := range this.InfractionParams {
+		if !this.InfractionParams[i].Equal(&that1.InfractionParams[i]) {
+			return false
+		}
+	}
+	if this.InfractionHistoryLength != that1.InfractionHistoryLength {
+		return false
+	}
+	if !this.DowntimeWarningThreshold.Equal(that1.DowntimeWarningThreshold) {
+		return false
+	}
+	return true
+}
+func (this *InfractionParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InfractionParams)
+	if !ok {
+		that2, ok := that.(InfractionParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InfractionType != that1.InfractionType {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if this.Tombstone != that1.Tombstone {
+		return false
+	}
+	return true
+}
+func (this *InfractionRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InfractionRecord)
+	if !ok {
+		that2, ok := that.(InfractionRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.InfractionType != that1.InfractionType {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if !this.JailedUntil.Equal(that1.JailedUntil) {
+		return false
+	}
+	if this.Tombstoned != that1.Tombstoned {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -327,6 +740,29 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowntimeWarned {
+		i--
+		if m.DowntimeWarned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastDowntimeOffenseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDowntimeOffenseTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.DowntimeOffenseCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeOffenseCount))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -342,12 +778,12 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if m.IndexOffset != 0 {
@@ -391,31 +827,98 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size := m.SlashFractionDowntime.Size()
+		size := m.DowntimeWarningThreshold.Size()
 		i -= size
-		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DowntimeWarningThreshold.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintSlashing(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x62
+	if m.InfractionHistoryLength != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InfractionHistoryLength))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.InfractionParams) > 0 {
+		for iNdEx := len(m.InfractionParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfractionParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.UnjailRequiresValidatorBond {
+		i--
+		if m.UnjailRequiresValidatorBond {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	{
-		size := m.SlashFractionDoubleSign.Size()
+		size := m.SlashFractionDowntimeMultiplier.Size()
 		i -= size
-		if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SlashFractionDowntimeMultiplier.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintSlashing(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	dAtA[i] = 0x42
+	{
+		size := m.DowntimeJailDurationMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeJailDurationMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeOffenseDecayPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeOffenseDecayPeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFractionDoubleSign.Size()
+		i -= size
+		if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -436,6 +939,130 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InfractionParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InfractionParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InfractionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tombstone {
+		i--
+		if m.Tombstone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.InfractionType != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InfractionType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InfractionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InfractionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InfractionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintSlashing(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.InfractionType != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InfractionType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -471,6 +1098,14 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.DowntimeOffenseCount != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeOffenseCount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDowntimeOffenseTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.DowntimeWarned {
+		n += 2
+	}
 	return n
 }
 
@@ -491,6 +1126,71 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeOffenseDecayPeriod)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeJailDurationMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntimeMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.UnjailRequiresValidatorBond {
+		n += 2
+	}
+	if len(m.InfractionParams) > 0 {
+		for _, e := range m.InfractionParams {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.InfractionHistoryLength != 0 {
+		n += 1 + sovSlashing(uint64(m.InfractionHistoryLength))
+	}
+	l = m.DowntimeWarningThreshold.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *InfractionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InfractionType != 0 {
+		n += 1 + sovSlashing(uint64(m.InfractionType))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Tombstone {
+		n += 2
+	}
+	return n
+}
+
+func (m *InfractionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	if m.InfractionType != 0 {
+		n += 1 + sovSlashing(uint64(m.InfractionType))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
 	return n
 }
 
@@ -671,6 +1371,78 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenseCount", wireType)
+			}
+			m.DowntimeOffenseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeOffenseCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDowntimeOffenseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastDowntimeOffenseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeWarned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DowntimeWarned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -872,6 +1644,572 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenseDecayPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeOffenseDecayPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDurationMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeJailDurationMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntimeMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntimeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailRequiresValidatorBond", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnjailRequiresValidatorBond = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfractionParams = append(m.InfractionParams, InfractionParams{})
+			if err := m.InfractionParams[len(m.InfractionParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHistoryLength", wireType)
+			}
+			m.InfractionHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHistoryLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeWarningThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeWarningThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InfractionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfractionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfractionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionType", wireType)
+			}
+			m.InfractionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionType |= InfractionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstone = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InfractionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfractionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfractionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionType", wireType)
+			}
+			m.InfractionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionType |= InfractionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -1,12 +1,12 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: cosmos/slashing/v1beta1/tx.proto
+// source: slashing/v1beta1/tx.proto
 
 package types
 
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -31,13 +31,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // MsgUnjail defines the Msg/Unjail request type
 type MsgUnjail struct {
 	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"address" yaml:"address"`
+	// delegator_address is an optional validator bond holder submitting the
+	// unjail on behalf of the validator. It is only accepted when the
+	// unjail_requires_validator_bond param is enabled, and must be either the
+	// validator operator's account or the unjailer designated by the operator.
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
 }
 
 func (m *MsgUnjail) Reset()         { *m = MsgUnjail{} }
 func (m *MsgUnjail) String() string { return proto.CompactTextString(m) }
 func (*MsgUnjail) ProtoMessage()    {}
 func (*MsgUnjail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c5611c0c4a59d9d, []int{0}
+	return fileDescriptor_3c171eb67e6bea22, []int{0}
 }
 func (m *MsgUnjail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -74,7 +79,7 @@ func (m *MsgUnjailResponse) Reset()         { *m = MsgUnjailResponse{} }
 func (m *MsgUnjailResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnjailResponse) ProtoMessage()    {}
 func (*MsgUnjailResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c5611c0c4a59d9d, []int{1}
+	return fileDescriptor_3c171eb67e6bea22, []int{1}
 }
 func (m *MsgUnjailResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -103,32 +108,120 @@ func (m *MsgUnjailResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnjailResponse proto.InternalMessageInfo
 
+// MsgSetUnjailer defines the Msg/SetUnjailer request type
+type MsgSetUnjailer struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"address" yaml:"address"`
+	// unjailer_address is the validator bond holder allowed to unjail the
+	// validator, or empty to remove the designated unjailer.
+	UnjailerAddress string `protobuf:"bytes,2,opt,name=unjailer_address,json=unjailerAddress,proto3" json:"unjailer_address,omitempty" yaml:"unjailer_address"`
+}
+
+func (m *MsgSetUnjailer) Reset()         { *m = MsgSetUnjailer{} }
+func (m *MsgSetUnjailer) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUnjailer) ProtoMessage()    {}
+func (*MsgSetUnjailer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c171eb67e6bea22, []int{2}
+}
+func (m *MsgSetUnjailer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUnjailer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUnjailer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetUnjailer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUnjailer.Merge(m, src)
+}
+func (m *MsgSetUnjailer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUnjailer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUnjailer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUnjailer proto.InternalMessageInfo
+
+// MsgSetUnjailerResponse defines the Msg/SetUnjailer response type
+type MsgSetUnjailerResponse struct {
+}
+
+func (m *MsgSetUnjailerResponse) Reset()         { *m = MsgSetUnjailerResponse{} }
+func (m *MsgSetUnjailerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUnjailerResponse) ProtoMessage()    {}
+func (*MsgSetUnjailerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c171eb67e6bea22, []int{3}
+}
+func (m *MsgSetUnjailerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUnjailerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUnjailerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetUnjailerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUnjailerResponse.Merge(m, src)
+}
+func (m *MsgSetUnjailerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUnjailerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUnjailerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUnjailerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "liquidstaking.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "liquidstaking.slashing.v1beta1.MsgUnjailResponse")
+	proto.RegisterType((*MsgSetUnjailer)(nil), "liquidstaking.slashing.v1beta1.MsgSetUnjailer")
+	proto.RegisterType((*MsgSetUnjailerResponse)(nil), "liquidstaking.slashing.v1beta1.MsgSetUnjailerResponse")
 }
 
-func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
-
-var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0x95, 0xa2, 0xb9, 0x38, 0x7d, 0x8b, 0xd3, 0x43, 0xf3, 0xb2, 0x12, 0x33, 0x73,
-	0x84, 0x5c, 0xb8, 0xf8, 0xca, 0x12, 0x73, 0x32, 0x53, 0x12, 0x4b, 0xf2, 0x8b, 0xe2, 0x13, 0x53,
-	0x52, 0x8a, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x64, 0x5f, 0xdd, 0x93, 0x67, 0x07, 0xf1,
-	0x53, 0x8b, 0x8b, 0x3f, 0xdd, 0x93, 0xe7, 0xab, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0x82, 0x0a, 0x28,
-	0x05, 0xf1, 0xc2, 0x35, 0x39, 0xa6, 0xa4, 0x14, 0x59, 0x71, 0x74, 0x2c, 0x90, 0x67, 0x98, 0xb1,
-	0x40, 0x9e, 0x51, 0x49, 0x98, 0x4b, 0x10, 0x6e, 0x78, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71,
-	0xaa, 0x51, 0x3c, 0x17, 0xb3, 0x6f, 0x71, 0xba, 0x50, 0x04, 0x17, 0x1b, 0xd4, 0x56, 0x25, 0x3d,
-	0x1c, 0x4e, 0xd6, 0x83, 0x6b, 0x96, 0xd2, 0x22, 0xac, 0x06, 0x66, 0x81, 0x93, 0xf7, 0x8a, 0x47,
-	0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84,
-	0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9b, 0x9e, 0x59,
-	0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x0d, 0x4c, 0x08, 0xa5, 0x5b, 0x9c, 0x92,
-	0xad, 0x5f, 0x81, 0x08, 0xd9, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x30, 0x19, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x1b, 0xa7, 0xdc, 0xcf, 0x79, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("slashing/v1beta1/tx.proto", fileDescriptor_3c171eb67e6bea22) }
+
+var fileDescriptor_3c171eb67e6bea22 = []byte{
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3d, 0x8f, 0xd3, 0x30,
+	0x18, 0xc7, 0x63, 0x90, 0x0e, 0xce, 0x88, 0x72, 0x17, 0x10, 0x17, 0x0a, 0x24, 0xc8, 0x0b, 0x08,
+	0xe9, 0x62, 0x1d, 0x6c, 0x37, 0x71, 0x27, 0x84, 0xc4, 0xd0, 0x25, 0x08, 0x09, 0x31, 0x80, 0xdc,
+	0xda, 0xf2, 0x19, 0x9c, 0xb8, 0xcd, 0xe3, 0x54, 0xed, 0x37, 0x60, 0x64, 0x64, 0xec, 0x84, 0xfa,
+	0x51, 0x3a, 0x76, 0x64, 0x8a, 0x50, 0xba, 0x31, 0xf6, 0x13, 0xa0, 0x36, 0x2f, 0x2a, 0xad, 0x78,
+	0x19, 0xd8, 0x92, 0xbf, 0x7f, 0xff, 0xe7, 0x1d, 0xdf, 0x01, 0xcd, 0xe0, 0x42, 0x25, 0x92, 0x0e,
+	0x4f, 0xba, 0xc2, 0xb2, 0x13, 0x6a, 0x47, 0x61, 0x3f, 0x35, 0xd6, 0xb8, 0x47, 0x3d, 0x03, 0xb1,
+	0x81, 0xb0, 0x26, 0xc2, 0x8a, 0x68, 0xdf, 0x92, 0x46, 0x9a, 0x35, 0x43, 0x57, 0x5f, 0x25, 0x4e,
+	0xbe, 0x22, 0xbc, 0xdf, 0x01, 0xf9, 0x3a, 0xf9, 0xc0, 0x94, 0x76, 0x9f, 0xe3, 0xd6, 0x90, 0x69,
+	0xc5, 0x99, 0x35, 0xe9, 0x7b, 0xc6, 0x79, 0xea, 0xa1, 0x07, 0xe8, 0xd1, 0xfe, 0xf9, 0xfd, 0x1f,
+	0x79, 0x70, 0x65, 0xf5, 0x2f, 0x00, 0x96, 0x79, 0xd0, 0x1a, 0xb3, 0x58, 0x9f, 0x92, 0x4a, 0x20,
+	0xd1, 0xf5, 0xc6, 0x74, 0xc6, 0x79, 0xea, 0xbe, 0xc4, 0x87, 0x5c, 0x68, 0x21, 0x9b, 0x28, 0x02,
+	0xc0, 0xbb, 0xb4, 0x0e, 0x74, 0x6f, 0x99, 0x07, 0x5e, 0xe9, 0xde, 0x41, 0x48, 0x74, 0xd0, 0x68,
+	0x67, 0xa5, 0x74, 0x7a, 0xf5, 0xd3, 0x24, 0x70, 0xbe, 0x4c, 0x02, 0x44, 0x6e, 0xe2, 0xc3, 0xa6,
+	0xce, 0x48, 0x40, 0xdf, 0x24, 0x20, 0xc8, 0x14, 0xe1, 0x56, 0x07, 0xe4, 0x2b, 0x61, 0xcb, 0x07,
+	0x91, 0xfe, 0xa7, 0x16, 0x5e, 0xe0, 0x83, 0xac, 0x8a, 0xb8, 0xd5, 0xc1, 0xdd, 0x65, 0x1e, 0x1c,
+	0x95, 0xe6, 0x6d, 0x82, 0x44, 0x37, 0x6a, 0x69, 0xb7, 0x7e, 0x0f, 0xdf, 0xfe, 0xb5, 0xd2, 0xba,
+	0x89, 0x27, 0x33, 0x84, 0x2f, 0x77, 0x40, 0xba, 0x6f, 0xf0, 0x5e, 0xb5, 0x06, 0x12, 0xfe, 0x66,
+	0x89, 0x61, 0x33, 0x82, 0xf6, 0xe3, 0xbf, 0x33, 0x75, 0x06, 0x57, 0xe2, 0x6b, 0x9b, 0x23, 0x7a,
+	0xf8, 0x27, 0xeb, 0x06, 0xd8, 0xa6, 0xff, 0x08, 0xd6, 0x89, 0xce, 0xdf, 0x4d, 0x0b, 0x1f, 0xcd,
+	0x0a, 0x1f, 0xcd, 0x0b, 0x1f, 0x7d, 0x2f, 0x7c, 0xf4, 0x79, 0xe1, 0x3b, 0xf3, 0x85, 0xef, 0x7c,
+	0x5b, 0xf8, 0xce, 0xdb, 0x67, 0x52, 0xd9, 0x8b, 0xac, 0x1b, 0xf6, 0x4c, 0x4c, 0xd5, 0x40, 0x67,
+	0xa0, 0x4c, 0xa2, 0x92, 0x1e, 0xd5, 0x6a, 0x90, 0x29, 0xae, 0xec, 0xf8, 0x18, 0x2c, 0xfb, 0xa8,
+	0x12, 0x79, 0x1c, 0x1b, 0x9e, 0x69, 0x41, 0x47, 0xb4, 0xb9, 0x73, 0x3b, 0xee, 0x0b, 0xe8, 0xee,
+	0xad, 0x8f, 0xf6, 0xe9, 0xcf, 0x01, 0x00, 0x49, 0x1c, 0xab, 0xcf, 0x00, 0x03, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -153,6 +246,9 @@ func (this *MsgUnjail) Equal(that interface{}) bool {
 	if this.ValidatorAddr != that1.ValidatorAddr {
 		return false
 	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
 	return true
 }
 func (this *MsgUnjailResponse) Equal(that interface{}) bool {
@@ -176,6 +272,54 @@ func (this *MsgUnjailResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSetUnjailer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetUnjailer)
+	if !ok {
+		that2, ok := that.(MsgSetUnjailer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddr != that1.ValidatorAddr {
+		return false
+	}
+	if this.UnjailerAddress != that1.UnjailerAddress {
+		return false
+	}
+	return true
+}
+func (this *MsgSetUnjailerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetUnjailerResponse)
+	if !ok {
+		that2, ok := that.(MsgSetUnjailerResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -193,6 +337,9 @@ type MsgClient interface {
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
+	// SetUnjailer defines a method for a validator operator to designate the
+	// validator bond holder allowed to unjail the validator on its behalf.
+	SetUnjailer(ctx context.Context, in *MsgSetUnjailer, opts ...grpc.CallOption) (*MsgSetUnjailerResponse, error)
 }
 
 type msgClient struct {
@@ -212,12 +359,24 @@ func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) SetUnjailer(ctx context.Context, in *MsgSetUnjailer, opts ...grpc.CallOption) (*MsgSetUnjailerResponse, error) {
+	out := new(MsgSetUnjailerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/SetUnjailer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
+	// SetUnjailer defines a method for a validator operator to designate the
+	// validator bond holder allowed to unjail the validator on its behalf.
+	SetUnjailer(context.Context, *MsgSetUnjailer) (*MsgSetUnjailerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -227,6 +386,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*MsgUnjailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
 }
+func (*UnimplementedMsgServer) SetUnjailer(ctx context.Context, req *MsgSetUnjailer) (*MsgSetUnjailerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnjailer not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -250,6 +412,24 @@ func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetUnjailer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUnjailer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUnjailer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/SetUnjailer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUnjailer(ctx, req.(*MsgSetUnjailer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -258,9 +438,13 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Unjail",
 			Handler:    _Msg_Unjail_Handler,
 		},
+		{
+			MethodName: "SetUnjailer",
+			Handler:    _Msg_SetUnjailer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/slashing/v1beta1/tx.proto",
+	Metadata: "slashing/v1beta1/tx.proto",
 }
 
 func (m *MsgUnjail) Marshal() (dAtA []byte, err error) {
@@ -283,6 +467,13 @@ func (m *MsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ValidatorAddr) > 0 {
 		i -= len(m.ValidatorAddr)
 		copy(dAtA[i:], m.ValidatorAddr)
@@ -316,6 +507,66 @@ func (m *MsgUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetUnjailer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUnjailer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUnjailer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnjailerAddress) > 0 {
+		i -= len(m.UnjailerAddress)
+		copy(dAtA[i:], m.UnjailerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnjailerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUnjailerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUnjailerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUnjailerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -337,6 +588,10 @@ func (m *MsgUnjail) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -349,6 +604,32 @@ func (m *MsgUnjailResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetUnjailer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UnjailerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetUnjailerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -416,6 +697,38 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -487,6 +800,170 @@ func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetUnjailer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetUnjailer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetUnjailer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnjailerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetUnjailerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetUnjailerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetUnjailerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
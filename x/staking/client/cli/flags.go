@@ -14,6 +14,7 @@ const (
 	FlagAmount              = "amount"
 	FlagSharesAmount        = "shares-amount"
 	FlagSharesFraction      = "shares-fraction"
+	FlagCompound            = "compound"
 
 	FlagMoniker         = "moniker"
 	FlagEditMoniker     = "new-moniker"
@@ -46,7 +46,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryLastTokenizeShareRecordID(),
 		GetCmdQueryTotalTokenizeSharedAssets(),
 		GetCmdQueryTokenizeShareLockInfo(),
+		GetCmdQueryTokenizeShareLockAllowlist(),
 		GetCmdQueryTotalLiquidStaked(),
+		GetCmdQueryProviderLiquidStaked(),
+		GetCmdQueryValidatorBondCompliance(),
 	)
 
 	return stakingQueryCmd
@@ -1022,6 +1025,50 @@ $ %s query staking total-liquid-staked
 	return cmd
 }
 
+// GetCmdQueryProviderLiquidStaked implements the query for the tokens liquid staked by a provider
+func GetCmdQueryProviderLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-liquid-staked [provider-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the tokens liquid staked by a liquid staking provider",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the number of tokens liquid staked by a single liquid staking provider,
+along with the cap on the portion of stake that may come from a single provider.
+Example:
+$ %s query staking provider-liquid-staked [provider-address]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			provider := args[0]
+			if _, err := sdk.AccAddressFromBech32(provider); err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProviderLiquidStaked(
+				cmd.Context(),
+				&types.QueryProviderLiquidStakedRequest{Address: provider},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryTokenizeShareLockInfo returns the tokenize share lock status for a user
 func GetCmdQueryTokenizeShareLockInfo() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -1065,3 +1112,94 @@ $ %s query staking tokenize-share-lock-info %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldm
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryTokenizeShareLockAllowlist returns the tokenize share lock allowlist for a user
+func GetCmdQueryTokenizeShareLockAllowlist() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-lock-allowlist [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query tokenize share lock allowlist",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the addresses on the tokenize share lock allowlist of a given account,
+along with the time at which each of them becomes active
+Example:
+$ %s query staking tokenize-share-lock-allowlist %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address := args[0]
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareLockAllowlist(
+				cmd.Context(),
+				&types.QueryTokenizeShareLockAllowlistRequest{Address: address},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryValidatorBondCompliance implements the query for whether a validator exceeds its validator bond cap
+func GetCmdQueryValidatorBondCompliance() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-bond-compliance [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether a validator's liquid shares exceed its validator bond cap",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a validator's liquid shares exceed its validator bond cap,
+the end of its grace period to top up its validator bond, and whether it is non-compliant.
+
+Example:
+$ %s query staking validator-bond-compliance %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.ValAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorBondCompliance(
+				cmd.Context(),
+				&types.QueryValidatorBondComplianceRequest{ValidatorAddr: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -49,6 +49,8 @@ func NewTxCmd() *cobra.Command {
 		NewTransferTokenizeShareRecordCmd(),
 		NewDisableTokenizeShares(),
 		NewEnableTokenizeShares(),
+		NewAllowTokenizeSharesRecipientCmd(),
+		NewDisallowTokenizeSharesRecipientCmd(),
 		NewValidatorBondCmd(),
 	)
 
@@ -552,8 +554,13 @@ func NewTokenizeSharesCmd() *cobra.Command {
 
 Example:
 $ %s tx staking tokenize-share %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+
+With --compound, the record's rewards in the bond denom are delegated back to the validator,
+increasing the stake redeemable for each share token:
+$ %s tx staking tokenize-share %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --compound --from mykey
 `,
 				version.AppName, bech32PrefixValAddr, bech32PrefixAccAddr,
+				version.AppName, bech32PrefixValAddr, bech32PrefixAccAddr,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -577,17 +584,24 @@ $ %s tx staking tokenize-share %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stak
 				return err
 			}
 
+			compound, err := cmd.Flags().GetBool(FlagCompound)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgTokenizeShares{
 				DelegatorAddress:    delAddr.String(),
 				ValidatorAddress:    valAddr.String(),
 				Amount:              amount,
 				TokenizedShareOwner: rewardOwner.String(),
+				Compound:            compound,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagCompound, false, "Delegate the record's rewards in the bond denom back to the validator instead of sending them to the reward owner")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -751,6 +765,90 @@ $ %s tx staking enable-tokenize-shares --from mykey
 	return cmd
 }
 
+// NewAllowTokenizeSharesRecipientCmd defines a command to add an address to the
+// tokenize share lock allowlist
+func NewAllowTokenizeSharesRecipientCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "allow-tokenize-shares-recipient [recipient]",
+		Short: "Add an address to the tokenize share lock allowlist",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Adds an address to the tokenize share lock allowlist of the sender.
+While tokenization is disabled for the sender, shares may still be tokenized with
+an address from the allowlist as the tokenized share owner. The address must wait
+1 unbonding period from the time of this transaction before it is permitted.
+
+Example:
+$ %s tx staking allow-tokenize-shares-recipient %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`, version.AppName, bech32PrefixAccAddr),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			msg := &types.MsgAllowTokenizeSharesRecipient{
+				DelegatorAddress: clientCtx.GetFromAddress().String(),
+				RecipientAddress: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDisallowTokenizeSharesRecipientCmd defines a command to remove an address from the
+// tokenize share lock allowlist
+func NewDisallowTokenizeSharesRecipientCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "disallow-tokenize-shares-recipient [recipient]",
+		Short: "Remove an address from the tokenize share lock allowlist",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Removes an address from the tokenize share lock allowlist of the sender.
+The removal takes effect immediately.
+
+Example:
+$ %s tx staking disallow-tokenize-shares-recipient %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`, version.AppName, bech32PrefixAccAddr),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			msg := &types.MsgDisallowTokenizeSharesRecipient{
+				DelegatorAddress: clientCtx.GetFromAddress().String(),
+				RecipientAddress: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewValidatorBondCmd defines a command to mark a delegation as a validator self bond
 func NewValidatorBondCmd() *cobra.Command {
 	cmd := &cobra.Command{
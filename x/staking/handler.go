@@ -60,6 +60,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.EnableTokenizeShares(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgAllowTokenizeSharesRecipient:
+			res, err := msgServer.AllowTokenizeSharesRecipient(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgDisallowTokenizeSharesRecipient:
+			res, err := msgServer.DisallowTokenizeSharesRecipient(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
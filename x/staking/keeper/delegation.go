@@ -86,6 +86,28 @@ func (k Keeper) GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress)
 	return delegations
 }
 
+// GetValidatorBondDelegations returns the validator bond delegations to a
+// validator, from the validator bond delegation index.
+func (k Keeper) GetValidatorBondDelegations(ctx sdk.Context, valAddr sdk.ValAddress) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	prefixKey := types.GetValidatorBondDelegationsIndexKey(valAddr)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the remainder of the key is the length prefixed delegator address
+		delAddr := sdk.AccAddress(iterator.Key()[len(prefixKey)+1:])
+		delegation, found := k.GetLiquidDelegation(ctx, delAddr, valAddr)
+		if !found {
+			panic(fmt.Sprintf("validator bond delegation from %s to %s not found", delAddr, valAddr))
+		}
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
 // GetDelegatorDelegations returns a given amount of all the delegations from a
 // delegator.
 func (k Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) (delegations []types.Delegation) {
@@ -113,6 +135,11 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(types.GetLiquidDelegationKey(delegatorAddress, delegation.GetValidatorAddr()), b)
+
+	// a delegation stays a validator bond until it is removed
+	if delegation.ValidatorBond {
+		store.Set(types.GetValidatorBondDelegationIndexKey(delegation.GetValidatorAddr(), delegatorAddress), []byte{})
+	}
 }
 
 // RemoveDelegation removes a delegation
@@ -126,6 +153,7 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) e
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetLiquidDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
+	store.Delete(types.GetValidatorBondDelegationIndexKey(delegation.GetValidatorAddr(), delegatorAddress))
 	return nil
 }
 
@@ -699,12 +727,21 @@ func (k Keeper) Delegate(
 		}
 	}
 
-	_, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
+	validator, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
 
 	// Update delegation
 	delegation.Shares = delegation.Shares.Add(newShares)
 	k.SetDelegation(ctx, delegation)
 
+	// Shares added to a validator bond delegation, whether from a delegation, a redelegation,
+	// a cancelled unbonding or redeemed tokenized shares, increase the validator's total validator bond
+	if delegation.ValidatorBond {
+		k.IncreaseValidatorBond(ctx, &validator, newShares)
+		if err := k.AfterValidatorBondChanged(ctx, delegatorAddress, delegation.GetValidatorAddr()); err != nil {
+			return newShares, err
+		}
+	}
+
 	// Call the after-modification hook
 	if err := k.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr()); err != nil {
 		return newShares, err
@@ -713,6 +750,45 @@ func (k Keeper) Delegate(
 	return newShares, nil
 }
 
+// DelegateTokens delegates unbonded tokens from the delegator's balance as MsgDelegate does:
+// a delegation from a liquid staking provider must fit within the global, provider and
+// validator liquid staking caps.
+func (k Keeper) DelegateTokens(
+	ctx sdk.Context, delegatorAddress sdk.AccAddress, valAddr sdk.ValAddress, tokens sdk.Int,
+) (newShares sdk.Dec, err error) {
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), sdkstaking.ErrNoValidatorFound
+	}
+
+	shares, err := validator.SharesFromTokens(tokens)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// if this delegation is from a liquid staking provider, it cannot exceed
+	// the global, provider or validator bond cap
+	if k.AccountIsLiquidStakingProvider(delegatorAddress) {
+		if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, tokens, false); err != nil {
+			return sdk.ZeroDec(), err
+		}
+		if err := k.SafelyIncreaseProviderLiquidStakedTokens(ctx, delegatorAddress, tokens, false); err != nil {
+			return sdk.ZeroDec(), err
+		}
+		if err := k.SafelyIncreaseValidatorTotalLiquidShares(ctx, &validator, shares); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+
+	// NOTE: source funds are always unbonded
+	newShares, err = k.Delegate(ctx, delegatorAddress, tokens, sdkstaking.Unbonded, validator, true)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return newShares, nil
+}
+
 // Unbond unbonds a particular delegation and perform associated store operations.
 func (k Keeper) Unbond(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -467,7 +468,10 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	ctx := sdk.UnwrapSDKContext(c)
 	params := k.GetParams(ctx)
 
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{
+		Params:                          params,
+		EffectiveGlobalLiquidStakingCap: k.EffectiveGlobalLiquidStakingCap(ctx).String(),
+	}, nil
 }
 
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
@@ -660,7 +664,26 @@ func (k Querier) TotalLiquidStaked(c context.Context, req *types.QueryTotalLiqui
 	ctx := sdk.UnwrapSDKContext(c)
 	totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx).String()
 	return &types.QueryTotalLiquidStakedResponse{
-		Tokens: totalLiquidStaked,
+		Tokens:                 totalLiquidStaked,
+		GlobalLiquidStakingCap: k.EffectiveGlobalLiquidStakingCap(ctx).String(),
+	}, nil
+}
+
+// Query the tokens liquid staked by a single liquid staking provider
+func (k Querier) ProviderLiquidStaked(c context.Context, req *types.QueryProviderLiquidStakedRequest) (*types.QueryProviderLiquidStakedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	provider, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryProviderLiquidStakedResponse{
+		Tokens:                   k.GetProviderLiquidStakedTokens(ctx, provider).String(),
+		ProviderLiquidStakingCap: k.ProviderLiquidStakingCap(ctx).String(),
 	}, nil
 }
 
@@ -684,3 +707,49 @@ func (k Querier) TokenizeShareLockInfo(c context.Context, req *types.QueryTokeni
 		ExpirationTime: timeString,
 	}, nil
 }
+
+// Query the tokenize share lock allowlist of an account
+func (k Querier) TokenizeShareLockAllowlist(c context.Context, req *types.QueryTokenizeShareLockAllowlistRequest) (*types.QueryTokenizeShareLockAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTokenizeShareLockAllowlistResponse{
+		Entries: k.GetTokenizeSharesAllowlist(ctx, address),
+	}, nil
+}
+
+// Query whether a validator's liquid shares exceed its validator bond cap
+func (k Querier) ValidatorBondCompliance(c context.Context, req *types.QueryValidatorBondComplianceRequest) (*types.QueryValidatorBondComplianceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	var gracePeriodEnd *time.Time
+	if end, found := k.GetValidatorBondCapViolation(ctx, valAddr); found {
+		gracePeriodEnd = &end
+	}
+
+	return &types.QueryValidatorBondComplianceResponse{
+		BondCapExceeded: k.CheckValidatorBondCapExceeded(ctx, validator),
+		GracePeriodEnd:  gracePeriodEnd,
+		NonCompliant:    k.IsValidatorBondNonCompliant(ctx, validator),
+	}, nil
+}
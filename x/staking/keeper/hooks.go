@@ -39,6 +39,46 @@ func (k Keeper) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordID uint6
 	return nil
 }
 
+// AfterTokenizeShareRecordCreated - call hook if registered
+func (k Keeper) AfterTokenizeShareRecordCreated(ctx sdk.Context, recordID uint64) error {
+	if k.hooks != nil {
+		return k.hooks.AfterTokenizeShareRecordCreated(ctx, recordID)
+	}
+	return nil
+}
+
+// AfterTokenizeShareRecordTransferred - call hook if registered
+func (k Keeper) AfterTokenizeShareRecordTransferred(ctx sdk.Context, recordID uint64, prevOwner, newOwner sdk.AccAddress) error {
+	if k.hooks != nil {
+		return k.hooks.AfterTokenizeShareRecordTransferred(ctx, recordID, prevOwner, newOwner)
+	}
+	return nil
+}
+
+// AfterSharesRedeemed - call hook if registered
+func (k Keeper) AfterSharesRedeemed(ctx sdk.Context, recordID uint64, delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens sdk.Int) error {
+	if k.hooks != nil {
+		return k.hooks.AfterSharesRedeemed(ctx, recordID, delAddr, valAddr, tokens)
+	}
+	return nil
+}
+
+// AfterValidatorBondChanged - call hook if registered
+func (k Keeper) AfterValidatorBondChanged(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if k.hooks != nil {
+		return k.hooks.AfterValidatorBondChanged(ctx, delAddr, valAddr)
+	}
+	return nil
+}
+
+// AfterTokenizeShareLockChanged - call hook if registered
+func (k Keeper) AfterTokenizeShareLockChanged(ctx sdk.Context, address sdk.AccAddress, status types.TokenizeShareLockStatus) error {
+	if k.hooks != nil {
+		return k.hooks.AfterTokenizeShareLockChanged(ctx, address, status)
+	}
+	return nil
+}
+
 // AfterValidatorBonded - call hook if registered
 func (k Keeper) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	if k.hooks != nil {
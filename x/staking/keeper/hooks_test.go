@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// mockTokenizeShareHooks records each call to the tokenize share lifecycle hooks
+// the remaining hooks are no-ops inherited from an empty MultiStakingHooks
+type mockTokenizeShareHooks struct {
+	types.MultiStakingHooks
+	calls []string
+}
+
+func (h *mockTokenizeShareHooks) AfterTokenizeShareRecordCreated(_ sdk.Context, recordID uint64) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterTokenizeShareRecordCreated %d", recordID))
+	return nil
+}
+
+func (h *mockTokenizeShareHooks) AfterTokenizeShareRecordTransferred(_ sdk.Context, recordID uint64, prevOwner, newOwner sdk.AccAddress) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterTokenizeShareRecordTransferred %d %s %s", recordID, prevOwner, newOwner))
+	return nil
+}
+
+func (h *mockTokenizeShareHooks) AfterSharesRedeemed(_ sdk.Context, recordID uint64, delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens sdk.Int) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterSharesRedeemed %d %s %s %s", recordID, delAddr, valAddr, tokens))
+	return nil
+}
+
+func (h *mockTokenizeShareHooks) AfterValidatorBondChanged(_ sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterValidatorBondChanged %s %s", delAddr, valAddr))
+	return nil
+}
+
+func (h *mockTokenizeShareHooks) AfterTokenizeShareLockChanged(_ sdk.Context, address sdk.AccAddress, status types.TokenizeShareLockStatus) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterTokenizeShareLockChanged %s %s", address, status))
+	return nil
+}
+
+func TestTokenizeShareHooks(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	hooks := &mockTokenizeShareHooks{}
+	app.StakingKeeper.SetHooks(hooks)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	// Create a delegator, a record owner, a validator bond delegator and validator
+	stakeAmount := sdk.NewInt(1000)
+	stakeToken := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), stakeAmount)
+
+	addresses := simapp.AddTestAddrs(app, ctx, 4, stakeAmount)
+	delegator, owner, bondDelegator := addresses[0], addresses[1], addresses[2]
+
+	pubKeys := simapp.CreateTestPubKeys(1)
+	validatorAddress := sdk.ValAddress(addresses[3])
+	validator := teststaking.NewValidator(t, validatorAddress, pubKeys[0])
+
+	validator.DelegatorShares = sdk.NewDec(1_000_000)
+	validator.Tokens = sdk.NewInt(1_000_000)
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	// Fix block time and set unbonding period to 1 day
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime)
+
+	unbondingPeriod := time.Hour * 24
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = unbondingPeriod
+	app.StakingKeeper.SetParams(ctx, params)
+
+	for _, address := range []sdk.AccAddress{delegator, bondDelegator} {
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), &types.MsgDelegate{
+			DelegatorAddress: address.String(),
+			ValidatorAddress: validatorAddress.String(),
+			Amount:           stakeToken,
+		})
+		require.NoError(t, err, "no error expected when delegating")
+	}
+
+	// Validator bond
+	_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+		DelegatorAddress: bondDelegator.String(),
+		ValidatorAddress: validatorAddress.String(),
+	})
+	require.NoError(t, err, "no error expected when validator bonding")
+
+	// Tokenize, transfer the record and redeem all shares
+	tokenizeResponse, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    validatorAddress.String(),
+		Amount:              stakeToken,
+		TokenizedShareOwner: delegator.String(),
+	})
+	require.NoError(t, err, "no error expected when tokenizing shares")
+
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), &types.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: 1,
+		Sender:                delegator.String(),
+		NewOwner:              owner.String(),
+	})
+	require.NoError(t, err, "no error expected when transferring the record")
+
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: delegator.String(),
+		Amount:           tokenizeResponse.Amount,
+	})
+	require.NoError(t, err, "no error expected when redeeming")
+
+	// Disable and re-enable tokenization, then let the lock expire
+	_, err = msgServer.DisableTokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgDisableTokenizeShares{
+		DelegatorAddress: delegator.String(),
+	})
+	require.NoError(t, err, "no error expected when disabling tokenization")
+
+	_, err = msgServer.EnableTokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgEnableTokenizeShares{
+		DelegatorAddress: delegator.String(),
+	})
+	require.NoError(t, err, "no error expected when enabling tokenization")
+
+	app.StakingKeeper.RemoveExpiredTokenizeShareLocks(ctx, blockTime.Add(unbondingPeriod))
+
+	expectedCalls := []string{
+		fmt.Sprintf("AfterValidatorBondChanged %s %s", bondDelegator, validatorAddress),
+		"AfterTokenizeShareRecordCreated 1",
+		fmt.Sprintf("AfterTokenizeShareRecordTransferred 1 %s %s", delegator, owner),
+		fmt.Sprintf("AfterSharesRedeemed 1 %s %s %s", delegator, validatorAddress, stakeAmount),
+		fmt.Sprintf("AfterTokenizeShareLockChanged %s LOCKED", delegator),
+		fmt.Sprintf("AfterTokenizeShareLockChanged %s LOCK_EXPIRING", delegator),
+		fmt.Sprintf("AfterTokenizeShareLockChanged %s UNLOCKED", delegator),
+	}
+	require.Equal(t, expectedCalls, hooks.calls, "hook calls")
+
+	// The record should have been removed after redeeming all shares
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.Error(t, err, "tokenize share record should be removed")
+}
@@ -19,6 +19,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "validator-bond-shares",
+		ValidatorBondSharesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -39,7 +41,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ValidatorBondSharesInvariant(k)(ctx)
 	}
 }
 
@@ -195,3 +202,50 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// ValidatorBondSharesInvariant checks whether the shares of all validator bond
+// delegations to a validator add up to the total validator bond shares stored
+// in the validator.
+func ValidatorBondSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		validators := k.GetAllValidators(ctx)
+		validatorsBondShares := map[string]sdk.Dec{}
+
+		// initialize a map: validator -> its validator bond shares
+		for _, validator := range validators {
+			validatorsBondShares[validator.GetOperator().String()] = sdk.ZeroDec()
+		}
+
+		// iterate through all the validator bond delegations to calculate the total validator bond shares for each validator
+		delegations := k.GetAllDelegations(ctx)
+		for _, delegation := range delegations {
+			if !delegation.ValidatorBond {
+				continue
+			}
+			delegationValidatorAddr := delegation.GetValidatorAddr().String()
+			validatorBondShares := validatorsBondShares[delegationValidatorAddr]
+			validatorsBondShares[delegationValidatorAddr] = validatorBondShares.Add(delegation.Shares)
+		}
+
+		// for each validator, check if its total validator bond shares calculated from the step above equals its recorded total
+		for _, validator := range validators {
+			expValBondShares := validator.TotalValidatorBondShares
+			calculatedValBondShares := validatorsBondShares[validator.GetOperator().String()]
+			if !calculatedValBondShares.Equal(expValBondShares) {
+				broken = true
+				msg += fmt.Sprintf("broken validator bond shares invariance:\n"+
+					"\tvalidator: %s\n"+
+					"\tvalidator.TotalValidatorBondShares: %v\n"+
+					"\tsum of validator bond Delegation.Shares: %v\n",
+					validator.GetOperator(), expValBondShares, calculatedValBondShares)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator bond shares", msg), broken
+	}
+}
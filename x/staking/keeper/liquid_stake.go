@@ -38,6 +38,43 @@ func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
 	return tokens
 }
 
+// SetProviderLiquidStakedTokens stores the outstanding tokens liquid staked by a single liquid staking provider
+// The entry is removed once the provider has no liquid staked tokens left
+func (k Keeper) SetProviderLiquidStakedTokens(ctx sdk.Context, provider sdk.AccAddress, tokens sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetProviderLiquidStakedTokensKey(provider)
+
+	if tokens.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	tokensBz, err := tokens.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(key, tokensBz)
+}
+
+// GetProviderLiquidStakedTokens returns the outstanding tokens liquid staked by a single liquid staking provider
+// Returns zero if the provider has no liquid staked tokens
+func (k Keeper) GetProviderLiquidStakedTokens(ctx sdk.Context, provider sdk.AccAddress) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	tokensBz := store.Get(types.GetProviderLiquidStakedTokensKey(provider))
+
+	if tokensBz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var tokens sdk.Int
+	if err := tokens.Unmarshal(tokensBz); err != nil {
+		panic(err)
+	}
+
+	return tokens
+}
+
 // Check if an account is a owned by a liquid staking provider
 // This is determined by checking if the account has a 32-length address
 // NOTE: This will have to be refactored before adapting it to chains beyond gaia
@@ -53,9 +90,10 @@ func (k Keeper) AccountIsLiquidStakingProvider(address sdk.AccAddress) bool {
 // the tokens are already included in the bonded pool
 // If the delegation's shares are not bonded (e.g. normal delegation),
 // we need to add the tokens to the current bonded pool balance to get the total staked
+// The cap is interpolated from the global liquid staking cap schedule, if one is set
 // Returns true if the cap is exceeded
 func (k Keeper) CheckExceedsGlobalLiquidStakingCap(ctx sdk.Context, tokens sdk.Int, sharesAlreadyBonded bool) bool {
-	liquidStakingCap := k.GlobalLiquidStakingCap(ctx)
+	liquidStakingCap := k.EffectiveGlobalLiquidStakingCap(ctx)
 	liquidStakedAmount := k.GetTotalLiquidStakedTokens(ctx)
 
 	// Determine the total stake from the balance of the bonded pool
@@ -74,6 +112,26 @@ func (k Keeper) CheckExceedsGlobalLiquidStakingCap(ctx sdk.Context, tokens sdk.I
 	return liquidStakePercent.GT(liquidStakingCap)
 }
 
+// CheckExceedsProviderLiquidStakingCap checks if a liquid delegation from a liquid staking
+// provider would cause that provider's portion of the total stake to exceed the provider cap
+// The total stake is determined in the same way as for the global cap
+// Returns true if the cap is exceeded
+func (k Keeper) CheckExceedsProviderLiquidStakingCap(ctx sdk.Context, provider sdk.AccAddress, tokens sdk.Int, sharesAlreadyBonded bool) bool {
+	liquidStakingCap := k.ProviderLiquidStakingCap(ctx)
+	providerLiquidStakedAmount := k.GetProviderLiquidStakedTokens(ctx, provider)
+
+	totalStakedAmount := k.TotalBondedTokens(ctx)
+	if !sharesAlreadyBonded {
+		totalStakedAmount = totalStakedAmount.Add(tokens)
+	}
+
+	// Calculate the percentage of stake that is liquid staked by the provider
+	updatedLiquidStaked := providerLiquidStakedAmount.Add(tokens).ToDec()
+	liquidStakePercent := updatedLiquidStaked.Quo(totalStakedAmount.ToDec())
+
+	return liquidStakePercent.GT(liquidStakingCap)
+}
+
 // CheckExceedsValidatorBondCap checks if a liquid delegation to a validator would cause
 // the liquid shares to exceed the validator bond factor
 // A liquid delegation is defined as either tokenized shares, or a delegation from an ICA Account
@@ -87,6 +145,13 @@ func (k Keeper) CheckExceedsValidatorBondCap(ctx sdk.Context, validator types.Va
 	return validator.TotalLiquidShares.Add(shares).GT(maxValLiquidShares)
 }
 
+// CheckValidatorBondCapExceeded checks if a validator's liquid shares already exceed
+// the validator bond factor, which can happen if its validator bond is slashed
+// Returns true if the cap is exceeded
+func (k Keeper) CheckValidatorBondCapExceeded(ctx sdk.Context, validator types.Validator) bool {
+	return k.CheckExceedsValidatorBondCap(ctx, validator, sdk.ZeroDec())
+}
+
 // CheckExceedsValidatorLiquidStakingCap checks if a liquid delegation could cause the
 // total liuquid shares to exceed the liquid staking cap
 // A liquid delegation is defined as either tokenized shares, or a delegation from an ICA Account
@@ -122,9 +187,36 @@ func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx sdk.Context, amount sdk.Int)
 	return nil
 }
 
+// SafelyIncreaseProviderLiquidStakedTokens increments the liquid staked tokens of a
+// liquid staking provider if the provider cap is not surpassed by this delegation
+func (k Keeper) SafelyIncreaseProviderLiquidStakedTokens(ctx sdk.Context, provider sdk.AccAddress, amount sdk.Int, sharesAlreadyBonded bool) error {
+	if k.CheckExceedsProviderLiquidStakingCap(ctx, provider, amount, sharesAlreadyBonded) {
+		return types.ErrProviderLiquidStakingCapExceeded
+	}
+
+	k.SetProviderLiquidStakedTokens(ctx, provider, k.GetProviderLiquidStakedTokens(ctx, provider).Add(amount))
+	return nil
+}
+
+// DecreaseProviderLiquidStakedTokens decrements the liquid staked tokens of a liquid staking provider
+// The tokens of a delegation are truncated separately when it is slashed and when it is undelegated,
+// so the total is floored at zero rather than preventing the provider from exiting
+func (k Keeper) DecreaseProviderLiquidStakedTokens(ctx sdk.Context, provider sdk.AccAddress, amount sdk.Int) {
+	providerLiquidStake := k.GetProviderLiquidStakedTokens(ctx, provider)
+	if amount.GT(providerLiquidStake) {
+		amount = providerLiquidStake
+	}
+	k.SetProviderLiquidStakedTokens(ctx, provider, providerLiquidStake.Sub(amount))
+}
+
 // SafelyIncreaseValidatorTotalLiquidShares increments the total liquid shares on a validator, if:
 // the validator bond factor and validator liquid staking cap will not be exceeded by this delegation
 func (k Keeper) SafelyIncreaseValidatorTotalLiquidShares(ctx sdk.Context, validator *types.Validator, shares sdk.Dec) error {
+	// Liquid delegations are blocked until a validator exceeding its validator bond cap tops up its bond
+	if k.CheckValidatorBondCapExceeded(ctx, *validator) {
+		return types.ErrValidatorBondCapExceeded
+	}
+
 	// Confirm the validator bond factor and validator liquid staking cap will be not exceeded
 	if k.CheckExceedsValidatorBondCap(ctx, *validator, shares) {
 		return types.ErrInsufficientValidatorBondShares
@@ -147,6 +239,7 @@ func (k Keeper) DecreaseValidatorTotalLiquidShares(ctx sdk.Context, validator *t
 	}
 	validator.TotalLiquidShares = validator.TotalLiquidShares.Sub(shares)
 	k.SetValidator(ctx, *validator)
+	k.clearValidatorBondCapViolation(ctx, *validator)
 	return nil
 }
 
@@ -156,18 +249,114 @@ func (k Keeper) DecreaseValidatorTotalLiquidShares(ctx sdk.Context, validator *t
 func (k Keeper) SafelyDecreaseValidatorBond(ctx sdk.Context, validator *types.Validator, shares sdk.Dec) error {
 	// Check if the decreased self bond will cause the validator bond threshold to be exceeded
 	validatorBondFactor := k.ValidatorBondFactor(ctx)
+	validatorBondEnabled := !validatorBondFactor.Equal(types.ValidatorBondDisabled)
 	maxValTotalShare := validator.TotalValidatorBondShares.Sub(shares).Mul(validatorBondFactor)
-	if validator.TotalLiquidShares.GT(maxValTotalShare) {
+	if validatorBondEnabled && validator.TotalLiquidShares.GT(maxValTotalShare) {
 		return types.ErrInsufficientValidatorBondShares
 	}
 
 	// Decrement the validator's total self bond
 	validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Sub(shares)
 	k.SetValidator(ctx, *validator)
+	k.clearValidatorBondCapViolation(ctx, *validator)
 
 	return nil
 }
 
+// IncreaseValidatorBond increments the total validator's self bond when shares are
+// added to a validator bond delegation, which can clear a validator bond cap violation
+func (k Keeper) IncreaseValidatorBond(ctx sdk.Context, validator *types.Validator, shares sdk.Dec) {
+	validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Add(shares)
+	k.SetValidator(ctx, *validator)
+	k.clearValidatorBondCapViolation(ctx, *validator)
+}
+
+// Records the time at which the grace period of a validator exceeding its
+// validator bond cap ends
+func (k Keeper) SetValidatorBondCapViolation(ctx sdk.Context, valAddr sdk.ValAddress, gracePeriodEnd time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorBondCapViolationKey(valAddr)
+	store.Set(key, sdk.FormatTimeBytes(gracePeriodEnd))
+}
+
+// Returns the time at which the grace period of a validator exceeding its
+// validator bond cap ends, and whether a violation was recorded at all
+func (k Keeper) GetValidatorBondCapViolation(ctx sdk.Context, valAddr sdk.ValAddress) (gracePeriodEnd time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorBondCapViolationKey(valAddr)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return time.Time{}, false
+	}
+	gracePeriodEnd, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return gracePeriodEnd, true
+}
+
+// Removes the validator bond cap violation of a validator
+func (k Keeper) RemoveValidatorBondCapViolation(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorBondCapViolationKey(valAddr)
+	store.Delete(key)
+}
+
+// UpdateValidatorBondCapViolation records a violation, starting the grace period, if a
+// validator's liquid shares exceed its validator bond cap, and clears the violation
+// once the validator is back under the cap
+// Violations are only recorded when the validator bond is slashed, so that validators
+// already exceeding the cap before it was enforced are not flagged by unrelated
+// delegation changes
+func (k Keeper) UpdateValidatorBondCapViolation(ctx sdk.Context, validator types.Validator) {
+	valAddr := validator.GetOperator()
+	_, found := k.GetValidatorBondCapViolation(ctx, valAddr)
+	exceeded := k.CheckValidatorBondCapExceeded(ctx, validator)
+
+	switch {
+	case exceeded && !found:
+		gracePeriodEnd := ctx.BlockTime().Add(k.ValidatorBondGracePeriod(ctx))
+		k.SetValidatorBondCapViolation(ctx, valAddr, gracePeriodEnd)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidatorBondCapExceeded,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyLiquidShares, validator.TotalLiquidShares.String()),
+				sdk.NewAttribute(types.AttributeKeyBondShares, validator.TotalValidatorBondShares.String()),
+				sdk.NewAttribute(types.AttributeKeyGracePeriodEnd, gracePeriodEnd.Format(time.RFC3339)),
+			),
+		)
+	case !exceeded && found:
+		k.clearValidatorBondCapViolation(ctx, validator)
+	}
+}
+
+// clearValidatorBondCapViolation clears the violation of a validator that is back
+// under its validator bond cap (e.g. after topping up its validator bond)
+func (k Keeper) clearValidatorBondCapViolation(ctx sdk.Context, validator types.Validator) {
+	valAddr := validator.GetOperator()
+	if _, found := k.GetValidatorBondCapViolation(ctx, valAddr); !found || k.CheckValidatorBondCapExceeded(ctx, validator) {
+		return
+	}
+
+	k.RemoveValidatorBondCapViolation(ctx, valAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorBondCapRestored,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+}
+
+// Checks whether a validator still exceeds its validator bond cap after its
+// grace period has ended
+func (k Keeper) IsValidatorBondNonCompliant(ctx sdk.Context, validator types.Validator) bool {
+	gracePeriodEnd, found := k.GetValidatorBondCapViolation(ctx, validator.GetOperator())
+	return found && !ctx.BlockTime().Before(gracePeriodEnd) && k.CheckValidatorBondCapExceeded(ctx, validator)
+}
+
 // Adds a lock that prevents tokenizing shares for an account
 // The tokenize share lock store is implemented by keying on the account address
 // and storing a timestamp as the value. The timestamp is empty when the lock is
@@ -284,7 +473,11 @@ func (k Keeper) RemoveExpiredTokenizeShareLocks(ctx sdk.Context, blockTime time.
 		k.cdc.MustUnmarshal(iterator.Value(), &authorizations)
 
 		for _, addressString := range authorizations.Addresses {
-			k.RemoveTokenizeSharesLock(ctx, sdk.MustAccAddressFromBech32(addressString))
+			address := sdk.MustAccAddressFromBech32(addressString)
+			k.RemoveTokenizeSharesLock(ctx, address)
+			if err := k.AfterTokenizeShareLockChanged(ctx, address, types.TokenizeShareLockStatus_UNLOCKED); err != nil {
+				panic(err)
+			}
 			unlockedAddresses = append(unlockedAddresses, addressString)
 		}
 		store.Delete(iterator.Key())
@@ -293,11 +486,121 @@ func (k Keeper) RemoveExpiredTokenizeShareLocks(ctx sdk.Context, blockTime time.
 	return unlockedAddresses
 }
 
+// Adds an address to the tokenize share lock allowlist of an account
+// The entry will only be honored once the unbonding period has passed
+// Returns the time at which the entry becomes active
+func (k Keeper) AddTokenizeSharesAllowlistEntry(ctx sdk.Context, address sdk.AccAddress, recipient sdk.AccAddress) time.Time {
+	params := k.GetParams(ctx)
+	activationTime := ctx.BlockTime().Add(params.UnbondingTime)
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetTokenizeSharesAllowlistKey(address, recipient)
+	store.Set(key, sdk.FormatTimeBytes(activationTime))
+
+	return activationTime
+}
+
+// Removes an address from the tokenize share lock allowlist of an account
+func (k Keeper) RemoveTokenizeSharesAllowlistEntry(ctx sdk.Context, address sdk.AccAddress, recipient sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetTokenizeSharesAllowlistKey(address, recipient)
+	store.Delete(key)
+}
+
+// Returns the time at which an allowlist entry becomes active, and whether
+// the recipient is on the account's allowlist at all
+func (k Keeper) GetTokenizeSharesAllowlistEntry(ctx sdk.Context, address sdk.AccAddress, recipient sdk.AccAddress) (activationTime time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetTokenizeSharesAllowlistKey(address, recipient)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return time.Time{}, false
+	}
+	activationTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return activationTime, true
+}
+
+// Returns all tokenize share lock allowlist entries of an account, including
+// the ones that have not yet become active
+func (k Keeper) GetTokenizeSharesAllowlist(ctx sdk.Context, address sdk.AccAddress) []types.TokenizeShareLockAllowlistEntry {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetTokenizeSharesAllowlistPrefix(address)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	entries := []types.TokenizeShareLockAllowlistEntry{}
+	for ; iterator.Valid(); iterator.Next() {
+		recipient := sdk.AccAddress(iterator.Key()[len(prefix)+1:])
+		activationTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+		entries = append(entries, types.TokenizeShareLockAllowlistEntry{
+			Recipient:      recipient.String(),
+			ActivationTime: activationTime,
+		})
+	}
+
+	return entries
+}
+
+// Checks whether a recipient is an active entry on the tokenize share lock
+// allowlist of an account (i.e. it has been on the allowlist for the full
+// unbonding period)
+func (k Keeper) IsTokenizeSharesRecipientAllowed(ctx sdk.Context, address sdk.AccAddress, recipient sdk.AccAddress) bool {
+	activationTime, found := k.GetTokenizeSharesAllowlistEntry(ctx, address, recipient)
+	return found && !ctx.BlockTime().Before(activationTime)
+}
+
+// IterateProviderLiquidStakedTokens iterates over the liquid staking providers with
+// outstanding liquid staked tokens
+func (k Keeper) IterateProviderLiquidStakedTokens(ctx sdk.Context, cb func(provider sdk.AccAddress, tokens sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ProviderLiquidStakedTokensKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the prefix followed by the length prefixed provider address
+		provider := sdk.AccAddress(iterator.Key()[len(types.ProviderLiquidStakedTokensKey)+1:])
+
+		var tokens sdk.Int
+		if err := tokens.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		if cb(provider, tokens) {
+			break
+		}
+	}
+}
+
+// Removes the liquid staked tokens of every liquid staking provider
+func (k Keeper) RemoveAllProviderLiquidStakedTokens(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ProviderLiquidStakedTokensKey)
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // Calculates and sets the global liquid staked tokens and total liquid shares by validator
 // The totals are determined by looping each delegation record and summing the stake
 // if the delegator has a 32-length address. Checking for a 32-length address will capture
 // ICA accounts, as well as tokenized delegations which are owned by module accounts
 // under the hood
+// The liquid staked tokens of each provider are recalculated as well, excluding the
+// tokenize share record module accounts, which are not providers themselves
 // This function must be called in the upgrade handler which onboards LSM, as
 // well as any time the liquid staking cap is re-enabled
 func (k Keeper) RefreshTotalLiquidStaked(ctx sdk.Context) error {
@@ -307,6 +610,15 @@ func (k Keeper) RefreshTotalLiquidStaked(ctx sdk.Context) error {
 		k.SetValidator(ctx, validator)
 	}
 
+	// Reset each provider's liquid staked tokens and collect the tokenize share record
+	// module accounts so that they can be excluded from the provider totals
+	k.RemoveAllProviderLiquidStakedTokens(ctx)
+
+	recordModuleAccounts := map[string]bool{}
+	for _, record := range k.GetAllTokenizeShareRecords(ctx) {
+		recordModuleAccounts[record.GetModuleAddress().String()] = true
+	}
+
 	// Sum up the total liquid tokens and increment each validator's total liquid shares
 	totalLiquidStakedTokens := sdk.ZeroInt()
 	for _, delegation := range k.GetAllDelegations(ctx) {
@@ -334,6 +646,11 @@ func (k Keeper) RefreshTotalLiquidStaked(ctx sdk.Context) error {
 			k.SetValidator(ctx, validator)
 
 			totalLiquidStakedTokens = totalLiquidStakedTokens.Add(liquidTokens)
+
+			if !recordModuleAccounts[delegation.DelegatorAddress] {
+				providerLiquidStakedTokens := k.GetProviderLiquidStakedTokens(ctx, delegatorAddress)
+				k.SetProviderLiquidStakedTokens(ctx, delegatorAddress, providerLiquidStakedTokens.Add(liquidTokens))
+			}
 		}
 	}
 
@@ -11,6 +11,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 	"github.com/stretchr/testify/require"
 )
@@ -286,6 +287,57 @@ func TestCheckExceedsGlobalLiquidStakingCap(t *testing.T) {
 	}
 }
 
+// Tests that CheckExceedsGlobalLiquidStakingCap follows the cap schedule
+func TestCheckExceedsGlobalLiquidStakingCapSchedule(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	// Ramp the cap from 10% to 30% over 10 hours, starting at a static cap of 0%
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.ZeroDec()
+	params.GlobalLiquidStakingCapSchedule = []types.GlobalLiquidStakingCapScheduleEntry{
+		{Time: start, Cap: sdk.MustNewDecFromStr("0.1")},
+		{Time: start.Add(10 * time.Hour), Cap: sdk.MustNewDecFromStr("0.3")},
+	}
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// Total Liquid Stake: 0, Total Stake: 100
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, sdk.ZeroInt())
+	clearPoolBalance(t, app, ctx)
+	fundPoolBalance(t, app, ctx, sdk.NewInt(100))
+
+	// Tokenizing 15 => 15/100 = 15%
+	newLiquidStake := sdk.NewInt(15)
+
+	// Before the schedule starts, the static cap of 0% applies
+	ctx = ctx.WithBlockTime(start.Add(-time.Hour))
+	require.True(t, app.StakingKeeper.EffectiveGlobalLiquidStakingCap(ctx).IsZero(), "cap before schedule")
+	require.True(t, app.StakingKeeper.CheckExceedsGlobalLiquidStakingCap(ctx, newLiquidStake, true), "exceeds before schedule")
+
+	// At the start of the schedule, the cap is 10%
+	ctx = ctx.WithBlockTime(start)
+	require.True(t, app.StakingKeeper.CheckExceedsGlobalLiquidStakingCap(ctx, newLiquidStake, true), "exceeds at start")
+
+	// Half way through the ramp, the cap is 20%
+	ctx = ctx.WithBlockTime(start.Add(5 * time.Hour))
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), app.StakingKeeper.EffectiveGlobalLiquidStakingCap(ctx), "cap half way")
+	require.False(t, app.StakingKeeper.CheckExceedsGlobalLiquidStakingCap(ctx, newLiquidStake, true), "exceeds half way")
+
+	// The effective cap should be exposed in the queries
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+	paramsResponse, err := querier.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err, "no error expected when querying params")
+	require.Equal(t, sdk.MustNewDecFromStr("0.2").String(), paramsResponse.EffectiveGlobalLiquidStakingCap, "params query cap")
+
+	totalLiquidStakedResponse, err := querier.TotalLiquidStaked(sdk.WrapSDKContext(ctx), &types.QueryTotalLiquidStaked{})
+	require.NoError(t, err, "no error expected when querying total liquid staked")
+	require.Equal(t, sdk.MustNewDecFromStr("0.2").String(), totalLiquidStakedResponse.GlobalLiquidStakingCap, "total liquid staked query cap")
+
+	// After the schedule ends, the last cap of 30% applies
+	ctx = ctx.WithBlockTime(start.Add(20 * time.Hour))
+	require.Equal(t, sdk.MustNewDecFromStr("0.3"), app.StakingKeeper.EffectiveGlobalLiquidStakingCap(ctx), "cap after schedule")
+}
+
 // Tests SafelyIncreaseTotalLiquidStakedTokens
 func TestSafelyIncreaseTotalLiquidStakedTokens(t *testing.T) {
 	_, app, ctx := createTestInput(t)
@@ -342,6 +394,44 @@ func TestDecreaseTotalLiquidStakedTokens(t *testing.T) {
 	require.ErrorIs(t, err, types.ErrTotalLiquidStakedUnderflow)
 }
 
+// Tests Increase/Decrease ProviderLiquidStakedTokens
+func TestProviderLiquidStakedTokens(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	providerA := createICAAccount(app, ctx, "provider-a")
+	providerB := createICAAccount(app, ctx, "provider-b")
+
+	// Set the total stake to 1000 and the provider cap to 10%
+	clearPoolBalance(t, app, ctx)
+	fundPoolBalance(t, app, ctx, sdk.NewInt(1000))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ProviderLiquidStakingCap = sdk.MustNewDecFromStr("0.1")
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// Each provider can liquid stake up to 100 tokens (100 / 1000 = 10%)
+	err := app.StakingKeeper.SafelyIncreaseProviderLiquidStakedTokens(ctx, providerA, sdk.NewInt(100), true)
+	require.NoError(t, err, "no error expected when increasing provider A")
+	err = app.StakingKeeper.SafelyIncreaseProviderLiquidStakedTokens(ctx, providerB, sdk.NewInt(60), true)
+	require.NoError(t, err, "no error expected when increasing provider B")
+
+	// Provider A is at the cap, while provider B still has room
+	err = app.StakingKeeper.SafelyIncreaseProviderLiquidStakedTokens(ctx, providerA, sdk.NewInt(1), true)
+	require.ErrorIs(t, err, types.ErrProviderLiquidStakingCapExceeded)
+	err = app.StakingKeeper.SafelyIncreaseProviderLiquidStakedTokens(ctx, providerB, sdk.NewInt(40), true)
+	require.NoError(t, err, "no error expected when increasing provider B to the cap")
+
+	require.Equal(t, sdk.NewInt(100), app.StakingKeeper.GetProviderLiquidStakedTokens(ctx, providerA), "provider A")
+	require.Equal(t, sdk.NewInt(100), app.StakingKeeper.GetProviderLiquidStakedTokens(ctx, providerB), "provider B")
+
+	// Decrease provider A, then decrease provider B by an excessive amount, which should floor at zero
+	app.StakingKeeper.DecreaseProviderLiquidStakedTokens(ctx, providerA, sdk.NewInt(40))
+	require.Equal(t, sdk.NewInt(60), app.StakingKeeper.GetProviderLiquidStakedTokens(ctx, providerA), "provider A after decrease")
+
+	app.StakingKeeper.DecreaseProviderLiquidStakedTokens(ctx, providerB, sdk.NewInt(101))
+	require.True(t, app.StakingKeeper.GetProviderLiquidStakedTokens(ctx, providerB).IsZero(), "provider B after decrease")
+}
+
 // Tests CheckExceedsValidatorBondCap
 func TestCheckExceedsValidatorBondCap(t *testing.T) {
 	_, app, ctx := createTestInput(t)
@@ -727,6 +817,127 @@ func TestDecreaseValidatorTotalLiquidShares(t *testing.T) {
 	require.ErrorIs(t, err, types.ErrValidatorLiquidSharesUnderflow)
 }
 
+// Tests UpdateValidatorBondCapViolation and IsValidatorBondNonCompliant
+func TestValidatorBondCapViolation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	gracePeriod := time.Hour
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(10)
+	params.ValidatorBondGracePeriod = gracePeriod
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// Create a validator with liquid shares at its validator bond cap
+	privKey := secp256k1.GenPrivKey()
+	valAddress := sdk.ValAddress(privKey.PubKey().Address())
+
+	validator := types.Validator{
+		OperatorAddress:          valAddress.String(),
+		TotalLiquidShares:        sdk.NewDec(100),
+		TotalValidatorBondShares: sdk.NewDec(10),
+		DelegatorShares:          sdk.NewDec(1000),
+	}
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	// Helper function to check the validator's compliance from the query
+	checkCompliance := func(exceeded, nonCompliant bool, gracePeriodEnd *time.Time, description string) {
+		res, err := querier.ValidatorBondCompliance(sdk.WrapSDKContext(ctx), &types.QueryValidatorBondComplianceRequest{
+			ValidatorAddr: valAddress.String(),
+		})
+		require.NoError(t, err, description)
+		require.Equal(t, exceeded, res.BondCapExceeded, "%s - bond cap exceeded", description)
+		require.Equal(t, nonCompliant, res.NonCompliant, "%s - non-compliant", description)
+		require.Equal(t, gracePeriodEnd, res.GracePeriodEnd, "%s - grace period end", description)
+	}
+
+	// A validator at its cap is not in violation
+	app.StakingKeeper.UpdateValidatorBondCapViolation(ctx, validator)
+	_, found := app.StakingKeeper.GetValidatorBondCapViolation(ctx, valAddress)
+	require.False(t, found, "no violation expected at the cap")
+	checkCompliance(false, false, nil, "at cap")
+
+	// Shrink the validator bond (e.g. from a slash), the violation should be recorded
+	validator.TotalValidatorBondShares = sdk.NewDec(5)
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.UpdateValidatorBondCapViolation(ctx, validator)
+
+	expectedGracePeriodEnd := ctx.BlockTime().Add(gracePeriod)
+	gracePeriodEnd, found := app.StakingKeeper.GetValidatorBondCapViolation(ctx, valAddress)
+	require.True(t, found, "violation expected after the bond shrinks")
+	require.Equal(t, expectedGracePeriodEnd, gracePeriodEnd, "grace period end")
+	checkCompliance(true, false, &expectedGracePeriodEnd, "during grace period")
+
+	// Further liquid delegations should be blocked
+	err := app.StakingKeeper.SafelyIncreaseValidatorTotalLiquidShares(ctx, &validator, sdk.OneDec())
+	require.ErrorIs(t, err, types.ErrValidatorBondCapExceeded)
+
+	// Recording the violation again should not extend the grace period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(gracePeriod / 2))
+	app.StakingKeeper.UpdateValidatorBondCapViolation(ctx, validator)
+	gracePeriodEnd, _ = app.StakingKeeper.GetValidatorBondCapViolation(ctx, valAddress)
+	require.Equal(t, expectedGracePeriodEnd, gracePeriodEnd, "grace period end after update")
+
+	// Once the grace period ends, the validator is flagged as non-compliant
+	ctx = ctx.WithBlockTime(expectedGracePeriodEnd)
+	require.True(t, app.StakingKeeper.IsValidatorBondNonCompliant(ctx, validator))
+	checkCompliance(true, true, &expectedGracePeriodEnd, "after grace period")
+
+	// Top up the validator bond, the violation should be cleared
+	validator.TotalValidatorBondShares = sdk.NewDec(10)
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.UpdateValidatorBondCapViolation(ctx, validator)
+
+	_, found = app.StakingKeeper.GetValidatorBondCapViolation(ctx, valAddress)
+	require.False(t, found, "violation should be cleared after topping up the bond")
+	require.False(t, app.StakingKeeper.IsValidatorBondNonCompliant(ctx, validator))
+	checkCompliance(false, false, nil, "after top up")
+}
+
+// Tests that delegation changes only clear validator bond cap violations
+func TestValidatorBondCapViolationOnlyClearedByDelegationChanges(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(10)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// Create a validator that already exceeds its validator bond cap of 100 shares
+	privKey := secp256k1.GenPrivKey()
+	valAddress := sdk.ValAddress(privKey.PubKey().Address())
+
+	validator := types.Validator{
+		OperatorAddress:          valAddress.String(),
+		TotalLiquidShares:        sdk.NewDec(150),
+		TotalValidatorBondShares: sdk.NewDec(10),
+		DelegatorShares:          sdk.NewDec(1000),
+	}
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	// An undelegation of liquid shares that leaves the validator above the cap does not record a violation
+	err := app.StakingKeeper.DecreaseValidatorTotalLiquidShares(ctx, &validator, sdk.NewDec(10))
+	require.NoError(t, err)
+	_, found := app.StakingKeeper.GetValidatorBondCapViolation(ctx, valAddress)
+	require.False(t, found, "no violation expected after an undelegation")
+
+	// Neither does a validator bond change that leaves the validator above the cap
+	app.StakingKeeper.IncreaseValidatorBond(ctx, &validator, sdk.NewDec(1))
+	_, found = app.StakingKeeper.GetValidatorBondCapViolation(ctx, valAddress)
+	require.False(t, found, "no violation expected after a validator bond change")
+
+	// Once recorded, e.g. by a slash, the violation is cleared when an undelegation brings
+	// the validator back under the cap
+	app.StakingKeeper.UpdateValidatorBondCapViolation(ctx, validator)
+	_, found = app.StakingKeeper.GetValidatorBondCapViolation(ctx, valAddress)
+	require.True(t, found, "violation expected")
+
+	err = app.StakingKeeper.DecreaseValidatorTotalLiquidShares(ctx, &validator, sdk.NewDec(30))
+	require.NoError(t, err)
+	_, found = app.StakingKeeper.GetValidatorBondCapViolation(ctx, valAddress)
+	require.False(t, found, "violation should be cleared once back under the cap")
+}
+
 // Tests Add/Remove/Get/SetTokenizeSharesLock
 func TestTokenizeSharesLock(t *testing.T) {
 	_, app, ctx := createTestInput(t)
@@ -777,6 +988,67 @@ func TestTokenizeSharesLock(t *testing.T) {
 	require.Equal(t, unlocked, status.String(), "addressB unlocked at end")
 }
 
+// Test Add/Remove/GetTokenizeSharesAllowlistEntry and IsTokenizeSharesRecipientAllowed
+func TestTokenizeSharesAllowlist(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addresses := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1))
+	delegator, recipientA, recipientB := addresses[0], addresses[1], addresses[2]
+
+	// Fix block time and set unbonding period to 1 day
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime)
+
+	unbondingPeriod := time.Hour * 24
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = unbondingPeriod
+	app.StakingKeeper.SetParams(ctx, params)
+	activationTime := blockTime.Add(unbondingPeriod)
+
+	// The allowlist should start empty
+	require.Empty(t, app.StakingKeeper.GetTokenizeSharesAllowlist(ctx, delegator), "allowlist empty at start")
+	_, found := app.StakingKeeper.GetTokenizeSharesAllowlistEntry(ctx, delegator, recipientA)
+	require.False(t, found, "recipientA not found at start")
+
+	// Add recipientA, it should only become active after the unbonding period
+	actualActivationTime := app.StakingKeeper.AddTokenizeSharesAllowlistEntry(ctx, delegator, recipientA)
+	require.Equal(t, activationTime, actualActivationTime, "activation time")
+
+	storedActivationTime, found := app.StakingKeeper.GetTokenizeSharesAllowlistEntry(ctx, delegator, recipientA)
+	require.True(t, found, "recipientA found")
+	require.Equal(t, activationTime, storedActivationTime, "stored activation time")
+
+	require.False(t, app.StakingKeeper.IsTokenizeSharesRecipientAllowed(ctx, delegator, recipientA),
+		"recipientA should not be allowed before the activation time")
+	require.False(t, app.StakingKeeper.IsTokenizeSharesRecipientAllowed(ctx, delegator, recipientB),
+		"recipientB should not be allowed")
+
+	// The allowlist should be scoped to the delegator
+	require.Empty(t, app.StakingKeeper.GetTokenizeSharesAllowlist(ctx, recipientA), "recipientA allowlist empty")
+
+	// Move forward to the activation time and add recipientB
+	ctx = ctx.WithBlockTime(activationTime)
+	app.StakingKeeper.AddTokenizeSharesAllowlistEntry(ctx, delegator, recipientB)
+
+	require.True(t, app.StakingKeeper.IsTokenizeSharesRecipientAllowed(ctx, delegator, recipientA),
+		"recipientA should be allowed at the activation time")
+	require.False(t, app.StakingKeeper.IsTokenizeSharesRecipientAllowed(ctx, delegator, recipientB),
+		"recipientB should not be allowed yet")
+
+	// Both entries should be returned
+	expectedEntries := []types.TokenizeShareLockAllowlistEntry{
+		{Recipient: recipientA.String(), ActivationTime: activationTime},
+		{Recipient: recipientB.String(), ActivationTime: activationTime.Add(unbondingPeriod)},
+	}
+	require.ElementsMatch(t, expectedEntries, app.StakingKeeper.GetTokenizeSharesAllowlist(ctx, delegator), "allowlist entries")
+
+	// Remove recipientA
+	app.StakingKeeper.RemoveTokenizeSharesAllowlistEntry(ctx, delegator, recipientA)
+	require.False(t, app.StakingKeeper.IsTokenizeSharesRecipientAllowed(ctx, delegator, recipientA),
+		"recipientA should no longer be allowed")
+	require.Equal(t, expectedEntries[1:], app.StakingKeeper.GetTokenizeSharesAllowlist(ctx, delegator), "allowlist entries at end")
+}
+
 // Test Get/SetPendingTokenizeShareAuthorizations
 func TestPendingTokenizeShareAuthorizations(t *testing.T) {
 	_, app, ctx := createTestInput(t)
@@ -1 +1,41 @@
 package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// The params added since version 3 are set to their defaults, as reading a
+// param missing from the store panics, the validator bond delegation
+// index is built, and the liquid staked totals, including the totals of each
+// liquid staking provider, are recalculated from the existing delegations.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !m.keeper.paramstore.Has(ctx, pair.Key) {
+			m.keeper.paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	m.keeper.IterateAllDelegations(ctx, func(delegation types.Delegation) (stop bool) {
+		if delegation.ValidatorBond {
+			delAddr := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
+			store.Set(types.GetValidatorBondDelegationIndexKey(delegation.GetValidatorAddr(), delAddr), []byte{})
+		}
+		return false
+	})
+
+	return m.keeper.RefreshTotalLiquidStaked(ctx)
+}
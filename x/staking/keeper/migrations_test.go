@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp_test "github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestMigrate3to4(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxEntries++
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// remove the params added since version 3, as on a chain upgraded from it
+	newKeys := [][]byte{
+		types.KeyGlobalLiquidStakingCapSchedule,
+		types.KeyProviderLiquidStakingCap,
+		types.KeyValidatorBondFirstLossFraction,
+		types.KeyValidatorBondGracePeriod,
+	}
+	subspace := app.GetSubspace(types.ModuleName)
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+	for _, key := range newKeys {
+		store.Delete(key)
+		require.False(t, subspace.Has(ctx, key))
+	}
+
+	// write a validator bond delegation without its index entry
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))
+	valAddr := app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+	delegation := types.NewDelegation(addrs[0], valAddr, sdk.NewDec(1000), true)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.GetValidatorBondDelegationIndexKey(valAddr, addrs[0]))
+	require.Empty(t, app.StakingKeeper.GetValidatorBondDelegations(ctx, valAddr))
+
+	// delegate from a liquid staking provider, whose stake is not tracked before version 4
+	provider := createICAAccount(app, ctx, "provider")
+	providerTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	require.NoError(t, simapp_test.FundAccount(app.BankKeeper, ctx, provider,
+		sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), providerTokens))))
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	require.True(t, found)
+	_, err := app.StakingKeeper.Delegate(ctx, provider, providerTokens, sdkstaking.Unbonded, validator, true)
+	require.NoError(t, err)
+	require.True(t, app.StakingKeeper.GetProviderLiquidStakedTokens(ctx, provider).IsZero())
+
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, migrator.Migrate3to4(ctx))
+	require.Equal(t, []types.Delegation{delegation}, app.StakingKeeper.GetValidatorBondDelegations(ctx, valAddr))
+
+	// the provider's existing delegation is counted in its total and the global total
+	require.Equal(t, providerTokens, app.StakingKeeper.GetProviderLiquidStakedTokens(ctx, provider))
+	require.Equal(t, providerTokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the removed params are set to their defaults and the others are kept
+	defaults := types.DefaultParams()
+	params.GlobalLiquidStakingCapSchedule = defaults.GlobalLiquidStakingCapSchedule
+	params.ProviderLiquidStakingCap = defaults.ProviderLiquidStakingCap
+	params.ValidatorBondFirstLossFraction = defaults.ValidatorBondFirstLossFraction
+	params.ValidatorBondGracePeriod = defaults.ValidatorBondGracePeriod
+	require.Equal(t, params, app.StakingKeeper.GetParams(ctx))
+}
@@ -225,24 +225,7 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 	}
 
 	tokens := msg.Amount.Amount
-	shares, err := validator.SharesFromTokens(tokens)
-	if err != nil {
-		return nil, err
-	}
-
-	// if this delegation is from a liquid staking provider, it cannot exceed
-	// the global or validator bond cap
-	if k.AccountIsLiquidStakingProvider(delegatorAddress) {
-		if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, tokens, false); err != nil {
-			return nil, err
-		}
-		if err := k.SafelyIncreaseValidatorTotalLiquidShares(ctx, &validator, shares); err != nil {
-			return nil, err
-		}
-	}
-
-	// NOTE: source funds are always unbonded
-	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, tokens, sdkstaking.Unbonded, validator, true)
+	newShares, err := k.DelegateTokens(ctx, delegatorAddress, validator.GetOperator(), tokens)
 	if err != nil {
 		return nil, err
 	}
@@ -325,11 +308,15 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		if err := k.SafelyDecreaseValidatorBond(ctx, &srcValidator, srcShares); err != nil {
 			return nil, err
 		}
+		if err := k.AfterValidatorBondChanged(ctx, delegatorAddress, valSrcAddr); err != nil {
+			return nil, err
+		}
 	}
 
 	// If this delegation is from a liquid staking provider, the delegation on the new validator
 	// cannot exceed that validator's self-bond cap
 	// The liquid shares from the source validator should get moved to the destination validator
+	// The provider's liquid staked tokens are unchanged since the tokens remain delegated
 	if k.AccountIsLiquidStakingProvider(delegatorAddress) {
 		if err := k.SafelyIncreaseValidatorTotalLiquidShares(ctx, &dstValidator, dstShares); err != nil {
 			return nil, err
@@ -424,14 +411,18 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		if err := k.SafelyDecreaseValidatorBond(ctx, &validator, shares); err != nil {
 			return nil, err
 		}
+		if err := k.AfterValidatorBondChanged(ctx, delegatorAddress, addr); err != nil {
+			return nil, err
+		}
 	}
 
-	// if this undelegation is from a liquid staking provider, the global and validator
-	// liquid counts should be decremented
+	// if this undelegation is from a liquid staking provider, the global, provider and
+	// validator liquid counts should be decremented
 	if k.AccountIsLiquidStakingProvider(delegatorAddress) {
 		if err := k.DecreaseTotalLiquidStakedTokens(ctx, tokens); err != nil {
 			return nil, err
 		}
+		k.DecreaseProviderLiquidStakedTokens(ctx, delegatorAddress, tokens)
 		if err := k.DecreaseValidatorTotalLiquidShares(ctx, &validator, shares); err != nil {
 			return nil, err
 		}
@@ -517,8 +508,8 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 		return nil, sdkstaking.ErrValidatorJailed
 	}
 
-	// if this undelegation was from a liquid staking provider, the global and validator
-	// liquid counts should be incremented
+	// if this undelegation was from a liquid staking provider, the global, provider and
+	// validator liquid counts should be incremented
 	tokens := msg.Amount.Amount
 	shares, err := validator.SharesFromTokens(tokens)
 	if err != nil {
@@ -528,6 +519,9 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 		if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, tokens, false); err != nil {
 			return nil, err
 		}
+		if err := k.SafelyIncreaseProviderLiquidStakedTokens(ctx, delegatorAddress, tokens, false); err != nil {
+			return nil, err
+		}
 		if err := k.SafelyIncreaseValidatorTotalLiquidShares(ctx, &validator, shares); err != nil {
 			return nil, err
 		}
@@ -639,12 +633,20 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 	}
 
 	// Check if the delegator has disabled tokenization
+	// While disabled, tokenization is only permitted if the tokenized share owner
+	// is an active entry on the delegator's tokenize share lock allowlist
 	lockStatus, unlockTime := k.GetTokenizeSharesLock(ctx, delegatorAddress)
-	if lockStatus == types.TokenizeShareLockStatus_LOCKED {
-		return nil, types.ErrTokenizeSharesDisabledForAccount
-	}
-	if lockStatus == types.TokenizeShareLockStatus_LOCK_EXPIRING {
-		return nil, types.ErrTokenizeSharesDisabledForAccount.Wrapf("tokenization will be allowed at %s", unlockTime)
+	if lockStatus != types.TokenizeShareLockStatus_UNLOCKED {
+		tokenizedShareOwner, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner)
+		if err != nil {
+			return nil, err
+		}
+		if !k.IsTokenizeSharesRecipientAllowed(ctx, delegatorAddress, tokenizedShareOwner) {
+			if lockStatus == types.TokenizeShareLockStatus_LOCK_EXPIRING {
+				return nil, types.ErrTokenizeSharesDisabledForAccount.Wrapf("tokenization will be allowed at %s", unlockTime)
+			}
+			return nil, types.ErrTokenizeSharesDisabledForAccount
+		}
 	}
 
 	delegation, found := k.GetLiquidDelegation(ctx, delegatorAddress, valAddr)
@@ -690,7 +692,8 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 	// If this tokenization is NOT from a liquid staking provider,
 	//   confirm it does not exceed the global and validator liquid staking cap
 	// If the tokenization is from a liquid staking provider,
-	//   the shares are already considered liquid and there's no need to increment the totals
+	//   the shares are already considered liquid and there's no need to increment the totals,
+	//   however, the shares are no longer delegated by the provider, so its liquid stake is decremented
 	if !k.AccountIsLiquidStakingProvider(delegatorAddress) {
 		if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, msg.Amount.Amount, true); err != nil {
 			return nil, err
@@ -698,6 +701,8 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		if err := k.SafelyIncreaseValidatorTotalLiquidShares(ctx, &validator, shares); err != nil {
 			return nil, err
 		}
+	} else {
+		k.DecreaseProviderLiquidStakedTokens(ctx, delegatorAddress, msg.Amount.Amount)
 	}
 
 	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
@@ -708,6 +713,7 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		Owner:         msg.TokenizedShareOwner,
 		ModuleAccount: fmt.Sprintf("%s%d", types.TokenizeShareModuleAccountPrefix, recordID),
 		Validator:     msg.ValidatorAddress,
+		Compound:      msg.Compound,
 	}
 
 	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), msg.Amount.Amount)
@@ -760,6 +766,10 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		return nil, err
 	}
 
+	if err := k.AfterTokenizeShareRecordCreated(ctx, record.Id); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTokenizeShares,
@@ -816,7 +826,8 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 
 	// If this redemption is NOT from a liquid staking provider, decrement the total liquid staked
 	// If the redemption was from a liquid staking provider, the shares are still considered
-	// liquid, even in their non-tokenized form (since they are owned by a liquid staking provider)
+	// liquid, even in their non-tokenized form (since they are owned by a liquid staking provider),
+	// and they count towards the provider's cap
 	if !k.AccountIsLiquidStakingProvider(delegatorAddress) {
 		if err := k.DecreaseTotalLiquidStakedTokens(ctx, tokens); err != nil {
 			return nil, err
@@ -824,6 +835,10 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 		if err := k.DecreaseValidatorTotalLiquidShares(ctx, &validator, shares); err != nil {
 			return nil, err
 		}
+	} else {
+		if err := k.SafelyIncreaseProviderLiquidStakedTokens(ctx, delegatorAddress, tokens, true); err != nil {
+			return nil, err
+		}
 	}
 
 	returnAmount, err := k.Unbond(ctx, record.GetModuleAddress(), valAddr, shares)
@@ -838,8 +853,8 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 	// Note: since delegation object has been changed from unbond call, it gets latest delegation
 	_, found = k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
 	if !found {
-		if k.hooks != nil {
-			return nil, k.hooks.BeforeTokenizeShareRecordRemoved(ctx, record.Id)
+		if err := k.BeforeTokenizeShareRecordRemoved(ctx, record.Id); err != nil {
+			return nil, err
 		}
 
 		err = k.DeleteTokenizeShareRecord(ctx, record.Id)
@@ -878,6 +893,10 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 		return nil, err
 	}
 
+	if err := k.AfterSharesRedeemed(ctx, record.Id, delegatorAddress, valAddr, returnAmount); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeRedeemShares,
@@ -921,6 +940,10 @@ func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types
 	}
 	k.setTokenizeShareRecordWithOwner(ctx, newOwner, record.Id)
 
+	if err := k.AfterTokenizeShareRecordTransferred(ctx, record.Id, oldOwner, newOwner); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTransferTokenizeShareRecord,
@@ -954,6 +977,10 @@ func (k msgServer) DisableTokenizeShares(goCtx context.Context, msg *types.MsgDi
 	// Note: if there is a lock expiration in progress, this will override the expiration
 	k.AddTokenizeSharesLock(ctx, delegator)
 
+	if err := k.AfterTokenizeShareLockChanged(ctx, delegator, types.TokenizeShareLockStatus_LOCKED); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgDisableTokenizeSharesResponse{}, nil
 }
 
@@ -977,9 +1004,48 @@ func (k msgServer) EnableTokenizeShares(goCtx context.Context, msg *types.MsgEna
 	// Otherwise queue the unlock
 	completionTime := k.QueueTokenizeSharesAuthorization(ctx, delegator)
 
+	if err := k.AfterTokenizeShareLockChanged(ctx, delegator, types.TokenizeShareLockStatus_LOCK_EXPIRING); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgEnableTokenizeSharesResponse{CompletionTime: completionTime}, nil
 }
 
+// AllowTokenizeSharesRecipient adds an address to the sender's tokenize share lock
+// allowlist, which will take effect after the unbonding period
+func (k msgServer) AllowTokenizeSharesRecipient(goCtx context.Context, msg *types.MsgAllowTokenizeSharesRecipient) (*types.MsgAllowTokenizeSharesRecipientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator := sdk.MustAccAddressFromBech32(msg.DelegatorAddress)
+	recipient := sdk.MustAccAddressFromBech32(msg.RecipientAddress)
+
+	// If the recipient is already on the allowlist, alert the user
+	if activationTime, found := k.GetTokenizeSharesAllowlistEntry(ctx, delegator, recipient); found {
+		return nil, types.ErrTokenizeSharesRecipientAlreadyAllowed.Wrapf("allowlist entry active at %s", activationTime)
+	}
+
+	activationTime := k.AddTokenizeSharesAllowlistEntry(ctx, delegator, recipient)
+
+	return &types.MsgAllowTokenizeSharesRecipientResponse{ActivationTime: activationTime}, nil
+}
+
+// DisallowTokenizeSharesRecipient removes an address from the sender's tokenize share
+// lock allowlist. Since this only restricts tokenization further, it takes effect immediately
+func (k msgServer) DisallowTokenizeSharesRecipient(goCtx context.Context, msg *types.MsgDisallowTokenizeSharesRecipient) (*types.MsgDisallowTokenizeSharesRecipientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator := sdk.MustAccAddressFromBech32(msg.DelegatorAddress)
+	recipient := sdk.MustAccAddressFromBech32(msg.RecipientAddress)
+
+	if _, found := k.GetTokenizeSharesAllowlistEntry(ctx, delegator, recipient); !found {
+		return nil, types.ErrTokenizeSharesRecipientNotAllowed
+	}
+
+	k.RemoveTokenizeSharesAllowlistEntry(ctx, delegator, recipient)
+
+	return &types.MsgDisallowTokenizeSharesRecipientResponse{}, nil
+}
+
 func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorBond) (*types.MsgValidatorBondResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -1011,8 +1077,11 @@ func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorB
 	if !delegation.ValidatorBond {
 		delegation.ValidatorBond = true
 		k.SetDelegation(ctx, delegation)
-		validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Add(delegation.Shares)
-		k.SetValidator(ctx, validator)
+		k.IncreaseValidatorBond(ctx, &validator, delegation.Shares)
+
+		if err := k.AfterValidatorBondChanged(ctx, delAddr, valAddr); err != nil {
+			return nil, err
+		}
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -769,6 +769,157 @@ func TestValidatorBond(t *testing.T) {
 	}
 }
 
+func TestDelegateToValidatorBond(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	pubKeys := simapp.CreateTestPubKeys(2)
+	validatorAddress := sdk.ValAddress(pubKeys[0].Address())
+	delegatorAddress := sdk.AccAddress(pubKeys[1].Address())
+
+	// Fund the delegator
+	delegationAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 20)
+	coins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), delegationAmount.MulRaw(2)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, delegatorAddress, coins))
+
+	// Create a validator whose liquid shares exceed its validator bond cap
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	validator := teststaking.NewValidator(t, validatorAddress, pubKeys[0])
+	validator, liquidShares := validator.AddTokensFromDel(delegationAmount.MulRaw(2))
+	validator.Status = sdkstaking.Bonded
+	validator.TotalLiquidShares = liquidShares
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+
+	// Delegate and convert the delegation into a validator bond
+	delegateMsg := &types.MsgDelegate{
+		DelegatorAddress: delegatorAddress.String(),
+		ValidatorAddress: validatorAddress.String(),
+		Amount:           sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), delegationAmount),
+	}
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), delegateMsg)
+	require.NoError(t, err, "no error expected from delegation")
+
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+		DelegatorAddress: delegatorAddress.String(),
+		ValidatorAddress: validatorAddress.String(),
+	})
+	require.NoError(t, err, "no error expected from validator bond transaction")
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, validatorAddress)
+	require.True(t, found)
+	require.True(t, app.StakingKeeper.CheckValidatorBondCapExceeded(ctx, validator), "validator bond cap should be exceeded")
+	_, found = app.StakingKeeper.GetValidatorBondCapViolation(ctx, validatorAddress)
+	require.False(t, found, "violations should only be recorded by slashes")
+
+	// Record the violation, as a slash of the validator bond would
+	app.StakingKeeper.UpdateValidatorBondCapViolation(ctx, validator)
+	_, found = app.StakingKeeper.GetValidatorBondCapViolation(ctx, validatorAddress)
+	require.True(t, found, "violation should be recorded")
+
+	// Top up the validator bond by delegating again
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), delegateMsg)
+	require.NoError(t, err, "no error expected from second delegation")
+
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, delegatorAddress, validatorAddress)
+	require.True(t, found)
+	validator, found = app.StakingKeeper.GetLiquidValidator(ctx, validatorAddress)
+	require.True(t, found)
+	require.Equal(t, delegation.Shares, validator.TotalValidatorBondShares, "validator bond shares should include the top up")
+
+	require.False(t, app.StakingKeeper.CheckValidatorBondCapExceeded(ctx, validator), "validator bond cap should no longer be exceeded")
+	_, found = app.StakingKeeper.GetValidatorBondCapViolation(ctx, validatorAddress)
+	require.False(t, found, "violation should be cleared")
+}
+
+// Tests that every path that adds shares back to a validator bond delegation
+// increases the validator's total validator bond shares
+func TestValidatorBondSharesRestored(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	pubKeys := simapp.CreateTestPubKeys(2)
+	stakeAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	delegatorAddress := simapp.AddTestAddrs(app, ctx, 1, stakeAmount.MulRaw(2))[0]
+
+	// Create two validators, each with a validator bond from the delegator
+	validatorAddresses := []sdk.ValAddress{}
+	for _, pubKey := range pubKeys {
+		validatorAddress := sdk.ValAddress(pubKey.Address())
+		validator := teststaking.NewValidator(t, validatorAddress, pubKey)
+		validator, _ = validator.AddTokensFromDel(stakeAmount)
+		app.StakingKeeper.SetValidator(ctx, validator)
+		app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+		validatorAddresses = append(validatorAddresses, validatorAddress)
+
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), &types.MsgDelegate{
+			DelegatorAddress: delegatorAddress.String(),
+			ValidatorAddress: validatorAddress.String(),
+			Amount:           sdk.NewCoin(bondDenom, stakeAmount),
+		})
+		require.NoError(t, err, "no error expected from delegation")
+
+		_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+			DelegatorAddress: delegatorAddress.String(),
+			ValidatorAddress: validatorAddress.String(),
+		})
+		require.NoError(t, err, "no error expected from validator bond transaction")
+	}
+
+	// Helper function to check that the validator bond shares match the delegation
+	checkValidatorBond := func(validatorAddress sdk.ValAddress, expectedShares sdk.Dec, description string) {
+		delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, delegatorAddress, validatorAddress)
+		require.True(t, found, "%s - delegation", description)
+		require.Equal(t, expectedShares, delegation.Shares, "%s - delegation shares", description)
+
+		validator, found := app.StakingKeeper.GetLiquidValidator(ctx, validatorAddress)
+		require.True(t, found, "%s - validator", description)
+		require.Equal(t, expectedShares, validator.TotalValidatorBondShares, "%s - validator bond shares", description)
+
+		_, broken := keeper.ValidatorBondSharesInvariant(app.StakingKeeper)(ctx)
+		require.False(t, broken, "%s - validator bond shares invariant", description)
+	}
+	fullShares := sdk.NewDecFromInt(stakeAmount)
+	halfAmount := stakeAmount.QuoRaw(2)
+	halfShares := sdk.NewDecFromInt(halfAmount)
+	checkValidatorBond(validatorAddresses[0], fullShares, "after validator bond")
+
+	// Undelegate half of the validator bond, then cancel the unbonding
+	_, err := msgServer.Undelegate(sdk.WrapSDKContext(ctx), &types.MsgUndelegate{
+		DelegatorAddress: delegatorAddress.String(),
+		ValidatorAddress: validatorAddresses[0].String(),
+		Amount:           sdk.NewCoin(bondDenom, halfAmount),
+	})
+	require.NoError(t, err, "no error expected from undelegation")
+	checkValidatorBond(validatorAddresses[0], halfShares, "after undelegation")
+
+	_, err = msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), &types.MsgCancelUnbondingDelegation{
+		DelegatorAddress: delegatorAddress.String(),
+		ValidatorAddress: validatorAddresses[0].String(),
+		Amount:           sdk.NewCoin(bondDenom, halfAmount),
+		CreationHeight:   ctx.BlockHeight(),
+	})
+	require.NoError(t, err, "no error expected from cancelling the unbonding")
+	checkValidatorBond(validatorAddresses[0], fullShares, "after cancelling the unbonding")
+
+	// Redelegate half of the second validator bond into the first
+	_, err = msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), &types.MsgBeginRedelegate{
+		DelegatorAddress:    delegatorAddress.String(),
+		ValidatorSrcAddress: validatorAddresses[1].String(),
+		ValidatorDstAddress: validatorAddresses[0].String(),
+		Amount:              sdk.NewCoin(bondDenom, halfAmount),
+	})
+	require.NoError(t, err, "no error expected from redelegation")
+	checkValidatorBond(validatorAddresses[1], halfShares, "redelegation source")
+	checkValidatorBond(validatorAddresses[0], fullShares.Add(halfShares), "redelegation destination")
+}
+
 func TestEnableDisableTokenizeShares(t *testing.T) {
 	_, app, ctx := createTestInput(t)
 	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
@@ -888,6 +1039,110 @@ func TestEnableDisableTokenizeShares(t *testing.T) {
 	require.NoError(t, err, "no error expected when tokenizing after lock has expired")
 }
 
+func TestTokenizeSharesLockAllowlist(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	// Create a delegator, an allowlisted owner and validator
+	stakeAmount := sdk.NewInt(1000)
+	stakeToken := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), stakeAmount)
+
+	addresses := simapp.AddTestAddrs(app, ctx, 4, stakeAmount)
+	delegatorAddress, allowedOwner, otherOwner := addresses[0], addresses[1], addresses[2]
+
+	pubKeys := simapp.CreateTestPubKeys(1)
+	validatorAddress := sdk.ValAddress(addresses[3])
+	validator := teststaking.NewValidator(t, validatorAddress, pubKeys[0])
+
+	validator.DelegatorShares = sdk.NewDec(1_000_000)
+	validator.Tokens = sdk.NewInt(1_000_000)
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	// Fix block time and set unbonding period to 1 day
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime)
+
+	unbondingPeriod := time.Hour * 24
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = unbondingPeriod
+	app.StakingKeeper.SetParams(ctx, params)
+	activationTime := blockTime.Add(unbondingPeriod)
+
+	// Build test messages
+	tokenizeAmount := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.NewInt(100))
+	delegateMsg := types.MsgDelegate{
+		DelegatorAddress: delegatorAddress.String(),
+		ValidatorAddress: validatorAddress.String(),
+		Amount:           stakeToken,
+	}
+	tokenizeToAllowedMsg := types.MsgTokenizeShares{
+		DelegatorAddress:    delegatorAddress.String(),
+		ValidatorAddress:    validatorAddress.String(),
+		Amount:              tokenizeAmount,
+		TokenizedShareOwner: allowedOwner.String(),
+	}
+	tokenizeToOtherMsg := types.MsgTokenizeShares{
+		DelegatorAddress:    delegatorAddress.String(),
+		ValidatorAddress:    validatorAddress.String(),
+		Amount:              tokenizeAmount,
+		TokenizedShareOwner: otherOwner.String(),
+	}
+	allowMsg := types.MsgAllowTokenizeSharesRecipient{
+		DelegatorAddress: delegatorAddress.String(),
+		RecipientAddress: allowedOwner.String(),
+	}
+	disallowMsg := types.MsgDisallowTokenizeSharesRecipient{
+		DelegatorAddress: delegatorAddress.String(),
+		RecipientAddress: allowedOwner.String(),
+	}
+
+	// Delegate and disable tokenization
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), &delegateMsg)
+	require.NoError(t, err, "no error expected when delegating")
+
+	_, err = msgServer.DisableTokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgDisableTokenizeShares{
+		DelegatorAddress: delegatorAddress.String(),
+	})
+	require.NoError(t, err, "no error expected when disabling tokenization")
+
+	// Removing an address that is not on the allowlist should error
+	_, err = msgServer.DisallowTokenizeSharesRecipient(sdk.WrapSDKContext(ctx), &disallowMsg)
+	require.ErrorIs(t, err, types.ErrTokenizeSharesRecipientNotAllowed)
+
+	// Add the owner to the allowlist, it should only become active after the unbonding period
+	allowResponse, err := msgServer.AllowTokenizeSharesRecipient(sdk.WrapSDKContext(ctx), &allowMsg)
+	require.NoError(t, err, "no error expected when adding to the allowlist")
+	require.Equal(t, activationTime, allowResponse.ActivationTime, "activation time")
+
+	// Adding the same address again should error
+	_, err = msgServer.AllowTokenizeSharesRecipient(sdk.WrapSDKContext(ctx), &allowMsg)
+	require.ErrorIs(t, err, types.ErrTokenizeSharesRecipientAlreadyAllowed)
+
+	// Tokenizing to the allowlisted owner should fail before the entry is active
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &tokenizeToAllowedMsg)
+	require.ErrorIs(t, err, types.ErrTokenizeSharesDisabledForAccount)
+
+	// Once the unbonding period has passed, tokenizing to the allowlisted owner should succeed
+	ctx = ctx.WithBlockTime(activationTime)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &tokenizeToAllowedMsg)
+	require.NoError(t, err, "no error expected when tokenizing to an allowlisted owner")
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err, "tokenize share record should exist")
+	require.Equal(t, allowedOwner.String(), record.Owner, "tokenize share record owner")
+
+	// Tokenizing to an address that's not on the allowlist should still fail
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &tokenizeToOtherMsg)
+	require.ErrorIs(t, err, types.ErrTokenizeSharesDisabledForAccount)
+
+	// Removing the owner from the allowlist should take effect immediately
+	_, err = msgServer.DisallowTokenizeSharesRecipient(sdk.WrapSDKContext(ctx), &disallowMsg)
+	require.NoError(t, err, "no error expected when removing from the allowlist")
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &tokenizeToAllowedMsg)
+	require.ErrorIs(t, err, types.ErrTokenizeSharesDisabledForAccount)
+}
+
 func TestUnbondValidator(t *testing.T) {
 	_, app, ctx := createTestInput(t)
 	addrs := simapp.AddTestAddrs(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
@@ -996,3 +1251,91 @@ func TestICADelegateUndelegate(t *testing.T) {
 	require.True(t, found, "validator should have been found")
 	require.Equal(t, sdk.ZeroDec(), validator.TotalLiquidShares, "validator total liquid shares after undelegation")
 }
+
+func TestProviderLiquidStakingCap(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+
+	// Create a provider (ICA account) and validator
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	providerAddress := createICAAccount(app, ctx, "ica-module-account")
+
+	fundedCoin := sdk.NewCoin(bondDenom, sdk.NewInt(2000))
+	err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(fundedCoin))
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, providerAddress, sdk.NewCoins(fundedCoin))
+	require.NoError(t, err)
+
+	addresses := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(0))
+	pubKeys := simapp.CreateTestPubKeys(1)
+	validatorAddress := sdk.ValAddress(addresses[0])
+	validator := teststaking.NewValidator(t, validatorAddress, pubKeys[0])
+
+	validator.DelegatorShares = sdk.NewDec(1_000_000)
+	validator.Tokens = sdk.NewInt(1_000_000)
+	validator.TotalLiquidShares = sdk.NewDec(0)
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	// Set the total stake to 1000 and the provider cap to 50%
+	clearPoolBalance(t, app, ctx)
+	fundPoolBalance(t, app, ctx, sdk.NewInt(1000))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ProviderLiquidStakingCap = sdk.MustNewDecFromStr("0.5")
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// Delegating 500 is within the cap (500 / 1500 = 33%)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), &types.MsgDelegate{
+		DelegatorAddress: providerAddress.String(),
+		ValidatorAddress: validatorAddress.String(),
+		Amount:           sdk.NewCoin(bondDenom, sdk.NewInt(500)),
+	})
+	require.NoError(t, err, "no error expected when delegating within the provider cap")
+	require.Equal(t, sdk.NewInt(500), app.StakingKeeper.GetProviderLiquidStakedTokens(ctx, providerAddress), "provider tokens after delegation")
+
+	// Delegating another 600 would exceed the cap (1100 / 1600 = 69%)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), &types.MsgDelegate{
+		DelegatorAddress: providerAddress.String(),
+		ValidatorAddress: validatorAddress.String(),
+		Amount:           sdk.NewCoin(bondDenom, sdk.NewInt(600)),
+	})
+	require.ErrorIs(t, err, types.ErrProviderLiquidStakingCapExceeded)
+
+	// The provider's liquid stake should be available from the query
+	res, err := querier.ProviderLiquidStaked(sdk.WrapSDKContext(ctx), &types.QueryProviderLiquidStakedRequest{
+		Address: providerAddress.String(),
+	})
+	require.NoError(t, err, "no error expected when querying the provider")
+	require.Equal(t, "500", res.Tokens, "provider tokens from query")
+	require.Equal(t, params.ProviderLiquidStakingCap.String(), res.ProviderLiquidStakingCap, "provider cap from query")
+
+	// Undelegating should decrement the provider's liquid stake
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), &types.MsgUndelegate{
+		DelegatorAddress: providerAddress.String(),
+		ValidatorAddress: validatorAddress.String(),
+		Amount:           sdk.NewCoin(bondDenom, sdk.NewInt(200)),
+	})
+	require.NoError(t, err, "no error expected when undelegating")
+	require.Equal(t, sdk.NewInt(300), app.StakingKeeper.GetProviderLiquidStakedTokens(ctx, providerAddress), "provider tokens after undelegation")
+
+	// Tokenizing moves the shares out of the provider's delegation
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    providerAddress.String(),
+		ValidatorAddress:    validatorAddress.String(),
+		Amount:              sdk.NewCoin(bondDenom, sdk.NewInt(100)),
+		TokenizedShareOwner: providerAddress.String(),
+	})
+	require.NoError(t, err, "no error expected when tokenizing")
+	require.Equal(t, sdk.NewInt(200), app.StakingKeeper.GetProviderLiquidStakedTokens(ctx, providerAddress), "provider tokens after tokenization")
+
+	// Redeeming moves the shares back into the provider's delegation
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err, "tokenize share record should exist")
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: providerAddress.String(),
+		Amount:           sdk.NewCoin(record.GetShareTokenDenom(), sdk.NewInt(100)),
+	})
+	require.NoError(t, err, "no error expected when redeeming")
+	require.Equal(t, sdk.NewInt(300), app.StakingKeeper.GetProviderLiquidStakedTokens(ctx, providerAddress), "provider tokens after redemption")
+}
@@ -71,6 +71,39 @@ func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// Schedule of points that the global liquid staking cap is interpolated between
+func (k Keeper) GlobalLiquidStakingCapSchedule(ctx sdk.Context) (res []types.GlobalLiquidStakingCapScheduleEntry) {
+	k.paramstore.Get(ctx, types.KeyGlobalLiquidStakingCapSchedule, &res)
+	return
+}
+
+// Global liquid staking cap in effect at the current block time, after applying the cap schedule
+func (k Keeper) EffectiveGlobalLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	return types.GetGlobalLiquidStakingCapAt(
+		k.GlobalLiquidStakingCap(ctx),
+		k.GlobalLiquidStakingCapSchedule(ctx),
+		ctx.BlockTime(),
+	)
+}
+
+// Liquid staking cap for each liquid staking provider
+func (k Keeper) ProviderLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyProviderLiquidStakingCap, &res)
+	return
+}
+
+// Maximum fraction of a validator's validator bond that absorbs a slash before it is socialized
+func (k Keeper) ValidatorBondFirstLossFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorBondFirstLossFraction, &res)
+	return
+}
+
+// Time a validator has to top up its validator bond after exceeding its validator bond cap
+func (k Keeper) ValidatorBondGracePeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyValidatorBondGracePeriod, &res)
+	return
+}
+
 // Get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -83,6 +116,10 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.ValidatorBondFactor(ctx),
 		k.GlobalLiquidStakingCap(ctx),
 		k.ValidatorLiquidStakingCap(ctx),
+		k.GlobalLiquidStakingCapSchedule(ctx),
+		k.ProviderLiquidStakingCap(ctx),
+		k.ValidatorBondFirstLossFraction(ctx),
+		k.ValidatorBondGracePeriod(ctx),
 	)
 }
 
@@ -29,7 +29,7 @@ import (
 //
 //	Infraction was committed at the current height or at a past height,
 //	not at a height in the future
-func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec, _ sdkstaking.InfractionType) {
+func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec, infractionType sdkstaking.InfractionType) {
 	logger := k.Logger(ctx)
 
 	if slashFactor.IsNegative() {
@@ -117,9 +117,14 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	tokensToBurn := sdk.MinInt(remainingSlashAmount, validator.Tokens)
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
 
+	// If bond-first slashing is enabled, the validator bond absorbs the slash
+	// first and only the remainder is shared by all delegators
+	validator, bondTokensSlashed := k.slashValidatorBond(ctx, validator, tokensToBurn)
+	socializedTokensToBurn := tokensToBurn.Sub(bondTokensSlashed)
+
 	// we need to calculate the *effective* slash fraction for distribution
 	if validator.Tokens.IsPositive() {
-		effectiveFraction := sdk.NewDecFromInt(tokensToBurn).QuoRoundUp(sdk.NewDecFromInt(validator.Tokens))
+		effectiveFraction := sdk.NewDecFromInt(socializedTokensToBurn).QuoRoundUp(sdk.NewDecFromInt(validator.Tokens))
 		// possible if power has changed
 		if effectiveFraction.GT(sdk.OneDec()) {
 			effectiveFraction = sdk.OneDec()
@@ -133,13 +138,19 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
-	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
+	preSlashValidator := validator
+	validator = k.RemoveValidatorTokens(ctx, validator, socializedTokensToBurn)
+
+	// Proportionally deduct any liquid tokens from the global total and the provider totals
+	// Validator bond delegations are never liquid, so the portion absorbed by the bond is excluded
+	if validator.DelegatorShares.IsPositive() {
+		validatorLiquidRatio := validator.TotalLiquidShares.Quo(validator.DelegatorShares)
+		slashedLiquidTokens := validatorLiquidRatio.Mul(sdk.NewDecFromInt(slashAmount.Sub(bondTokensSlashed))).TruncateInt()
+		if err := k.DecreaseTotalLiquidStakedTokens(ctx, slashedLiquidTokens); err != nil {
+			panic(err)
+		}
 
-	// Proportionally deduct any liquid tokens from the global total
-	validatorLiquidRatio := validator.TotalLiquidShares.Quo(validator.DelegatorShares)
-	slashedLiquidTokens := validatorLiquidRatio.Mul(sdk.NewDecFromInt(slashAmount)).TruncateInt()
-	if err := k.DecreaseTotalLiquidStakedTokens(ctx, slashedLiquidTokens); err != nil {
-		panic(err)
+		k.decreaseProviderLiquidStakedTokensOnSlash(ctx, preSlashValidator, validator)
 	}
 
 	switch validator.GetStatus() {
@@ -158,11 +169,106 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	logger.Info(
 		"validator slashed by slash factor",
 		"validator", validator.GetOperator().String(),
+		"infraction", infractionType.String(),
 		"slash_factor", slashFactor.String(),
 		"burned", tokensToBurn,
 	)
 }
 
+// slashValidatorBond takes up to the validator bond first loss fraction of a
+// validator's validator bond to cover a slash, removing shares from each
+// validator bond delegation in proportion to its shares. The shares are
+// removed at the validator's current exchange rate, so other delegators are
+// unaffected. It returns the updated validator and the tokens removed, which
+// the caller is responsible for burning.
+func (k Keeper) slashValidatorBond(ctx sdk.Context, validator types.Validator, tokensToBurn sdk.Int) (types.Validator, sdk.Int) {
+	firstLossFraction := k.ValidatorBondFirstLossFraction(ctx)
+	if !firstLossFraction.IsPositive() || !validator.TotalValidatorBondShares.IsPositive() || !tokensToBurn.IsPositive() {
+		return validator, sdk.ZeroInt()
+	}
+
+	// Determine the validator bond shares that cover the slash, capped at the first loss fraction
+	sharesToBurn, err := validator.SharesFromTokens(tokensToBurn)
+	if err != nil {
+		return validator, sdk.ZeroInt()
+	}
+	maxBondShares := validator.TotalValidatorBondShares.Mul(firstLossFraction)
+	bondSharesToBurn := sdk.MinDec(sharesToBurn, maxBondShares)
+
+	valAddr := validator.GetOperator()
+	removedShares := sdk.ZeroDec()
+	bondHolders := []sdk.AccAddress{}
+	operatorSlashed := false
+	for _, delegation := range k.GetValidatorBondDelegations(ctx, valAddr) {
+		shares := delegation.Shares.Mul(bondSharesToBurn).QuoTruncate(validator.TotalValidatorBondShares)
+		shares = sdk.MinDec(shares, delegation.Shares)
+		if shares.IsZero() {
+			continue
+		}
+
+		// Rewards are withdrawn and the delegation's distribution stake is reset, as with an undelegation
+		delegatorAddress := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
+		if err := k.BeforeDelegationSharesModified(ctx, delegatorAddress, valAddr); err != nil {
+			panic(err)
+		}
+
+		delegation.Shares = delegation.Shares.Sub(shares)
+		if delegation.Shares.IsZero() {
+			err = k.RemoveDelegation(ctx, delegation)
+		} else {
+			k.SetDelegation(ctx, delegation)
+			err = k.AfterDelegationModified(ctx, delegatorAddress, valAddr)
+		}
+		if err != nil {
+			panic(err)
+		}
+
+		removedShares = removedShares.Add(shares)
+		bondHolders = append(bondHolders, delegatorAddress)
+		operatorSlashed = operatorSlashed || delegatorAddress.Equals(valAddr)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashValidatorBond,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyAmount, validator.TokensFromShares(shares).TruncateInt().String()),
+			),
+		)
+	}
+
+	if removedShares.IsZero() {
+		return validator, sdk.ZeroInt()
+	}
+
+	validator, removedTokens := k.RemoveValidatorTokensAndShares(ctx, validator, removedShares)
+	validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Sub(removedShares)
+	k.SetValidator(ctx, validator)
+
+	// Jail the validator if the slash removed its operator's self-delegation or left it below
+	// the minimum self-delegation, since the validator could not be unjailed without one
+	if operatorSlashed && !validator.Jailed {
+		selfDelegation, found := k.GetLiquidDelegation(ctx, sdk.AccAddress(valAddr), valAddr)
+		if !found || validator.TokensFromShares(selfDelegation.Shares).TruncateInt().LT(validator.GetMinSelfDelegation()) {
+			k.jailValidator(ctx, validator)
+			validator.Jailed = true
+		}
+	}
+
+	// A socialized slash leaves the ratio of liquid to validator bond shares unchanged, so the
+	// validator bond cap can only be exceeded once the bond has absorbed part of the slash.
+	// In that case, block further liquid delegations and start the grace period to top up the bond
+	k.UpdateValidatorBondCapViolation(ctx, validator)
+
+	for _, delegatorAddress := range bondHolders {
+		if err := k.AfterValidatorBondChanged(ctx, delegatorAddress, valAddr); err != nil {
+			panic(err)
+		}
+	}
+
+	return validator, removedTokens
+}
+
 // jail a validator
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
@@ -317,3 +423,32 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, _ types.Validator, redelegati
 
 	return totalSlashAmount
 }
+
+// decreaseProviderLiquidStakedTokensOnSlash deducts the tokens each liquid staking provider
+// lost in the slash of its delegation to the validator from the provider's total
+func (k Keeper) decreaseProviderLiquidStakedTokensOnSlash(ctx sdk.Context, preSlashValidator, validator types.Validator) {
+	valAddr := validator.GetOperator()
+
+	type providerSlash struct {
+		provider sdk.AccAddress
+		tokens   sdk.Int
+	}
+	slashes := []providerSlash{}
+	k.IterateProviderLiquidStakedTokens(ctx, func(provider sdk.AccAddress, _ sdk.Int) (stop bool) {
+		delegation, found := k.GetLiquidDelegation(ctx, provider, valAddr)
+		if !found || delegation.ValidatorBond {
+			return false
+		}
+
+		preSlashTokens := preSlashValidator.TokensFromShares(delegation.Shares)
+		slashedTokens := preSlashTokens.Sub(validator.TokensFromShares(delegation.Shares)).TruncateInt()
+		if slashedTokens.IsPositive() {
+			slashes = append(slashes, providerSlash{provider, slashedTokens})
+		}
+		return false
+	})
+
+	for _, slash := range slashes {
+		k.DecreaseProviderLiquidStakedTokens(ctx, slash.provider, slash.tokens)
+	}
+}
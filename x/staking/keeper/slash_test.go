@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -257,6 +258,190 @@ func TestSlashValidatorAtCurrentHeight(t *testing.T) {
 	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 5).String(), diffTokens.String())
 }
 
+// tests that the validator bond absorbs a slash first when bond-first slashing is enabled
+func TestSlashValidatorBondFirst(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(25, 2)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFirstLossFraction = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// set a validator bond delegation worth 2 of the validator's 10 tokens
+	bondShares := sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 2))
+	bondDelegation := types.NewDelegation(addrDels[0], addrVals[0], bondShares, true)
+	app.StakingKeeper.SetDelegation(ctx, bondDelegation)
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
+	require.True(t, found)
+	validator.TotalValidatorBondShares = bondShares
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	require.Equal(t, []types.Delegation{bondDelegation}, app.StakingKeeper.GetValidatorBondDelegations(ctx, addrVals[0]))
+
+	hooks := &mockTokenizeShareHooks{}
+	app.StakingKeeper.SetHooks(hooks)
+
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	oldBondedPoolBalances := app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
+
+	// slash 2.5 tokens, of which half the validator bond (1 token) is absorbed by the bond
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction, 0)
+
+	// the bond holder is notified of the validator bond change
+	require.Equal(t, []string{fmt.Sprintf("AfterValidatorBondChanged %s %s", addrDels[0], addrVals[0])}, hooks.calls)
+
+	bondDelegation, found = app.StakingKeeper.GetLiquidDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 1)), bondDelegation.Shares,
+		"bond delegation shares should be halved")
+
+	validator, found = app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, bondDelegation.Shares, validator.TotalValidatorBondShares, "total validator bond shares")
+	require.Equal(t, sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 9)), validator.DelegatorShares,
+		"the shares removed from the bond should be removed from the validator")
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 10).Sub(app.StakingKeeper.TokensFromConsensusPower(ctx, 10).QuoRaw(4)),
+		validator.Tokens, "the full slash amount should be removed from the validator")
+
+	// the remaining 1.5 tokens are shared by all 9 remaining shares
+	require.Equal(t, sdk.NewDecWithPrec(75, 1).Quo(sdk.NewDec(9)).MulInt(app.StakingKeeper.PowerReduction(ctx)).TruncateInt(),
+		validator.TokensFromShares(sdk.NewDecFromInt(app.StakingKeeper.PowerReduction(ctx))).TruncateInt(),
+		"the socialized slash should be shared by all remaining shares")
+
+	// the full slash amount is burned
+	newBondedPoolBalances := app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
+	diffTokens := oldBondedPoolBalances.Sub(newBondedPoolBalances).AmountOf(app.StakingKeeper.BondDenom(ctx))
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 10).QuoRaw(4).String(), diffTokens.String())
+
+	// with bond-first slashing disabled, the validator bond is slashed like any other delegation
+	params.ValidatorBondFirstLossFraction = sdk.ZeroDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction, 0)
+
+	bondDelegation, found = app.StakingKeeper.GetLiquidDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 1)), bondDelegation.Shares,
+		"bond delegation shares should be unchanged")
+
+	validator, found = app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, bondDelegation.Shares, validator.TotalValidatorBondShares, "total validator bond shares should be unchanged")
+}
+
+// tests that a validator is jailed once a slash of its validator bond removes its operator's self-delegation
+func TestSlashValidatorBondJailsOperator(t *testing.T) {
+	app, ctx, _, addrVals := bootstrapSlashTest(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(25, 2)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFirstLossFraction = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the operator's self-delegation is a validator bond worth 1 of the validator's 10 tokens
+	operator := sdk.AccAddress(addrVals[0])
+	bondShares := sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(operator, addrVals[0], bondShares, true))
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
+	require.True(t, found)
+	validator.TotalValidatorBondShares = bondShares
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	// slash 2.5 tokens, which the validator bond absorbs entirely
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction, 0)
+
+	_, found = app.StakingKeeper.GetLiquidDelegation(ctx, operator, addrVals[0])
+	require.False(t, found, "the self-delegation should be removed")
+
+	validator, found = app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.True(t, validator.IsJailed(), "the validator should be jailed")
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 10).Sub(app.StakingKeeper.TokensFromConsensusPower(ctx, 10).QuoRaw(4)),
+		validator.Tokens, "the full slash amount should be removed from the validator")
+}
+
+// tests that a slash leaving the liquid shares above the validator bond cap records a violation
+func TestSlashValidatorBondCapViolation(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(1, 1)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(2)
+	params.ValidatorBondFirstLossFraction = sdk.ZeroDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// set a validator bond of 2 tokens, with liquid shares at the cap of 4 tokens
+	bondShares := sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 2))
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[0], addrVals[0], bondShares, true))
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
+	require.True(t, found)
+	validator.TotalValidatorBondShares = bondShares
+	validator.TotalLiquidShares = bondShares.MulInt64(2)
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, validator.TotalLiquidShares.TruncateInt())
+
+	// a slash shared by all delegators leaves the validator at its cap
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction, 0)
+
+	_, found = app.StakingKeeper.GetValidatorBondCapViolation(ctx, addrVals[0])
+	require.False(t, found, "no violation expected after a socialized slash")
+
+	// slash 1 token, which is absorbed by the validator bond
+	params.ValidatorBondFirstLossFraction = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction, 0)
+
+	gracePeriodEnd, found := app.StakingKeeper.GetValidatorBondCapViolation(ctx, addrVals[0])
+	require.True(t, found, "violation expected after the validator bond is slashed")
+	require.Equal(t, ctx.BlockTime().Add(params.ValidatorBondGracePeriod), gracePeriodEnd)
+
+	violationEvent := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeValidatorBondCapExceeded {
+			violationEvent = true
+		}
+	}
+	require.True(t, violationEvent, "validator bond cap exceeded event expected")
+}
+
+// tests that a slash deducts the slashed liquid tokens from the provider totals
+func TestSlashProviderLiquidStakedTokens(t *testing.T) {
+	app, ctx, _, addrVals := bootstrapSlashTest(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(1, 1)
+
+	// a provider delegates 4 of the validator's 10 tokens
+	provider := createICAAccount(app, ctx, "provider")
+	providerTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	providerShares := sdk.NewDecFromInt(providerTokens)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(provider, addrVals[0], providerShares, false))
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
+	require.True(t, found)
+	validator.TotalLiquidShares = providerShares
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, providerTokens)
+	app.StakingKeeper.SetProviderLiquidStakedTokens(ctx, provider, providerTokens)
+
+	// a provider without a delegation to the slashed validator is unaffected
+	otherProvider := createICAAccount(app, ctx, "other-provider")
+	app.StakingKeeper.SetProviderLiquidStakedTokens(ctx, otherProvider, providerTokens)
+
+	// slash 10% of the validator's tokens
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction, 0)
+
+	expectedTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4).MulRaw(9).QuoRaw(10)
+	require.Equal(t, expectedTokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx), "total liquid staked tokens")
+	require.Equal(t, expectedTokens, app.StakingKeeper.GetProviderLiquidStakedTokens(ctx, provider), "provider")
+	require.Equal(t, providerTokens, app.StakingKeeper.GetProviderLiquidStakedTokens(ctx, otherProvider), "other provider")
+}
+
 // tests Slash at a previous height with an unbonding delegation
 func TestSlashWithUnbondingDelegation(t *testing.T) {
 	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
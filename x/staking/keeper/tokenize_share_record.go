@@ -5,7 +5,10 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
@@ -56,6 +59,33 @@ func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAdd
 	return
 }
 
+// IterateTokenizeShareRecordsByOwner iterates over the tokenize share records of an owner in id
+// order, starting from startRecordID, until the callback returns true
+func (k Keeper) IterateTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress, startRecordID uint64,
+	cb func(tokenizeShareRecord types.TokenizeShareRecord) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := store.Iterator(
+		types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, startRecordID),
+		sdk.PrefixEndBytes(types.GetTokenizeShareRecordIdsByOwnerPrefix(owner)),
+	)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var id gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(it.Value(), &id)
+
+		tokenizeShareRecord, err := k.GetTokenizeShareRecord(ctx, id.Value)
+		if err != nil {
+			continue
+		}
+		if cb(tokenizeShareRecord) {
+			break
+		}
+	}
+}
+
 func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
@@ -84,6 +114,31 @@ func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (tokenizeShareRecord
 	return
 }
 
+// GetTokenizeShareRecordsByValidator returns a page of the tokenize share records of a validator
+func (k Keeper) GetTokenizeShareRecordsByValidator(ctx sdk.Context, valAddr sdk.ValAddress, pageReq *query.PageRequest) (
+	tokenizeShareRecords []types.TokenizeShareRecord, pageRes *query.PageResponse, err error,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenizeShareRecordPrefix)
+	validator := valAddr.String()
+
+	pageRes, err = query.FilteredPaginate(store, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var tokenizeShareRecord types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(value, &tokenizeShareRecord); err != nil {
+			return false, err
+		}
+
+		if tokenizeShareRecord.Validator != validator {
+			return false, nil
+		}
+
+		if accumulate {
+			tokenizeShareRecords = append(tokenizeShareRecords, tokenizeShareRecord)
+		}
+		return true, nil
+	})
+	return tokenizeShareRecords, pageRes, err
+}
+
 func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, tokenizeShareRecord types.TokenizeShareRecord) error {
 	if k.hasTokenizeShareRecord(ctx, tokenizeShareRecord.Id) {
 		return errorsmod.Wrapf(types.ErrTokenizeShareRecordAlreadyExists, "TokenizeShareRecord already exists: %d", tokenizeShareRecord.Id)
@@ -119,6 +174,61 @@ func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, recordID uint64) erro
 	return nil
 }
 
+// CompoundTokenizeShareRecordRewards delegates the given amount of the bond denom, held by
+// the record's module account, back to the record's validator. The new shares back the
+// existing share tokens, raising the tokens each share token redeems for.
+// The shares are liquid, so the global and validator liquid staking caps apply.
+func (k Keeper) CompoundTokenizeShareRecordRewards(ctx sdk.Context, record types.TokenizeShareRecord, amount sdk.Int) (sdk.Dec, error) {
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), sdkstaking.ErrNoValidatorFound
+	}
+
+	shares, err := validator.SharesFromTokens(amount)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, amount, false); err != nil {
+		return sdk.ZeroDec(), err
+	}
+	if err := k.SafelyIncreaseValidatorTotalLiquidShares(ctx, &validator, shares); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return k.Delegate(ctx, record.GetModuleAddress(), amount, sdkstaking.Unbonded, validator, true)
+}
+
+// RemoveUndelegatedTokenizeShareRecord removes a record whose delegation no longer exists,
+// e.g. because its validator was removed. The remaining share tokens
+// cannot be redeemed anymore.
+func (k Keeper) RemoveUndelegatedTokenizeShareRecord(ctx sdk.Context, recordID uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr); found {
+		return errorsmod.Wrapf(types.ErrTokenizeShareRecordStillDelegated, "record %d", recordID)
+	}
+
+	if err := k.BeforeTokenizeShareRecordRemoved(ctx, record.Id); err != nil {
+		return err
+	}
+
+	return k.DeleteTokenizeShareRecord(ctx, record.Id)
+}
+
 func (k Keeper) hasTokenizeShareRecord(ctx sdk.Context, id uint64) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(types.GetTokenizeShareRecordByIndexKey(id))
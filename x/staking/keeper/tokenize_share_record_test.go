@@ -58,4 +58,19 @@ func (suite *KeeperTestSuite) TestGetTokenizeShareRecord() {
 
 	tokenizeShareRecords = app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner2)
 	suite.Equal(len(tokenizeShareRecords), 1)
+
+	// iteration over an owner's records starts from the given id and stops when requested
+	var iterated []types.TokenizeShareRecord
+	app.StakingKeeper.IterateTokenizeShareRecordsByOwner(ctx, owner1, 1, func(record types.TokenizeShareRecord) bool {
+		iterated = append(iterated, record)
+		return false
+	})
+	suite.Equal([]types.TokenizeShareRecord{tokenizeShareRecord3}, iterated)
+
+	iterated = nil
+	app.StakingKeeper.IterateTokenizeShareRecordsByOwner(ctx, owner1, 0, func(record types.TokenizeShareRecord) bool {
+		iterated = append(iterated, record)
+		return true
+	})
+	suite.Equal([]types.TokenizeShareRecord{tokenizeShareRecord1}, iterated)
 }
@@ -194,6 +194,7 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	store.Delete(types.GetValidatorBondCapViolationKey(address))
 
 	// call hooks
 	err = k.AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator())
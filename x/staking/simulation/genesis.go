@@ -77,6 +77,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		validatorBondFactor,
 		globalLiquidStakingCap,
 		validatorLiquidStakingCap,
+		types.DefaultGlobalLiquidStakingCapSchedule,
+		types.DefaultProviderLiquidStakingCap,
+		types.DefaultValidatorBondFirstLossFraction,
+		types.DefaultValidatorBondGracePeriod,
 	)
 
 	// validators & delegations
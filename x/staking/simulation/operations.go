@@ -665,6 +665,7 @@ func SimulateMsgTokenizeShares(ak types.AccountKeeper, bk types.BankKeeper, k ke
 			ValidatorAddress:    srcAddr.String(),
 			Amount:              sdk.NewCoin(k.BondDenom(ctx), tokenizeShareAmt),
 			TokenizedShareOwner: delAddr.String(),
+			Compound:            r.Intn(2) == 0,
 		}
 
 		account := ak.GetAccount(ctx, simAccount.Address)
@@ -22,6 +22,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgTransferTokenizeShareRecord{}, "cosmos-sdk/MsgTransferTokenizeShareRecord", nil)
 	cdc.RegisterConcrete(&MsgDisableTokenizeShares{}, "cosmos-sdk/MsgDisableTokenizeShares", nil)
 	cdc.RegisterConcrete(&MsgEnableTokenizeShares{}, "cosmos-sdk/MsgEnableTokenizeShares", nil)
+	cdc.RegisterConcrete(&MsgAllowTokenizeSharesRecipient{}, "cosmos-sdk/MsgAllowTokenizeSharesRecipient", nil)
+	cdc.RegisterConcrete(&MsgDisallowTokenizeSharesRecipient{}, "cosmos-sdk/MsgDisallowTokenizeSharesRecipient", nil)
 
 	// cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	// cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList", nil)
@@ -43,6 +45,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgTransferTokenizeShareRecord{},
 		&MsgDisableTokenizeShares{},
 		&MsgEnableTokenizeShares{},
+		&MsgAllowTokenizeSharesRecipient{},
+		&MsgDisallowTokenizeSharesRecipient{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -68,4 +68,9 @@ var (
 	ErrTokenizeSharesAlreadyDisabledForAccount  = errorsmod.Register(ModuleName, 58, "tokenize shares is already disabled for this account")
 	ErrValidatorLiquidSharesUnderflow           = errorsmod.Register(ModuleName, 59, "validator liquid shares underflow")
 	ErrTotalLiquidStakedUnderflow               = errorsmod.Register(ModuleName, 60, "total liquid staked underflow")
+	ErrTokenizeSharesRecipientAlreadyAllowed    = errorsmod.Register(ModuleName, 61, "address is already on the tokenize share lock allowlist")
+	ErrTokenizeSharesRecipientNotAllowed        = errorsmod.Register(ModuleName, 62, "address is not on the tokenize share lock allowlist")
+	ErrProviderLiquidStakingCapExceeded         = errorsmod.Register(ModuleName, 63, "delegation from liquid staking provider exceeds the provider cap")
+	ErrValidatorBondCapExceeded                 = errorsmod.Register(ModuleName, 64, "validator liquid shares already exceed the validator bond cap")
+	ErrTokenizeShareRecordStillDelegated        = errorsmod.Register(ModuleName, 65, "tokenize share record is still delegated")
 )
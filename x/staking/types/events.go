@@ -13,6 +13,9 @@ const (
 	EventTypeRedeemShares                = "redeem_shares"
 	EventTypeTransferTokenizeShareRecord = "transfer_tokenize_share_record"
 	EventTypeValidatorBondDelegation     = "validator_bond_delegation"
+	EventTypeSlashValidatorBond          = "slash_validator_bond"
+	EventTypeValidatorBondCapExceeded    = "validator_bond_cap_exceeded"
+	EventTypeValidatorBondCapRestored    = "validator_bond_cap_restored"
 
 	AttributeKeyValidator      = "validator"
 	AttributeKeyCommissionRate = "commission_rate"
@@ -24,5 +27,8 @@ const (
 	AttributeKeyShareOwner     = "share_owner"
 	AttributeKeyShareRecordID  = "share_record_id"
 	AttributeKeyAmount         = "amount"
+	AttributeKeyLiquidShares   = "total_liquid_shares"
+	AttributeKeyBondShares     = "total_validator_bond_shares"
+	AttributeKeyGracePeriodEnd = "grace_period_end"
 	AttributeValueCategory     = ModuleName
 )
@@ -94,10 +94,15 @@ type DelegationSet interface {
 
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
-	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error                           // Must be called when a validator is created
-	BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error                         // Must be called when a validator's state changes
-	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error // Must be called when a validator is deleted
-	BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordID uint64) error                       // Must be called when tokenize share record is deleted
+	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error                                                        // Must be called when a validator is created
+	BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error                                                      // Must be called when a validator's state changes
+	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error                              // Must be called when a validator is deleted
+	BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordID uint64) error                                                    // Must be called when tokenize share record is deleted
+	AfterTokenizeShareRecordCreated(ctx sdk.Context, recordID uint64) error                                                     // Must be called when a tokenize share record is created
+	AfterTokenizeShareRecordTransferred(ctx sdk.Context, recordID uint64, prevOwner, newOwner sdk.AccAddress) error             // Must be called when a tokenize share record changes owner
+	AfterSharesRedeemed(ctx sdk.Context, recordID uint64, delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens sdk.Int) error // Must be called when share tokens are redeemed for a delegation
+	AfterValidatorBondChanged(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error                            // Must be called when a validator's total validator bond shares change
+	AfterTokenizeShareLockChanged(ctx sdk.Context, address sdk.AccAddress, status TokenizeShareLockStatus) error                // Must be called when an account's tokenize share lock status changes
 
 	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error         // Must be called when a validator is bonded
 	AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error // Must be called when a validator begins unbonding
@@ -50,6 +50,51 @@ func (h MultiStakingHooks) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, rec
 	return nil
 }
 
+func (h MultiStakingHooks) AfterTokenizeShareRecordCreated(ctx sdk.Context, recordID uint64) error {
+	for i := range h {
+		if err := h[i].AfterTokenizeShareRecordCreated(ctx, recordID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterTokenizeShareRecordTransferred(ctx sdk.Context, recordID uint64, prevOwner, newOwner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterTokenizeShareRecordTransferred(ctx, recordID, prevOwner, newOwner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterSharesRedeemed(ctx sdk.Context, recordID uint64, delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens sdk.Int) error {
+	for i := range h {
+		if err := h[i].AfterSharesRedeemed(ctx, recordID, delAddr, valAddr, tokens); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterValidatorBondChanged(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	for i := range h {
+		if err := h[i].AfterValidatorBondChanged(ctx, delAddr, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterTokenizeShareLockChanged(ctx sdk.Context, address sdk.AccAddress, status TokenizeShareLockStatus) error {
+	for i := range h {
+		if err := h[i].AfterTokenizeShareLockChanged(ctx, address, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h MultiStakingHooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	for i := range h {
 		if err := h[i].AfterValidatorBonded(ctx, consAddr, valAddr); err != nil {
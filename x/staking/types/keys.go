@@ -59,6 +59,10 @@ var (
 	TotalLiquidStakedTokensKey         = []byte{0x65} // key for total liquid staked tokens
 	TokenizeSharesLockKey              = []byte{0x66} // key for locking tokenize shares
 	TokenizeSharesUnlockQueueKey       = []byte{0x67} // key for the queue that unlocks tokenize shares
+	TokenizeSharesAllowlistKey         = []byte{0x68} // key for the tokenize share lock allowlist
+	ProviderLiquidStakedTokensKey      = []byte{0x69} // key for the liquid staked tokens of each liquid staking provider
+	ValidatorBondCapViolationKey       = []byte{0x6A} // key for the grace period end of validators exceeding their validator bond cap
+	ValidatorBondDelegationIndexKey    = []byte{0x6B} // prefix for each key for a validator bond delegation, by validator operator
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -383,9 +387,46 @@ func GetTokenizeSharesLockKey(owner sdk.AccAddress) []byte {
 	return append(TokenizeSharesLockKey, address.MustLengthPrefix(owner)...)
 }
 
+// GetTokenizeSharesAllowlistPrefix returns the prefix key used for getting the
+// tokenize share lock allowlist of a specified account
+func GetTokenizeSharesAllowlistPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeSharesAllowlistKey, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeSharesAllowlistKey returns the key for storing a tokenize share lock
+// allowlist entry of a specified account
+func GetTokenizeSharesAllowlistKey(owner, recipient sdk.AccAddress) []byte {
+	return append(GetTokenizeSharesAllowlistPrefix(owner), address.MustLengthPrefix(recipient)...)
+}
+
 // GetTokenizeShareAuthorizationTimeKey returns the prefix key used for getting a set of pending
 // tokenize share unlocks that complete at the given time
 func GetTokenizeShareAuthorizationTimeKey(timestamp time.Time) []byte {
 	bz := sdk.FormatTimeBytes(timestamp)
 	return append(TokenizeSharesUnlockQueueKey, bz...)
 }
+
+// GetProviderLiquidStakedTokensKey returns the key for storing the liquid staked tokens
+// of a specified liquid staking provider
+func GetProviderLiquidStakedTokensKey(provider sdk.AccAddress) []byte {
+	return append(ProviderLiquidStakedTokensKey, address.MustLengthPrefix(provider)...)
+}
+
+// GetValidatorBondCapViolationKey returns the key for storing the grace period
+// end of a validator whose liquid shares exceed its validator bond cap
+func GetValidatorBondCapViolationKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorBondCapViolationKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorBondDelegationIndexKey creates the index key for a validator bond
+// delegation, by validator operator
+// VALUE: none (key rearrangement used)
+func GetValidatorBondDelegationIndexKey(valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	return append(GetValidatorBondDelegationsIndexKey(valAddr), address.MustLengthPrefix(delAddr)...)
+}
+
+// GetValidatorBondDelegationsIndexKey creates the prefix for the validator bond
+// delegations of a validator
+func GetValidatorBondDelegationsIndexKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorBondDelegationIndexKey, address.MustLengthPrefix(valAddr)...)
+}
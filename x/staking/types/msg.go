@@ -15,19 +15,21 @@ import (
 //
 //nolint:gosec // these are not hard coded credentials
 const (
-	TypeMsgUndelegate                  = "begin_unbonding"
-	TypeMsgUnbondValidator             = "unbond_validator"
-	TypeMsgEditValidator               = "edit_validator"
-	TypeMsgCreateValidator             = "create_validator"
-	TypeMsgDelegate                    = "delegate"
-	TypeMsgBeginRedelegate             = "begin_redelegate"
-	TypeMsgCancelUnbondingDelegation   = "cancel_unbond"
-	TypeMsgTokenizeShares              = "tokenize_shares"
-	TypeMsgRedeemTokensforShares       = "redeem_tokens_for_shares"
-	TypeMsgTransferTokenizeShareRecord = "transfer_tokenize_share_record"
-	TypeMsgDisableTokenizeShares       = "disable_tokenize_shares"
-	TypeMsgEnableTokenizeShares        = "enable_tokenize_shares"
-	TypeMsgValidatorBond               = "validator_bond"
+	TypeMsgUndelegate                      = "begin_unbonding"
+	TypeMsgUnbondValidator                 = "unbond_validator"
+	TypeMsgEditValidator                   = "edit_validator"
+	TypeMsgCreateValidator                 = "create_validator"
+	TypeMsgDelegate                        = "delegate"
+	TypeMsgBeginRedelegate                 = "begin_redelegate"
+	TypeMsgCancelUnbondingDelegation       = "cancel_unbond"
+	TypeMsgTokenizeShares                  = "tokenize_shares"
+	TypeMsgRedeemTokensforShares           = "redeem_tokens_for_shares"
+	TypeMsgTransferTokenizeShareRecord     = "transfer_tokenize_share_record"
+	TypeMsgDisableTokenizeShares           = "disable_tokenize_shares"
+	TypeMsgEnableTokenizeShares            = "enable_tokenize_shares"
+	TypeMsgAllowTokenizeSharesRecipient    = "allow_tokenize_shares_recipient"
+	TypeMsgDisallowTokenizeSharesRecipient = "disallow_tokenize_shares_recipient"
+	TypeMsgValidatorBond                   = "validator_bond"
 )
 
 var (
@@ -44,6 +46,8 @@ var (
 	_ sdk.Msg                            = &MsgTransferTokenizeShareRecord{}
 	_ sdk.Msg                            = &MsgDisableTokenizeShares{}
 	_ sdk.Msg                            = &MsgEnableTokenizeShares{}
+	_ sdk.Msg                            = &MsgAllowTokenizeSharesRecipient{}
+	_ sdk.Msg                            = &MsgDisallowTokenizeSharesRecipient{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgValidatorBond{}
 )
@@ -538,6 +542,68 @@ func (msg MsgEnableTokenizeShares) ValidateBasic() error {
 	return nil
 }
 
+// Type implements the sdk.Msg interface.
+func (msg MsgAllowTokenizeSharesRecipient) Type() string { return TypeMsgAllowTokenizeSharesRecipient }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAllowTokenizeSharesRecipient) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgAllowTokenizeSharesRecipient) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAllowTokenizeSharesRecipient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.RecipientAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return nil
+}
+
+// Type implements the sdk.Msg interface.
+func (msg MsgDisallowTokenizeSharesRecipient) Type() string {
+	return TypeMsgDisallowTokenizeSharesRecipient
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgDisallowTokenizeSharesRecipient) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgDisallowTokenizeSharesRecipient) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDisallowTokenizeSharesRecipient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.RecipientAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return nil
+}
+
 // NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation instance.
 //
 //nolint:interfacer
@@ -30,6 +30,10 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultValidatorBondGracePeriod reflects one week in seconds as the default
+	// time a validator has to top up its validator bond after exceeding its cap
+	DefaultValidatorBondGracePeriod time.Duration = time.Hour * 24 * 7
 )
 
 var (
@@ -44,6 +48,12 @@ var (
 	DefaultGlobalLiquidStakingCap = sdk.OneDec()
 	// DefaultValidatorLiquidStakingCap is set to 100%
 	DefaultValidatorLiquidStakingCap = sdk.OneDec()
+	// DefaultGlobalLiquidStakingCapSchedule is empty (the static global cap is used)
+	DefaultGlobalLiquidStakingCapSchedule []GlobalLiquidStakingCapScheduleEntry
+	// DefaultProviderLiquidStakingCap is set to 100%
+	DefaultProviderLiquidStakingCap = sdk.OneDec()
+	// DefaultValidatorBondFirstLossFraction is set to 0% (bond-first slashing disabled)
+	DefaultValidatorBondFirstLossFraction = sdk.ZeroDec()
 )
 
 var (
@@ -56,6 +66,11 @@ var (
 	KeyValidatorBondFactor       = []byte("ValidatorBondFactor")
 	KeyGlobalLiquidStakingCap    = []byte("GlobalLiquidStakingCap")
 	KeyValidatorLiquidStakingCap = []byte("ValidatorLiquidStakingCap")
+
+	KeyGlobalLiquidStakingCapSchedule = []byte("GlobalLiquidStakingCapSchedule")
+	KeyProviderLiquidStakingCap       = []byte("ProviderLiquidStakingCap")
+	KeyValidatorBondFirstLossFraction = []byte("ValidatorBondFirstLossFraction")
+	KeyValidatorBondGracePeriod       = []byte("ValidatorBondGracePeriod")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -76,6 +91,10 @@ func NewParams(
 	validatorBondFactor sdk.Dec,
 	globalLiquidStakingCap sdk.Dec,
 	validatorLiquidStakingCap sdk.Dec,
+	globalLiquidStakingCapSchedule []GlobalLiquidStakingCapScheduleEntry,
+	providerLiquidStakingCap sdk.Dec,
+	validatorBondFirstLossFraction sdk.Dec,
+	validatorBondGracePeriod time.Duration,
 ) Params {
 	return Params{
 		UnbondingTime:             unbondingTime,
@@ -87,6 +106,11 @@ func NewParams(
 		ValidatorBondFactor:       validatorBondFactor,
 		GlobalLiquidStakingCap:    globalLiquidStakingCap,
 		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+
+		GlobalLiquidStakingCapSchedule: globalLiquidStakingCapSchedule,
+		ProviderLiquidStakingCap:       providerLiquidStakingCap,
+		ValidatorBondFirstLossFraction: validatorBondFirstLossFraction,
+		ValidatorBondGracePeriod:       validatorBondGracePeriod,
 	}
 }
 
@@ -102,6 +126,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyValidatorBondFactor, &p.ValidatorBondFactor, validateValidatorBondFactor),
 		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateGlobalLiquidStakingCap),
 		paramtypes.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateValidatorLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCapSchedule, &p.GlobalLiquidStakingCapSchedule, validateGlobalLiquidStakingCapSchedule),
+		paramtypes.NewParamSetPair(KeyProviderLiquidStakingCap, &p.ProviderLiquidStakingCap, validateProviderLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyValidatorBondFirstLossFraction, &p.ValidatorBondFirstLossFraction, validateValidatorBondFirstLossFraction),
+		paramtypes.NewParamSetPair(KeyValidatorBondGracePeriod, &p.ValidatorBondGracePeriod, validateValidatorBondGracePeriod),
 	}
 }
 
@@ -117,6 +145,10 @@ func DefaultParams() Params {
 		DefaultValidatorBondFactor,
 		DefaultGlobalLiquidStakingCap,
 		DefaultValidatorLiquidStakingCap,
+		DefaultGlobalLiquidStakingCapSchedule,
+		DefaultProviderLiquidStakingCap,
+		DefaultValidatorBondFirstLossFraction,
+		DefaultValidatorBondGracePeriod,
 	)
 }
 
@@ -168,7 +200,23 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	err := validateValidatorBondFactor(p.ValidatorBondFactor)
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateGlobalLiquidStakingCapSchedule(p.GlobalLiquidStakingCapSchedule); err != nil {
+		return err
+	}
+
+	if err := validateProviderLiquidStakingCap(p.ProviderLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateValidatorBondFirstLossFraction(p.ValidatorBondFirstLossFraction); err != nil {
+		return err
+	}
+
+	err := validateValidatorBondGracePeriod(p.ValidatorBondGracePeriod)
 
 	return err
 }
@@ -309,3 +357,95 @@ func validateValidatorLiquidStakingCap(i interface{}) error {
 
 	return nil
 }
+
+func validateProviderLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("provider liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("provider liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateValidatorBondFirstLossFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("validator bond first loss fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("validator bond first loss fraction cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateValidatorBondGracePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("validator bond grace period cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateGlobalLiquidStakingCapSchedule(i interface{}) error {
+	v, ok := i.([]GlobalLiquidStakingCapScheduleEntry)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for idx, entry := range v {
+		if entry.Cap.IsNil() {
+			return fmt.Errorf("global liquid staking cap schedule entry %d has no cap", idx)
+		}
+		if entry.Cap.IsNegative() {
+			return fmt.Errorf("global liquid staking cap schedule entry %d cannot be negative: %s", idx, entry.Cap)
+		}
+		if entry.Cap.GT(sdk.OneDec()) {
+			return fmt.Errorf("global liquid staking cap schedule entry %d cannot be greater than 100%%: %s", idx, entry.Cap)
+		}
+		if idx > 0 && !entry.Time.After(v[idx-1].Time) {
+			return fmt.Errorf("global liquid staking cap schedule times must be strictly increasing: %s is not after %s",
+				entry.Time, v[idx-1].Time)
+		}
+	}
+
+	return nil
+}
+
+// GetGlobalLiquidStakingCapAt returns the global liquid staking cap in effect at the given time
+// Before the first point of the schedule (or if there is no schedule), the static cap applies
+// Between two points, the cap is linearly interpolated, and after the last point, the last cap applies
+func GetGlobalLiquidStakingCapAt(staticCap sdk.Dec, schedule []GlobalLiquidStakingCapScheduleEntry, blockTime time.Time) sdk.Dec {
+	if len(schedule) == 0 || blockTime.Before(schedule[0].Time) {
+		return staticCap
+	}
+
+	for i := 0; i < len(schedule)-1; i++ {
+		start, end := schedule[i], schedule[i+1]
+		if !blockTime.Before(end.Time) {
+			continue
+		}
+
+		elapsed := sdk.NewDec(blockTime.Sub(start.Time).Nanoseconds())
+		duration := sdk.NewDec(end.Time.Sub(start.Time).Nanoseconds())
+		return start.Cap.Add(end.Cap.Sub(start.Cap).Mul(elapsed).Quo(duration))
+	}
+
+	return schedule[len(schedule)-1].Cap
+}
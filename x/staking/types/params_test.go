@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -36,3 +37,57 @@ func Test_validateParams(t *testing.T) {
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
 }
+
+func Test_validateGlobalLiquidStakingCapSchedule(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	params := types.DefaultParams()
+
+	// increasing times and caps within [0, 1] are valid
+	params.GlobalLiquidStakingCapSchedule = []types.GlobalLiquidStakingCapScheduleEntry{
+		{Time: start, Cap: sdk.MustNewDecFromStr("0.05")},
+		{Time: start.Add(time.Hour), Cap: sdk.MustNewDecFromStr("0.25")},
+	}
+	require.NoError(t, params.Validate())
+
+	// times must be strictly increasing
+	params.GlobalLiquidStakingCapSchedule[1].Time = start
+	require.Error(t, params.Validate())
+
+	// caps must be within [0, 1]
+	params.GlobalLiquidStakingCapSchedule[1].Time = start.Add(time.Hour)
+	params.GlobalLiquidStakingCapSchedule[1].Cap = sdk.MustNewDecFromStr("1.01")
+	require.Error(t, params.Validate())
+
+	params.GlobalLiquidStakingCapSchedule[1].Cap = sdk.MustNewDecFromStr("-0.01")
+	require.Error(t, params.Validate())
+}
+
+func TestGetGlobalLiquidStakingCapAt(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	staticCap := sdk.MustNewDecFromStr("0.5")
+	schedule := []types.GlobalLiquidStakingCapScheduleEntry{
+		{Time: start, Cap: sdk.MustNewDecFromStr("0.1")},
+		{Time: start.Add(10 * time.Hour), Cap: sdk.MustNewDecFromStr("0.2")},
+		{Time: start.Add(20 * time.Hour), Cap: sdk.MustNewDecFromStr("0.4")},
+	}
+
+	testCases := []struct {
+		name        string
+		schedule    []types.GlobalLiquidStakingCapScheduleEntry
+		blockTime   time.Time
+		expectedCap sdk.Dec
+	}{
+		{"no schedule", nil, start, staticCap},
+		{"before first point", schedule, start.Add(-time.Hour), staticCap},
+		{"at first point", schedule, start, sdk.MustNewDecFromStr("0.1")},
+		{"between first and second point", schedule, start.Add(5 * time.Hour), sdk.MustNewDecFromStr("0.15")},
+		{"at second point", schedule, start.Add(10 * time.Hour), sdk.MustNewDecFromStr("0.2")},
+		{"between second and third point", schedule, start.Add(15 * time.Hour), sdk.MustNewDecFromStr("0.3")},
+		{"after last point", schedule, start.Add(30 * time.Hour), sdk.MustNewDecFromStr("0.4")},
+	}
+
+	for _, tc := range testCases {
+		actualCap := types.GetGlobalLiquidStakingCapAt(staticCap, tc.schedule, tc.blockTime)
+		require.Equal(t, tc.expectedCap, actualCap, tc.name)
+	}
+}
@@ -12,6 +12,8 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -19,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1377,6 +1381,9 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// params holds all the parameters of this module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// effective_global_liquid_staking_cap is the global liquid staking cap at the
+	// current block time, after applying the cap schedule
+	EffectiveGlobalLiquidStakingCap string `protobuf:"bytes,2,opt,name=effective_global_liquid_staking_cap,json=effectiveGlobalLiquidStakingCap,proto3" json:"effective_global_liquid_staking_cap,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -1419,6 +1426,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetEffectiveGlobalLiquidStakingCap() string {
+	if m != nil {
+		return m.EffectiveGlobalLiquidStakingCap
+	}
+	return ""
+}
+
 // QueryTokenizeShareRecordByIdRequest is request type for the
 // Query/QueryTokenizeShareRecordById RPC method.
 type QueryTokenizeShareRecordByIdRequest struct {
@@ -1957,7 +1971,7 @@ func (m *QueryTotalTokenizeSharedAssetsResponse) GetValue() types.Coin {
 	return types.Coin{}
 }
 
-// QueryQueryTotalLiquidStakedRequest is request type for the
+// QueryTotalLiquidStakedRequest is request type for the
 // Query/QueryQueryTotalLiquidStaked RPC method.
 type QueryTotalLiquidStaked struct {
 }
@@ -1995,10 +2009,13 @@ func (m *QueryTotalLiquidStaked) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTotalLiquidStaked proto.InternalMessageInfo
 
-// QueryQueryTotalLiquidStakedResponse is response type for the
+// QueryTotalLiquidStakedResponse is response type for the
 // Query/QueryQueryTotalLiquidStaked RPC method.
 type QueryTotalLiquidStakedResponse struct {
 	Tokens string `protobuf:"bytes,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	// global_liquid_staking_cap is the global liquid staking cap at the
+	// current block time, after applying the cap schedule
+	GlobalLiquidStakingCap string `protobuf:"bytes,2,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3" json:"global_liquid_staking_cap,omitempty"`
 }
 
 func (m *QueryTotalLiquidStakedResponse) Reset()         { *m = QueryTotalLiquidStakedResponse{} }
@@ -2041,6 +2058,115 @@ func (m *QueryTotalLiquidStakedResponse) GetTokens() string {
 	return ""
 }
 
+func (m *QueryTotalLiquidStakedResponse) GetGlobalLiquidStakingCap() string {
+	if m != nil {
+		return m.GlobalLiquidStakingCap
+	}
+	return ""
+}
+
+// QueryProviderLiquidStakedRequest is request type for the
+// Query/ProviderLiquidStaked RPC method.
+type QueryProviderLiquidStakedRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryProviderLiquidStakedRequest) Reset()         { *m = QueryProviderLiquidStakedRequest{} }
+func (m *QueryProviderLiquidStakedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderLiquidStakedRequest) ProtoMessage()    {}
+func (*QueryProviderLiquidStakedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8598f616533c087, []int{42}
+}
+func (m *QueryProviderLiquidStakedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderLiquidStakedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderLiquidStakedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderLiquidStakedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderLiquidStakedRequest.Merge(m, src)
+}
+func (m *QueryProviderLiquidStakedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderLiquidStakedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderLiquidStakedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderLiquidStakedRequest proto.InternalMessageInfo
+
+func (m *QueryProviderLiquidStakedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryProviderLiquidStakedResponse is response type for the
+// Query/ProviderLiquidStaked RPC method.
+type QueryProviderLiquidStakedResponse struct {
+	Tokens string `protobuf:"bytes,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	// provider_liquid_staking_cap is the cap on the portion of stake that
+	// may come from a single liquid staking provider
+	ProviderLiquidStakingCap string `protobuf:"bytes,2,opt,name=provider_liquid_staking_cap,json=providerLiquidStakingCap,proto3" json:"provider_liquid_staking_cap,omitempty"`
+}
+
+func (m *QueryProviderLiquidStakedResponse) Reset()         { *m = QueryProviderLiquidStakedResponse{} }
+func (m *QueryProviderLiquidStakedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderLiquidStakedResponse) ProtoMessage()    {}
+func (*QueryProviderLiquidStakedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8598f616533c087, []int{43}
+}
+func (m *QueryProviderLiquidStakedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderLiquidStakedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderLiquidStakedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderLiquidStakedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderLiquidStakedResponse.Merge(m, src)
+}
+func (m *QueryProviderLiquidStakedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderLiquidStakedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderLiquidStakedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderLiquidStakedResponse proto.InternalMessageInfo
+
+func (m *QueryProviderLiquidStakedResponse) GetTokens() string {
+	if m != nil {
+		return m.Tokens
+	}
+	return ""
+}
+
+func (m *QueryProviderLiquidStakedResponse) GetProviderLiquidStakingCap() string {
+	if m != nil {
+		return m.ProviderLiquidStakingCap
+	}
+	return ""
+}
+
 // QueryTokenizeShareLockInfo queries the tokenize share lock information
 // associated with given account
 type QueryTokenizeShareLockInfo struct {
@@ -2051,7 +2177,7 @@ func (m *QueryTokenizeShareLockInfo) Reset()         { *m = QueryTokenizeShareLo
 func (m *QueryTokenizeShareLockInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryTokenizeShareLockInfo) ProtoMessage()    {}
 func (*QueryTokenizeShareLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8598f616533c087, []int{42}
+	return fileDescriptor_b8598f616533c087, []int{44}
 }
 func (m *QueryTokenizeShareLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2098,7 +2224,7 @@ func (m *QueryTokenizeShareLockInfoResponse) Reset()         { *m = QueryTokeniz
 func (m *QueryTokenizeShareLockInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTokenizeShareLockInfoResponse) ProtoMessage()    {}
 func (*QueryTokenizeShareLockInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8598f616533c087, []int{43}
+	return fileDescriptor_b8598f616533c087, []int{45}
 }
 func (m *QueryTokenizeShareLockInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2141,6 +2267,216 @@ func (m *QueryTokenizeShareLockInfoResponse) GetExpirationTime() string {
 	return ""
 }
 
+// QueryTokenizeShareLockAllowlistRequest is request type for the
+// Query/TokenizeShareLockAllowlist RPC method.
+type QueryTokenizeShareLockAllowlistRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryTokenizeShareLockAllowlistRequest) Reset() {
+	*m = QueryTokenizeShareLockAllowlistRequest{}
+}
+func (m *QueryTokenizeShareLockAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareLockAllowlistRequest) ProtoMessage()    {}
+func (*QueryTokenizeShareLockAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8598f616533c087, []int{46}
+}
+func (m *QueryTokenizeShareLockAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareLockAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareLockAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareLockAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareLockAllowlistRequest.Merge(m, src)
+}
+func (m *QueryTokenizeShareLockAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareLockAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareLockAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareLockAllowlistRequest proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareLockAllowlistRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryTokenizeShareLockAllowlistResponse is response type for the
+// Query/TokenizeShareLockAllowlist RPC method.
+type QueryTokenizeShareLockAllowlistResponse struct {
+	Entries []TokenizeShareLockAllowlistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QueryTokenizeShareLockAllowlistResponse) Reset() {
+	*m = QueryTokenizeShareLockAllowlistResponse{}
+}
+func (m *QueryTokenizeShareLockAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareLockAllowlistResponse) ProtoMessage()    {}
+func (*QueryTokenizeShareLockAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8598f616533c087, []int{47}
+}
+func (m *QueryTokenizeShareLockAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareLockAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareLockAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareLockAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareLockAllowlistResponse.Merge(m, src)
+}
+func (m *QueryTokenizeShareLockAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareLockAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareLockAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareLockAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareLockAllowlistResponse) GetEntries() []TokenizeShareLockAllowlistEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// QueryValidatorBondComplianceRequest is request type for the
+// Query/ValidatorBondCompliance RPC method.
+type QueryValidatorBondComplianceRequest struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryValidatorBondComplianceRequest) Reset()         { *m = QueryValidatorBondComplianceRequest{} }
+func (m *QueryValidatorBondComplianceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorBondComplianceRequest) ProtoMessage()    {}
+func (*QueryValidatorBondComplianceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8598f616533c087, []int{48}
+}
+func (m *QueryValidatorBondComplianceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorBondComplianceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorBondComplianceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorBondComplianceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorBondComplianceRequest.Merge(m, src)
+}
+func (m *QueryValidatorBondComplianceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorBondComplianceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorBondComplianceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorBondComplianceRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorBondComplianceRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryValidatorBondComplianceResponse is response type for the
+// Query/ValidatorBondCompliance RPC method.
+type QueryValidatorBondComplianceResponse struct {
+	// bond_cap_exceeded is true if the validator's liquid shares exceed its
+	// validator bond cap
+	BondCapExceeded bool `protobuf:"varint,1,opt,name=bond_cap_exceeded,json=bondCapExceeded,proto3" json:"bond_cap_exceeded,omitempty"`
+	// grace_period_end is the time at which the validator is flagged as
+	// non-compliant, unset if no violation was recorded
+	GracePeriodEnd *time.Time `protobuf:"bytes,2,opt,name=grace_period_end,json=gracePeriodEnd,proto3,stdtime" json:"grace_period_end,omitempty"`
+	// non_compliant is true if the validator still exceeds its validator bond
+	// cap after its grace period has ended
+	NonCompliant bool `protobuf:"varint,3,opt,name=non_compliant,json=nonCompliant,proto3" json:"non_compliant,omitempty"`
+}
+
+func (m *QueryValidatorBondComplianceResponse) Reset()         { *m = QueryValidatorBondComplianceResponse{} }
+func (m *QueryValidatorBondComplianceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorBondComplianceResponse) ProtoMessage()    {}
+func (*QueryValidatorBondComplianceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8598f616533c087, []int{49}
+}
+func (m *QueryValidatorBondComplianceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorBondComplianceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorBondComplianceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorBondComplianceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorBondComplianceResponse.Merge(m, src)
+}
+func (m *QueryValidatorBondComplianceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorBondComplianceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorBondComplianceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorBondComplianceResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorBondComplianceResponse) GetBondCapExceeded() bool {
+	if m != nil {
+		return m.BondCapExceeded
+	}
+	return false
+}
+
+func (m *QueryValidatorBondComplianceResponse) GetGracePeriodEnd() *time.Time {
+	if m != nil {
+		return m.GracePeriodEnd
+	}
+	return nil
+}
+
+func (m *QueryValidatorBondComplianceResponse) GetNonCompliant() bool {
+	if m != nil {
+		return m.NonCompliant
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("liquidstaking.staking.v1beta1.TokenizeShareLockStatus", TokenizeShareLockStatus_name, TokenizeShareLockStatus_value)
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "liquidstaking.staking.v1beta1.QueryValidatorsRequest")
@@ -2185,132 +2521,157 @@ func init() {
 	proto.RegisterType((*QueryTotalTokenizeSharedAssetsResponse)(nil), "liquidstaking.staking.v1beta1.QueryTotalTokenizeSharedAssetsResponse")
 	proto.RegisterType((*QueryTotalLiquidStaked)(nil), "liquidstaking.staking.v1beta1.QueryTotalLiquidStaked")
 	proto.RegisterType((*QueryTotalLiquidStakedResponse)(nil), "liquidstaking.staking.v1beta1.QueryTotalLiquidStakedResponse")
+	proto.RegisterType((*QueryProviderLiquidStakedRequest)(nil), "liquidstaking.staking.v1beta1.QueryProviderLiquidStakedRequest")
+	proto.RegisterType((*QueryProviderLiquidStakedResponse)(nil), "liquidstaking.staking.v1beta1.QueryProviderLiquidStakedResponse")
 	proto.RegisterType((*QueryTokenizeShareLockInfo)(nil), "liquidstaking.staking.v1beta1.QueryTokenizeShareLockInfo")
 	proto.RegisterType((*QueryTokenizeShareLockInfoResponse)(nil), "liquidstaking.staking.v1beta1.QueryTokenizeShareLockInfoResponse")
+	proto.RegisterType((*QueryTokenizeShareLockAllowlistRequest)(nil), "liquidstaking.staking.v1beta1.QueryTokenizeShareLockAllowlistRequest")
+	proto.RegisterType((*QueryTokenizeShareLockAllowlistResponse)(nil), "liquidstaking.staking.v1beta1.QueryTokenizeShareLockAllowlistResponse")
+	proto.RegisterType((*QueryValidatorBondComplianceRequest)(nil), "liquidstaking.staking.v1beta1.QueryValidatorBondComplianceRequest")
+	proto.RegisterType((*QueryValidatorBondComplianceResponse)(nil), "liquidstaking.staking.v1beta1.QueryValidatorBondComplianceResponse")
 }
 
 func init() { proto.RegisterFile("staking/v1beta1/query.proto", fileDescriptor_b8598f616533c087) }
 
 var fileDescriptor_b8598f616533c087 = []byte{
-	// 1874 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0xd4, 0x48,
-	0x16, 0xef, 0x6a, 0x42, 0x80, 0xc7, 0x92, 0x0d, 0x95, 0x10, 0x12, 0x03, 0x9d, 0x60, 0x3e, 0x92,
-	0x8d, 0x94, 0x6e, 0x12, 0x08, 0x0a, 0xec, 0x26, 0x21, 0x9f, 0x10, 0x11, 0x41, 0x70, 0x80, 0x65,
-	0xb9, 0x64, 0x9d, 0xb6, 0xe9, 0x78, 0xd3, 0xed, 0xea, 0xd8, 0x6e, 0x48, 0xc8, 0xe6, 0xb0, 0x2b,
-	0xad, 0x76, 0xa5, 0x3d, 0xcc, 0x48, 0x73, 0xe0, 0xca, 0x01, 0x69, 0x24, 0x66, 0xb8, 0x8c, 0xe0,
-	0x34, 0x12, 0xd2, 0xdc, 0xb8, 0x0d, 0x9a, 0xd1, 0x08, 0xa4, 0x91, 0x18, 0x14, 0xe6, 0x30, 0x87,
-	0x39, 0xcc, 0x9f, 0x30, 0xea, 0x72, 0xd9, 0xed, 0x6e, 0xdb, 0x6d, 0xb7, 0xd3, 0x91, 0xc2, 0x29,
-	0x5d, 0xe5, 0x7a, 0xef, 0xfd, 0xde, 0x57, 0x7d, 0xfc, 0x14, 0x38, 0xa2, 0x1b, 0xe2, 0xb2, 0xa2,
-	0x66, 0x52, 0xf7, 0xfb, 0x17, 0x65, 0x43, 0xec, 0x4f, 0xad, 0x14, 0x64, 0x6d, 0x2d, 0x99, 0xd7,
-	0x88, 0x41, 0xf0, 0xb1, 0xac, 0xb2, 0x52, 0x50, 0x24, 0xb6, 0x24, 0x69, 0xfd, 0x65, 0x4b, 0xb9,
-	0xde, 0x34, 0xd1, 0x73, 0x44, 0x4f, 0x2d, 0x8a, 0xba, 0x6c, 0xca, 0xd9, 0x5a, 0xf2, 0x62, 0x46,
-	0x51, 0x45, 0x43, 0x21, 0xaa, 0xa9, 0x8a, 0x6b, 0xcd, 0x90, 0x0c, 0xa1, 0x3f, 0x53, 0xc5, 0x5f,
-	0x6c, 0xf6, 0x68, 0x86, 0x90, 0x4c, 0x56, 0x4e, 0x89, 0x79, 0x25, 0x25, 0xaa, 0x2a, 0x31, 0xa8,
-	0x88, 0xce, 0xbe, 0x1e, 0xab, 0xc4, 0x66, 0x01, 0x30, 0x3f, 0x27, 0x9c, 0xe6, 0xad, 0x25, 0x69,
-	0xa2, 0x58, 0x26, 0x3b, 0xcc, 0xef, 0x0b, 0xa6, 0x55, 0x73, 0x60, 0x7e, 0xe2, 0x57, 0xa1, 0xed,
-	0x46, 0x11, 0xef, 0x6d, 0x31, 0xab, 0x48, 0xa2, 0x41, 0x34, 0x5d, 0x90, 0x57, 0x0a, 0xb2, 0x6e,
-	0xe0, 0x36, 0x68, 0xd4, 0x0d, 0xd1, 0x28, 0xe8, 0xed, 0xa8, 0x0b, 0xf5, 0xec, 0x13, 0xd8, 0x08,
-	0x4f, 0x03, 0x94, 0x7c, 0x6a, 0x8f, 0x77, 0xa1, 0x9e, 0xfd, 0x03, 0xa7, 0x93, 0x4c, 0x69, 0x11,
-	0x41, 0xd2, 0x0c, 0x1c, 0xc3, 0x91, 0x9c, 0x13, 0x33, 0x32, 0xd3, 0x29, 0x38, 0x24, 0xf9, 0xaf,
-	0x10, 0x1c, 0x76, 0x99, 0xd6, 0xf3, 0x44, 0xd5, 0x65, 0x7c, 0x0d, 0xe0, 0xbe, 0x3d, 0xdb, 0x8e,
-	0xba, 0x76, 0xf5, 0xec, 0x1f, 0xe8, 0x49, 0x56, 0xcd, 0x41, 0xd2, 0x56, 0x33, 0xde, 0xf0, 0xea,
-	0x5d, 0x67, 0x4c, 0x70, 0x68, 0xc0, 0x97, 0x3d, 0x30, 0x77, 0x07, 0x62, 0x36, 0xc1, 0x94, 0x81,
-	0xbe, 0x03, 0x87, 0xca, 0x31, 0x5b, 0xd1, 0x1a, 0x85, 0x26, 0xdb, 0xde, 0x82, 0x28, 0x49, 0x9a,
-	0x19, 0xb5, 0xf1, 0xf6, 0xef, 0x9e, 0xf7, 0xb5, 0x32, 0x43, 0x63, 0x92, 0xa4, 0xc9, 0xba, 0x3e,
-	0x6f, 0x68, 0x8a, 0x9a, 0x11, 0x0e, 0xd8, 0xeb, 0x8b, 0xf3, 0xfc, 0xbd, 0xca, 0x44, 0xd8, 0xc1,
-	0x98, 0x85, 0x7d, 0xf6, 0x52, 0xaa, 0xb5, 0xf6, 0x58, 0x94, 0x14, 0xf0, 0x5f, 0x20, 0xe8, 0x2a,
-	0x37, 0x34, 0x29, 0x67, 0xe5, 0x8c, 0x59, 0x6e, 0xf5, 0xf2, 0xa6, 0x6e, 0x45, 0xf2, 0x1b, 0x82,
-	0xe3, 0x55, 0xd0, 0xb2, 0x08, 0xfd, 0x0b, 0x41, 0xab, 0x64, 0xcf, 0x2f, 0x68, 0x6c, 0xde, 0xaa,
-	0x9c, 0xfe, 0x80, 0x68, 0x95, 0x54, 0x5a, 0x1a, 0xc7, 0x8f, 0x14, 0xc3, 0xf6, 0xf4, 0xa7, 0xce,
-	0x16, 0xf7, 0x37, 0x5d, 0x68, 0x91, 0xdc, 0x93, 0xf5, 0x2b, 0xb1, 0xe7, 0x08, 0xfe, 0x54, 0xee,
-	0xf2, 0x2d, 0x75, 0x91, 0xa8, 0x92, 0xa2, 0x66, 0x76, 0x72, 0xa6, 0xde, 0x23, 0xe8, 0x0d, 0x03,
-	0x9b, 0xa5, 0x4c, 0x81, 0x96, 0x82, 0xf5, 0xdd, 0x95, 0xb0, 0x81, 0x80, 0x84, 0x79, 0x68, 0x66,
-	0x85, 0x8e, 0x6d, 0xa5, 0xdb, 0x90, 0x99, 0x27, 0x88, 0xf5, 0xa8, 0xb3, 0x28, 0xec, 0x34, 0xb0,
-	0xa2, 0x08, 0x9d, 0x06, 0x7b, 0x3d, 0x4d, 0x83, 0x3b, 0x8f, 0xf1, 0x9a, 0xf2, 0x78, 0x71, 0xef,
-	0xff, 0x1e, 0x77, 0xc6, 0x7e, 0x79, 0xdc, 0x19, 0xe3, 0x37, 0xd8, 0xbe, 0xea, 0x2e, 0x5d, 0xbc,
-	0x08, 0x2d, 0x1e, 0x7d, 0xc2, 0x36, 0x95, 0xda, 0xdb, 0x44, 0xc0, 0xee, 0x4e, 0xe0, 0x9f, 0x21,
-	0xe8, 0xa4, 0xf6, 0x3d, 0xb2, 0xb4, 0x13, 0xc3, 0x65, 0xb0, 0xfd, 0xd0, 0x13, 0x2e, 0x8b, 0xdb,
-	0x1c, 0x34, 0x9a, 0x85, 0xc5, 0x42, 0x15, 0xbd, 0x40, 0x99, 0x1e, 0xfe, 0x85, 0xb5, 0x0d, 0x4f,
-	0x5a, 0x7e, 0x79, 0x37, 0xf7, 0xd6, 0xc2, 0x54, 0xa7, 0xe6, 0x76, 0x44, 0xeb, 0xad, 0xb5, 0x21,
-	0x7b, 0xe3, 0x66, 0xf1, 0xfa, 0x47, 0xbd, 0xf7, 0x63, 0x33, 0x78, 0xdb, 0xbb, 0xf1, 0xbe, 0xb4,
-	0x36, 0x5e, 0xdb, 0xb5, 0x80, 0x8d, 0x77, 0xa7, 0xe5, 0xc6, 0xde, 0x82, 0x03, 0x1c, 0xf8, 0x88,
-	0xb7, 0xe0, 0x97, 0x71, 0xe8, 0xa0, 0x2e, 0x0a, 0xb2, 0xb4, 0x2d, 0x39, 0xc1, 0xba, 0x96, 0x5e,
-	0xa8, 0x71, 0x6b, 0x69, 0xd6, 0xb5, 0xf4, 0xed, 0x8a, 0x43, 0x15, 0x4b, 0xba, 0x51, 0xa9, 0x67,
-	0x57, 0x90, 0x1e, 0x49, 0x37, 0x6e, 0x57, 0x39, 0x9c, 0x1b, 0xea, 0x50, 0x23, 0x6f, 0x10, 0x70,
-	0x5e, 0x01, 0x64, 0x35, 0x91, 0x87, 0x36, 0x4d, 0xae, 0xd2, 0xba, 0x67, 0x03, 0xca, 0xc2, 0xa9,
-	0xb5, 0xa2, 0x79, 0x0f, 0x69, 0xf2, 0x76, 0xdf, 0x9b, 0x3a, 0xcb, 0xab, 0xdf, 0xfd, 0xa6, 0xd9,
-	0x81, 0x4d, 0xfb, 0xb5, 0xeb, 0x20, 0xf8, 0x98, 0xde, 0x43, 0x5f, 0x22, 0x48, 0xf8, 0xa0, 0xdf,
-	0x89, 0x67, 0x3d, 0xf1, 0x2d, 0x91, 0x6d, 0x7a, 0x6d, 0x9d, 0x63, 0xdd, 0x76, 0x45, 0xd1, 0x0d,
-	0xa2, 0x29, 0x69, 0x31, 0x3b, 0xa3, 0xde, 0x23, 0x8e, 0x27, 0xf6, 0x92, 0xac, 0x64, 0x96, 0x0c,
-	0x6a, 0x68, 0x97, 0xc0, 0x46, 0xfc, 0xdf, 0xe1, 0x88, 0xa7, 0x14, 0x83, 0x38, 0x06, 0x0d, 0x4b,
-	0x8a, 0x6e, 0x30, 0x74, 0x7d, 0x01, 0xe8, 0x2a, 0x94, 0x50, 0x51, 0x1e, 0x43, 0x33, 0xb5, 0x30,
-	0x47, 0x48, 0x96, 0xa1, 0xe1, 0x05, 0x38, 0xe8, 0x98, 0x63, 0xb6, 0x86, 0xa1, 0x21, 0x4f, 0x48,
-	0x96, 0xd9, 0x3a, 0x11, 0x60, 0xab, 0x28, 0xca, 0x82, 0x40, 0xc5, 0xf8, 0x56, 0xc0, 0xa6, 0x4e,
-	0x51, 0x13, 0x73, 0x56, 0x1b, 0xf2, 0x77, 0xa1, 0xa5, 0x6c, 0x96, 0xd9, 0x9a, 0x80, 0xc6, 0x3c,
-	0x9d, 0x61, 0xd6, 0x4e, 0x05, 0x59, 0xa3, 0x8b, 0xad, 0x8b, 0x95, 0x29, 0xca, 0x0f, 0xc2, 0x09,
-	0xaa, 0xfb, 0x26, 0x59, 0x96, 0x55, 0xe5, 0xa1, 0x3c, 0xbf, 0x24, 0x6a, 0xb2, 0x20, 0xa7, 0x89,
-	0x26, 0x8d, 0xaf, 0xcd, 0x48, 0x56, 0xe8, 0x9b, 0x20, 0xae, 0x98, 0xb7, 0xb9, 0x06, 0x21, 0xae,
-	0x48, 0xfc, 0x2a, 0x9c, 0xac, 0x2e, 0x56, 0xba, 0x09, 0x6a, 0x74, 0x36, 0xe4, 0x4d, 0xd0, 0x4b,
-	0x1f, 0x03, 0x6c, 0xea, 0xe1, 0x47, 0xe0, 0xb4, 0xbf, 0xe5, 0x49, 0x59, 0x25, 0x39, 0x0b, 0x73,
-	0x2b, 0xec, 0x96, 0x8a, 0x63, 0x46, 0xc8, 0x98, 0x03, 0x7e, 0x1d, 0xba, 0x03, 0xe5, 0xb7, 0x0d,
-	0xfc, 0x30, 0x9c, 0xf2, 0x33, 0xae, 0x5f, 0x7f, 0xa0, 0xca, 0x92, 0x03, 0x3b, 0x79, 0xa0, 0xca,
-	0x9a, 0x85, 0x9d, 0x0e, 0xf8, 0x7f, 0xfa, 0xfb, 0x6e, 0x89, 0x33, 0xe8, 0x02, 0xec, 0x31, 0x4d,
-	0x86, 0xbd, 0xa0, 0xf8, 0x63, 0xb7, 0x14, 0xf1, 0xa7, 0x58, 0xa9, 0x8c, 0x65, 0xb3, 0x5e, 0x00,
-	0xac, 0x6a, 0x7d, 0xc8, 0x4a, 0xc3, 0x77, 0xd9, 0x36, 0x42, 0xec, 0x66, 0xf1, 0x9d, 0x15, 0x75,
-	0xc3, 0x63, 0xb9, 0x5d, 0xcf, 0xfc, 0x10, 0x8b, 0x64, 0x95, 0x85, 0x0c, 0x66, 0x65, 0xe5, 0x77,
-	0xdb, 0x29, 0x34, 0xc4, 0x72, 0x07, 0xa5, 0x31, 0x5d, 0x97, 0x0d, 0x3b, 0x0e, 0x0b, 0x76, 0xb2,
-	0x7c, 0x17, 0x32, 0x13, 0x83, 0xb0, 0xfb, 0xbe, 0x98, 0x2d, 0x58, 0x0f, 0xcb, 0x8e, 0xb2, 0x93,
-	0xc5, 0xf2, 0x7e, 0x82, 0x28, 0xd6, 0x95, 0xd1, 0x5c, 0xcd, 0xb7, 0xb3, 0xe7, 0x35, 0x35, 0x30,
-	0x4b, 0x43, 0x37, 0x6f, 0x88, 0xcb, 0xb2, 0xc4, 0x0f, 0xb1, 0x53, 0xc6, 0xf5, 0xc5, 0x36, 0xd9,
-	0x06, 0x8d, 0x46, 0x11, 0x92, 0xcd, 0x56, 0x9a, 0x23, 0xfe, 0x3c, 0xdb, 0x80, 0xcb, 0xf0, 0xce,
-	0x92, 0xf4, 0x72, 0x71, 0x33, 0xc4, 0xed, 0xb0, 0x47, 0x34, 0x4f, 0x0e, 0x26, 0x66, 0x0d, 0x79,
-	0x19, 0x78, 0x7f, 0x39, 0xa7, 0x55, 0x4f, 0x8e, 0xb4, 0x1b, 0xfe, 0x28, 0xaf, 0xe6, 0x15, 0xcd,
-	0xbc, 0x44, 0x19, 0x4a, 0x4e, 0x36, 0xcf, 0x2c, 0xa1, 0xa9, 0x34, 0x7d, 0x53, 0xc9, 0xc9, 0xbd,
-	0xd3, 0x70, 0xd8, 0x65, 0x61, 0xde, 0xd4, 0x01, 0xd0, 0x38, 0x7b, 0x7d, 0xe2, 0xea, 0xd4, 0x64,
-	0x73, 0x0c, 0xff, 0x01, 0xf6, 0xde, 0xba, 0xc6, 0x46, 0x08, 0x1f, 0x84, 0x03, 0xc5, 0xdf, 0x0b,
-	0x53, 0x77, 0xe6, 0x66, 0x84, 0x99, 0x6b, 0x97, 0x9b, 0xe3, 0x03, 0x3f, 0x1e, 0x87, 0xdd, 0x14,
-	0x2f, 0xfe, 0x1c, 0x01, 0x94, 0x6e, 0x10, 0x78, 0x30, 0xa0, 0x06, 0xbd, 0xc9, 0x5f, 0xee, 0x7c,
-	0xad, 0x62, 0xec, 0xf1, 0xdf, 0xfb, 0xef, 0xef, 0x7f, 0xfe, 0x2c, 0x7e, 0x12, 0xf3, 0x8c, 0x65,
-	0x4e, 0x55, 0x12, 0xd7, 0x8e, 0x4b, 0xc8, 0x0b, 0x04, 0xfb, 0x6c, 0x15, 0xf8, 0x5c, 0x4d, 0x16,
-	0x2d, 0x9c, 0x83, 0x35, 0x4a, 0x31, 0x98, 0x7f, 0xa6, 0x30, 0x07, 0xf1, 0xd9, 0x60, 0x98, 0xa9,
-	0xf5, 0xf2, 0xcb, 0xc7, 0x06, 0xde, 0x44, 0xd0, 0xea, 0x45, 0x47, 0xe2, 0xd1, 0x9a, 0xc0, 0xb8,
-	0xdf, 0x94, 0xdc, 0xa5, 0xe8, 0x0a, 0x98, 0x63, 0x97, 0xa9, 0x63, 0x63, 0x78, 0x34, 0x82, 0x63,
-	0x29, 0xc7, 0x83, 0x00, 0xff, 0x37, 0x0e, 0xc7, 0xaa, 0x32, 0x79, 0xf8, 0x4a, 0x4d, 0x60, 0xab,
-	0x3c, 0xa5, 0xb9, 0x99, 0x3a, 0x68, 0x62, 0xfe, 0xdf, 0xa0, 0xfe, 0x5f, 0xc5, 0x33, 0x51, 0xfc,
-	0x2f, 0xbd, 0x86, 0x9d, 0x91, 0xf8, 0x01, 0x01, 0x94, 0x4c, 0x85, 0x6b, 0x28, 0x17, 0xe3, 0x15,
-	0xae, 0xa1, 0xdc, 0xc4, 0x07, 0x7f, 0x87, 0x3a, 0x24, 0xe0, 0xb9, 0x2d, 0x26, 0x34, 0xb5, 0x5e,
-	0x7e, 0x09, 0xdf, 0xc0, 0xff, 0x89, 0x43, 0x8b, 0x47, 0x2c, 0xf1, 0x48, 0x18, 0xa4, 0xfe, 0xdc,
-	0x1e, 0x37, 0x1a, 0x59, 0x9e, 0xb9, 0x9c, 0xa3, 0x2e, 0x67, 0xb0, 0x5c, 0x6f, 0x97, 0x3d, 0x13,
-	0x8c, 0xdf, 0x20, 0x68, 0xf5, 0x22, 0xb3, 0xc2, 0xb5, 0x73, 0x15, 0xfa, 0x2e, 0x5c, 0x3b, 0x57,
-	0xe3, 0xd1, 0xf8, 0xbf, 0xd0, 0x50, 0x9c, 0xc7, 0xe7, 0xfc, 0x42, 0x51, 0x35, 0xc3, 0xc5, 0x1e,
-	0xae, 0x4a, 0x05, 0x85, 0xeb, 0xe1, 0x30, 0x74, 0x58, 0xb8, 0x1e, 0x0e, 0xc5, 0x4b, 0x05, 0xf7,
-	0xb0, 0xed, 0x67, 0xc8, 0x14, 0xeb, 0xf8, 0x5b, 0x04, 0x07, 0xca, 0x08, 0x0f, 0x3c, 0x14, 0x06,
-	0xaf, 0x17, 0xc9, 0xc4, 0x5d, 0x88, 0x20, 0xc9, 0x3c, 0x9b, 0xa1, 0x9e, 0x4d, 0xe0, 0xb1, 0x28,
-	0x9e, 0x69, 0x65, 0xf8, 0xdf, 0x21, 0x68, 0xf1, 0x60, 0x0c, 0xc2, 0x75, 0xaf, 0x3f, 0x43, 0xc2,
-	0x8d, 0x46, 0x96, 0x67, 0x3e, 0x4e, 0x53, 0x1f, 0x2f, 0xe1, 0x91, 0x28, 0x3e, 0x3a, 0x6e, 0x07,
-	0xbf, 0x22, 0xc0, 0x6e, 0x3b, 0x78, 0x38, 0x1a, 0x3e, 0xcb, 0xbd, 0x91, 0xa8, 0xe2, 0xcc, 0xbb,
-	0xbf, 0x52, 0xef, 0x6e, 0xe0, 0xeb, 0x5b, 0xf3, 0xce, 0x7d, 0xa9, 0xf8, 0x06, 0x41, 0x53, 0xf9,
-	0x4b, 0x1d, 0x87, 0x2a, 0x34, 0x4f, 0x62, 0x81, 0xbb, 0x18, 0x45, 0x94, 0xb9, 0x38, 0x44, 0x5d,
-	0x1c, 0xc0, 0x67, 0xfc, 0x5c, 0x5c, 0xb2, 0xe5, 0x16, 0x14, 0xf5, 0x1e, 0x49, 0xad, 0x9b, 0xac,
-	0xc5, 0x06, 0xfe, 0x04, 0x41, 0xc3, 0x1c, 0x21, 0x59, 0x9c, 0x0a, 0x63, 0xde, 0x41, 0x3d, 0x70,
-	0x67, 0xc2, 0x0b, 0x30, 0x94, 0x27, 0x29, 0xca, 0x04, 0x3e, 0xea, 0x87, 0x32, 0x5f, 0x04, 0xf2,
-	0x08, 0x41, 0xa3, 0xc9, 0x12, 0xe0, 0xfe, 0x50, 0x26, 0x9c, 0x34, 0x05, 0x37, 0x50, 0x8b, 0x08,
-	0xc3, 0x75, 0x9a, 0xe2, 0xea, 0xc2, 0x09, 0x5f, 0x5c, 0x26, 0x9c, 0x27, 0xa8, 0xe2, 0xe6, 0x5f,
-	0xe2, 0x1a, 0xf0, 0x78, 0x18, 0xbb, 0xd5, 0xf9, 0x0d, 0x6e, 0x62, 0x4b, 0x3a, 0x98, 0x33, 0x31,
-	0xfc, 0x0c, 0x01, 0xe7, 0x4f, 0x2c, 0xe0, 0xa9, 0xc8, 0x56, 0x9c, 0xc4, 0x06, 0x37, 0xbd, 0x55,
-	0x35, 0x36, 0xde, 0xa7, 0x08, 0x3a, 0x7c, 0xc9, 0x04, 0x3c, 0x19, 0xd1, 0x4e, 0x19, 0x95, 0xc1,
-	0x4d, 0x6d, 0x51, 0x8b, 0x0d, 0xb6, 0x58, 0x03, 0x3e, 0xa4, 0x42, 0xb8, 0x1a, 0xa8, 0x4e, 0x5c,
-	0x84, 0xab, 0x81, 0x00, 0x56, 0x83, 0xc5, 0xd4, 0x97, 0x56, 0x08, 0x17, 0xd3, 0x20, 0xfa, 0x22,
-	0x5c, 0x4c, 0x03, 0xb9, 0x0d, 0xbb, 0x00, 0x7c, 0x08, 0x8a, 0xb0, 0x05, 0x50, 0x9d, 0x08, 0x09,
-	0x5b, 0x00, 0x01, 0x2c, 0x09, 0x1f, 0xc3, 0xff, 0x47, 0x70, 0xd0, 0x45, 0x69, 0x84, 0x7b, 0x61,
-	0xb8, 0xc4, 0xb8, 0xe1, 0x48, 0x62, 0x0e, 0x34, 0x8f, 0x10, 0x1c, 0xf2, 0xa6, 0x49, 0x2e, 0xd4,
-	0x5c, 0xf1, 0x96, 0x28, 0x37, 0x16, 0x59, 0xb4, 0x84, 0x6c, 0xfc, 0x6f, 0xaf, 0x36, 0x13, 0xe8,
-	0xf5, 0x66, 0x02, 0xbd, 0xdf, 0x4c, 0xa0, 0x4f, 0x3f, 0x24, 0x62, 0xaf, 0x3f, 0x24, 0x62, 0x6f,
-	0x3f, 0x24, 0x62, 0x77, 0x47, 0x33, 0x8a, 0xb1, 0x54, 0x58, 0x4c, 0xa6, 0x49, 0x2e, 0xa5, 0xac,
-	0x64, 0x0b, 0xba, 0x42, 0x54, 0x45, 0x4d, 0xa7, 0x4c, 0xa3, 0x8a, 0xb1, 0xd6, 0xc7, 0x0c, 0xf6,
-	0xe5, 0x88, 0x54, 0xc8, 0xca, 0xa9, 0x55, 0x7b, 0x43, 0x36, 0xd6, 0xf2, 0xb2, 0xbe, 0xd8, 0x48,
-	0xff, 0x0d, 0xee, 0xec, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0x27, 0x14, 0xd9, 0xb3, 0xfe, 0x27,
-	0x00, 0x00,
+	// 2189 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0xd7, 0xac, 0x65, 0xd9, 0x7e, 0x89, 0x15, 0x79, 0x24, 0xcb, 0x12, 0x1d, 0xef, 0x2a, 0xb4,
+	0x3e, 0x5c, 0x01, 0xde, 0x8d, 0x65, 0xcb, 0xb0, 0xd3, 0x48, 0xb2, 0xb4, 0x5a, 0x39, 0x6a, 0x04,
+	0x5b, 0xa6, 0x1d, 0xd7, 0xed, 0xa1, 0x5b, 0x6a, 0x39, 0x5a, 0xb1, 0xe6, 0x72, 0xd6, 0x24, 0x57,
+	0x96, 0xec, 0xfa, 0xd0, 0x02, 0x45, 0x5b, 0xf4, 0xd0, 0x00, 0x3d, 0xe4, 0xd0, 0x4b, 0x0e, 0x01,
+	0x5a, 0xa4, 0xcd, 0xa5, 0x48, 0x4e, 0x05, 0x02, 0x14, 0xbd, 0xe4, 0x56, 0xa3, 0x45, 0x91, 0x9c,
+	0x92, 0x40, 0xee, 0xa1, 0x87, 0x1e, 0xfa, 0x27, 0x04, 0x24, 0x87, 0x5c, 0x72, 0x97, 0x5f, 0xcb,
+	0x95, 0x00, 0xe5, 0x24, 0xcd, 0x70, 0xde, 0x7b, 0xbf, 0xf7, 0x35, 0x1f, 0xbf, 0x85, 0xb3, 0xba,
+	0x21, 0x3e, 0x94, 0xd5, 0x6a, 0x61, 0xfb, 0xd2, 0x06, 0x31, 0xc4, 0x4b, 0x85, 0x47, 0x0d, 0xa2,
+	0xed, 0xe6, 0xeb, 0x1a, 0x35, 0x28, 0x3e, 0xa7, 0xc8, 0x8f, 0x1a, 0xb2, 0xc4, 0x96, 0xe4, 0x9d,
+	0xbf, 0x6c, 0x29, 0x37, 0x5d, 0xa1, 0x7a, 0x8d, 0xea, 0x85, 0x0d, 0x51, 0x27, 0xb6, 0x9c, 0xab,
+	0xa5, 0x2e, 0x56, 0x65, 0x55, 0x34, 0x64, 0xaa, 0xda, 0xaa, 0xb8, 0xa1, 0x2a, 0xad, 0x52, 0xeb,
+	0xdf, 0x82, 0xf9, 0x1f, 0x9b, 0x7d, 0xb5, 0x4a, 0x69, 0x55, 0x21, 0x05, 0xb1, 0x2e, 0x17, 0x44,
+	0x55, 0xa5, 0x86, 0x25, 0xa2, 0xb3, 0xaf, 0xe7, 0x5a, 0xb1, 0x39, 0x00, 0xec, 0xcf, 0x59, 0xaf,
+	0x79, 0x67, 0x49, 0x85, 0xca, 0x8e, 0xc9, 0x51, 0xfb, 0x7b, 0xd9, 0xb6, 0x6a, 0x0f, 0xd8, 0xa7,
+	0x1c, 0xb3, 0x6b, 0x8d, 0x36, 0x1a, 0x9b, 0x05, 0x43, 0xae, 0x11, 0xdd, 0x10, 0x6b, 0x75, 0x7b,
+	0x01, 0xbf, 0x03, 0xc3, 0x77, 0x4c, 0x87, 0xee, 0x8b, 0x8a, 0x2c, 0x89, 0x06, 0xd5, 0x74, 0x81,
+	0x3c, 0x6a, 0x10, 0xdd, 0xc0, 0xc3, 0xd0, 0xa7, 0x1b, 0xa2, 0xd1, 0xd0, 0x47, 0xd0, 0x18, 0xba,
+	0x70, 0x42, 0x60, 0x23, 0xbc, 0x02, 0xd0, 0x74, 0x7a, 0x24, 0x33, 0x86, 0x2e, 0xbc, 0x34, 0x33,
+	0x99, 0x67, 0x56, 0x4d, 0x88, 0x79, 0x3b, 0xb2, 0x0c, 0x68, 0x7e, 0x5d, 0xac, 0x12, 0xa6, 0x53,
+	0xf0, 0x48, 0xf2, 0x7f, 0x41, 0x70, 0xa6, 0xcd, 0xb4, 0x5e, 0xa7, 0xaa, 0x4e, 0xf0, 0x2d, 0x80,
+	0x6d, 0x77, 0x76, 0x04, 0x8d, 0x1d, 0xb9, 0xf0, 0xd2, 0xcc, 0x85, 0x7c, 0x64, 0x92, 0xf2, 0xae,
+	0x9a, 0xa5, 0xde, 0xcf, 0xbe, 0xcc, 0xf5, 0x08, 0x1e, 0x0d, 0xf8, 0x66, 0x00, 0xe6, 0xa9, 0x58,
+	0xcc, 0x36, 0x18, 0x1f, 0xe8, 0x07, 0x70, 0xda, 0x8f, 0xd9, 0x89, 0xd6, 0x02, 0xf4, 0xbb, 0xf6,
+	0xca, 0xa2, 0x24, 0x69, 0x76, 0xd4, 0x96, 0x46, 0xfe, 0xf9, 0xf1, 0xc5, 0x21, 0x66, 0x68, 0x51,
+	0x92, 0x34, 0xa2, 0xeb, 0x77, 0x0d, 0x4d, 0x56, 0xab, 0xc2, 0x49, 0x77, 0xbd, 0x39, 0xcf, 0x6f,
+	0xb6, 0x26, 0xc2, 0x0d, 0xc6, 0x1a, 0x9c, 0x70, 0x97, 0x5a, 0x5a, 0x3b, 0x8f, 0x45, 0x53, 0x01,
+	0xff, 0x27, 0x04, 0x63, 0x7e, 0x43, 0xcb, 0x44, 0x21, 0x55, 0xbb, 0x1e, 0xf7, 0xcb, 0x9b, 0x7d,
+	0x2b, 0x92, 0xff, 0x23, 0x78, 0x2d, 0x02, 0x2d, 0x8b, 0xd0, 0xcf, 0x10, 0x0c, 0x49, 0xee, 0x7c,
+	0x59, 0x63, 0xf3, 0x4e, 0xe5, 0x5c, 0x8a, 0x89, 0x56, 0x53, 0xa5, 0xa3, 0x71, 0xe9, 0xac, 0x19,
+	0xb6, 0x0f, 0xbf, 0xca, 0x0d, 0xb6, 0x7f, 0xd3, 0x85, 0x41, 0xa9, 0x7d, 0x72, 0xff, 0x4a, 0xec,
+	0x63, 0x04, 0xdf, 0xf1, 0xbb, 0xfc, 0x8e, 0xba, 0x41, 0x55, 0x49, 0x56, 0xab, 0x87, 0x39, 0x53,
+	0x5f, 0x23, 0x98, 0x4e, 0x02, 0x9b, 0xa5, 0x4c, 0x86, 0xc1, 0x86, 0xf3, 0xbd, 0x2d, 0x61, 0x33,
+	0x31, 0x09, 0x0b, 0xd0, 0xcc, 0x0a, 0x1d, 0xbb, 0x4a, 0x0f, 0x20, 0x33, 0x1f, 0x20, 0xd6, 0xa3,
+	0xde, 0xa2, 0x70, 0xd3, 0xc0, 0x8a, 0x22, 0x71, 0x1a, 0xdc, 0xf5, 0x56, 0x1a, 0xda, 0xf3, 0x98,
+	0xe9, 0x28, 0x8f, 0x6f, 0x1c, 0xff, 0xd5, 0xfb, 0xb9, 0x9e, 0xff, 0xbe, 0x9f, 0xeb, 0xe1, 0x9f,
+	0xc1, 0x99, 0x36, 0x94, 0x2c, 0xea, 0x1b, 0x30, 0x18, 0xd0, 0x27, 0x6c, 0x53, 0xe9, 0xbc, 0x4d,
+	0x04, 0xdc, 0xde, 0x09, 0xfc, 0x47, 0x08, 0x72, 0x96, 0xfd, 0x80, 0x2c, 0x1d, 0xc6, 0x70, 0x19,
+	0x30, 0x16, 0x0e, 0x97, 0xc5, 0x6d, 0x1d, 0xfa, 0xec, 0xc2, 0x62, 0xa1, 0x4a, 0x5f, 0xa0, 0x4c,
+	0x0f, 0xff, 0x89, 0xb3, 0x0d, 0x2f, 0x3b, 0x7e, 0x05, 0x37, 0x77, 0x77, 0x61, 0xda, 0xa7, 0xe6,
+	0xf6, 0x44, 0xeb, 0x0b, 0x67, 0x43, 0x0e, 0xc6, 0xcd, 0xe2, 0xf5, 0x93, 0xfd, 0xde, 0x8f, 0xed,
+	0xe0, 0x1d, 0xec, 0xc6, 0xfb, 0xa9, 0xb3, 0xf1, 0xba, 0xae, 0xc5, 0x6c, 0xbc, 0x87, 0x2d, 0x37,
+	0xee, 0x16, 0x1c, 0xe3, 0xc0, 0xb7, 0x78, 0x0b, 0xfe, 0x34, 0x03, 0xa3, 0x96, 0x8b, 0x02, 0x91,
+	0x0e, 0x24, 0x27, 0x58, 0xd7, 0x2a, 0xe5, 0x0e, 0xb7, 0x96, 0x01, 0x5d, 0xab, 0xdc, 0x6f, 0x39,
+	0x54, 0xb1, 0xa4, 0x1b, 0xad, 0x7a, 0x8e, 0xc4, 0xe9, 0x91, 0x74, 0xe3, 0x7e, 0xc4, 0xe1, 0xdc,
+	0xbb, 0x0f, 0x35, 0xf2, 0x39, 0x02, 0x2e, 0x28, 0x80, 0xac, 0x26, 0xea, 0x30, 0xac, 0x91, 0x88,
+	0xd6, 0xbd, 0x1c, 0x53, 0x16, 0x5e, 0xad, 0x2d, 0xcd, 0x7b, 0x5a, 0x23, 0x07, 0x7d, 0x6f, 0xca,
+	0xf9, 0xab, 0xbf, 0xfd, 0x4d, 0x73, 0x08, 0x9b, 0xf6, 0xaf, 0x6d, 0x07, 0xc1, 0xb7, 0xe9, 0x3d,
+	0xf4, 0x67, 0x04, 0xd9, 0x10, 0xf4, 0x87, 0xf1, 0xac, 0xa7, 0xa1, 0x25, 0x72, 0x40, 0xaf, 0xad,
+	0x2b, 0xac, 0xdb, 0xde, 0x92, 0x75, 0x83, 0x6a, 0x72, 0x45, 0x54, 0x56, 0xd5, 0x4d, 0xea, 0x79,
+	0x62, 0x6f, 0x11, 0xb9, 0xba, 0x65, 0x58, 0x86, 0x8e, 0x08, 0x6c, 0xc4, 0xff, 0x18, 0xce, 0x06,
+	0x4a, 0x31, 0x88, 0x8b, 0xd0, 0xbb, 0x25, 0xeb, 0x06, 0x43, 0x77, 0x31, 0x06, 0x5d, 0x8b, 0x12,
+	0x4b, 0x94, 0xc7, 0x30, 0x60, 0x59, 0x58, 0xa7, 0x54, 0x61, 0x68, 0x78, 0x01, 0x4e, 0x79, 0xe6,
+	0x98, 0xad, 0x39, 0xe8, 0xad, 0x53, 0xaa, 0x30, 0x5b, 0xe7, 0x63, 0x6c, 0x99, 0xa2, 0x2c, 0x08,
+	0x96, 0x18, 0x3f, 0x04, 0xd8, 0xd6, 0x29, 0x6a, 0x62, 0xcd, 0x69, 0x43, 0xfe, 0x8f, 0x08, 0x06,
+	0x7d, 0xd3, 0xcc, 0x58, 0x11, 0xfa, 0xea, 0xd6, 0x0c, 0x33, 0x37, 0x11, 0x67, 0xce, 0x5a, 0xec,
+	0xdc, 0xac, 0x6c, 0x51, 0xbc, 0x06, 0xe7, 0xc9, 0xe6, 0x26, 0xa9, 0x18, 0xf2, 0x36, 0x29, 0x57,
+	0x15, 0xba, 0x21, 0x2a, 0x65, 0x5b, 0x4d, 0x99, 0xc9, 0x97, 0x2b, 0x62, 0xdd, 0xae, 0x21, 0x21,
+	0xe7, 0x2e, 0xbd, 0x69, 0xad, 0x5c, 0xb3, 0x16, 0xde, 0xb5, 0xd7, 0x15, 0xc5, 0x3a, 0x3f, 0x0b,
+	0xe7, 0x2d, 0xa4, 0xf7, 0xe8, 0x43, 0xa2, 0xca, 0x4f, 0xc8, 0xdd, 0x2d, 0x51, 0x23, 0x02, 0xa9,
+	0x50, 0x4d, 0x5a, 0xda, 0x5d, 0x95, 0x9c, 0x4c, 0xf6, 0x43, 0x46, 0xb6, 0x2f, 0x87, 0xbd, 0x42,
+	0x46, 0x96, 0xf8, 0x1d, 0x18, 0x8f, 0x16, 0x6b, 0x5e, 0x2c, 0x35, 0x6b, 0x36, 0xe1, 0xc5, 0x32,
+	0x48, 0x1f, 0x73, 0xdf, 0xd6, 0xc3, 0xcf, 0xc3, 0x64, 0xb8, 0xe5, 0x65, 0xa2, 0xd2, 0x9a, 0x83,
+	0x79, 0x08, 0x8e, 0x4a, 0xe6, 0x98, 0xf1, 0x3b, 0xf6, 0x80, 0x7f, 0x0a, 0x53, 0xb1, 0xf2, 0x07,
+	0x06, 0x7e, 0x0e, 0x26, 0xc2, 0x8c, 0xeb, 0xb7, 0x1f, 0xab, 0x44, 0xf2, 0x60, 0xa7, 0x8f, 0x55,
+	0xa2, 0x39, 0xd8, 0xad, 0x01, 0xff, 0x53, 0x98, 0x8c, 0x13, 0x67, 0xd0, 0x05, 0x38, 0x66, 0x9b,
+	0x4c, 0x7a, 0xdf, 0x09, 0xc7, 0xee, 0x28, 0xe2, 0x27, 0x58, 0xa9, 0x2c, 0x2a, 0x4a, 0x10, 0x00,
+	0xa7, 0xf8, 0x9f, 0xc0, 0x78, 0xf4, 0xb2, 0x03, 0x84, 0x38, 0xc5, 0xe2, 0xbb, 0x26, 0xea, 0x46,
+	0xc0, 0x72, 0xb7, 0x9e, 0xf9, 0x6b, 0x30, 0x19, 0xb7, 0x90, 0xc1, 0x6c, 0xad, 0xfc, 0x29, 0x37,
+	0x85, 0x86, 0xe8, 0x77, 0x50, 0x5a, 0xd4, 0x75, 0x62, 0xb8, 0x71, 0x28, 0xc3, 0x64, 0xdc, 0x42,
+	0x66, 0x62, 0x16, 0x8e, 0x6e, 0x8b, 0x4a, 0xc3, 0x79, 0xa7, 0x8e, 0xfa, 0x0e, 0x2a, 0xc7, 0xfb,
+	0x22, 0x95, 0x9d, 0x1b, 0xa8, 0xbd, 0x9a, 0x1f, 0x81, 0xe1, 0xa6, 0x81, 0x66, 0x63, 0x13, 0x89,
+	0xd7, 0x21, 0x1b, 0xfc, 0xc5, 0x35, 0x39, 0x0c, 0x7d, 0x86, 0x09, 0xc9, 0x25, 0x3f, 0xed, 0x11,
+	0xbe, 0x0e, 0xa3, 0x71, 0x5b, 0xca, 0x70, 0x35, 0x78, 0x27, 0x79, 0x93, 0x9d, 0xf3, 0xeb, 0x1a,
+	0xdd, 0x96, 0x25, 0xa2, 0xf9, 0xed, 0xda, 0x65, 0x3d, 0x02, 0xc7, 0x44, 0xfb, 0x24, 0x63, 0x76,
+	0x9d, 0x21, 0xff, 0x04, 0x5e, 0x8b, 0x90, 0x8e, 0x41, 0x3d, 0x07, 0x67, 0xeb, 0x4c, 0x2e, 0x1c,
+	0xf7, 0x48, 0xbd, 0x4d, 0x35, 0x43, 0x7e, 0x95, 0x1d, 0x62, 0xbe, 0x24, 0xad, 0xd1, 0xca, 0x43,
+	0xf3, 0x40, 0x89, 0xc0, 0x4c, 0x80, 0x0f, 0x97, 0xf3, 0x82, 0x0e, 0xe4, 0x99, 0xa7, 0xe0, 0x15,
+	0xb2, 0x53, 0x97, 0x35, 0xfb, 0x22, 0x6a, 0xf2, 0xd6, 0x0c, 0x68, 0x7f, 0x73, 0xfa, 0x9e, 0x5c,
+	0x23, 0xfc, 0x12, 0x4c, 0x06, 0x9b, 0x59, 0x54, 0x14, 0xfa, 0x58, 0x91, 0x75, 0x23, 0x3e, 0xbc,
+	0xbf, 0x46, 0x30, 0x15, 0xab, 0x84, 0x01, 0xfe, 0x11, 0x1c, 0x23, 0xaa, 0xa1, 0xc9, 0xee, 0xa5,
+	0x78, 0xbe, 0x93, 0xc6, 0xf4, 0xe9, 0x2c, 0xa9, 0x86, 0xb6, 0xeb, 0x34, 0x29, 0x53, 0xca, 0xaf,
+	0xb1, 0x7d, 0xa4, 0x79, 0xad, 0xa0, 0xaa, 0x54, 0xa4, 0xb5, 0xba, 0x22, 0x8b, 0x6a, 0xc5, 0xb9,
+	0x4e, 0xe2, 0x89, 0x60, 0xe6, 0xaf, 0x95, 0x57, 0xfe, 0x3b, 0x82, 0xf1, 0x68, 0x75, 0xcc, 0xad,
+	0x69, 0x38, 0x65, 0xbe, 0xdb, 0xcc, 0x8a, 0x28, 0x93, 0x9d, 0x0a, 0x21, 0x12, 0xb1, 0xfb, 0xfa,
+	0xb8, 0xf0, 0x8a, 0xf9, 0xa1, 0x28, 0xd6, 0x4b, 0x6c, 0x1a, 0x7f, 0x0f, 0x06, 0xaa, 0x9a, 0x58,
+	0x21, 0xe5, 0x3a, 0xd1, 0x64, 0x2a, 0x95, 0x89, 0x2a, 0xb1, 0x5b, 0x24, 0x97, 0xb7, 0x7f, 0x71,
+	0xc8, 0x3b, 0xbf, 0x38, 0xe4, 0xef, 0x39, 0xbf, 0x38, 0x2c, 0xf5, 0xbe, 0xfb, 0x55, 0x0e, 0x09,
+	0xfd, 0x96, 0xe4, 0xba, 0x25, 0x58, 0x52, 0x25, 0x7c, 0x1e, 0x4e, 0xaa, 0x54, 0x2d, 0x57, 0x18,
+	0x22, 0xc3, 0x7a, 0x26, 0x1d, 0x17, 0x5e, 0x56, 0xa9, 0xea, 0xa0, 0x34, 0xa6, 0x57, 0xe0, 0x4c,
+	0x5b, 0x14, 0xef, 0xda, 0x75, 0x02, 0xd0, 0xb7, 0x76, 0xbb, 0xf8, 0x76, 0x69, 0x79, 0xa0, 0x07,
+	0xbf, 0x0c, 0xc7, 0xdf, 0xb9, 0xc5, 0x46, 0x08, 0x9f, 0x82, 0x93, 0xe6, 0xff, 0xe5, 0xd2, 0x83,
+	0xf5, 0x55, 0x61, 0xf5, 0xd6, 0xcd, 0x81, 0xcc, 0xcc, 0xf3, 0x09, 0x38, 0x6a, 0x45, 0x03, 0xff,
+	0x01, 0x01, 0x34, 0x6f, 0xda, 0x78, 0x36, 0x26, 0x87, 0xc1, 0x3f, 0x92, 0x70, 0x57, 0x3b, 0x15,
+	0x63, 0x24, 0xd9, 0xf4, 0xcf, 0xff, 0xf5, 0x9f, 0xdf, 0x65, 0xc6, 0x31, 0xcf, 0x7e, 0xae, 0x29,
+	0xb4, 0xfe, 0x02, 0xe4, 0xb9, 0xac, 0x7f, 0x82, 0xe0, 0x84, 0xab, 0x02, 0x5f, 0xe9, 0xc8, 0xa2,
+	0x83, 0x73, 0xb6, 0x43, 0x29, 0x06, 0xf3, 0xbb, 0x16, 0xcc, 0x59, 0x7c, 0x39, 0x1e, 0x66, 0xe1,
+	0xa9, 0xbf, 0x1a, 0x9f, 0xe1, 0x3d, 0x04, 0x43, 0x41, 0xb4, 0x3d, 0x5e, 0xe8, 0x08, 0x4c, 0x3b,
+	0xf7, 0xc2, 0xdd, 0x48, 0xaf, 0x80, 0x39, 0x76, 0xd3, 0x72, 0x6c, 0x11, 0x2f, 0xa4, 0x70, 0xac,
+	0xe0, 0x79, 0x38, 0xe3, 0x5f, 0x66, 0xe0, 0x5c, 0x24, 0xe3, 0x8d, 0xdf, 0xea, 0x08, 0x6c, 0x04,
+	0xe5, 0xc4, 0xad, 0xee, 0x83, 0x26, 0xe6, 0xff, 0x1d, 0xcb, 0xff, 0xb7, 0xf1, 0x6a, 0x1a, 0xff,
+	0x9b, 0xac, 0x91, 0x37, 0x12, 0xff, 0x46, 0x00, 0x4d, 0x53, 0xc9, 0x1a, 0xaa, 0x8d, 0x19, 0xe6,
+	0xae, 0x76, 0x2a, 0xc6, 0x1c, 0x7a, 0x60, 0x39, 0x24, 0xe0, 0xf5, 0x2e, 0x13, 0x5a, 0x78, 0xea,
+	0x7f, 0xac, 0x3e, 0xc3, 0xbf, 0xc8, 0xc0, 0x60, 0x40, 0x2c, 0xf1, 0x7c, 0x12, 0xa4, 0xe1, 0x1c,
+	0x38, 0xb7, 0x90, 0x5a, 0x9e, 0xb9, 0x5c, 0xb3, 0x5c, 0xae, 0x62, 0xb2, 0xdf, 0x2e, 0x07, 0x26,
+	0x18, 0x7f, 0x8e, 0x60, 0x28, 0x88, 0xf4, 0x4d, 0xd6, 0xce, 0x11, 0x34, 0x77, 0xb2, 0x76, 0x8e,
+	0xe2, 0x9b, 0xf9, 0x37, 0xad, 0x50, 0x5c, 0xc5, 0x57, 0xc2, 0x42, 0x11, 0x99, 0x61, 0xb3, 0x87,
+	0x23, 0x29, 0xd3, 0x64, 0x3d, 0x9c, 0x84, 0x36, 0x4e, 0xd6, 0xc3, 0x89, 0xf8, 0xdb, 0xf8, 0x1e,
+	0x76, 0xfd, 0x4c, 0x98, 0x62, 0x1d, 0xff, 0x03, 0xc1, 0x49, 0x1f, 0x31, 0x88, 0xaf, 0x25, 0xc1,
+	0x1b, 0x44, 0xc6, 0x72, 0xd7, 0x53, 0x48, 0x32, 0xcf, 0x56, 0x2d, 0xcf, 0x8a, 0x78, 0x31, 0x8d,
+	0x67, 0x9a, 0x0f, 0xff, 0x97, 0x08, 0x06, 0x03, 0x98, 0xb5, 0x64, 0xdd, 0x1b, 0xce, 0x24, 0x72,
+	0x0b, 0xa9, 0xe5, 0x99, 0x8f, 0x2b, 0x96, 0x8f, 0x37, 0xf0, 0x7c, 0x1a, 0x1f, 0x3d, 0xb7, 0x83,
+	0xff, 0x21, 0xc0, 0xed, 0x76, 0xf0, 0x5c, 0x3a, 0x7c, 0x8e, 0x7b, 0xf3, 0x69, 0xc5, 0x99, 0x77,
+	0xdf, 0xb7, 0xbc, 0xbb, 0x83, 0x6f, 0x77, 0xe7, 0x5d, 0xfb, 0xa5, 0xe2, 0x6f, 0x08, 0xfa, 0xfd,
+	0x8c, 0x16, 0x4e, 0x54, 0x68, 0x81, 0x04, 0x1c, 0xf7, 0x46, 0x1a, 0x51, 0xe6, 0xe2, 0x35, 0xcb,
+	0xc5, 0x19, 0xfc, 0x7a, 0x98, 0x8b, 0x5b, 0xae, 0x5c, 0x59, 0x56, 0x37, 0x69, 0xe1, 0xa9, 0xcd,
+	0xee, 0x3d, 0xc3, 0xbf, 0x45, 0xd0, 0x6b, 0x32, 0x65, 0xb8, 0x90, 0xc4, 0xbc, 0x87, 0xa2, 0xe3,
+	0x5e, 0x4f, 0x2e, 0xc0, 0x50, 0x8e, 0x5b, 0x28, 0xb3, 0xf8, 0xd5, 0x30, 0x94, 0x26, 0x4d, 0x87,
+	0xdf, 0x43, 0xd0, 0x67, 0x93, 0x69, 0xf8, 0x52, 0x22, 0x13, 0x5e, 0x3a, 0x8f, 0x9b, 0xe9, 0x44,
+	0x84, 0xe1, 0x9a, 0xb4, 0x70, 0x8d, 0xe1, 0x6c, 0x28, 0x2e, 0x1b, 0xce, 0x07, 0x08, 0xce, 0x04,
+	0x10, 0x10, 0x26, 0x89, 0x86, 0x97, 0x92, 0xd8, 0x8d, 0x26, 0xee, 0xb8, 0x62, 0x57, 0x3a, 0x98,
+	0x33, 0x3d, 0xf8, 0x23, 0x04, 0x5c, 0x38, 0x63, 0x86, 0x4b, 0xa9, 0xad, 0x78, 0x19, 0x3b, 0x6e,
+	0xa5, 0x5b, 0x35, 0x2e, 0xde, 0x0f, 0x11, 0x8c, 0x86, 0xb2, 0x64, 0x78, 0x39, 0xa5, 0x1d, 0x1f,
+	0x47, 0xc7, 0x95, 0xba, 0xd4, 0xe2, 0x82, 0x35, 0x6b, 0x20, 0x84, 0x2d, 0x4b, 0x56, 0x03, 0xd1,
+	0x8c, 0x1c, 0x57, 0xec, 0x4a, 0x87, 0x2f, 0xa6, 0xa1, 0x7c, 0x59, 0xb2, 0x98, 0xc6, 0xf1, 0x72,
+	0x5c, 0xa9, 0x4b, 0x2d, 0x2d, 0x05, 0x10, 0xc2, 0xbc, 0x25, 0x2d, 0x80, 0x68, 0x86, 0x8f, 0x2b,
+	0x75, 0xa9, 0xc5, 0x05, 0xfb, 0x1b, 0x04, 0xa7, 0xda, 0xb8, 0xba, 0x64, 0x2f, 0x8c, 0x36, 0x31,
+	0x6e, 0x2e, 0x95, 0x98, 0x07, 0xcd, 0xef, 0x11, 0x0c, 0x05, 0xd1, 0x70, 0xc9, 0x2e, 0xc2, 0x11,
+	0xf4, 0x1f, 0x77, 0x23, 0xbd, 0x02, 0x17, 0xdd, 0x7b, 0x08, 0x4e, 0x07, 0x13, 0x75, 0xd7, 0x3b,
+	0xee, 0x47, 0x47, 0x94, 0x5b, 0x4c, 0x2d, 0x1a, 0xb5, 0x47, 0xfa, 0xa8, 0xb0, 0x14, 0x7b, 0x64,
+	0x10, 0xc7, 0xc7, 0xad, 0x74, 0xab, 0xc6, 0xb7, 0xed, 0x84, 0x90, 0x66, 0xc9, 0xb6, 0x9d, 0x68,
+	0x02, 0x8f, 0x2b, 0x76, 0xa5, 0xc3, 0x81, 0xb9, 0xf4, 0x83, 0xcf, 0xf6, 0xb2, 0xe8, 0xf9, 0x5e,
+	0x16, 0x7d, 0xbd, 0x97, 0x45, 0xef, 0xbe, 0xc8, 0xf6, 0x3c, 0x7f, 0x91, 0xed, 0xf9, 0xe2, 0x45,
+	0xb6, 0xe7, 0x87, 0x0b, 0x55, 0xd9, 0xd8, 0x6a, 0x6c, 0xe4, 0x2b, 0xb4, 0x56, 0x90, 0x1f, 0x29,
+	0x0d, 0x5d, 0xa6, 0xaa, 0xac, 0x56, 0x0a, 0xb6, 0x59, 0xd9, 0xd8, 0xbd, 0xc8, 0x4c, 0x5e, 0xac,
+	0x51, 0xa9, 0xa1, 0x90, 0xc2, 0x8e, 0x7b, 0x0a, 0x1b, 0xbb, 0x75, 0xa2, 0x6f, 0xf4, 0x59, 0x24,
+	0xde, 0xe5, 0x6f, 0x06, 0x00, 0xd9, 0x38, 0x13, 0x1d, 0x3c, 0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2371,8 +2732,14 @@ type QueryClient interface {
 	TotalTokenizeSharedAssets(ctx context.Context, in *QueryTotalTokenizeSharedAssetsRequest, opts ...grpc.CallOption) (*QueryTotalTokenizeSharedAssetsResponse, error)
 	// Query for total liquid staked (including tokenized shares or owned by an liquid staking provider)
 	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStaked, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
+	// Query for the tokens liquid staked by a single liquid staking provider
+	ProviderLiquidStaked(ctx context.Context, in *QueryProviderLiquidStakedRequest, opts ...grpc.CallOption) (*QueryProviderLiquidStakedResponse, error)
 	// Query tokenize share locks
 	TokenizeShareLockInfo(ctx context.Context, in *QueryTokenizeShareLockInfo, opts ...grpc.CallOption) (*QueryTokenizeShareLockInfoResponse, error)
+	// Query the tokenize share lock allowlist of an account
+	TokenizeShareLockAllowlist(ctx context.Context, in *QueryTokenizeShareLockAllowlistRequest, opts ...grpc.CallOption) (*QueryTokenizeShareLockAllowlistResponse, error)
+	// Query whether a validator's liquid shares exceed its validator bond cap
+	ValidatorBondCompliance(ctx context.Context, in *QueryValidatorBondComplianceRequest, opts ...grpc.CallOption) (*QueryValidatorBondComplianceResponse, error)
 }
 
 type queryClient struct {
@@ -2572,6 +2939,15 @@ func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiqui
 	return out, nil
 }
 
+func (c *queryClient) ProviderLiquidStaked(ctx context.Context, in *QueryProviderLiquidStakedRequest, opts ...grpc.CallOption) (*QueryProviderLiquidStakedResponse, error) {
+	out := new(QueryProviderLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.staking.v1beta1.Query/ProviderLiquidStaked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TokenizeShareLockInfo(ctx context.Context, in *QueryTokenizeShareLockInfo, opts ...grpc.CallOption) (*QueryTokenizeShareLockInfoResponse, error) {
 	out := new(QueryTokenizeShareLockInfoResponse)
 	err := c.cc.Invoke(ctx, "/liquidstaking.staking.v1beta1.Query/TokenizeShareLockInfo", in, out, opts...)
@@ -2581,6 +2957,24 @@ func (c *queryClient) TokenizeShareLockInfo(ctx context.Context, in *QueryTokeni
 	return out, nil
 }
 
+func (c *queryClient) TokenizeShareLockAllowlist(ctx context.Context, in *QueryTokenizeShareLockAllowlistRequest, opts ...grpc.CallOption) (*QueryTokenizeShareLockAllowlistResponse, error) {
+	out := new(QueryTokenizeShareLockAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.staking.v1beta1.Query/TokenizeShareLockAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorBondCompliance(ctx context.Context, in *QueryValidatorBondComplianceRequest, opts ...grpc.CallOption) (*QueryValidatorBondComplianceResponse, error) {
+	out := new(QueryValidatorBondComplianceResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.staking.v1beta1.Query/ValidatorBondCompliance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status.
@@ -2629,8 +3023,14 @@ type QueryServer interface {
 	TotalTokenizeSharedAssets(context.Context, *QueryTotalTokenizeSharedAssetsRequest) (*QueryTotalTokenizeSharedAssetsResponse, error)
 	// Query for total liquid staked (including tokenized shares or owned by an liquid staking provider)
 	TotalLiquidStaked(context.Context, *QueryTotalLiquidStaked) (*QueryTotalLiquidStakedResponse, error)
+	// Query for the tokens liquid staked by a single liquid staking provider
+	ProviderLiquidStaked(context.Context, *QueryProviderLiquidStakedRequest) (*QueryProviderLiquidStakedResponse, error)
 	// Query tokenize share locks
 	TokenizeShareLockInfo(context.Context, *QueryTokenizeShareLockInfo) (*QueryTokenizeShareLockInfoResponse, error)
+	// Query the tokenize share lock allowlist of an account
+	TokenizeShareLockAllowlist(context.Context, *QueryTokenizeShareLockAllowlistRequest) (*QueryTokenizeShareLockAllowlistResponse, error)
+	// Query whether a validator's liquid shares exceed its validator bond cap
+	ValidatorBondCompliance(context.Context, *QueryValidatorBondComplianceRequest) (*QueryValidatorBondComplianceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2700,9 +3100,18 @@ func (*UnimplementedQueryServer) TotalTokenizeSharedAssets(ctx context.Context,
 func (*UnimplementedQueryServer) TotalLiquidStaked(ctx context.Context, req *QueryTotalLiquidStaked) (*QueryTotalLiquidStakedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
 }
+func (*UnimplementedQueryServer) ProviderLiquidStaked(ctx context.Context, req *QueryProviderLiquidStakedRequest) (*QueryProviderLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderLiquidStaked not implemented")
+}
 func (*UnimplementedQueryServer) TokenizeShareLockInfo(ctx context.Context, req *QueryTokenizeShareLockInfo) (*QueryTokenizeShareLockInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareLockInfo not implemented")
 }
+func (*UnimplementedQueryServer) TokenizeShareLockAllowlist(ctx context.Context, req *QueryTokenizeShareLockAllowlistRequest) (*QueryTokenizeShareLockAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareLockAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorBondCompliance(ctx context.Context, req *QueryValidatorBondComplianceRequest) (*QueryValidatorBondComplianceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorBondCompliance not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -3086,6 +3495,24 @@ func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProviderLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.staking.v1beta1.Query/ProviderLiquidStaked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderLiquidStaked(ctx, req.(*QueryProviderLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TokenizeShareLockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTokenizeShareLockInfo)
 	if err := dec(in); err != nil {
@@ -3104,6 +3531,42 @@ func _Query_TokenizeShareLockInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenizeShareLockAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareLockAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareLockAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.staking.v1beta1.Query/TokenizeShareLockAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareLockAllowlist(ctx, req.(*QueryTokenizeShareLockAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorBondCompliance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorBondComplianceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorBondCompliance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.staking.v1beta1.Query/ValidatorBondCompliance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorBondCompliance(ctx, req.(*QueryValidatorBondComplianceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "liquidstaking.staking.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -3192,11 +3655,23 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalLiquidStaked",
 			Handler:    _Query_TotalLiquidStaked_Handler,
 		},
+		{
+			MethodName: "ProviderLiquidStaked",
+			Handler:    _Query_ProviderLiquidStaked_Handler,
+		},
 		{
 			MethodName: "TokenizeShareLockInfo",
 			Handler:    _Query_TokenizeShareLockInfo_Handler,
 		},
-	},
+		{
+			MethodName: "TokenizeShareLockAllowlist",
+			Handler:    _Query_TokenizeShareLockAllowlist_Handler,
+		},
+		{
+			MethodName: "ValidatorBondCompliance",
+			Handler:    _Query_ValidatorBondCompliance_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "staking/v1beta1/query.proto",
 }
@@ -4289,6 +4764,13 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EffectiveGlobalLiquidStakingCap) > 0 {
+		i -= len(m.EffectiveGlobalLiquidStakingCap)
+		copy(dAtA[i:], m.EffectiveGlobalLiquidStakingCap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EffectiveGlobalLiquidStakingCap)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -4703,6 +5185,80 @@ func (m *QueryTotalLiquidStakedResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.GlobalLiquidStakingCap) > 0 {
+		i -= len(m.GlobalLiquidStakingCap)
+		copy(dAtA[i:], m.GlobalLiquidStakingCap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GlobalLiquidStakingCap)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		i -= len(m.Tokens)
+		copy(dAtA[i:], m.Tokens)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tokens)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderLiquidStakedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderLiquidStakedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderLiquidStakedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderLiquidStakedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderLiquidStakedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderLiquidStakedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderLiquidStakingCap) > 0 {
+		i -= len(m.ProviderLiquidStakingCap)
+		copy(dAtA[i:], m.ProviderLiquidStakingCap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderLiquidStakingCap)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Tokens) > 0 {
 		i -= len(m.Tokens)
 		copy(dAtA[i:], m.Tokens)
@@ -4780,6 +5336,156 @@ func (m *QueryTokenizeShareLockInfoResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTokenizeShareLockAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareLockAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareLockAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareLockAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareLockAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareLockAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorBondComplianceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorBondComplianceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorBondComplianceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorBondComplianceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorBondComplianceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorBondComplianceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NonCompliant {
+		i--
+		if m.NonCompliant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GracePeriodEnd != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.GracePeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.GracePeriodEnd):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintQuery(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BondCapExceeded {
+		i--
+		if m.BondCapExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -5223,6 +5929,10 @@ func (m *QueryParamsResponse) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.EffectiveGlobalLiquidStakingCap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -5385,6 +6095,40 @@ func (m *QueryTotalLiquidStakedResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.GlobalLiquidStakingCap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderLiquidStakedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderLiquidStakedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tokens)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderLiquidStakingCap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -5418,24 +6162,84 @@ func (m *QueryTokenizeShareLockInfoResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryTokenizeShareLockAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryTokenizeShareLockAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+
+func (m *QueryValidatorBondComplianceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorBondComplianceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BondCapExceeded {
+		n += 2
+	}
+	if m.GracePeriodEnd != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.GracePeriodEnd)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NonCompliant {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -8313,6 +9117,38 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveGlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveGlobalLiquidStakingCap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9314,6 +10150,38 @@ func (m *QueryTotalLiquidStakedResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Tokens = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalLiquidStakingCap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9335,7 +10203,7 @@ func (m *QueryTotalLiquidStakedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenizeShareLockInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderLiquidStakedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9358,10 +10226,10 @@ func (m *QueryTokenizeShareLockInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenizeShareLockInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderLiquidStakedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenizeShareLockInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderLiquidStakedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9417,7 +10285,7 @@ func (m *QueryTokenizeShareLockInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenizeShareLockInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderLiquidStakedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9440,15 +10308,15 @@ func (m *QueryTokenizeShareLockInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenizeShareLockInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderLiquidStakedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenizeShareLockInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderLiquidStakedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9476,11 +10344,11 @@ func (m *QueryTokenizeShareLockInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Tokens = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderLiquidStakingCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9508,7 +10376,89 @@ func (m *QueryTokenizeShareLockInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpirationTime = string(dAtA[iNdEx:postIndex])
+			m.ProviderLiquidStakingCap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizeShareLockInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareLockInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareLockInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9531,6 +10481,494 @@ func (m *QueryTokenizeShareLockInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTokenizeShareLockInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareLockInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareLockInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpirationTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizeShareLockAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareLockAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareLockAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizeShareLockAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareLockAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareLockAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, TokenizeShareLockAllowlistEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorBondComplianceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorBondComplianceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorBondComplianceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorBondComplianceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorBondComplianceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorBondComplianceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondCapExceeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BondCapExceeded = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GracePeriodEnd == nil {
+				m.GracePeriodEnd = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.GracePeriodEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonCompliant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonCompliant = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -859,6 +859,20 @@ type Params struct {
 	// validator_liquid_staking_cap represents a cap on the portion of stake that
 	// comes from liquid staking providers for a specific validator
 	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
+	// global_liquid_staking_cap_schedule is an optional schedule of (time, cap)
+	// points that the global liquid staking cap is linearly interpolated between
+	GlobalLiquidStakingCapSchedule []GlobalLiquidStakingCapScheduleEntry `protobuf:"bytes,10,rep,name=global_liquid_staking_cap_schedule,json=globalLiquidStakingCapSchedule,proto3" json:"global_liquid_staking_cap_schedule" yaml:"global_liquid_staking_cap_schedule"`
+	// provider_liquid_staking_cap represents a cap on the portion of stake that
+	// comes from any single liquid staking provider
+	ProviderLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=provider_liquid_staking_cap,json=providerLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"provider_liquid_staking_cap" yaml:"provider_liquid_staking_cap"`
+	// validator_bond_first_loss_fraction is the maximum fraction of a
+	// validator's validator bond that absorbs a slash before the remainder is
+	// shared by all delegators. Zero disables bond-first slashing
+	ValidatorBondFirstLossFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=validator_bond_first_loss_fraction,json=validatorBondFirstLossFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_first_loss_fraction" yaml:"validator_bond_first_loss_fraction"`
+	// validator_bond_grace_period is the time a validator whose liquid shares
+	// exceed its validator bond cap after a slash or jailing has to top up its
+	// validator bond before it is flagged as non-compliant
+	ValidatorBondGracePeriod time.Duration `protobuf:"bytes,13,opt,name=validator_bond_grace_period,json=validatorBondGracePeriod,proto3,stdduration" json:"validator_bond_grace_period" yaml:"validator_bond_grace_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -928,6 +942,67 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetGlobalLiquidStakingCapSchedule() []GlobalLiquidStakingCapScheduleEntry {
+	if m != nil {
+		return m.GlobalLiquidStakingCapSchedule
+	}
+	return nil
+}
+
+func (m *Params) GetValidatorBondGracePeriod() time.Duration {
+	if m != nil {
+		return m.ValidatorBondGracePeriod
+	}
+	return 0
+}
+
+// GlobalLiquidStakingCapScheduleEntry is a point in the global liquid staking
+// cap schedule, specifying the cap that applies from the given time
+type GlobalLiquidStakingCapScheduleEntry struct {
+	Time time.Time                              `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	Cap  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cap"`
+}
+
+func (m *GlobalLiquidStakingCapScheduleEntry) Reset()         { *m = GlobalLiquidStakingCapScheduleEntry{} }
+func (m *GlobalLiquidStakingCapScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*GlobalLiquidStakingCapScheduleEntry) ProtoMessage()    {}
+func (*GlobalLiquidStakingCapScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{16}
+}
+func (m *GlobalLiquidStakingCapScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalLiquidStakingCapScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GlobalLiquidStakingCapScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GlobalLiquidStakingCapScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalLiquidStakingCapScheduleEntry.Merge(m, src)
+}
+func (m *GlobalLiquidStakingCapScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalLiquidStakingCapScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalLiquidStakingCapScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalLiquidStakingCapScheduleEntry proto.InternalMessageInfo
+
+func (m *GlobalLiquidStakingCapScheduleEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -938,7 +1013,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{16}
+	return fileDescriptor_76a7656dabf68054, []int{17}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -993,7 +1068,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{17}
+	return fileDescriptor_76a7656dabf68054, []int{18}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1041,7 +1116,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{18}
+	return fileDescriptor_76a7656dabf68054, []int{19}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1170,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{19}
+	return fileDescriptor_76a7656dabf68054, []int{20}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1130,13 +1205,16 @@ type TokenizeShareRecord struct {
 	Owner         string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
 	Validator     string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+	// compound defines whether rewards in the bond denom are delegated back to the validator
+	// rather than sent to the owner
+	Compound bool `protobuf:"varint,5,opt,name=compound,proto3" json:"compound,omitempty"`
 }
 
 func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
 func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
 func (*TokenizeShareRecord) ProtoMessage()    {}
 func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{20}
+	return fileDescriptor_76a7656dabf68054, []int{21}
 }
 func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1193,6 +1271,13 @@ func (m *TokenizeShareRecord) GetValidator() string {
 	return ""
 }
 
+func (m *TokenizeShareRecord) GetCompound() bool {
+	if m != nil {
+		return m.Compound
+	}
+	return false
+}
+
 // PendingTokenizeShareAuthorizations stores a list of addresses that have their
 // tokenize share enablement in progress
 type PendingTokenizeShareAuthorizations struct {
@@ -1203,7 +1288,7 @@ func (m *PendingTokenizeShareAuthorizations) Reset()         { *m = PendingToken
 func (m *PendingTokenizeShareAuthorizations) String() string { return proto.CompactTextString(m) }
 func (*PendingTokenizeShareAuthorizations) ProtoMessage()    {}
 func (*PendingTokenizeShareAuthorizations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{21}
+	return fileDescriptor_76a7656dabf68054, []int{22}
 }
 func (m *PendingTokenizeShareAuthorizations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1239,6 +1324,61 @@ func (m *PendingTokenizeShareAuthorizations) GetAddresses() []string {
 	return nil
 }
 
+// TokenizeShareLockAllowlistEntry is an address that may still be set as the
+// tokenized share owner while the account has tokenization disabled, once
+// the activation time has been reached
+type TokenizeShareLockAllowlistEntry struct {
+	Recipient      string    `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	ActivationTime time.Time `protobuf:"bytes,2,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
+}
+
+func (m *TokenizeShareLockAllowlistEntry) Reset()         { *m = TokenizeShareLockAllowlistEntry{} }
+func (m *TokenizeShareLockAllowlistEntry) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareLockAllowlistEntry) ProtoMessage()    {}
+func (*TokenizeShareLockAllowlistEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{23}
+}
+func (m *TokenizeShareLockAllowlistEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareLockAllowlistEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareLockAllowlistEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareLockAllowlistEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareLockAllowlistEntry.Merge(m, src)
+}
+func (m *TokenizeShareLockAllowlistEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareLockAllowlistEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareLockAllowlistEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareLockAllowlistEntry proto.InternalMessageInfo
+
+func (m *TokenizeShareLockAllowlistEntry) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *TokenizeShareLockAllowlistEntry) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("liquidstaking.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "liquidstaking.staking.v1beta1.HistoricalInfo")
@@ -1257,139 +1397,157 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "liquidstaking.staking.v1beta1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "liquidstaking.staking.v1beta1.Redelegation")
 	proto.RegisterType((*Params)(nil), "liquidstaking.staking.v1beta1.Params")
+	proto.RegisterType((*GlobalLiquidStakingCapScheduleEntry)(nil), "liquidstaking.staking.v1beta1.GlobalLiquidStakingCapScheduleEntry")
 	proto.RegisterType((*DelegationResponse)(nil), "liquidstaking.staking.v1beta1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "liquidstaking.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "liquidstaking.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "liquidstaking.staking.v1beta1.Pool")
 	proto.RegisterType((*TokenizeShareRecord)(nil), "liquidstaking.staking.v1beta1.TokenizeShareRecord")
 	proto.RegisterType((*PendingTokenizeShareAuthorizations)(nil), "liquidstaking.staking.v1beta1.PendingTokenizeShareAuthorizations")
+	proto.RegisterType((*TokenizeShareLockAllowlistEntry)(nil), "liquidstaking.staking.v1beta1.TokenizeShareLockAllowlistEntry")
 }
 
 func init() { proto.RegisterFile("staking/v1beta1/staking.proto", fileDescriptor_76a7656dabf68054) }
 
 var fileDescriptor_76a7656dabf68054 = []byte{
-	// 1935 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xdb, 0x6f, 0x63, 0x47,
-	0x19, 0xf7, 0x71, 0xbc, 0x8e, 0xfd, 0x39, 0xb1, 0x93, 0xd9, 0xb4, 0x38, 0x66, 0x37, 0xb6, 0x8c,
-	0xb6, 0x64, 0x0b, 0xb1, 0x69, 0x90, 0x0a, 0xac, 0x90, 0x50, 0x1c, 0x67, 0xd9, 0xb0, 0xdb, 0xad,
-	0x39, 0xb9, 0x94, 0x16, 0x24, 0x6b, 0x7c, 0xce, 0xac, 0x33, 0xe4, 0xf8, 0x1c, 0xf7, 0xcc, 0x78,
-	0x1b, 0x17, 0x90, 0x10, 0x48, 0xa8, 0x8a, 0x84, 0xb4, 0x4f, 0xa8, 0x2f, 0x2b, 0xad, 0x04, 0xbc,
-	0xa0, 0x3e, 0x56, 0xfc, 0x01, 0x3c, 0x55, 0x48, 0x48, 0x4b, 0x9f, 0x80, 0xa2, 0x50, 0xed, 0xbe,
-	0x20, 0x9e, 0x10, 0xef, 0x48, 0x68, 0x2e, 0xe7, 0x12, 0x3b, 0x5d, 0xaf, 0x57, 0x41, 0xaa, 0xd4,
-	0x97, 0xf8, 0xcc, 0xe5, 0xfb, 0xcd, 0x37, 0xbf, 0xef, 0x36, 0x33, 0x81, 0xcb, 0x8c, 0xe3, 0x43,
-	0xea, 0x76, 0xeb, 0x77, 0x5f, 0xea, 0x10, 0x8e, 0x5f, 0xaa, 0xeb, 0x76, 0xad, 0xef, 0x7b, 0xdc,
-	0x43, 0x97, 0x1d, 0xfa, 0xe6, 0x80, 0xda, 0x41, 0x67, 0xf0, 0xab, 0x27, 0x97, 0x96, 0xba, 0x5e,
-	0xd7, 0x93, 0x33, 0xeb, 0xe2, 0x4b, 0x09, 0x95, 0x96, 0xbb, 0x9e, 0xd7, 0x75, 0x48, 0x5d, 0xb6,
-	0x3a, 0x83, 0x3b, 0x75, 0xec, 0x0e, 0xf5, 0xd0, 0xca, 0xe8, 0x90, 0x3d, 0xf0, 0x31, 0xa7, 0x9e,
-	0xab, 0xc7, 0xcb, 0xa3, 0xe3, 0x9c, 0xf6, 0x08, 0xe3, 0xb8, 0xd7, 0x0f, 0xb0, 0x2d, 0x8f, 0xf5,
-	0x3c, 0xd6, 0x56, 0x8b, 0xaa, 0x46, 0x80, 0xad, 0x5a, 0xf5, 0x0e, 0x66, 0x24, 0xdc, 0x8e, 0xe5,
-	0xd1, 0x00, 0xfb, 0x12, 0x27, 0xae, 0x4d, 0xfc, 0x1e, 0x75, 0x79, 0x9d, 0x0f, 0xfb, 0x84, 0xa9,
-	0xbf, 0x6a, 0xb4, 0x7a, 0xcf, 0x80, 0xfc, 0x0d, 0xca, 0xb8, 0xe7, 0x53, 0x0b, 0x3b, 0xdb, 0xee,
-	0x1d, 0x0f, 0xbd, 0x0c, 0xe9, 0x03, 0x82, 0x6d, 0xe2, 0x17, 0x8d, 0x8a, 0xb1, 0x9a, 0x5b, 0x2f,
-	0xd6, 0x22, 0x84, 0x9a, 0x92, 0xbd, 0x21, 0xc7, 0x1b, 0xa9, 0x0f, 0x4e, 0xca, 0x09, 0x53, 0xcf,
-	0x46, 0xd7, 0x21, 0x7d, 0x17, 0x3b, 0x8c, 0xf0, 0x62, 0xb2, 0x32, 0xb3, 0x9a, 0x5b, 0x5f, 0xad,
-	0x3d, 0x91, 0xc5, 0xda, 0x3e, 0x76, 0xa8, 0x8d, 0xb9, 0x17, 0xe2, 0x28, 0xe9, 0xea, 0x7b, 0x49,
-	0x28, 0x6c, 0x7a, 0xbd, 0x1e, 0x65, 0x8c, 0x7a, 0xae, 0x89, 0x39, 0x61, 0xa8, 0x05, 0x29, 0x1f,
-	0x73, 0x22, 0x35, 0xca, 0x36, 0xbe, 0x29, 0xe6, 0xff, 0xed, 0xa4, 0xfc, 0x42, 0x97, 0xf2, 0x83,
-	0x41, 0xa7, 0x66, 0x79, 0x3d, 0xcd, 0x89, 0xfe, 0x59, 0x63, 0xf6, 0xa1, 0xde, 0x66, 0x93, 0x58,
-	0x1f, 0xbe, 0xbf, 0x06, 0x9a, 0xb2, 0x26, 0xb1, 0x4c, 0x89, 0x84, 0x5e, 0x83, 0x4c, 0x0f, 0x1f,
-	0xb5, 0x25, 0x6a, 0xf2, 0x1c, 0x50, 0x67, 0x7b, 0xf8, 0x48, 0xe8, 0x8a, 0x6c, 0x28, 0x08, 0x60,
-	0xeb, 0x00, 0xbb, 0x5d, 0xa2, 0xf0, 0x67, 0xce, 0x01, 0x7f, 0xbe, 0x87, 0x8f, 0x36, 0x25, 0xa6,
-	0x58, 0xe5, 0x5a, 0xe6, 0xdd, 0x07, 0xe5, 0xc4, 0x3f, 0x1f, 0x94, 0x8d, 0xea, 0x1f, 0x0c, 0x80,
-	0x88, 0x2e, 0x64, 0xc1, 0x82, 0x15, 0xb6, 0xe4, 0xf2, 0x4c, 0xdb, 0xb1, 0x36, 0xc1, 0x1e, 0x23,
-	0x9c, 0x37, 0x32, 0x42, 0xdf, 0x87, 0x27, 0x65, 0xc3, 0x2c, 0x58, 0x23, 0xe6, 0xd8, 0x82, 0xdc,
-	0xa0, 0x6f, 0x63, 0x4e, 0xda, 0xc2, 0x51, 0x25, 0x7f, 0xb9, 0xf5, 0x52, 0x4d, 0x79, 0x71, 0x2d,
-	0xf0, 0xe2, 0xda, 0x6e, 0xe0, 0xc5, 0x0a, 0xeb, 0xde, 0x3f, 0xca, 0x86, 0x09, 0x4a, 0x50, 0x0c,
-	0xc5, 0x36, 0xf1, 0x9e, 0x01, 0xb9, 0x26, 0x61, 0x96, 0x4f, 0xfb, 0x22, 0x2c, 0x50, 0x11, 0x66,
-	0x7b, 0x9e, 0x4b, 0x0f, 0xb5, 0x13, 0x66, 0xcd, 0xa0, 0x89, 0x4a, 0x90, 0xa1, 0x36, 0x71, 0x39,
-	0xe5, 0x43, 0x65, 0x37, 0x33, 0x6c, 0x0b, 0xa9, 0xb7, 0x48, 0x87, 0xd1, 0x80, 0x72, 0x33, 0x68,
-	0xa2, 0xab, 0xb0, 0xc0, 0x88, 0x35, 0xf0, 0x29, 0x1f, 0xb6, 0x2d, 0xcf, 0xe5, 0xd8, 0xe2, 0xc5,
-	0x94, 0x9c, 0x52, 0x08, 0xfa, 0x37, 0x55, 0xb7, 0x00, 0xb1, 0x09, 0xc7, 0xd4, 0x61, 0xc5, 0x0b,
-	0x0a, 0x44, 0x37, 0x63, 0xea, 0x7e, 0x34, 0x0b, 0xd9, 0xd0, 0x7d, 0xd1, 0x26, 0x2c, 0x78, 0x7d,
-	0xe2, 0x8b, 0xef, 0x36, 0xb6, 0x6d, 0x9f, 0x30, 0xa6, 0x1d, 0xb5, 0xf8, 0xe1, 0xfb, 0x6b, 0x4b,
-	0xda, 0x88, 0x1b, 0x6a, 0x64, 0x87, 0xfb, 0xd4, 0xed, 0x9a, 0x85, 0x40, 0x42, 0x77, 0xa3, 0xd7,
-	0x85, 0xdd, 0x5c, 0x46, 0x5c, 0x36, 0x60, 0xed, 0xfe, 0xa0, 0x73, 0x48, 0x86, 0x9a, 0xd7, 0xa5,
-	0x31, 0x5e, 0x37, 0xdc, 0x61, 0xa3, 0xf8, 0xc7, 0x08, 0xda, 0xf2, 0x87, 0x7d, 0xee, 0xd5, 0x5a,
-	0x83, 0xce, 0x4d, 0x32, 0x14, 0xd6, 0xd2, 0x38, 0x2d, 0x09, 0x83, 0x9e, 0x87, 0xf4, 0x0f, 0x31,
-	0x75, 0x88, 0x2d, 0x59, 0xc9, 0x98, 0xba, 0x85, 0x36, 0x20, 0xcd, 0x38, 0xe6, 0x03, 0x26, 0xa9,
-	0xc8, 0xaf, 0x5f, 0x9d, 0xe0, 0x20, 0x0d, 0xcf, 0xb5, 0x77, 0xa4, 0x80, 0xa9, 0x05, 0xd1, 0x2e,
-	0xa4, 0xb9, 0x77, 0x48, 0x5c, 0xcd, 0xd5, 0x54, 0x3e, 0xbe, 0xed, 0xf2, 0x98, 0x8f, 0x6f, 0xbb,
-	0xdc, 0xd4, 0x58, 0xa8, 0x0b, 0x0b, 0x36, 0x71, 0x48, 0x57, 0x32, 0xca, 0x0e, 0xb0, 0x4f, 0x58,
-	0x31, 0x7d, 0x0e, 0x31, 0x54, 0x08, 0x51, 0x77, 0x24, 0x28, 0x32, 0x21, 0x67, 0x47, 0x5e, 0x57,
-	0x9c, 0x95, 0x7c, 0xbf, 0x38, 0x81, 0x86, 0x98, 0x9f, 0xea, 0xcc, 0x15, 0x07, 0x11, 0xae, 0x36,
-	0x70, 0x3b, 0x9e, 0x6b, 0x53, 0xb7, 0xdb, 0x3e, 0x20, 0xb4, 0x7b, 0xc0, 0x8b, 0x99, 0x8a, 0xb1,
-	0x3a, 0x63, 0x16, 0xc2, 0xfe, 0x1b, 0xb2, 0x1b, 0xdd, 0x84, 0x7c, 0x34, 0x55, 0x46, 0x52, 0x76,
-	0x8a, 0x48, 0x9a, 0x0f, 0x65, 0xc5, 0x28, 0x7a, 0x15, 0x20, 0x0a, 0xd3, 0x22, 0x48, 0xa0, 0xab,
-	0x4f, 0x1d, 0xf2, 0x7a, 0x27, 0x31, 0x08, 0xf4, 0x23, 0xf8, 0x3c, 0xf7, 0x38, 0x76, 0xda, 0x77,
-	0x03, 0x4f, 0x6f, 0x8b, 0xf5, 0x02, 0x83, 0xe4, 0xce, 0xc1, 0x20, 0x45, 0xb9, 0x40, 0x54, 0x08,
-	0x84, 0x83, 0x29, 0xcb, 0x38, 0x70, 0x51, 0x2d, 0xae, 0x36, 0x10, 0x2c, 0x3a, 0x77, 0x0e, 0x8b,
-	0x2e, 0x4a, 0xe0, 0x5b, 0x12, 0x57, 0xad, 0x76, 0x6d, 0xee, 0x9d, 0x07, 0xe5, 0x84, 0x8e, 0xee,
-	0x44, 0xb5, 0x05, 0x73, 0xfb, 0xd8, 0xd1, 0x81, 0x49, 0x18, 0x7a, 0x19, 0xb2, 0x38, 0x68, 0x14,
-	0x8d, 0xca, 0xcc, 0x13, 0x03, 0x3b, 0x9a, 0xaa, 0xf2, 0xc5, 0x4f, 0xff, 0x5e, 0x31, 0xaa, 0xbf,
-	0x31, 0x20, 0xdd, 0xdc, 0x6f, 0x61, 0xea, 0xa3, 0x2d, 0x58, 0x8c, 0x7c, 0xfb, 0x69, 0xb3, 0x45,
-	0x14, 0x0e, 0x41, 0xba, 0xd8, 0x82, 0xc5, 0xc8, 0x2c, 0x01, 0x4c, 0x72, 0x12, 0x4c, 0x28, 0xa2,
-	0xfb, 0x47, 0x36, 0x7e, 0x0b, 0x66, 0x95, 0x96, 0x0c, 0x6d, 0xc0, 0x85, 0xbe, 0xf8, 0x90, 0xfb,
-	0xcd, 0xad, 0x5f, 0x99, 0x14, 0x13, 0x52, 0x4c, 0x3b, 0x91, 0x92, 0xac, 0xfe, 0xd7, 0x00, 0x68,
-	0xee, 0xef, 0xef, 0xfa, 0xb4, 0xef, 0x10, 0x7e, 0x5e, 0x1b, 0xbf, 0x05, 0xcf, 0x45, 0x1b, 0x67,
-	0xbe, 0xf5, 0xd4, 0x9b, 0xbf, 0x18, 0x8a, 0xed, 0xf8, 0xd6, 0x99, 0x68, 0x36, 0xe3, 0x21, 0xda,
-	0xcc, 0x53, 0xa3, 0x35, 0x19, 0x3f, 0x9b, 0xcd, 0x37, 0x20, 0x17, 0x6d, 0x9f, 0xa1, 0x9b, 0x90,
-	0xe1, 0xfa, 0x5b, 0x93, 0x7a, 0x75, 0x22, 0xa9, 0x81, 0xb4, 0x26, 0x36, 0x04, 0xa8, 0xfe, 0x36,
-	0x09, 0xd0, 0x54, 0xd4, 0x88, 0x50, 0xfd, 0x54, 0x39, 0x95, 0x28, 0x0a, 0x3a, 0x5c, 0xcf, 0xe3,
-	0xe0, 0xa3, 0xb1, 0xd0, 0x15, 0xc8, 0x9f, 0x4e, 0x44, 0xb2, 0x6a, 0x65, 0xcc, 0xf9, 0xbb, 0xf1,
-	0xf4, 0x31, 0x62, 0x83, 0xe3, 0x24, 0x5c, 0xdc, 0x0b, 0xd2, 0xe4, 0xa7, 0x96, 0xb0, 0xd7, 0x60,
-	0x96, 0xb8, 0xdc, 0xa7, 0x92, 0x31, 0xe1, 0x19, 0x5f, 0x9b, 0xe0, 0x19, 0x67, 0x6c, 0x69, 0xcb,
-	0xe5, 0xfe, 0x50, 0xfb, 0x49, 0x80, 0x36, 0x42, 0xc6, 0x47, 0x49, 0x28, 0x7e, 0x92, 0x24, 0xfa,
-	0x22, 0x14, 0x2c, 0x9f, 0xc8, 0x8e, 0xa0, 0x6a, 0x19, 0xb2, 0x6a, 0xe5, 0x83, 0x6e, 0x5d, 0xb4,
-	0x5e, 0x01, 0x71, 0x1c, 0x14, 0x6e, 0x28, 0xa6, 0x4e, 0x7d, 0xfe, 0xcb, 0x47, 0xc2, 0xb2, 0x6c,
-	0x11, 0x28, 0x50, 0x97, 0x72, 0x8a, 0x9d, 0x76, 0x07, 0x3b, 0xd8, 0xb5, 0x9e, 0xe5, 0xb8, 0x3c,
-	0x7e, 0x94, 0xc8, 0x6b, 0xd0, 0x86, 0xc2, 0x44, 0xfb, 0x30, 0x1b, 0xc0, 0xa7, 0xce, 0x01, 0x3e,
-	0x00, 0x8b, 0x9d, 0x09, 0xff, 0x9a, 0x84, 0x45, 0x93, 0xd8, 0x9f, 0x2d, 0x5a, 0xbf, 0x0f, 0xa0,
-	0xc2, 0x53, 0x24, 0xcf, 0x67, 0x60, 0x76, 0x3c, 0xdc, 0xb3, 0x0a, 0xaf, 0xc9, 0x78, 0x8c, 0xdb,
-	0x3f, 0x27, 0x61, 0x2e, 0xce, 0xed, 0x67, 0xa0, 0x98, 0xa0, 0x56, 0x94, 0x14, 0x52, 0x32, 0x29,
-	0x7c, 0x65, 0x42, 0x52, 0x18, 0x73, 0xbe, 0x27, 0x67, 0x83, 0x07, 0x69, 0x48, 0xb7, 0xb0, 0x8f,
-	0x7b, 0x0c, 0x7d, 0x67, 0xec, 0x1c, 0xaa, 0x6e, 0x8c, 0xcb, 0x63, 0xae, 0xd7, 0xd4, 0xef, 0x16,
-	0xca, 0xf3, 0xde, 0x3d, 0xe3, 0x18, 0x7a, 0x05, 0xf2, 0xe2, 0xfa, 0x1b, 0xee, 0x48, 0x71, 0x39,
-	0x2f, 0xef, 0xaf, 0xe1, 0x41, 0x8f, 0xa1, 0x32, 0xe4, 0xc4, 0xb4, 0x28, 0xed, 0x89, 0x39, 0xd0,
-	0xc3, 0x47, 0x5b, 0xaa, 0x07, 0xad, 0x01, 0x3a, 0x08, 0xdf, 0x25, 0xda, 0x11, 0x13, 0x62, 0xde,
-	0x62, 0x34, 0x12, 0x4c, 0xbf, 0x0c, 0x20, 0x0f, 0xa7, 0x36, 0x71, 0xbd, 0x9e, 0xbe, 0xb8, 0x65,
-	0x45, 0x4f, 0x53, 0x74, 0xa0, 0x1f, 0xc3, 0xc5, 0x1e, 0x75, 0xdb, 0x23, 0x37, 0x63, 0x7d, 0xa9,
-	0xb8, 0x35, 0x9d, 0xc3, 0xfe, 0xe7, 0xa4, 0x5c, 0x1a, 0xe2, 0x9e, 0x73, 0xad, 0x7a, 0x06, 0x64,
-	0xd5, 0x5c, 0xec, 0x51, 0xf7, 0xf4, 0x55, 0x1a, 0xfd, 0xcc, 0x88, 0x7b, 0x86, 0xd4, 0xf3, 0x0e,
-	0xb6, 0xb8, 0xe7, 0xcb, 0x1b, 0x47, 0xb6, 0x71, 0x7b, 0x6a, 0x05, 0x2e, 0x29, 0x05, 0xce, 0x04,
-	0xad, 0xc6, 0xfc, 0x49, 0x94, 0xc4, 0xeb, 0xb2, 0x17, 0xfd, 0xd2, 0x80, 0xe5, 0xae, 0xe3, 0x75,
-	0x62, 0x67, 0x6a, 0xe5, 0x40, 0x6d, 0x0b, 0xf7, 0xe5, 0x0d, 0x25, 0xdb, 0x30, 0xa7, 0x56, 0xa4,
-	0xa2, 0x14, 0xf9, 0x44, 0xe0, 0xaa, 0xf9, 0xbc, 0x1a, 0xd3, 0xe7, 0x6d, 0x35, 0xb2, 0x89, 0xfb,
-	0xe8, 0x57, 0x06, 0x5c, 0x8a, 0xf4, 0x3f, 0x43, 0xa5, 0xac, 0x54, 0x69, 0x6f, 0x6a, 0x95, 0xbe,
-	0x30, 0xca, 0xcd, 0x59, 0x5a, 0x2d, 0x87, 0xc3, 0xa3, 0x8a, 0xc5, 0xd2, 0xce, 0xef, 0x0c, 0x40,
-	0x51, 0x9d, 0x34, 0x09, 0xeb, 0x8b, 0x9b, 0xb5, 0xb8, 0x69, 0x45, 0x91, 0xa6, 0x43, 0x65, 0xe2,
-	0x59, 0x2e, 0x14, 0x08, 0x6e, 0x5a, 0xb1, 0x6c, 0xf6, 0x8d, 0xa8, 0x38, 0x25, 0x75, 0xe0, 0xe9,
-	0x3c, 0xd1, 0xc1, 0x8c, 0xc4, 0x6e, 0x6b, 0x34, 0x90, 0x1e, 0xab, 0x3f, 0x89, 0xea, 0xc7, 0x06,
-	0x2c, 0x8f, 0xa5, 0x80, 0x50, 0x67, 0x02, 0xc8, 0x8f, 0x0d, 0xca, 0x80, 0x1a, 0x6a, 0xdd, 0x9f,
-	0x35, 0xb1, 0x2c, 0xfa, 0x63, 0xe5, 0xee, 0xff, 0x55, 0x66, 0x53, 0xd2, 0x1e, 0x7f, 0x32, 0x60,
-	0x29, 0xae, 0x4c, 0xb8, 0xbb, 0x3d, 0x98, 0x8b, 0xeb, 0xa2, 0xf7, 0xf5, 0xa5, 0x29, 0xf6, 0xa5,
-	0xb7, 0x74, 0x0a, 0x06, 0x7d, 0x2f, 0x4a, 0xc1, 0xea, 0x49, 0xf3, 0xeb, 0xd3, 0x32, 0x15, 0x68,
-	0x38, 0x9a, 0x8a, 0x53, 0xd2, 0x64, 0x3f, 0x4f, 0x42, 0xaa, 0xe5, 0x79, 0x0e, 0xfa, 0x09, 0x2c,
-	0xba, 0x1e, 0x97, 0x41, 0x4c, 0xec, 0xb6, 0x7e, 0x51, 0x51, 0xe5, 0xec, 0xbb, 0xd3, 0x11, 0xf8,
-	0xaf, 0x93, 0xf2, 0x38, 0xd4, 0x08, 0xab, 0x05, 0xd7, 0xe3, 0x0d, 0x39, 0xbe, 0xab, 0xde, 0x5b,
-	0x7c, 0x98, 0x3f, 0xbd, 0xb4, 0x2a, 0x7f, 0xaf, 0x4c, 0xbd, 0xf4, 0xfc, 0x93, 0x96, 0x9d, 0xeb,
-	0xc4, 0xd6, 0xbc, 0x96, 0x11, 0x16, 0xfd, 0xb7, 0xb0, 0xea, 0x2f, 0x0c, 0xb8, 0x28, 0x3b, 0xe9,
-	0xdb, 0x44, 0xde, 0xc7, 0x4d, 0x62, 0x79, 0xbe, 0x8d, 0xf2, 0x90, 0xa4, 0xb6, 0x64, 0x21, 0x65,
-	0x26, 0xa9, 0x8d, 0x96, 0xe0, 0x82, 0xf7, 0x96, 0x4b, 0x7c, 0xfd, 0xec, 0xa7, 0x1a, 0xb2, 0xde,
-	0x78, 0xf6, 0xc0, 0x21, 0x6d, 0x6c, 0x59, 0xde, 0xc0, 0xe5, 0xfa, 0xe9, 0x6f, 0x5e, 0xf5, 0x6e,
-	0xa8, 0x4e, 0x74, 0x09, 0xb2, 0x61, 0xc4, 0xeb, 0x97, 0xbf, 0xa8, 0x43, 0xbb, 0xd7, 0x0f, 0xa0,
-	0xda, 0x22, 0xaa, 0x92, 0xc5, 0xd5, 0xd9, 0x18, 0xf0, 0x03, 0xcf, 0xa7, 0x6f, 0x4b, 0xab, 0x3e,
-	0xf3, 0x6b, 0xc0, 0x8b, 0xbf, 0x37, 0x00, 0xa2, 0x17, 0x34, 0xf4, 0x65, 0xf8, 0x5c, 0xe3, 0xd5,
-	0xdb, 0xcd, 0xf6, 0xce, 0xee, 0xc6, 0xee, 0xde, 0x4e, 0x7b, 0xef, 0xf6, 0x4e, 0x6b, 0x6b, 0x73,
-	0xfb, 0xfa, 0xf6, 0x56, 0x73, 0x21, 0x51, 0x2a, 0x1c, 0xdf, 0xaf, 0xe4, 0xf6, 0x5c, 0xd6, 0x27,
-	0x16, 0xbd, 0x43, 0x89, 0x8d, 0x5e, 0x80, 0xa5, 0xd3, 0xb3, 0x45, 0x6b, 0xab, 0xb9, 0x60, 0x94,
-	0xe6, 0x8e, 0xef, 0x57, 0x32, 0xea, 0x54, 0x4f, 0x6c, 0xb4, 0x0a, 0xcf, 0x8d, 0xcf, 0xdb, 0xbe,
-	0xfd, 0xed, 0x85, 0x64, 0x69, 0xfe, 0xf8, 0x7e, 0x25, 0x1b, 0x1e, 0xff, 0x51, 0x15, 0x50, 0x7c,
-	0xa6, 0xc6, 0x9b, 0x29, 0xc1, 0xf1, 0xfd, 0x4a, 0x5a, 0x79, 0x47, 0x29, 0xf5, 0xce, 0xaf, 0x57,
-	0x12, 0x8d, 0xd7, 0x3f, 0x78, 0xb4, 0x62, 0x3c, 0x7c, 0xb4, 0x62, 0x7c, 0xfc, 0x68, 0xc5, 0xb8,
-	0xf7, 0x78, 0x25, 0xf1, 0xf0, 0xf1, 0x4a, 0xe2, 0x2f, 0x8f, 0x57, 0x12, 0x6f, 0x7c, 0x2b, 0xe6,
-	0x18, 0xf4, 0x4d, 0x67, 0x20, 0x0a, 0x1e, 0x75, 0xad, 0xba, 0x0a, 0x12, 0xca, 0x87, 0x6b, 0x3a,
-	0x40, 0xd6, 0x94, 0x31, 0xea, 0x47, 0xc1, 0xff, 0x59, 0x94, 0xd7, 0x74, 0xd2, 0xf2, 0x60, 0xf1,
-	0xd5, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x82, 0x25, 0xf9, 0xf6, 0x8f, 0x19, 0x00, 0x00,
+	// 2185 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x5d, 0x68, 0x5c, 0xc7,
+	0x15, 0xd6, 0x5d, 0xad, 0x25, 0xed, 0x59, 0x49, 0x2b, 0x8d, 0x94, 0x74, 0x2d, 0xdb, 0x5a, 0xb1,
+	0xc1, 0xa9, 0x95, 0x56, 0xab, 0xda, 0x05, 0x37, 0x35, 0x85, 0x56, 0xab, 0x95, 0x6d, 0xd5, 0x8a,
+	0xb3, 0xbd, 0xfa, 0x49, 0x93, 0x14, 0x2e, 0x77, 0xef, 0x1d, 0xaf, 0xa6, 0xba, 0x7b, 0x67, 0x73,
+	0x67, 0x56, 0xf6, 0xa6, 0x2d, 0x94, 0xf6, 0xc5, 0x18, 0x0a, 0x86, 0x42, 0xc9, 0x8b, 0xc1, 0xf4,
+	0x87, 0x94, 0x12, 0xe8, 0x4b, 0xe8, 0x7b, 0xfb, 0x14, 0x0a, 0x05, 0x37, 0x4f, 0x6d, 0x53, 0xd4,
+	0x60, 0xbf, 0x94, 0x3e, 0x95, 0xbc, 0x17, 0xca, 0xfc, 0xdc, 0x1f, 0xed, 0xae, 0xb5, 0x5a, 0xa3,
+	0x40, 0x20, 0x2f, 0xd2, 0x9d, 0x39, 0x73, 0xbe, 0x39, 0xe7, 0x9b, 0x73, 0x66, 0xce, 0xcc, 0xc2,
+	0x39, 0xc6, 0xed, 0x3d, 0xe2, 0xd7, 0x97, 0xf7, 0x2f, 0xd6, 0x30, 0xb7, 0x2f, 0x2e, 0xeb, 0x76,
+	0xa9, 0x19, 0x50, 0x4e, 0xd1, 0x39, 0x8f, 0xbc, 0xd5, 0x22, 0x6e, 0xd8, 0x19, 0xfe, 0xd7, 0x83,
+	0xe7, 0x66, 0xeb, 0xb4, 0x4e, 0xe5, 0xc8, 0x65, 0xf1, 0xa5, 0x94, 0xe6, 0x4e, 0xd7, 0x29, 0xad,
+	0x7b, 0x78, 0x59, 0xb6, 0x6a, 0xad, 0x5b, 0xcb, 0xb6, 0xdf, 0xd6, 0xa2, 0xf9, 0x4e, 0x91, 0xdb,
+	0x0a, 0x6c, 0x4e, 0xa8, 0xaf, 0xe5, 0x85, 0x4e, 0x39, 0x27, 0x0d, 0xcc, 0xb8, 0xdd, 0x68, 0x86,
+	0xd8, 0x0e, 0x65, 0x0d, 0xca, 0x2c, 0x35, 0xa9, 0x6a, 0x84, 0xd8, 0xaa, 0xb5, 0x5c, 0xb3, 0x19,
+	0x8e, 0xdc, 0x71, 0x28, 0x09, 0xb1, 0xcf, 0x72, 0xec, 0xbb, 0x38, 0x68, 0x10, 0x9f, 0x2f, 0xf3,
+	0x76, 0x13, 0x33, 0xf5, 0x57, 0x49, 0x8b, 0xf7, 0x0d, 0x98, 0xbc, 0x4e, 0x18, 0xa7, 0x01, 0x71,
+	0x6c, 0x6f, 0xdd, 0xbf, 0x45, 0xd1, 0x65, 0x18, 0xd9, 0xc5, 0xb6, 0x8b, 0x83, 0xbc, 0xb1, 0x60,
+	0x5c, 0xc8, 0x5e, 0xca, 0x97, 0x62, 0x84, 0x92, 0xd2, 0xbd, 0x2e, 0xe5, 0xe5, 0xf4, 0x07, 0x07,
+	0x85, 0x21, 0x53, 0x8f, 0x46, 0x57, 0x61, 0x64, 0xdf, 0xf6, 0x18, 0xe6, 0xf9, 0xd4, 0xc2, 0xf0,
+	0x85, 0xec, 0xa5, 0x0b, 0xa5, 0x23, 0x59, 0x2c, 0xed, 0xd8, 0x1e, 0x71, 0x6d, 0x4e, 0x23, 0x1c,
+	0xa5, 0x5d, 0x7c, 0x2f, 0x05, 0xb9, 0x55, 0xda, 0x68, 0x10, 0xc6, 0x08, 0xf5, 0x4d, 0x9b, 0x63,
+	0x86, 0xaa, 0x90, 0x0e, 0x6c, 0x8e, 0xa5, 0x45, 0x99, 0xf2, 0x37, 0xc4, 0xf8, 0x7f, 0x1c, 0x14,
+	0x5e, 0xac, 0x13, 0xbe, 0xdb, 0xaa, 0x95, 0x1c, 0xda, 0xd0, 0x9c, 0xe8, 0x7f, 0x4b, 0xcc, 0xdd,
+	0xd3, 0x6e, 0x56, 0xb0, 0xf3, 0xe1, 0xfb, 0x4b, 0xa0, 0x29, 0xab, 0x60, 0xc7, 0x94, 0x48, 0xe8,
+	0x35, 0x18, 0x6b, 0xd8, 0x77, 0x2c, 0x89, 0x9a, 0x3a, 0x01, 0xd4, 0xd1, 0x86, 0x7d, 0x47, 0xd8,
+	0x8a, 0x5c, 0xc8, 0x09, 0x60, 0x67, 0xd7, 0xf6, 0xeb, 0x58, 0xe1, 0x0f, 0x9f, 0x00, 0xfe, 0x44,
+	0xc3, 0xbe, 0xb3, 0x2a, 0x31, 0xc5, 0x2c, 0x57, 0xc6, 0xde, 0x79, 0x58, 0x18, 0xfa, 0xf7, 0xc3,
+	0x82, 0x51, 0xfc, 0x93, 0x01, 0x10, 0xd3, 0x85, 0x1c, 0x98, 0x72, 0xa2, 0x96, 0x9c, 0x9e, 0xe9,
+	0x75, 0x2c, 0xf5, 0x59, 0x8f, 0x0e, 0xce, 0xcb, 0x63, 0xc2, 0xde, 0x47, 0x07, 0x05, 0xc3, 0xcc,
+	0x39, 0x1d, 0xcb, 0xb1, 0x06, 0xd9, 0x56, 0xd3, 0xb5, 0x39, 0xb6, 0x44, 0xa0, 0x4a, 0xfe, 0xb2,
+	0x97, 0xe6, 0x4a, 0x2a, 0x8a, 0x4b, 0x61, 0x14, 0x97, 0xb6, 0xc2, 0x28, 0x56, 0x58, 0xf7, 0xff,
+	0x55, 0x30, 0x4c, 0x50, 0x8a, 0x42, 0x94, 0x70, 0xe2, 0x3d, 0x03, 0xb2, 0x15, 0xcc, 0x9c, 0x80,
+	0x34, 0x45, 0x5a, 0xa0, 0x3c, 0x8c, 0x36, 0xa8, 0x4f, 0xf6, 0x74, 0x10, 0x66, 0xcc, 0xb0, 0x89,
+	0xe6, 0x60, 0x8c, 0xb8, 0xd8, 0xe7, 0x84, 0xb7, 0xd5, 0xba, 0x99, 0x51, 0x5b, 0x68, 0xdd, 0xc6,
+	0x35, 0x46, 0x42, 0xca, 0xcd, 0xb0, 0x89, 0x16, 0x61, 0x8a, 0x61, 0xa7, 0x15, 0x10, 0xde, 0xb6,
+	0x1c, 0xea, 0x73, 0xdb, 0xe1, 0xf9, 0xb4, 0x1c, 0x92, 0x0b, 0xfb, 0x57, 0x55, 0xb7, 0x00, 0x71,
+	0x31, 0xb7, 0x89, 0xc7, 0xf2, 0xa7, 0x14, 0x88, 0x6e, 0x26, 0xcc, 0xfd, 0x68, 0x14, 0x32, 0x51,
+	0xf8, 0xa2, 0x55, 0x98, 0xa2, 0x4d, 0x1c, 0x88, 0x6f, 0xcb, 0x76, 0xdd, 0x00, 0x33, 0xa6, 0x03,
+	0x35, 0xff, 0xe1, 0xfb, 0x4b, 0xb3, 0x7a, 0x11, 0x57, 0x94, 0x64, 0x93, 0x07, 0xc4, 0xaf, 0x9b,
+	0xb9, 0x50, 0x43, 0x77, 0xa3, 0xd7, 0xc5, 0xba, 0xf9, 0x0c, 0xfb, 0xac, 0xc5, 0xac, 0x66, 0xab,
+	0xb6, 0x87, 0xdb, 0x9a, 0xd7, 0xd9, 0x2e, 0x5e, 0x57, 0xfc, 0x76, 0x39, 0xff, 0xe7, 0x18, 0xda,
+	0x09, 0xda, 0x4d, 0x4e, 0x4b, 0xd5, 0x56, 0xed, 0x06, 0x6e, 0x9b, 0xb9, 0x08, 0xa7, 0x2a, 0x61,
+	0xd0, 0xf3, 0x30, 0xf2, 0x7d, 0x9b, 0x78, 0xd8, 0x95, 0xac, 0x8c, 0x99, 0xba, 0x85, 0x56, 0x60,
+	0x84, 0x71, 0x9b, 0xb7, 0x98, 0xa4, 0x62, 0xf2, 0xd2, 0x62, 0x9f, 0x00, 0x29, 0x53, 0xdf, 0xdd,
+	0x94, 0x0a, 0xa6, 0x56, 0x44, 0x5b, 0x30, 0xc2, 0xe9, 0x1e, 0xf6, 0x35, 0x57, 0x03, 0xc5, 0xf8,
+	0xba, 0xcf, 0x13, 0x31, 0xbe, 0xee, 0x73, 0x53, 0x63, 0xa1, 0x3a, 0x4c, 0xb9, 0xd8, 0xc3, 0x75,
+	0xc9, 0x28, 0xdb, 0xb5, 0x03, 0xcc, 0xf2, 0x23, 0x27, 0x90, 0x43, 0xb9, 0x08, 0x75, 0x53, 0x82,
+	0x22, 0x13, 0xb2, 0x6e, 0x1c, 0x75, 0xf9, 0x51, 0xc9, 0xf7, 0x4b, 0x7d, 0x68, 0x48, 0xc4, 0xa9,
+	0xde, 0xb9, 0x92, 0x20, 0x22, 0xd4, 0x5a, 0x7e, 0x8d, 0xfa, 0x2e, 0xf1, 0xeb, 0xd6, 0x2e, 0x26,
+	0xf5, 0x5d, 0x9e, 0x1f, 0x5b, 0x30, 0x2e, 0x0c, 0x9b, 0xb9, 0xa8, 0xff, 0xba, 0xec, 0x46, 0x37,
+	0x60, 0x32, 0x1e, 0x2a, 0x33, 0x29, 0x33, 0x40, 0x26, 0x4d, 0x44, 0xba, 0x42, 0x8a, 0x5e, 0x05,
+	0x88, 0xd3, 0x34, 0x0f, 0x12, 0x68, 0xf1, 0xd8, 0x29, 0xaf, 0x3d, 0x49, 0x40, 0xa0, 0x1f, 0xc0,
+	0x19, 0x4e, 0xb9, 0xed, 0x59, 0xfb, 0x61, 0xa4, 0x5b, 0x62, 0xbe, 0x70, 0x41, 0xb2, 0x27, 0xb0,
+	0x20, 0x79, 0x39, 0x41, 0x7c, 0x10, 0x88, 0x00, 0x53, 0x2b, 0xe3, 0xc1, 0x8c, 0x9a, 0x5c, 0x39,
+	0x10, 0x4e, 0x3a, 0x7e, 0x02, 0x93, 0x4e, 0x4b, 0xe0, 0x0d, 0x89, 0xab, 0x66, 0xbb, 0x32, 0x7e,
+	0xf7, 0x61, 0x61, 0x48, 0x67, 0xf7, 0x50, 0xb1, 0x0a, 0xe3, 0x3b, 0xb6, 0xa7, 0x13, 0x13, 0x33,
+	0x74, 0x19, 0x32, 0x76, 0xd8, 0xc8, 0x1b, 0x0b, 0xc3, 0x47, 0x26, 0x76, 0x3c, 0x54, 0xed, 0x17,
+	0x3f, 0xfe, 0xe7, 0x82, 0x51, 0xfc, 0xb5, 0x01, 0x23, 0x95, 0x9d, 0xaa, 0x4d, 0x02, 0xb4, 0x06,
+	0xd3, 0x71, 0x6c, 0x1f, 0x77, 0xb7, 0x88, 0xd3, 0x41, 0xf7, 0x0b, 0x98, 0x78, 0x59, 0x42, 0x98,
+	0x54, 0x3f, 0x98, 0x48, 0x45, 0xf7, 0x77, 0x38, 0xbe, 0x01, 0xa3, 0xca, 0x4a, 0x86, 0x56, 0xe0,
+	0x54, 0x53, 0x7c, 0x48, 0x7f, 0xb3, 0x97, 0xce, 0xf7, 0xcb, 0x09, 0xa9, 0xa6, 0x83, 0x48, 0x69,
+	0x16, 0xff, 0x67, 0x00, 0x54, 0x76, 0x76, 0xb6, 0x02, 0xd2, 0xf4, 0x30, 0x3f, 0x29, 0xc7, 0x37,
+	0xe0, 0xb9, 0xd8, 0x71, 0x16, 0x38, 0xc7, 0x76, 0x7e, 0x26, 0x52, 0xdb, 0x0c, 0x9c, 0x9e, 0x68,
+	0x2e, 0xe3, 0x11, 0xda, 0xf0, 0xb1, 0xd1, 0x2a, 0x8c, 0xf7, 0x66, 0xf3, 0x0d, 0xc8, 0xc6, 0xee,
+	0x33, 0x74, 0x03, 0xc6, 0xb8, 0xfe, 0xd6, 0xa4, 0x2e, 0xf6, 0x25, 0x35, 0xd4, 0xd6, 0xc4, 0x46,
+	0x00, 0xc5, 0xdf, 0xa4, 0x00, 0x2a, 0x8a, 0x1a, 0x91, 0xaa, 0x9f, 0xa9, 0xa0, 0x12, 0x87, 0x82,
+	0x4e, 0xd7, 0x93, 0x28, 0x7c, 0x34, 0x16, 0x3a, 0x0f, 0x93, 0x87, 0x37, 0x22, 0x79, 0x6a, 0x8d,
+	0x99, 0x13, 0xfb, 0xc9, 0xed, 0xa3, 0x63, 0x0d, 0xee, 0xa5, 0x60, 0x66, 0x3b, 0xdc, 0x26, 0x3f,
+	0xb3, 0x84, 0xbd, 0x06, 0xa3, 0xd8, 0xe7, 0x01, 0x91, 0x8c, 0x89, 0xc8, 0xf8, 0x5a, 0x9f, 0xc8,
+	0xe8, 0xe1, 0xd2, 0x9a, 0xcf, 0x83, 0xb6, 0x8e, 0x93, 0x10, 0xad, 0x83, 0x8c, 0x8f, 0x52, 0x90,
+	0x7f, 0x9a, 0x26, 0xfa, 0x22, 0xe4, 0x9c, 0x00, 0xcb, 0x8e, 0xf0, 0xd4, 0x32, 0xe4, 0xa9, 0x35,
+	0x19, 0x76, 0xeb, 0x43, 0xeb, 0x15, 0x10, 0xe5, 0xa0, 0x08, 0x43, 0x31, 0x74, 0xe0, 0xfa, 0x6f,
+	0x32, 0x56, 0x16, 0x62, 0x84, 0x21, 0x47, 0x7c, 0xc2, 0x89, 0xed, 0x59, 0x35, 0xdb, 0xb3, 0x7d,
+	0xe7, 0x59, 0xca, 0xe5, 0xee, 0x52, 0x62, 0x52, 0x83, 0x96, 0x15, 0x26, 0xda, 0x81, 0xd1, 0x10,
+	0x3e, 0x7d, 0x02, 0xf0, 0x21, 0x58, 0xa2, 0x26, 0xfc, 0x7b, 0x0a, 0xa6, 0x4d, 0xec, 0x7e, 0xbe,
+	0x68, 0x7d, 0x13, 0x40, 0xa5, 0xa7, 0xd8, 0x3c, 0xf3, 0xe9, 0x13, 0x48, 0xf7, 0x8c, 0xc2, 0xab,
+	0x30, 0x9e, 0xe0, 0xf6, 0xaf, 0x29, 0x18, 0x4f, 0x72, 0xfb, 0x39, 0x38, 0x4c, 0x50, 0x35, 0xde,
+	0x14, 0xd2, 0x72, 0x53, 0xf8, 0x4a, 0x9f, 0x4d, 0xa1, 0x2b, 0xf8, 0x8e, 0xde, 0x0d, 0x7e, 0x9f,
+	0x85, 0x91, 0xaa, 0x1d, 0xd8, 0x0d, 0x86, 0xbe, 0xdd, 0x55, 0x87, 0xaa, 0x1b, 0xe3, 0xe9, 0xae,
+	0xd0, 0xab, 0xe8, 0x77, 0x0b, 0x15, 0x79, 0xef, 0xf4, 0x28, 0x43, 0xcf, 0xc3, 0xa4, 0xb8, 0xfe,
+	0x46, 0x1e, 0x29, 0x2e, 0x27, 0xe4, 0xfd, 0x35, 0x2a, 0xf4, 0x18, 0x2a, 0x40, 0x56, 0x0c, 0x8b,
+	0xb7, 0x3d, 0x31, 0x06, 0x1a, 0xf6, 0x9d, 0x35, 0xd5, 0x83, 0x96, 0x00, 0xed, 0x46, 0xef, 0x12,
+	0x56, 0xcc, 0x84, 0x18, 0x37, 0x1d, 0x4b, 0xc2, 0xe1, 0xe7, 0x00, 0x64, 0x71, 0xea, 0x62, 0x9f,
+	0x36, 0xf4, 0xc5, 0x2d, 0x23, 0x7a, 0x2a, 0xa2, 0x03, 0xfd, 0x10, 0x66, 0x1a, 0xc4, 0xb7, 0x3a,
+	0x6e, 0xc6, 0xfa, 0x52, 0xb1, 0x31, 0x58, 0xc0, 0x7e, 0x72, 0x50, 0x98, 0x6b, 0xdb, 0x0d, 0xef,
+	0x4a, 0xb1, 0x07, 0x64, 0xd1, 0x9c, 0x6e, 0x10, 0xff, 0xf0, 0x55, 0x1a, 0xfd, 0xc4, 0x48, 0x46,
+	0x86, 0xb4, 0xf3, 0x96, 0xed, 0x70, 0x1a, 0xc8, 0x1b, 0x47, 0xa6, 0x7c, 0x73, 0x60, 0x03, 0xce,
+	0x2a, 0x03, 0x7a, 0x82, 0x16, 0xcd, 0x99, 0x43, 0x47, 0xe2, 0x55, 0xd9, 0x8b, 0x7e, 0x66, 0xc0,
+	0xe9, 0xba, 0x47, 0x6b, 0x89, 0x9a, 0x5a, 0x05, 0x90, 0xe5, 0xd8, 0x4d, 0x79, 0x43, 0xc9, 0x94,
+	0xcd, 0x81, 0x0d, 0x59, 0x50, 0x86, 0x3c, 0x15, 0xb8, 0x68, 0x3e, 0xaf, 0x64, 0xba, 0xde, 0x56,
+	0x92, 0x55, 0xbb, 0x89, 0x7e, 0x61, 0xc0, 0xd9, 0xd8, 0xfe, 0x1e, 0x26, 0x65, 0xa4, 0x49, 0xdb,
+	0x03, 0x9b, 0xf4, 0x42, 0x27, 0x37, 0xbd, 0xac, 0x3a, 0x1d, 0x89, 0xbb, 0x0c, 0xfb, 0xa3, 0x01,
+	0x4f, 0xf7, 0xc7, 0x62, 0xce, 0x2e, 0x76, 0x5b, 0x1e, 0xce, 0x83, 0x4c, 0xca, 0x72, 0x9f, 0xa4,
+	0xbc, 0xd6, 0xd3, 0xf9, 0x4d, 0x0d, 0xa2, 0xd2, 0xf4, 0xa2, 0x70, 0xf1, 0x93, 0x83, 0xc2, 0x62,
+	0x1f, 0x2e, 0xa3, 0xb9, 0x8b, 0xe6, 0x7c, 0xfd, 0x48, 0x5c, 0xf4, 0x73, 0x03, 0xce, 0x34, 0x03,
+	0xba, 0x4f, 0x5c, 0xdc, 0x93, 0x5b, 0x75, 0x79, 0xdb, 0x1a, 0x98, 0xdb, 0xa2, 0x32, 0xf1, 0x08,
+	0xe8, 0xa2, 0x99, 0x0f, 0xa5, 0x5d, 0xcc, 0xbe, 0x6b, 0x40, 0x57, 0xc8, 0x92, 0x80, 0x71, 0xcb,
+	0xa3, 0x8c, 0x59, 0xb7, 0x02, 0xdb, 0x91, 0xd7, 0x70, 0x75, 0xc9, 0x7b, 0x73, 0x60, 0xe3, 0x16,
+	0x7b, 0x27, 0x45, 0xf7, 0x0c, 0x45, 0x73, 0xfe, 0x70, 0x86, 0x88, 0x21, 0x1b, 0x94, 0xb1, 0xab,
+	0x7a, 0x00, 0xba, 0x6b, 0xc0, 0x99, 0x0e, 0x9c, 0x7a, 0x60, 0x3b, 0xd8, 0x6a, 0xe2, 0x80, 0x50,
+	0x37, 0x3f, 0xd1, 0x6f, 0x7f, 0x2c, 0xe9, 0x35, 0x2d, 0xf6, 0xb4, 0x29, 0x89, 0x55, 0x94, 0xbb,
+	0x68, 0xfe, 0x90, 0x41, 0xd7, 0x84, 0xbc, 0x2a, 0xc5, 0x89, 0x53, 0xf0, 0x5d, 0x03, 0x5e, 0x38,
+	0x46, 0x3c, 0xa1, 0x97, 0x21, 0x9d, 0xd8, 0xc4, 0x8f, 0x57, 0x3f, 0x48, 0x0d, 0xf4, 0x2d, 0x18,
+	0x16, 0xd1, 0xa1, 0x4e, 0xbf, 0xd2, 0x60, 0x0b, 0x60, 0x0a, 0xd5, 0x2b, 0x69, 0x69, 0xe9, 0xef,
+	0x0c, 0x40, 0x71, 0x81, 0x69, 0x62, 0xd6, 0xa4, 0x3e, 0x93, 0x4f, 0x14, 0xf1, 0x11, 0xa5, 0xcd,
+	0xeb, 0x7b, 0x09, 0x8a, 0x14, 0xc2, 0x27, 0x8a, 0x18, 0x02, 0x7d, 0x3d, 0xae, 0xea, 0x52, 0x7a,
+	0x45, 0xf4, 0x01, 0x2b, 0x5e, 0xc3, 0x13, 0xcf, 0x1c, 0x24, 0xd4, 0xee, 0x2a, 0xdc, 0x86, 0x8a,
+	0x1f, 0x1b, 0x70, 0xba, 0xeb, 0xec, 0x8c, 0x6c, 0xc6, 0x80, 0x82, 0x84, 0x50, 0x9e, 0x44, 0x6d,
+	0x6d, 0xfb, 0xb3, 0x9e, 0xc8, 0xd3, 0x41, 0xa7, 0xe0, 0x53, 0xab, 0x4f, 0xd5, 0x7a, 0xfc, 0xc5,
+	0x80, 0xd9, 0xa4, 0x31, 0x91, 0x77, 0xdb, 0x30, 0x9e, 0xb4, 0x45, 0xfb, 0xf5, 0xa5, 0x01, 0xfc,
+	0xd2, 0x2e, 0x1d, 0x82, 0x41, 0xdf, 0x8d, 0x6b, 0x17, 0xf5, 0x5b, 0xc0, 0xcb, 0x83, 0x32, 0x15,
+	0x5a, 0xd8, 0x59, 0xc3, 0xa4, 0xe5, 0x92, 0xfd, 0x34, 0x05, 0xe9, 0x2a, 0xa5, 0x1e, 0xfa, 0x11,
+	0x4c, 0xfb, 0x94, 0xcb, 0xa4, 0xc2, 0xae, 0xa5, 0x9f, 0x22, 0x55, 0x1d, 0xf8, 0x9d, 0xc1, 0x08,
+	0xfc, 0xcf, 0x41, 0xa1, 0x1b, 0xaa, 0x83, 0xd5, 0x9c, 0x4f, 0x79, 0x59, 0xca, 0xb7, 0xa4, 0x18,
+	0x05, 0x30, 0x71, 0x78, 0x6a, 0x95, 0x39, 0xaf, 0x0c, 0x3c, 0xf5, 0xc4, 0x51, 0xd3, 0x8e, 0xd7,
+	0x12, 0x73, 0x5e, 0x19, 0x13, 0x2b, 0xfa, 0x5f, 0xb1, 0xaa, 0xbf, 0x34, 0x60, 0x46, 0x76, 0x92,
+	0xb7, 0xb1, 0x7c, 0xc8, 0x32, 0xb1, 0x43, 0x03, 0x17, 0x4d, 0x42, 0x8a, 0xb8, 0x92, 0x85, 0xb4,
+	0x99, 0x22, 0x2e, 0x9a, 0x85, 0x53, 0xf4, 0xb6, 0x8f, 0x03, 0xfd, 0x5e, 0xae, 0x1a, 0xb2, 0x50,
+	0xa3, 0x62, 0xd3, 0xb0, 0x6c, 0xc7, 0xa1, 0x2d, 0x9f, 0xeb, 0x37, 0xf3, 0x09, 0xd5, 0xbb, 0xa2,
+	0x3a, 0xd1, 0x59, 0xc8, 0x44, 0x5b, 0x93, 0x7e, 0x32, 0x8f, 0x3b, 0xc4, 0x6b, 0xbc, 0xb8, 0x78,
+	0xd0, 0x96, 0xef, 0xca, 0xa2, 0x6b, 0xcc, 0x8c, 0xda, 0x3a, 0xf4, 0xbe, 0x07, 0xc5, 0x2a, 0x56,
+	0xe5, 0x61, 0xd2, 0xd4, 0x95, 0x16, 0xdf, 0xa5, 0x01, 0x79, 0x5b, 0xae, 0xf8, 0x33, 0x3f, 0xb1,
+	0x15, 0x7f, 0x6b, 0x40, 0xe1, 0x10, 0xee, 0x06, 0x75, 0xf6, 0x56, 0x3c, 0x8f, 0xde, 0xf6, 0x08,
+	0xe3, 0x2a, 0xb5, 0x2e, 0x43, 0x26, 0xc0, 0x0e, 0x69, 0x12, 0xec, 0xf3, 0xbe, 0x77, 0x84, 0x78,
+	0xa8, 0xb8, 0x91, 0x89, 0xd3, 0x60, 0xdf, 0x7e, 0xc6, 0x1b, 0x59, 0xac, 0x2c, 0xc4, 0x2f, 0xfd,
+	0xc1, 0x00, 0x88, 0x5f, 0xd0, 0xd1, 0x97, 0xe1, 0x0b, 0xe5, 0x57, 0x6f, 0x56, 0xac, 0xcd, 0xad,
+	0x95, 0xad, 0xed, 0x4d, 0x6b, 0xfb, 0xe6, 0x66, 0x75, 0x6d, 0x75, 0xfd, 0xea, 0xfa, 0x5a, 0x65,
+	0x6a, 0x68, 0x2e, 0x77, 0xef, 0xc1, 0x42, 0x76, 0xdb, 0x67, 0x4d, 0xec, 0x90, 0x5b, 0x04, 0xbb,
+	0xe8, 0x45, 0x98, 0x3d, 0x3c, 0x5a, 0xb4, 0xd6, 0x2a, 0x53, 0xc6, 0xdc, 0xf8, 0xbd, 0x07, 0x0b,
+	0x63, 0xea, 0x56, 0x8f, 0x5d, 0x74, 0x01, 0x9e, 0xeb, 0x1e, 0xb7, 0x7e, 0xf3, 0xda, 0x54, 0x6a,
+	0x6e, 0xe2, 0xde, 0x83, 0x85, 0x4c, 0x74, 0xfd, 0x47, 0x45, 0x40, 0xc9, 0x91, 0x1a, 0x6f, 0x78,
+	0x0e, 0xee, 0x3d, 0x58, 0x18, 0x51, 0x41, 0x3e, 0x97, 0xbe, 0xfb, 0xab, 0xf9, 0xa1, 0xf2, 0xeb,
+	0x1f, 0x3c, 0x9e, 0x37, 0x1e, 0x3d, 0x9e, 0x37, 0x3e, 0x7e, 0x3c, 0x6f, 0xdc, 0x7f, 0x32, 0x3f,
+	0xf4, 0xe8, 0xc9, 0xfc, 0xd0, 0xdf, 0x9e, 0xcc, 0x0f, 0xbd, 0xf1, 0xcd, 0x44, 0x7c, 0x93, 0xb7,
+	0xbc, 0x16, 0x23, 0xd4, 0x27, 0xbe, 0xb3, 0xac, 0x72, 0x9d, 0xf0, 0xf6, 0x92, 0xce, 0xf3, 0x25,
+	0x15, 0x53, 0xcb, 0x77, 0xc2, 0xdf, 0x59, 0x55, 0xf0, 0xd7, 0x46, 0x24, 0x83, 0x5f, 0xfd, 0xff,
+	0x00, 0xe7, 0xcc, 0x9b, 0x3f, 0x8f, 0x1d, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1398,492 +1556,506 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 7757 bytes of a gzipped FileDescriptorSet
+		// 7977 bytes of a gzipped FileDescriptorSet
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x7d, 0x70, 0x1c, 0xc9,
-		0x75, 0x1f, 0xf6, 0x03, 0x8b, 0xdd, 0xb7, 0x8b, 0xc5, 0xa0, 0x01, 0x92, 0x4b, 0xf0, 0x08, 0xe0,
-		0xf6, 0x74, 0x77, 0x24, 0xef, 0x08, 0xde, 0xf1, 0x8e, 0xe4, 0x71, 0x69, 0xe9, 0xb2, 0x5f, 0xe4,
-		0x81, 0xc4, 0xc7, 0x6a, 0x16, 0xe0, 0x7d, 0x38, 0xa9, 0xc9, 0x60, 0xb6, 0xb1, 0x98, 0xe3, 0xec,
-		0xcc, 0x68, 0x66, 0x96, 0x24, 0x18, 0x27, 0x75, 0x8e, 0x12, 0x47, 0x66, 0xca, 0xb6, 0x1c, 0xa7,
-		0x6c, 0x59, 0x16, 0x95, 0x93, 0x65, 0x47, 0x8e, 0xa2, 0x7c, 0xd8, 0x52, 0x94, 0x38, 0xa9, 0xa4,
-		0x14, 0xa7, 0x92, 0x28, 0xae, 0x4a, 0x4a, 0xf2, 0x1f, 0xb1, 0x13, 0x27, 0x17, 0xe5, 0xa4, 0x4a,
-		0x14, 0x47, 0x8e, 0x1d, 0xe7, 0x52, 0x95, 0x94, 0x4a, 0x55, 0xa9, 0xfe, 0x9a, 0x99, 0xfd, 0xc2,
-		0x2c, 0x18, 0x9e, 0xec, 0x2a, 0xff, 0x05, 0xf4, 0xeb, 0xf7, 0x7e, 0xfd, 0xfa, 0xf5, 0xeb, 0xf7,
-		0x5e, 0xf7, 0xcc, 0x2c, 0xfc, 0xb3, 0x2b, 0xb0, 0xdc, 0xb6, 0xac, 0xb6, 0x81, 0xcf, 0xd9, 0x8e,
-		0xe5, 0x59, 0x3b, 0xdd, 0xdd, 0x73, 0x2d, 0xec, 0x6a, 0x8e, 0x6e, 0x7b, 0x96, 0xb3, 0x42, 0x69,
-		0x68, 0x86, 0x71, 0xac, 0x08, 0x8e, 0xe2, 0x3a, 0xcc, 0x5e, 0xd5, 0x0d, 0x5c, 0xf3, 0x19, 0x9b,
-		0xd8, 0x43, 0x2f, 0x41, 0x72, 0x57, 0x37, 0x70, 0x21, 0xb6, 0x9c, 0x38, 0x95, 0x3d, 0xff, 0x81,
-		0x95, 0x3e, 0xa1, 0x95, 0x5e, 0x89, 0x06, 0x21, 0xcb, 0x54, 0xa2, 0xf8, 0xad, 0x24, 0xcc, 0x0d,
-		0xe9, 0x45, 0x08, 0x92, 0xa6, 0xda, 0x21, 0x88, 0xb1, 0x53, 0x19, 0x99, 0xfe, 0x8f, 0x0a, 0x30,
-		0x65, 0xab, 0xda, 0x2d, 0xb5, 0x8d, 0x0b, 0x71, 0x4a, 0x16, 0x4d, 0xb4, 0x08, 0xd0, 0xc2, 0x36,
-		0x36, 0x5b, 0xd8, 0xd4, 0xf6, 0x0b, 0x89, 0xe5, 0xc4, 0xa9, 0x8c, 0x1c, 0xa2, 0xa0, 0x67, 0x60,
-		0xd6, 0xee, 0xee, 0x18, 0xba, 0xa6, 0x84, 0xd8, 0x60, 0x39, 0x71, 0x6a, 0x52, 0x96, 0x58, 0x47,
-		0x2d, 0x60, 0x7e, 0x1a, 0x66, 0xee, 0x60, 0xf5, 0x56, 0x98, 0x35, 0x4b, 0x59, 0xf3, 0x84, 0x1c,
-		0x62, 0xac, 0x42, 0xae, 0x83, 0x5d, 0x57, 0x6d, 0x63, 0xc5, 0xdb, 0xb7, 0x71, 0x21, 0x49, 0x67,
-		0xbf, 0x3c, 0x30, 0xfb, 0xfe, 0x99, 0x67, 0xb9, 0xd4, 0xd6, 0xbe, 0x8d, 0x51, 0x19, 0x32, 0xd8,
-		0xec, 0x76, 0x18, 0xc2, 0xe4, 0x08, 0xfb, 0xd5, 0xcd, 0x6e, 0xa7, 0x1f, 0x25, 0x4d, 0xc4, 0x38,
-		0xc4, 0x94, 0x8b, 0x9d, 0xdb, 0xba, 0x86, 0x0b, 0x29, 0x0a, 0xf0, 0xf4, 0x00, 0x40, 0x93, 0xf5,
-		0xf7, 0x63, 0x08, 0x39, 0x54, 0x85, 0x0c, 0xbe, 0xeb, 0x61, 0xd3, 0xd5, 0x2d, 0xb3, 0x30, 0x45,
-		0x41, 0x9e, 0x1c, 0xb2, 0x8a, 0xd8, 0x68, 0xf5, 0x43, 0x04, 0x72, 0xe8, 0x22, 0x4c, 0x59, 0xb6,
-		0xa7, 0x5b, 0xa6, 0x5b, 0x48, 0x2f, 0xc7, 0x4e, 0x65, 0xcf, 0x3f, 0x36, 0xd4, 0x11, 0x36, 0x19,
-		0x8f, 0x2c, 0x98, 0xd1, 0x2a, 0x48, 0xae, 0xd5, 0x75, 0x34, 0xac, 0x68, 0x56, 0x0b, 0x2b, 0xba,
-		0xb9, 0x6b, 0x15, 0x32, 0x14, 0x60, 0x69, 0x70, 0x22, 0x94, 0xb1, 0x6a, 0xb5, 0xf0, 0xaa, 0xb9,
-		0x6b, 0xc9, 0x79, 0xb7, 0xa7, 0x8d, 0x8e, 0x42, 0xca, 0xdd, 0x37, 0x3d, 0xf5, 0x6e, 0x21, 0x47,
-		0x3d, 0x84, 0xb7, 0x8a, 0xbf, 0x9a, 0x82, 0x99, 0x71, 0x5c, 0xec, 0x0a, 0x4c, 0xee, 0x92, 0x59,
-		0x16, 0xe2, 0x87, 0xb1, 0x01, 0x93, 0xe9, 0x35, 0x62, 0xea, 0x21, 0x8d, 0x58, 0x86, 0xac, 0x89,
-		0x5d, 0x0f, 0xb7, 0x98, 0x47, 0x24, 0xc6, 0xf4, 0x29, 0x60, 0x42, 0x83, 0x2e, 0x95, 0x7c, 0x28,
-		0x97, 0x7a, 0x0d, 0x66, 0x7c, 0x95, 0x14, 0x47, 0x35, 0xdb, 0xc2, 0x37, 0xcf, 0x45, 0x69, 0xb2,
-		0x52, 0x17, 0x72, 0x32, 0x11, 0x93, 0xf3, 0xb8, 0xa7, 0x8d, 0x6a, 0x00, 0x96, 0x89, 0xad, 0x5d,
-		0xa5, 0x85, 0x35, 0xa3, 0x90, 0x1e, 0x61, 0xa5, 0x4d, 0xc2, 0x32, 0x60, 0x25, 0x8b, 0x51, 0x35,
-		0x03, 0x5d, 0x0e, 0x5c, 0x6d, 0x6a, 0x84, 0xa7, 0xac, 0xb3, 0x4d, 0x36, 0xe0, 0x6d, 0xdb, 0x90,
-		0x77, 0x30, 0xf1, 0x7b, 0xdc, 0xe2, 0x33, 0xcb, 0x50, 0x25, 0x56, 0x22, 0x67, 0x26, 0x73, 0x31,
-		0x36, 0xb1, 0x69, 0x27, 0xdc, 0x44, 0x4f, 0x80, 0x4f, 0x50, 0xa8, 0x5b, 0x01, 0x8d, 0x42, 0x39,
-		0x41, 0xdc, 0x50, 0x3b, 0x78, 0xe1, 0x1e, 0xe4, 0x7b, 0xcd, 0x83, 0xe6, 0x61, 0xd2, 0xf5, 0x54,
-		0xc7, 0xa3, 0x5e, 0x38, 0x29, 0xb3, 0x06, 0x92, 0x20, 0x81, 0xcd, 0x16, 0x8d, 0x72, 0x93, 0x32,
-		0xf9, 0x17, 0xfd, 0x89, 0x60, 0xc2, 0x09, 0x3a, 0xe1, 0xa7, 0x06, 0x57, 0xb4, 0x07, 0xb9, 0x7f,
-		0xde, 0x0b, 0x97, 0x60, 0xba, 0x67, 0x02, 0xe3, 0x0e, 0x5d, 0xfc, 0x21, 0x38, 0x32, 0x14, 0x1a,
-		0xbd, 0x06, 0xf3, 0x5d, 0x53, 0x37, 0x3d, 0xec, 0xd8, 0x0e, 0x26, 0x1e, 0xcb, 0x86, 0x2a, 0xfc,
-		0xd7, 0xa9, 0x11, 0x3e, 0xb7, 0x1d, 0xe6, 0x66, 0x28, 0xf2, 0x5c, 0x77, 0x90, 0x78, 0x26, 0x93,
-		0xfe, 0xf6, 0x94, 0xf4, 0xd6, 0x5b, 0x6f, 0xbd, 0x15, 0x2f, 0xfe, 0xd3, 0x14, 0xcc, 0x0f, 0xdb,
-		0x33, 0x43, 0xb7, 0xef, 0x51, 0x48, 0x99, 0xdd, 0xce, 0x0e, 0x76, 0xa8, 0x91, 0x26, 0x65, 0xde,
-		0x42, 0x65, 0x98, 0x34, 0xd4, 0x1d, 0x6c, 0x14, 0x92, 0xcb, 0xb1, 0x53, 0xf9, 0xf3, 0xcf, 0x8c,
-		0xb5, 0x2b, 0x57, 0xd6, 0x88, 0x88, 0xcc, 0x24, 0xd1, 0x87, 0x20, 0xc9, 0x43, 0x34, 0x41, 0x38,
-		0x33, 0x1e, 0x02, 0xd9, 0x4b, 0x32, 0x95, 0x43, 0x27, 0x20, 0x43, 0xfe, 0x32, 0xdf, 0x48, 0x51,
-		0x9d, 0xd3, 0x84, 0x40, 0xfc, 0x02, 0x2d, 0x40, 0x9a, 0x6e, 0x93, 0x16, 0x16, 0xa9, 0xcd, 0x6f,
-		0x13, 0xc7, 0x6a, 0xe1, 0x5d, 0xb5, 0x6b, 0x78, 0xca, 0x6d, 0xd5, 0xe8, 0x62, 0xea, 0xf0, 0x19,
-		0x39, 0xc7, 0x89, 0x37, 0x09, 0x0d, 0x2d, 0x41, 0x96, 0xed, 0x2a, 0xdd, 0x6c, 0xe1, 0xbb, 0x34,
-		0x7a, 0x4e, 0xca, 0x6c, 0xa3, 0xad, 0x12, 0x0a, 0x19, 0xfe, 0x4d, 0xd7, 0x32, 0x85, 0x6b, 0xd2,
-		0x21, 0x08, 0x81, 0x0e, 0x7f, 0xa9, 0x3f, 0x70, 0x9f, 0x1c, 0x3e, 0xbd, 0x81, 0xbd, 0xf4, 0x34,
-		0xcc, 0x50, 0x8e, 0x17, 0xf8, 0xd2, 0xab, 0x46, 0x61, 0x76, 0x39, 0x76, 0x2a, 0x2d, 0xe7, 0x19,
-		0x79, 0x93, 0x53, 0x8b, 0x5f, 0x8e, 0x43, 0x92, 0x06, 0x96, 0x19, 0xc8, 0x6e, 0xbd, 0xde, 0xa8,
-		0x2b, 0xb5, 0xcd, 0xed, 0xca, 0x5a, 0x5d, 0x8a, 0xa1, 0x3c, 0x00, 0x25, 0x5c, 0x5d, 0xdb, 0x2c,
-		0x6f, 0x49, 0x71, 0xbf, 0xbd, 0xba, 0xb1, 0x75, 0xf1, 0x45, 0x29, 0xe1, 0x0b, 0x6c, 0x33, 0x42,
-		0x32, 0xcc, 0xf0, 0xc2, 0x79, 0x69, 0x12, 0x49, 0x90, 0x63, 0x00, 0xab, 0xaf, 0xd5, 0x6b, 0x17,
-		0x5f, 0x94, 0x52, 0xbd, 0x94, 0x17, 0xce, 0x4b, 0x53, 0x68, 0x1a, 0x32, 0x94, 0x52, 0xd9, 0xdc,
-		0x5c, 0x93, 0xd2, 0x3e, 0x66, 0x73, 0x4b, 0x5e, 0xdd, 0xb8, 0x26, 0x65, 0x7c, 0xcc, 0x6b, 0xf2,
-		0xe6, 0x76, 0x43, 0x02, 0x1f, 0x61, 0xbd, 0xde, 0x6c, 0x96, 0xaf, 0xd5, 0xa5, 0xac, 0xcf, 0x51,
-		0x79, 0x7d, 0xab, 0xde, 0x94, 0x72, 0x3d, 0x6a, 0xbd, 0x70, 0x5e, 0x9a, 0xf6, 0x87, 0xa8, 0x6f,
-		0x6c, 0xaf, 0x4b, 0x79, 0x34, 0x0b, 0xd3, 0x6c, 0x08, 0xa1, 0xc4, 0x4c, 0x1f, 0xe9, 0xe2, 0x8b,
-		0x92, 0x14, 0x28, 0xc2, 0x50, 0x66, 0x7b, 0x08, 0x17, 0x5f, 0x94, 0x50, 0xb1, 0x0a, 0x93, 0xd4,
-		0x0d, 0x11, 0x82, 0xfc, 0x5a, 0xb9, 0x52, 0x5f, 0x53, 0x36, 0x1b, 0x5b, 0xab, 0x9b, 0x1b, 0xe5,
-		0x35, 0x29, 0x16, 0xd0, 0xe4, 0xfa, 0x87, 0xb7, 0x57, 0xe5, 0x7a, 0x4d, 0x8a, 0x87, 0x69, 0x8d,
-		0x7a, 0x79, 0xab, 0x5e, 0x93, 0x12, 0x45, 0x0d, 0xe6, 0x87, 0x05, 0xd4, 0xa1, 0x5b, 0x28, 0xe4,
-		0x0b, 0xf1, 0x11, 0xbe, 0x40, 0xb1, 0xfa, 0x7d, 0xa1, 0xf8, 0xcd, 0x38, 0xcc, 0x0d, 0x49, 0x2a,
-		0x43, 0x07, 0x79, 0x19, 0x26, 0x99, 0x2f, 0xb3, 0x34, 0x7b, 0x7a, 0x68, 0x76, 0xa2, 0x9e, 0x3d,
-		0x90, 0x6a, 0xa9, 0x5c, 0xb8, 0xd4, 0x48, 0x8c, 0x28, 0x35, 0x08, 0xc4, 0x80, 0xc3, 0xfe, 0xa9,
-		0x81, 0xe0, 0xcf, 0xf2, 0xe3, 0xc5, 0x71, 0xf2, 0x23, 0xa5, 0x1d, 0x2e, 0x09, 0x4c, 0x0e, 0x49,
-		0x02, 0x57, 0x60, 0x76, 0x00, 0x68, 0xec, 0x60, 0xfc, 0xd1, 0x18, 0x14, 0x46, 0x19, 0x27, 0x22,
-		0x24, 0xc6, 0x7b, 0x42, 0xe2, 0x95, 0x7e, 0x0b, 0x3e, 0x3e, 0x7a, 0x11, 0x06, 0xd6, 0xfa, 0x73,
-		0x31, 0x38, 0x3a, 0xbc, 0xa4, 0x1c, 0xaa, 0xc3, 0x87, 0x20, 0xd5, 0xc1, 0xde, 0x9e, 0x25, 0xca,
-		0xaa, 0xa7, 0x86, 0x24, 0x6b, 0xd2, 0xdd, 0xbf, 0xd8, 0x5c, 0x2a, 0x9c, 0xed, 0x13, 0xa3, 0xea,
-		0x42, 0xa6, 0xcd, 0x80, 0xa6, 0x3f, 0x1a, 0x87, 0x23, 0x43, 0xc1, 0x87, 0x2a, 0x7a, 0x12, 0x40,
-		0x37, 0xed, 0xae, 0xc7, 0x4a, 0x27, 0x16, 0x89, 0x33, 0x94, 0x42, 0x83, 0x17, 0x89, 0xb2, 0x5d,
-		0xcf, 0xef, 0x4f, 0xd0, 0x7e, 0x60, 0x24, 0xca, 0xf0, 0x52, 0xa0, 0x68, 0x92, 0x2a, 0xba, 0x38,
-		0x62, 0xa6, 0x03, 0x8e, 0xf9, 0x1c, 0x48, 0x9a, 0xa1, 0x63, 0xd3, 0x53, 0x5c, 0xcf, 0xc1, 0x6a,
-		0x47, 0x37, 0xdb, 0x34, 0xd5, 0xa4, 0x4b, 0x93, 0xbb, 0xaa, 0xe1, 0x62, 0x79, 0x86, 0x75, 0x37,
-		0x45, 0x2f, 0x91, 0xa0, 0x0e, 0xe4, 0x84, 0x24, 0x52, 0x3d, 0x12, 0xac, 0xdb, 0x97, 0x28, 0xfe,
-		0x64, 0x06, 0xb2, 0xa1, 0x02, 0x1c, 0x3d, 0x0e, 0xb9, 0x37, 0xd5, 0xdb, 0xaa, 0x22, 0x0e, 0x55,
-		0xcc, 0x12, 0x59, 0x42, 0x6b, 0xf0, 0x83, 0xd5, 0x73, 0x30, 0x4f, 0x59, 0xac, 0xae, 0x87, 0x1d,
-		0x45, 0x33, 0x54, 0xd7, 0xa5, 0x46, 0x4b, 0x53, 0x56, 0x44, 0xfa, 0x36, 0x49, 0x57, 0x55, 0xf4,
-		0xa0, 0x0b, 0x30, 0x47, 0x25, 0x3a, 0x5d, 0xc3, 0xd3, 0x6d, 0x03, 0x2b, 0xe4, 0x98, 0xe7, 0xd2,
-		0x94, 0xe3, 0x6b, 0x36, 0x4b, 0x38, 0xd6, 0x39, 0x03, 0xd1, 0xc8, 0x45, 0x35, 0x38, 0x49, 0xc5,
-		0xda, 0xd8, 0xc4, 0x8e, 0xea, 0x61, 0x05, 0x7f, 0xa4, 0xab, 0x1a, 0xae, 0xa2, 0x9a, 0x2d, 0x65,
-		0x4f, 0x75, 0xf7, 0x0a, 0xf3, 0x04, 0xa0, 0x12, 0x2f, 0xc4, 0xe4, 0xe3, 0x84, 0xf1, 0x1a, 0xe7,
-		0xab, 0x53, 0xb6, 0xb2, 0xd9, 0x7a, 0x45, 0x75, 0xf7, 0x50, 0x09, 0x8e, 0x52, 0x14, 0xd7, 0x73,
-		0x74, 0xb3, 0xad, 0x68, 0x7b, 0x58, 0xbb, 0xa5, 0x74, 0xbd, 0xdd, 0x97, 0x0a, 0x27, 0xc2, 0xe3,
-		0x53, 0x0d, 0x9b, 0x94, 0xa7, 0x4a, 0x58, 0xb6, 0xbd, 0xdd, 0x97, 0x50, 0x13, 0x72, 0x64, 0x31,
-		0x3a, 0xfa, 0x3d, 0xac, 0xec, 0x5a, 0x0e, 0xcd, 0xa1, 0xf9, 0x21, 0xa1, 0x29, 0x64, 0xc1, 0x95,
-		0x4d, 0x2e, 0xb0, 0x6e, 0xb5, 0x70, 0x69, 0xb2, 0xd9, 0xa8, 0xd7, 0x6b, 0x72, 0x56, 0xa0, 0x5c,
-		0xb5, 0x1c, 0xe2, 0x50, 0x6d, 0xcb, 0x37, 0x70, 0x96, 0x39, 0x54, 0xdb, 0x12, 0xe6, 0xbd, 0x00,
-		0x73, 0x9a, 0xc6, 0xe6, 0xac, 0x6b, 0x0a, 0x3f, 0x8c, 0xb9, 0x05, 0xa9, 0xc7, 0x58, 0x9a, 0x76,
-		0x8d, 0x31, 0x70, 0x1f, 0x77, 0xd1, 0x65, 0x38, 0x12, 0x18, 0x2b, 0x2c, 0x38, 0x3b, 0x30, 0xcb,
-		0x7e, 0xd1, 0x0b, 0x30, 0x67, 0xef, 0x0f, 0x0a, 0xa2, 0x9e, 0x11, 0xed, 0xfd, 0x7e, 0xb1, 0x4b,
-		0x30, 0x6f, 0xef, 0xd9, 0x83, 0x72, 0x67, 0xc2, 0x72, 0xc8, 0xde, 0xb3, 0xfb, 0x05, 0x9f, 0xa4,
-		0x27, 0x73, 0x07, 0x6b, 0xaa, 0x87, 0x5b, 0x85, 0x63, 0x61, 0xf6, 0x50, 0x07, 0x5a, 0x01, 0x49,
-		0xd3, 0x14, 0x6c, 0xaa, 0x3b, 0x06, 0x56, 0x54, 0x07, 0x9b, 0xaa, 0x5b, 0x58, 0xa2, 0xcc, 0x49,
-		0xcf, 0xe9, 0x62, 0x39, 0xaf, 0x69, 0x75, 0xda, 0x59, 0xa6, 0x7d, 0xe8, 0x0c, 0xcc, 0x5a, 0x3b,
-		0x6f, 0x6a, 0xcc, 0x23, 0x15, 0xdb, 0xc1, 0xbb, 0xfa, 0xdd, 0xc2, 0x07, 0xa8, 0x79, 0x67, 0x48,
-		0x07, 0xf5, 0xc7, 0x06, 0x25, 0xa3, 0xd3, 0x20, 0x69, 0xee, 0x9e, 0xea, 0xd8, 0x34, 0x24, 0xbb,
-		0xb6, 0xaa, 0xe1, 0xc2, 0x93, 0x8c, 0x95, 0xd1, 0x37, 0x04, 0x99, 0xec, 0x08, 0xf7, 0x8e, 0xbe,
-		0xeb, 0x09, 0xc4, 0xa7, 0xd9, 0x8e, 0xa0, 0x34, 0x8e, 0x76, 0x0a, 0x24, 0x62, 0x89, 0x9e, 0x81,
-		0x4f, 0x51, 0xb6, 0xbc, 0xbd, 0x67, 0x87, 0xc7, 0x7d, 0x02, 0xa6, 0x09, 0x67, 0x30, 0xe8, 0x69,
-		0x56, 0xb8, 0xd9, 0x7b, 0xa1, 0x11, 0x5f, 0x84, 0xa3, 0x84, 0xa9, 0x83, 0x3d, 0xb5, 0xa5, 0x7a,
-		0x6a, 0x88, 0xfb, 0x59, 0xca, 0x4d, 0xcc, 0xbe, 0xce, 0x3b, 0x7b, 0xf4, 0x74, 0xba, 0x3b, 0xfb,
-		0xbe, 0x63, 0x9d, 0x65, 0x7a, 0x12, 0x9a, 0x70, 0xad, 0xf7, 0xad, 0x38, 0x2f, 0x96, 0x20, 0x17,
-		0xf6, 0x7b, 0x94, 0x01, 0xe6, 0xf9, 0x52, 0x8c, 0x14, 0x41, 0xd5, 0xcd, 0x1a, 0x29, 0x5f, 0xde,
-		0xa8, 0x4b, 0x71, 0x52, 0x46, 0xad, 0xad, 0x6e, 0xd5, 0x15, 0x79, 0x7b, 0x63, 0x6b, 0x75, 0xbd,
-		0x2e, 0x25, 0x42, 0x85, 0xfd, 0xf5, 0x64, 0xfa, 0x29, 0xe9, 0x69, 0x52, 0x35, 0xe4, 0x7b, 0x4f,
-		0x6a, 0xe8, 0x07, 0xe0, 0x98, 0xb8, 0x56, 0x71, 0xb1, 0xa7, 0xdc, 0xd1, 0x1d, 0xba, 0x21, 0x3b,
-		0x2a, 0x4b, 0x8e, 0xbe, 0xff, 0xcc, 0x73, 0xae, 0x26, 0xf6, 0x5e, 0xd5, 0x1d, 0xb2, 0xdd, 0x3a,
-		0xaa, 0x87, 0xd6, 0x60, 0xc9, 0xb4, 0x14, 0xd7, 0x53, 0xcd, 0x96, 0xea, 0xb4, 0x94, 0xe0, 0x42,
-		0x4b, 0x51, 0x35, 0x0d, 0xbb, 0xae, 0xc5, 0x12, 0xa1, 0x8f, 0xf2, 0x98, 0x69, 0x35, 0x39, 0x73,
-		0x90, 0x21, 0xca, 0x9c, 0xb5, 0xcf, 0x7d, 0x13, 0xa3, 0xdc, 0xf7, 0x04, 0x64, 0x3a, 0xaa, 0xad,
-		0x60, 0xd3, 0x73, 0xf6, 0x69, 0x7d, 0x9e, 0x96, 0xd3, 0x1d, 0xd5, 0xae, 0x93, 0xf6, 0xf7, 0xe5,
-		0x98, 0x74, 0x3d, 0x99, 0x4e, 0x4a, 0x93, 0xd7, 0x93, 0xe9, 0x49, 0x29, 0x75, 0x3d, 0x99, 0x4e,
-		0x49, 0x53, 0xd7, 0x93, 0xe9, 0xb4, 0x94, 0xb9, 0x9e, 0x4c, 0x67, 0x24, 0x28, 0xbe, 0x9b, 0x80,
-		0x5c, 0xb8, 0x82, 0x27, 0x07, 0x22, 0x8d, 0xe6, 0xb0, 0x18, 0x8d, 0x72, 0x4f, 0x1c, 0x58, 0xef,
-		0xaf, 0x54, 0x49, 0x72, 0x2b, 0xa5, 0x58, 0xb9, 0x2c, 0x33, 0x49, 0x52, 0x58, 0x10, 0xf7, 0xc3,
-		0xac, 0x3c, 0x49, 0xcb, 0xbc, 0x85, 0xae, 0x41, 0xea, 0x4d, 0x97, 0x62, 0xa7, 0x28, 0xf6, 0x07,
-		0x0e, 0xc6, 0xbe, 0xde, 0xa4, 0xe0, 0x99, 0xeb, 0x4d, 0x65, 0x63, 0x53, 0x5e, 0x2f, 0xaf, 0xc9,
-		0x5c, 0x1c, 0x1d, 0x87, 0xa4, 0xa1, 0xde, 0xdb, 0xef, 0x4d, 0x83, 0x94, 0x34, 0xee, 0xb2, 0x1c,
-		0x87, 0xe4, 0x1d, 0xac, 0xde, 0xea, 0x4d, 0x3e, 0x94, 0xf4, 0x3e, 0x6e, 0x8f, 0x73, 0x30, 0x49,
-		0xed, 0x85, 0x00, 0xb8, 0xc5, 0xa4, 0x09, 0x94, 0x86, 0x64, 0x75, 0x53, 0x26, 0x5b, 0x44, 0x82,
-		0x1c, 0xa3, 0x2a, 0x8d, 0xd5, 0x7a, 0xb5, 0x2e, 0xc5, 0x8b, 0x17, 0x20, 0xc5, 0x8c, 0x40, 0xb6,
-		0x8f, 0x6f, 0x06, 0x69, 0x82, 0x37, 0x39, 0x46, 0x4c, 0xf4, 0x6e, 0xaf, 0x57, 0xea, 0xb2, 0x14,
-		0x1f, 0x58, 0xfc, 0xa2, 0x0b, 0xb9, 0x70, 0x65, 0xfe, 0xfd, 0x39, 0x9e, 0x7f, 0x25, 0x06, 0xd9,
-		0x50, 0xa5, 0x4d, 0x4a, 0x24, 0xd5, 0x30, 0xac, 0x3b, 0x8a, 0x6a, 0xe8, 0xaa, 0xcb, 0x5d, 0x03,
-		0x28, 0xa9, 0x4c, 0x28, 0xe3, 0x2e, 0xdd, 0xf7, 0x69, 0xd3, 0x4c, 0x4a, 0xa9, 0xe2, 0xa7, 0x63,
-		0x20, 0xf5, 0x97, 0xba, 0x7d, 0x6a, 0xc6, 0xfe, 0x30, 0xd5, 0x2c, 0x7e, 0x2a, 0x06, 0xf9, 0xde,
-		0xfa, 0xb6, 0x4f, 0xbd, 0xc7, 0xff, 0x50, 0xd5, 0xfb, 0x46, 0x1c, 0xa6, 0x7b, 0xaa, 0xda, 0x71,
-		0xb5, 0xfb, 0x08, 0xcc, 0xea, 0x2d, 0xdc, 0xb1, 0x2d, 0x0f, 0x9b, 0xda, 0xbe, 0x62, 0xe0, 0xdb,
-		0xd8, 0x28, 0x14, 0x69, 0xd0, 0x38, 0x77, 0x70, 0xdd, 0xbc, 0xb2, 0x1a, 0xc8, 0xad, 0x11, 0xb1,
-		0xd2, 0xdc, 0x6a, 0xad, 0xbe, 0xde, 0xd8, 0xdc, 0xaa, 0x6f, 0x54, 0x5f, 0x57, 0xb6, 0x37, 0x6e,
-		0x6c, 0x6c, 0xbe, 0xba, 0x21, 0x4b, 0x7a, 0x1f, 0xdb, 0xfb, 0xb8, 0xed, 0x1b, 0x20, 0xf5, 0x2b,
-		0x85, 0x8e, 0xc1, 0x30, 0xb5, 0xa4, 0x09, 0x34, 0x07, 0x33, 0x1b, 0x9b, 0x4a, 0x73, 0xb5, 0x56,
-		0x57, 0xea, 0x57, 0xaf, 0xd6, 0xab, 0x5b, 0x4d, 0x76, 0x13, 0xe2, 0x73, 0x6f, 0xf5, 0x6c, 0xf0,
-		0xe2, 0x27, 0x13, 0x30, 0x37, 0x44, 0x13, 0x54, 0xe6, 0x67, 0x18, 0x76, 0xac, 0x3a, 0x3b, 0x8e,
-		0xf6, 0x2b, 0xa4, 0x8a, 0x68, 0xa8, 0x8e, 0xc7, 0x8f, 0x3c, 0xa7, 0x81, 0x58, 0xc9, 0xf4, 0xf4,
-		0x5d, 0x1d, 0x3b, 0xfc, 0x86, 0x89, 0x1d, 0x6c, 0x66, 0x02, 0x3a, 0xbb, 0x64, 0x7a, 0x16, 0x90,
-		0x6d, 0xb9, 0xba, 0xa7, 0xdf, 0xc6, 0x8a, 0x6e, 0x8a, 0xeb, 0x28, 0x72, 0xd0, 0x49, 0xca, 0x92,
-		0xe8, 0x59, 0x35, 0x3d, 0x9f, 0xdb, 0xc4, 0x6d, 0xb5, 0x8f, 0x9b, 0x04, 0xf3, 0x84, 0x2c, 0x89,
-		0x1e, 0x9f, 0xfb, 0x71, 0xc8, 0xb5, 0xac, 0x2e, 0xa9, 0xfe, 0x18, 0x1f, 0xc9, 0x1d, 0x31, 0x39,
-		0xcb, 0x68, 0x3e, 0x0b, 0xaf, 0xeb, 0x83, 0x7b, 0xb0, 0x9c, 0x9c, 0x65, 0x34, 0xc6, 0xf2, 0x34,
-		0xcc, 0xa8, 0xed, 0xb6, 0x43, 0xc0, 0x05, 0x10, 0x3b, 0xa9, 0xe4, 0x7d, 0x32, 0x65, 0x5c, 0xb8,
-		0x0e, 0x69, 0x61, 0x07, 0x92, 0xbc, 0x89, 0x25, 0x14, 0x9b, 0x1d, 0xbf, 0xe3, 0xa7, 0x32, 0x72,
-		0xda, 0x14, 0x9d, 0x8f, 0x43, 0x4e, 0x77, 0x95, 0xe0, 0x5a, 0x3f, 0xbe, 0x1c, 0x3f, 0x95, 0x96,
-		0xb3, 0xba, 0xeb, 0x5f, 0x89, 0x16, 0x3f, 0x17, 0x87, 0x7c, 0xef, 0x63, 0x09, 0x54, 0x83, 0xb4,
-		0x61, 0x69, 0x2a, 0x75, 0x2d, 0xf6, 0x4c, 0xec, 0x54, 0xc4, 0x93, 0x8c, 0x95, 0x35, 0xce, 0x2f,
-		0xfb, 0x92, 0x0b, 0xff, 0x26, 0x06, 0x69, 0x41, 0x46, 0x47, 0x21, 0x69, 0xab, 0xde, 0x1e, 0x85,
-		0x9b, 0xac, 0xc4, 0xa5, 0x98, 0x4c, 0xdb, 0x84, 0xee, 0xda, 0xaa, 0x49, 0x5d, 0x80, 0xd3, 0x49,
-		0x9b, 0xac, 0xab, 0x81, 0xd5, 0x16, 0x3d, 0x06, 0x59, 0x9d, 0x0e, 0x36, 0x3d, 0x57, 0xac, 0x2b,
-		0xa7, 0x57, 0x39, 0x19, 0x3d, 0x03, 0xb3, 0x9e, 0xa3, 0xea, 0x46, 0x0f, 0x6f, 0x92, 0xf2, 0x4a,
-		0xa2, 0xc3, 0x67, 0x2e, 0xc1, 0x71, 0x81, 0xdb, 0xc2, 0x9e, 0xaa, 0xed, 0xe1, 0x56, 0x20, 0x94,
-		0xa2, 0xd7, 0x1d, 0xc7, 0x38, 0x43, 0x8d, 0xf7, 0x0b, 0xd9, 0xe2, 0xd7, 0x63, 0x30, 0x2b, 0x0e,
-		0x6e, 0x2d, 0xdf, 0x58, 0xeb, 0x00, 0xaa, 0x69, 0x5a, 0x5e, 0xd8, 0x5c, 0x83, 0xae, 0x3c, 0x20,
-		0xb7, 0x52, 0xf6, 0x85, 0xe4, 0x10, 0xc0, 0x42, 0x07, 0x20, 0xe8, 0x19, 0x69, 0xb6, 0x25, 0xc8,
-		0xf2, 0x67, 0x4e, 0xf4, 0xc1, 0x25, 0x3b, 0xea, 0x03, 0x23, 0x91, 0x13, 0x1e, 0x9a, 0x87, 0xc9,
-		0x1d, 0xdc, 0xd6, 0x4d, 0x7e, 0x93, 0xcc, 0x1a, 0xe2, 0x42, 0x26, 0xe9, 0x5f, 0xc8, 0x54, 0xfe,
-		0x1c, 0xcc, 0x69, 0x56, 0xa7, 0x5f, 0xdd, 0x8a, 0xd4, 0x77, 0xdd, 0xe0, 0xbe, 0x12, 0x7b, 0xe3,
-		0x2c, 0x67, 0x6a, 0x5b, 0x86, 0x6a, 0xb6, 0x57, 0x2c, 0xa7, 0x1d, 0x3c, 0x78, 0x25, 0x15, 0x8f,
-		0x1b, 0x7a, 0xfc, 0x6a, 0xef, 0xfc, 0x9f, 0x58, 0xec, 0xe7, 0xe3, 0x89, 0x6b, 0x8d, 0xca, 0xe7,
-		0xe3, 0x0b, 0xd7, 0x98, 0x60, 0x43, 0x18, 0x43, 0xc6, 0xbb, 0x06, 0xd6, 0xc8, 0x04, 0xe1, 0x77,
-		0x9f, 0x81, 0xf9, 0xb6, 0xd5, 0xb6, 0x28, 0xd2, 0x39, 0xf2, 0x1f, 0x7f, 0x72, 0x9b, 0xf1, 0xa9,
-		0x0b, 0x91, 0x8f, 0x79, 0x4b, 0x1b, 0x30, 0xc7, 0x99, 0x15, 0xfa, 0xe8, 0x88, 0x1d, 0x6c, 0xd0,
-		0x81, 0xb7, 0x6a, 0x85, 0x5f, 0xfe, 0x16, 0x4d, 0xdf, 0xf2, 0x2c, 0x17, 0x25, 0x7d, 0xec, 0xec,
-		0x53, 0x92, 0xe1, 0x48, 0x0f, 0x1e, 0xdb, 0xa4, 0xd8, 0x89, 0x40, 0xfc, 0xe7, 0x1c, 0x71, 0x2e,
-		0x84, 0xd8, 0xe4, 0xa2, 0xa5, 0x2a, 0x4c, 0x1f, 0x06, 0xeb, 0x5f, 0x70, 0xac, 0x1c, 0x0e, 0x83,
-		0x5c, 0x83, 0x19, 0x0a, 0xa2, 0x75, 0x5d, 0xcf, 0xea, 0xd0, 0x08, 0x78, 0x30, 0xcc, 0xbf, 0xfc,
-		0x16, 0xdb, 0x35, 0x79, 0x22, 0x56, 0xf5, 0xa5, 0x4a, 0x25, 0xa0, 0x4f, 0xcb, 0x5a, 0x58, 0x33,
-		0x22, 0x10, 0xbe, 0xca, 0x15, 0xf1, 0xf9, 0x4b, 0x37, 0x61, 0x9e, 0xfc, 0x4f, 0x03, 0x54, 0x58,
-		0x93, 0xe8, 0x2b, 0xb8, 0xc2, 0xd7, 0x3f, 0xca, 0x36, 0xe6, 0x9c, 0x0f, 0x10, 0xd2, 0x29, 0xb4,
-		0x8a, 0x6d, 0xec, 0x79, 0xd8, 0x71, 0x15, 0xd5, 0x18, 0xa6, 0x5e, 0xe8, 0x0e, 0xa3, 0xf0, 0xb3,
-		0xdf, 0xe9, 0x5d, 0xc5, 0x6b, 0x4c, 0xb2, 0x6c, 0x18, 0xa5, 0x6d, 0x38, 0x36, 0xc4, 0x2b, 0xc6,
-		0xc0, 0xfc, 0x24, 0xc7, 0x9c, 0x1f, 0xf0, 0x0c, 0x02, 0xdb, 0x00, 0x41, 0xf7, 0xd7, 0x72, 0x0c,
-		0xcc, 0x9f, 0xe3, 0x98, 0x88, 0xcb, 0x8a, 0x25, 0x25, 0x88, 0xd7, 0x61, 0xf6, 0x36, 0x76, 0x76,
-		0x2c, 0x97, 0xdf, 0x1b, 0x8d, 0x01, 0xf7, 0x29, 0x0e, 0x37, 0xc3, 0x05, 0xe9, 0x45, 0x12, 0xc1,
-		0xba, 0x0c, 0xe9, 0x5d, 0x55, 0xc3, 0x63, 0x40, 0x3c, 0xe0, 0x10, 0x53, 0x84, 0x9f, 0x88, 0x96,
-		0x21, 0xd7, 0xb6, 0x78, 0x8e, 0x8a, 0x16, 0xff, 0x34, 0x17, 0xcf, 0x0a, 0x19, 0x0e, 0x61, 0x5b,
-		0x76, 0xd7, 0x20, 0x09, 0x2c, 0x1a, 0xe2, 0xaf, 0x09, 0x08, 0x21, 0xc3, 0x21, 0x0e, 0x61, 0xd6,
-		0xb7, 0x05, 0x84, 0x1b, 0xb2, 0xe7, 0xcb, 0x90, 0xb5, 0x4c, 0x63, 0xdf, 0x32, 0xc7, 0x51, 0xe2,
-		0x33, 0x1c, 0x01, 0xb8, 0x08, 0x01, 0xb8, 0x02, 0x99, 0x71, 0x17, 0xe2, 0x17, 0xbf, 0x23, 0xb6,
-		0x87, 0x58, 0x81, 0x6b, 0x30, 0x23, 0x02, 0x94, 0x6e, 0x99, 0x63, 0x40, 0xfc, 0x75, 0x0e, 0x91,
-		0x0f, 0x89, 0xf1, 0x69, 0x78, 0xd8, 0xf5, 0xda, 0x78, 0x1c, 0x90, 0xcf, 0x89, 0x69, 0x70, 0x11,
-		0x6e, 0xca, 0x1d, 0x6c, 0x6a, 0x7b, 0xe3, 0x21, 0xfc, 0x92, 0x30, 0xa5, 0x90, 0x21, 0x10, 0x55,
-		0x98, 0xee, 0xa8, 0x8e, 0xbb, 0xa7, 0x1a, 0x63, 0x2d, 0xc7, 0xdf, 0xe0, 0x18, 0x39, 0x5f, 0x88,
-		0x5b, 0xa4, 0x6b, 0x1e, 0x06, 0xe6, 0xf3, 0xc2, 0x22, 0x21, 0x31, 0xbe, 0xf5, 0x5c, 0x8f, 0x5e,
-		0xb2, 0x1d, 0x06, 0xed, 0x6f, 0x8a, 0xad, 0xc7, 0x64, 0xd7, 0xc3, 0x88, 0x57, 0x20, 0xe3, 0xea,
-		0xf7, 0xc6, 0x82, 0xf9, 0x82, 0x58, 0x69, 0x2a, 0x40, 0x84, 0x5f, 0x87, 0xe3, 0x43, 0xd3, 0xc4,
-		0x18, 0x60, 0x7f, 0x8b, 0x83, 0x1d, 0x1d, 0x92, 0x2a, 0x78, 0x48, 0x38, 0x2c, 0xe4, 0xdf, 0x16,
-		0x21, 0x01, 0xf7, 0x61, 0x35, 0xc8, 0xa9, 0xc1, 0x55, 0x77, 0x0f, 0x67, 0xb5, 0xbf, 0x23, 0xac,
-		0xc6, 0x64, 0x7b, 0xac, 0xb6, 0x05, 0x47, 0x39, 0xe2, 0xe1, 0xd6, 0xf5, 0xef, 0x8a, 0xc0, 0xca,
-		0xa4, 0xb7, 0x7b, 0x57, 0xf7, 0x07, 0x61, 0xc1, 0x37, 0xa7, 0x28, 0x4f, 0x5d, 0xa5, 0xa3, 0xda,
-		0x63, 0x20, 0xff, 0x32, 0x47, 0x16, 0x11, 0xdf, 0xaf, 0x6f, 0xdd, 0x75, 0xd5, 0x26, 0xe0, 0xaf,
-		0x41, 0x41, 0x80, 0x77, 0x4d, 0x07, 0x6b, 0x56, 0xdb, 0xd4, 0xef, 0xe1, 0xd6, 0x18, 0xd0, 0xbf,
-		0xd2, 0xb7, 0x54, 0xdb, 0x21, 0x71, 0x82, 0xbc, 0x0a, 0x92, 0x5f, 0xab, 0x28, 0x7a, 0xc7, 0xb6,
-		0x1c, 0x2f, 0x02, 0xf1, 0x8b, 0x62, 0xa5, 0x7c, 0xb9, 0x55, 0x2a, 0x56, 0xaa, 0x03, 0x7b, 0xf2,
-		0x3c, 0xae, 0x4b, 0x7e, 0x89, 0x03, 0x4d, 0x07, 0x52, 0x3c, 0x70, 0x68, 0x56, 0xc7, 0x56, 0x9d,
-		0x71, 0xe2, 0xdf, 0xdf, 0x13, 0x81, 0x83, 0x8b, 0xf0, 0xc0, 0x41, 0x2a, 0x3a, 0x92, 0xed, 0xc7,
-		0x40, 0xf8, 0xb2, 0x08, 0x1c, 0x42, 0x86, 0x43, 0x88, 0x82, 0x61, 0x0c, 0x88, 0xbf, 0x2f, 0x20,
-		0x84, 0x0c, 0x81, 0xf8, 0x70, 0x90, 0x68, 0x1d, 0xdc, 0xd6, 0x5d, 0xcf, 0x61, 0x45, 0xf1, 0xc1,
-		0x50, 0xff, 0xe0, 0x3b, 0xbd, 0x45, 0x98, 0x1c, 0x12, 0x25, 0x91, 0x88, 0x5f, 0xbb, 0xd2, 0x33,
-		0x53, 0xb4, 0x62, 0xbf, 0x2a, 0x22, 0x51, 0x48, 0x8c, 0xe8, 0x16, 0xaa, 0x10, 0x89, 0xd9, 0x35,
-		0x72, 0x52, 0x18, 0x03, 0xee, 0x1f, 0xf6, 0x29, 0xd7, 0x14, 0xb2, 0x04, 0x33, 0x54, 0xff, 0x74,
-		0xcd, 0x5b, 0x78, 0x7f, 0x2c, 0xef, 0xfc, 0x47, 0x7d, 0xf5, 0xcf, 0x36, 0x93, 0x64, 0x31, 0x64,
-		0xa6, 0xaf, 0x9e, 0x42, 0x51, 0xef, 0x19, 0x15, 0x7e, 0xf8, 0x3d, 0x3e, 0xdf, 0xde, 0x72, 0xaa,
-		0xb4, 0x46, 0x9c, 0xbc, 0xb7, 0xe8, 0x89, 0x06, 0xfb, 0xe8, 0x7b, 0xbe, 0x9f, 0xf7, 0xd4, 0x3c,
-		0xa5, 0xab, 0x30, 0xdd, 0x53, 0xf0, 0x44, 0x43, 0xfd, 0x05, 0x0e, 0x95, 0x0b, 0xd7, 0x3b, 0xa5,
-		0x0b, 0x90, 0x24, 0xc5, 0x4b, 0xb4, 0xf8, 0x5f, 0xe4, 0xe2, 0x94, 0xbd, 0xf4, 0x41, 0x48, 0x8b,
-		0xa2, 0x25, 0x5a, 0xf4, 0x47, 0xb8, 0xa8, 0x2f, 0x42, 0xc4, 0x45, 0xc1, 0x12, 0x2d, 0xfe, 0x97,
-		0x84, 0xb8, 0x10, 0x21, 0xe2, 0xe3, 0x9b, 0xf0, 0x2b, 0x7f, 0x39, 0xc9, 0x93, 0x8e, 0xb0, 0xdd,
-		0x15, 0x98, 0xe2, 0x95, 0x4a, 0xb4, 0xf4, 0x8f, 0xf2, 0xc1, 0x85, 0x44, 0xe9, 0x12, 0x4c, 0x8e,
-		0x69, 0xf0, 0x1f, 0xe3, 0xa2, 0x8c, 0xbf, 0x54, 0x85, 0x6c, 0xa8, 0x3a, 0x89, 0x16, 0xff, 0x71,
-		0x2e, 0x1e, 0x96, 0x22, 0xaa, 0xf3, 0xea, 0x24, 0x1a, 0xe0, 0x27, 0x84, 0xea, 0x5c, 0x82, 0x98,
-		0x4d, 0x14, 0x26, 0xd1, 0xd2, 0x1f, 0x17, 0x56, 0x17, 0x22, 0xa5, 0x97, 0x21, 0xe3, 0x27, 0x9b,
-		0x68, 0xf9, 0x9f, 0xe4, 0xf2, 0x81, 0x0c, 0xb1, 0x40, 0x28, 0xd9, 0x45, 0x43, 0xfc, 0x15, 0x61,
-		0x81, 0x90, 0x14, 0xd9, 0x46, 0xfd, 0x05, 0x4c, 0x34, 0xd2, 0x4f, 0x89, 0x6d, 0xd4, 0x57, 0xbf,
-		0x90, 0xd5, 0xa4, 0x31, 0x3f, 0x1a, 0xe2, 0xaf, 0x8a, 0xd5, 0xa4, 0xfc, 0x44, 0x8d, 0xfe, 0x8a,
-		0x20, 0x1a, 0xe3, 0x67, 0x84, 0x1a, 0x7d, 0x05, 0x41, 0xa9, 0x01, 0x68, 0xb0, 0x1a, 0x88, 0xc6,
-		0xfb, 0x04, 0xc7, 0x9b, 0x1d, 0x28, 0x06, 0x4a, 0xaf, 0xc2, 0xd1, 0xe1, 0x95, 0x40, 0x34, 0xea,
-		0xcf, 0xbe, 0xd7, 0x77, 0x76, 0x0b, 0x17, 0x02, 0xa5, 0xad, 0x20, 0xa5, 0x84, 0xab, 0x80, 0x68,
-		0xd8, 0x4f, 0xbe, 0xd7, 0x1b, 0xb8, 0xc3, 0x45, 0x40, 0xa9, 0x0c, 0x10, 0x24, 0xe0, 0x68, 0xac,
-		0x4f, 0x71, 0xac, 0x90, 0x10, 0xd9, 0x1a, 0x3c, 0xff, 0x46, 0xcb, 0x3f, 0x10, 0x5b, 0x83, 0x4b,
-		0x90, 0xad, 0x21, 0x52, 0x6f, 0xb4, 0xf4, 0xa7, 0xc5, 0xd6, 0x10, 0x22, 0xc4, 0xb3, 0x43, 0xd9,
-		0x2d, 0x1a, 0xe1, 0x33, 0xc2, 0xb3, 0x43, 0x52, 0xa5, 0x0d, 0x98, 0x1d, 0x48, 0x88, 0xd1, 0x50,
-		0x3f, 0xcf, 0xa1, 0xa4, 0xfe, 0x7c, 0x18, 0x4e, 0x5e, 0x3c, 0x19, 0x46, 0xa3, 0x7d, 0xb6, 0x2f,
-		0x79, 0xf1, 0x5c, 0x58, 0xba, 0x02, 0x69, 0xb3, 0x6b, 0x18, 0x64, 0xf3, 0xa0, 0x83, 0xdf, 0x0d,
-		0x2c, 0xfc, 0xb7, 0xef, 0x72, 0xeb, 0x08, 0x81, 0xd2, 0x05, 0x98, 0xc4, 0x9d, 0x1d, 0xdc, 0x8a,
-		0x92, 0xfc, 0x9d, 0xef, 0x8a, 0x80, 0x49, 0xb8, 0x4b, 0x2f, 0x03, 0xb0, 0xab, 0x11, 0xfa, 0x30,
-		0x30, 0x42, 0xf6, 0xbf, 0x7f, 0x97, 0xbf, 0x8c, 0x13, 0x88, 0x04, 0x00, 0xec, 0xd5, 0x9e, 0x83,
-		0x01, 0xbe, 0xd3, 0x0b, 0x40, 0x57, 0xe4, 0x32, 0x4c, 0xbd, 0xe9, 0x5a, 0xa6, 0xa7, 0xb6, 0xa3,
-		0xa4, 0x7f, 0x97, 0x4b, 0x0b, 0x7e, 0x62, 0xb0, 0x8e, 0xe5, 0x60, 0x4f, 0x6d, 0xbb, 0x51, 0xb2,
-		0xff, 0x83, 0xcb, 0xfa, 0x02, 0x44, 0x58, 0x53, 0x5d, 0x6f, 0x9c, 0x79, 0xff, 0x9e, 0x10, 0x16,
-		0x02, 0x44, 0x69, 0xf2, 0xff, 0x2d, 0xbc, 0x1f, 0x25, 0xfb, 0xfb, 0x42, 0x69, 0xce, 0x5f, 0xfa,
-		0x20, 0x64, 0xc8, 0xbf, 0xec, 0x0d, 0xbb, 0x08, 0xe1, 0xff, 0xc9, 0x85, 0x03, 0x09, 0x32, 0xb2,
-		0xeb, 0xb5, 0x3c, 0x3d, 0xda, 0xd8, 0x7f, 0xc0, 0x57, 0x5a, 0xf0, 0x97, 0xca, 0x90, 0x75, 0xbd,
-		0x56, 0xab, 0xcb, 0xeb, 0xd3, 0x08, 0xf1, 0xff, 0xf5, 0x5d, 0xff, 0xca, 0xc2, 0x97, 0x21, 0xab,
-		0x7d, 0xe7, 0x96, 0x67, 0x5b, 0xf4, 0x81, 0x47, 0x14, 0xc2, 0x7b, 0x1c, 0x21, 0x24, 0x52, 0xaa,
-		0x42, 0x8e, 0xcc, 0xc5, 0xc1, 0x36, 0xa6, 0x4f, 0xa7, 0x22, 0x20, 0xfe, 0x37, 0x37, 0x40, 0x8f,
-		0x50, 0xe5, 0x4f, 0x7f, 0xf5, 0xdd, 0xc5, 0xd8, 0xd7, 0xde, 0x5d, 0x8c, 0x7d, 0xe3, 0xdd, 0xc5,
-		0xd8, 0xc7, 0xbf, 0xb9, 0x38, 0xf1, 0xb5, 0x6f, 0x2e, 0x4e, 0xfc, 0xd6, 0x37, 0x17, 0x27, 0x86,
-		0xdf, 0x12, 0xc3, 0x35, 0xeb, 0x9a, 0xc5, 0xee, 0x87, 0xdf, 0x78, 0xb2, 0xad, 0x7b, 0x7b, 0xdd,
-		0x9d, 0x15, 0xcd, 0xea, 0x9c, 0xd3, 0x2c, 0xb7, 0x63, 0xb9, 0xe7, 0x7a, 0xef, 0x75, 0xe9, 0x7f,
-		0xf0, 0xbd, 0x18, 0x39, 0x33, 0xf7, 0x5e, 0xe7, 0xaa, 0xe6, 0xfe, 0xa8, 0xcf, 0x75, 0x2e, 0x42,
-		0xa2, 0x6c, 0xee, 0xa3, 0xe3, 0x2c, 0xc0, 0x29, 0x5d, 0xc7, 0xe0, 0xaf, 0x79, 0x4d, 0x91, 0xf6,
-		0xb6, 0x63, 0xa0, 0xf9, 0xe0, 0x5d, 0xcc, 0xd8, 0xa9, 0x1c, 0x7f, 0xc1, 0xb2, 0xf2, 0xe3, 0xb1,
-		0xc3, 0xcd, 0x24, 0x5d, 0x36, 0xf7, 0xe9, 0x44, 0x1a, 0xb1, 0x37, 0x9e, 0x8d, 0xbc, 0xe7, 0xbe,
-		0x65, 0x5a, 0x77, 0x4c, 0xa2, 0xb6, 0xbd, 0x23, 0xee, 0xb8, 0x17, 0xfb, 0xef, 0xb8, 0x5f, 0xc5,
-		0x86, 0x71, 0x83, 0xf0, 0x6d, 0x11, 0x91, 0x9d, 0x14, 0x7b, 0xa3, 0x18, 0x7e, 0x2a, 0x0e, 0x8b,
-		0x03, 0xd7, 0xd9, 0xdc, 0x09, 0x46, 0x19, 0xa1, 0x04, 0xe9, 0x9a, 0xf0, 0xad, 0x02, 0x4c, 0xb9,
-		0x58, 0xb3, 0xcc, 0x96, 0x4b, 0x0d, 0x91, 0x90, 0x45, 0x93, 0x18, 0xc2, 0x54, 0x4d, 0xcb, 0xe5,
-		0x2f, 0x4a, 0xb2, 0x46, 0xe5, 0xe7, 0x0e, 0x69, 0x88, 0x69, 0x31, 0x92, 0xb0, 0xc6, 0xf3, 0x63,
-		0x5a, 0x43, 0x4c, 0xa2, 0xe7, 0xe6, 0x7f, 0x5c, 0xab, 0xfc, 0x4c, 0x1c, 0x96, 0xfa, 0xad, 0x42,
-		0x76, 0x96, 0xeb, 0xa9, 0x1d, 0x7b, 0x94, 0x59, 0xae, 0x40, 0x66, 0x4b, 0xf0, 0x1c, 0xda, 0x2e,
-		0x0f, 0x0e, 0x69, 0x97, 0xbc, 0x3f, 0x94, 0x30, 0xcc, 0xf9, 0x31, 0x0d, 0xe3, 0xcf, 0xe3, 0xa1,
-		0x2c, 0xf3, 0x7f, 0x53, 0x70, 0x9c, 0x6d, 0x27, 0x85, 0x6d, 0x25, 0xd6, 0xe0, 0x36, 0xc9, 0x85,
-		0xbb, 0xa2, 0x9f, 0x93, 0x14, 0x6f, 0xc0, 0xdc, 0x2a, 0x89, 0x16, 0xe4, 0x14, 0x14, 0x3c, 0xe1,
-		0x19, 0xfa, 0x2e, 0xe9, 0x72, 0x4f, 0xc1, 0xcf, 0x9f, 0x30, 0x85, 0x49, 0xc5, 0x1f, 0x8e, 0x81,
-		0xd4, 0xd4, 0x54, 0x43, 0x75, 0xfe, 0x7f, 0xa1, 0xd0, 0x25, 0x00, 0xfa, 0x0d, 0x52, 0xf0, 0xd1,
-		0x50, 0xfe, 0x7c, 0x61, 0x25, 0x3c, 0xb9, 0x15, 0x36, 0x12, 0xfd, 0x22, 0x21, 0x43, 0x79, 0xc9,
-		0xbf, 0x67, 0x5e, 0x03, 0x08, 0x3a, 0xd0, 0x09, 0x38, 0xd6, 0xac, 0x96, 0xd7, 0xca, 0xb2, 0xc2,
-		0x5e, 0x6e, 0xdf, 0x68, 0x36, 0xea, 0xd5, 0xd5, 0xab, 0xab, 0xf5, 0x9a, 0x34, 0x81, 0x8e, 0x02,
-		0x0a, 0x77, 0xfa, 0xef, 0xa5, 0x1c, 0x81, 0xd9, 0x30, 0x9d, 0xbd, 0x21, 0x1f, 0x27, 0x95, 0xa2,
-		0xde, 0xb1, 0x0d, 0x4c, 0x1f, 0xfd, 0x29, 0xba, 0xb0, 0x5a, 0x74, 0x11, 0xf2, 0xaf, 0xfe, 0x2d,
-		0x7b, 0x6b, 0x7a, 0x2e, 0x10, 0xf7, 0x6d, 0x5e, 0x5a, 0x83, 0x59, 0x55, 0xd3, 0xb0, 0xdd, 0x03,
-		0x19, 0x11, 0xaa, 0x09, 0x20, 0x7d, 0x98, 0xc9, 0x25, 0x03, 0xb4, 0x4b, 0x90, 0x72, 0xe9, 0xec,
-		0xa3, 0x20, 0x7e, 0x9d, 0x43, 0x70, 0xf6, 0x92, 0x09, 0xb3, 0xa4, 0xf2, 0x53, 0x1d, 0x1c, 0x52,
-		0xe3, 0xe0, 0x7b, 0x86, 0x7f, 0xfc, 0xc5, 0xe7, 0xe8, 0xa3, 0xcd, 0xc7, 0x7b, 0x97, 0x65, 0x88,
-		0x3b, 0xc9, 0x12, 0xc7, 0x0e, 0x14, 0xc5, 0x90, 0x17, 0xe3, 0x71, 0x85, 0x0f, 0x1e, 0xec, 0x9f,
-		0xf0, 0xc1, 0x16, 0x87, 0xf9, 0x40, 0x68, 0xa4, 0x69, 0x8e, 0xca, 0x3a, 0x2a, 0xf5, 0x51, 0x7b,
-		0xfa, 0x8d, 0x67, 0x06, 0xb3, 0x13, 0xfb, 0x73, 0x96, 0x22, 0x5f, 0x09, 0x0f, 0xe3, 0xef, 0xbd,
-		0xdf, 0x4c, 0xc0, 0x22, 0x67, 0xde, 0x51, 0x5d, 0x7c, 0xee, 0xf6, 0xf3, 0x3b, 0xd8, 0x53, 0x9f,
-		0x3f, 0xa7, 0x59, 0xba, 0x88, 0xd5, 0x73, 0x7c, 0x3b, 0x92, 0xfe, 0x15, 0xde, 0xbf, 0x30, 0xf4,
-		0x81, 0xe6, 0xc2, 0xe8, 0x6d, 0x5c, 0xdc, 0x86, 0x64, 0xd5, 0xd2, 0x4d, 0x12, 0xaa, 0x5a, 0xd8,
-		0xb4, 0x3a, 0x7c, 0xf7, 0xb0, 0x06, 0x7a, 0x1e, 0x52, 0x6a, 0xc7, 0xea, 0x9a, 0x1e, 0xdb, 0x39,
-		0x95, 0xe3, 0x5f, 0x7d, 0x67, 0x69, 0xe2, 0xdf, 0xbf, 0xb3, 0x94, 0x58, 0x35, 0xbd, 0xdf, 0xf8,
-		0xd2, 0x59, 0xe0, 0x50, 0xab, 0xa6, 0x27, 0x73, 0xc6, 0x52, 0xf2, 0xdb, 0x6f, 0x2f, 0xc5, 0x8a,
-		0xaf, 0xc1, 0x54, 0x0d, 0x6b, 0x0f, 0x83, 0x5c, 0xc3, 0x5a, 0x08, 0xb9, 0x86, 0xb5, 0x3e, 0xe4,
-		0x4b, 0x90, 0x5e, 0x35, 0x3d, 0xf6, 0x22, 0xfa, 0x33, 0x90, 0xd0, 0x4d, 0xf6, 0x6e, 0xe3, 0x81,
-		0xba, 0x11, 0x2e, 0x22, 0x58, 0xc3, 0x9a, 0x2f, 0xd8, 0xc2, 0x5a, 0xbf, 0xe0, 0xe0, 0xd0, 0x84,
-		0xab, 0x52, 0xfb, 0xad, 0xff, 0xbc, 0x38, 0xf1, 0xd6, 0xbb, 0x8b, 0x13, 0x23, 0x97, 0xb8, 0x38,
-		0x72, 0x89, 0xdd, 0xd6, 0x2d, 0x16, 0x91, 0xfd, 0x95, 0xfd, 0x7c, 0x12, 0x4e, 0xd2, 0xef, 0x93,
-		0x9c, 0x8e, 0x6e, 0x7a, 0xe7, 0x34, 0x67, 0xdf, 0xf6, 0x2c, 0x12, 0x37, 0xad, 0x5d, 0xbe, 0xb0,
-		0xb3, 0x41, 0xf7, 0x0a, 0xeb, 0x1e, 0xbe, 0xac, 0xc5, 0x5d, 0x98, 0x6c, 0x10, 0x39, 0x62, 0x62,
-		0xcf, 0xf2, 0x54, 0x83, 0xe7, 0x1f, 0xd6, 0x20, 0x54, 0xf6, 0x4d, 0x53, 0x9c, 0x51, 0x75, 0xf1,
-		0x39, 0x93, 0x81, 0xd5, 0x5d, 0xf6, 0x6a, 0x78, 0x82, 0x16, 0x2e, 0x69, 0x42, 0xa0, 0x6f, 0x81,
-		0xcf, 0xc3, 0xa4, 0xda, 0x65, 0xef, 0x30, 0x24, 0x48, 0x45, 0x43, 0x1b, 0xc5, 0x1b, 0x30, 0xc5,
-		0x9f, 0xa4, 0x22, 0x09, 0x12, 0xb7, 0xf0, 0x3e, 0x1d, 0x27, 0x27, 0x93, 0x7f, 0xd1, 0x0a, 0x4c,
-		0x52, 0xe5, 0xf9, 0x37, 0x2f, 0x85, 0x95, 0x01, 0xed, 0x57, 0xa8, 0x92, 0x32, 0x63, 0x2b, 0x5e,
-		0x87, 0x74, 0xcd, 0xea, 0xe8, 0xa6, 0xd5, 0x8b, 0x96, 0x61, 0x68, 0x54, 0x67, 0xbb, 0xcb, 0xbd,
-		0x42, 0x66, 0x0d, 0x74, 0x14, 0x52, 0xec, 0x53, 0x01, 0xfe, 0x1e, 0x06, 0x6f, 0x15, 0xab, 0x30,
-		0x45, 0xb1, 0x37, 0x6d, 0x12, 0xfc, 0xfd, 0xb7, 0x32, 0x33, 0xfc, 0xc3, 0x31, 0x0e, 0x1f, 0x0f,
-		0x94, 0x45, 0x90, 0x6c, 0xa9, 0x9e, 0xca, 0xe7, 0x4d, 0xff, 0x2f, 0x7e, 0x08, 0xd2, 0x1c, 0xc4,
-		0x45, 0xe7, 0x21, 0x61, 0xd9, 0x2e, 0x7f, 0x93, 0x62, 0x61, 0xd4, 0x54, 0x36, 0xed, 0x4a, 0x92,
-		0xf8, 0x8c, 0x4c, 0x98, 0x2b, 0xf2, 0x48, 0xb7, 0x78, 0x29, 0xe4, 0x16, 0xa1, 0x25, 0x0f, 0xfd,
-		0xcb, 0x96, 0x74, 0xc0, 0x1d, 0x7c, 0x67, 0xf9, 0x4c, 0x1c, 0x16, 0x43, 0xbd, 0xb7, 0xb1, 0xe3,
-		0xea, 0x96, 0xc9, 0x3c, 0x8a, 0x7b, 0x0b, 0x0a, 0x29, 0xc9, 0xfb, 0x47, 0xb8, 0xcb, 0x07, 0x21,
-		0x51, 0xb6, 0x6d, 0xb4, 0x00, 0x69, 0xda, 0xd6, 0x2c, 0xe6, 0x2f, 0x49, 0xd9, 0x6f, 0x93, 0x3e,
-		0xd7, 0xda, 0xf5, 0xee, 0xa8, 0x8e, 0xff, 0x35, 0x9d, 0x68, 0x17, 0x2f, 0x43, 0xa6, 0x6a, 0x99,
-		0x2e, 0x36, 0xdd, 0x2e, 0xad, 0x6c, 0x76, 0x0c, 0x4b, 0xbb, 0xc5, 0x11, 0x58, 0x83, 0x18, 0x5c,
-		0xb5, 0x6d, 0x2a, 0x99, 0x94, 0xc9, 0xbf, 0x6c, 0xcf, 0x56, 0x9a, 0x23, 0x4d, 0x74, 0xf9, 0xf0,
-		0x26, 0xe2, 0x93, 0xf4, 0x6d, 0xf4, 0xbd, 0x18, 0x3c, 0x36, 0xb8, 0xa1, 0x6e, 0xe1, 0x7d, 0xf7,
-		0xb0, 0xfb, 0xe9, 0x35, 0xc8, 0x34, 0xe8, 0x27, 0xed, 0x37, 0xf0, 0x3e, 0x5a, 0x80, 0x29, 0xdc,
-		0x3a, 0x7f, 0xe1, 0xc2, 0xf3, 0x97, 0x99, 0xb7, 0xbf, 0x32, 0x21, 0x0b, 0x02, 0x5a, 0x84, 0x8c,
-		0x8b, 0x35, 0xfb, 0xfc, 0x85, 0x8b, 0xb7, 0x9e, 0x67, 0xee, 0xf5, 0xca, 0x84, 0x1c, 0x90, 0x4a,
-		0x69, 0x32, 0xeb, 0x6f, 0x7f, 0x66, 0x29, 0x56, 0x99, 0x84, 0x84, 0xdb, 0xed, 0xbc, 0xaf, 0x3e,
-		0xf2, 0xc9, 0x49, 0x58, 0x0e, 0x4b, 0xd2, 0xfa, 0xef, 0xb6, 0x6a, 0xe8, 0x2d, 0x35, 0xf8, 0x31,
-		0x02, 0x29, 0x64, 0x03, 0xca, 0x31, 0x22, 0x53, 0x1c, 0x68, 0xc9, 0xe2, 0xaf, 0xc4, 0x20, 0x77,
-		0x53, 0x20, 0x37, 0xb1, 0x87, 0xae, 0x00, 0xf8, 0x23, 0x89, 0x6d, 0x73, 0x62, 0xa5, 0x7f, 0xac,
-		0x15, 0x5f, 0x46, 0x0e, 0xb1, 0xa3, 0x4b, 0xd4, 0x11, 0x6d, 0xcb, 0xe5, 0x5f, 0x58, 0x45, 0x88,
-		0xfa, 0xcc, 0xe8, 0x59, 0x40, 0x34, 0xc2, 0x29, 0xb7, 0x2d, 0x4f, 0x37, 0xdb, 0x8a, 0x6d, 0xdd,
-		0xe1, 0xdf, 0xad, 0x26, 0x64, 0x89, 0xf6, 0xdc, 0xa4, 0x1d, 0x0d, 0x42, 0x27, 0x4a, 0x67, 0x7c,
-		0x14, 0x52, 0xac, 0xab, 0xad, 0x96, 0x83, 0x5d, 0x97, 0x07, 0x31, 0xd1, 0x44, 0x57, 0x60, 0xca,
-		0xee, 0xee, 0x28, 0x22, 0x62, 0x64, 0xcf, 0x3f, 0x36, 0x6c, 0xff, 0x0b, 0xff, 0xe0, 0x11, 0x20,
-		0x65, 0x77, 0x77, 0x88, 0xb7, 0x3c, 0x0e, 0xb9, 0x21, 0xca, 0x64, 0x6f, 0x07, 0x7a, 0xd0, 0x5f,
-		0x52, 0xe0, 0x33, 0x50, 0x6c, 0x47, 0xb7, 0x1c, 0xdd, 0xdb, 0xa7, 0xaf, 0x43, 0x25, 0x64, 0x49,
-		0x74, 0x34, 0x38, 0xbd, 0x78, 0x0b, 0x66, 0x9a, 0xb4, 0x88, 0x0b, 0x34, 0xbf, 0x10, 0xe8, 0x17,
-		0x8b, 0xd6, 0x6f, 0xa4, 0x66, 0xf1, 0x01, 0xcd, 0x2a, 0x1f, 0x1e, 0xe9, 0x9d, 0x97, 0x0e, 0xef,
-		0x9d, 0xbd, 0xd9, 0xee, 0xf7, 0x8e, 0xf7, 0x6c, 0x4e, 0xe6, 0x9c, 0xe1, 0xf0, 0x35, 0xae, 0x63,
-		0x46, 0x9d, 0xd1, 0x16, 0x0e, 0x4e, 0xaa, 0x0b, 0x11, 0x61, 0x74, 0x21, 0x72, 0x0b, 0x15, 0x2f,
-		0xc3, 0x74, 0x43, 0x75, 0xbc, 0x26, 0xf6, 0x5e, 0xc1, 0x6a, 0x0b, 0x3b, 0xbd, 0x59, 0x77, 0x5a,
-		0x64, 0x5d, 0x04, 0x49, 0x9a, 0x5a, 0x59, 0xd6, 0xa1, 0xff, 0x17, 0xf7, 0x20, 0x49, 0x5f, 0x89,
-		0xf4, 0x33, 0x32, 0x97, 0x60, 0x19, 0x99, 0xc4, 0xd2, 0x7d, 0x0f, 0xbb, 0xe2, 0x1a, 0x81, 0x36,
-		0xd0, 0x8b, 0x22, 0xaf, 0x26, 0x0e, 0xce, 0xab, 0xdc, 0x11, 0x79, 0x76, 0x35, 0x60, 0xaa, 0x42,
-		0x42, 0xf1, 0x6a, 0xcd, 0x57, 0x24, 0x16, 0x28, 0x82, 0xd6, 0x61, 0xc6, 0x56, 0x1d, 0x8f, 0x7e,
-		0x1d, 0xb2, 0x47, 0x67, 0xc1, 0x7d, 0x7d, 0x69, 0x70, 0xe7, 0xf5, 0x4c, 0x96, 0x8f, 0x32, 0x6d,
-		0x87, 0x89, 0xc5, 0xff, 0x92, 0x84, 0x14, 0x37, 0xc6, 0x07, 0x61, 0x8a, 0x9b, 0x95, 0x7b, 0xe7,
-		0xc9, 0x95, 0xc1, 0xc4, 0xb4, 0xe2, 0x27, 0x10, 0x8e, 0x27, 0x64, 0xd0, 0x53, 0x90, 0xd6, 0xf6,
-		0x54, 0xdd, 0x54, 0xf4, 0x16, 0x2f, 0x08, 0xb3, 0xef, 0xbe, 0xb3, 0x34, 0x55, 0x25, 0xb4, 0xd5,
-		0x9a, 0x3c, 0x45, 0x3b, 0x57, 0x5b, 0xa4, 0x12, 0xd8, 0xc3, 0x7a, 0x7b, 0xcf, 0xe3, 0x3b, 0x8c,
-		0xb7, 0xd0, 0x4b, 0x90, 0x24, 0x0e, 0xc1, 0xbf, 0x1d, 0x5c, 0x18, 0xa8, 0xf0, 0xfd, 0x23, 0x74,
-		0x25, 0x4d, 0x06, 0xfe, 0xf8, 0x7f, 0x5a, 0x8a, 0xc9, 0x54, 0x02, 0x55, 0x61, 0xda, 0x50, 0x5d,
-		0x4f, 0xa1, 0x19, 0x8c, 0x0c, 0x3f, 0x49, 0x21, 0x8e, 0x0f, 0x1a, 0x84, 0x1b, 0x96, 0xab, 0x9e,
-		0x25, 0x52, 0x8c, 0xd4, 0x42, 0xa7, 0x40, 0xa2, 0x20, 0x9a, 0xd5, 0xe9, 0xe8, 0x1e, 0xab, 0xad,
-		0x52, 0xd4, 0xee, 0x79, 0x42, 0xaf, 0x52, 0x32, 0xad, 0xb0, 0x4e, 0x40, 0x86, 0x7e, 0xad, 0x44,
-		0x59, 0xd8, 0x7b, 0xb8, 0x69, 0x42, 0xa0, 0x9d, 0x4f, 0xc3, 0x4c, 0x10, 0x1f, 0x19, 0x4b, 0x9a,
-		0xa1, 0x04, 0x64, 0xca, 0xf8, 0x1c, 0xcc, 0x9b, 0xf8, 0x2e, 0x7d, 0x33, 0xb8, 0x87, 0x3b, 0x43,
-		0xb9, 0x11, 0xe9, 0xbb, 0xd9, 0x2b, 0xf1, 0x24, 0xe4, 0x35, 0x61, 0x7c, 0xc6, 0x0b, 0x94, 0x77,
-		0xda, 0xa7, 0x52, 0xb6, 0xe3, 0x90, 0x56, 0x6d, 0x9b, 0x31, 0x64, 0x79, 0x7c, 0xb4, 0x6d, 0xda,
-		0x75, 0x06, 0x66, 0xe9, 0x1c, 0x1d, 0xec, 0x76, 0x0d, 0x8f, 0x83, 0xe4, 0x28, 0xcf, 0x0c, 0xe9,
-		0x90, 0x19, 0x9d, 0xf2, 0x3e, 0x01, 0xd3, 0xf8, 0xb6, 0xde, 0xc2, 0xa6, 0x86, 0x19, 0xdf, 0x34,
-		0xe5, 0xcb, 0x09, 0x22, 0x65, 0x3a, 0x0d, 0x7e, 0xdc, 0x53, 0x44, 0x4c, 0xce, 0x33, 0x3c, 0x41,
-		0x2f, 0x33, 0x72, 0xb1, 0x00, 0xc9, 0x9a, 0xea, 0xa9, 0xa4, 0xc0, 0xf0, 0xee, 0xb2, 0x44, 0x93,
-		0x93, 0xc9, 0xbf, 0xc5, 0x6f, 0xc7, 0x21, 0x79, 0xd3, 0xf2, 0x30, 0x7a, 0x21, 0x54, 0x00, 0xe6,
-		0x87, 0xf9, 0x73, 0x53, 0x6f, 0x9b, 0xb8, 0xb5, 0xee, 0xb6, 0x43, 0x3f, 0x2d, 0x10, 0xb8, 0x53,
-		0xbc, 0xc7, 0x9d, 0xe6, 0x61, 0xd2, 0xb1, 0xba, 0x66, 0x4b, 0xbc, 0xc2, 0x4a, 0x1b, 0xa8, 0x0e,
-		0x69, 0xdf, 0x4b, 0x92, 0x51, 0x5e, 0x32, 0x43, 0xbc, 0x84, 0xf8, 0x30, 0x27, 0xc8, 0x53, 0x3b,
-		0xdc, 0x59, 0x2a, 0x90, 0xf1, 0x83, 0x17, 0xf7, 0xb6, 0xf1, 0x1c, 0x36, 0x10, 0x23, 0xc9, 0xc4,
-		0x5f, 0x7b, 0xdf, 0x78, 0xcc, 0xe3, 0x24, 0xbf, 0x83, 0x5b, 0xaf, 0xc7, 0xad, 0xf8, 0xcf, 0x1c,
-		0x4c, 0xd1, 0x79, 0x05, 0x6e, 0xc5, 0x7e, 0xea, 0xe0, 0x31, 0xc8, 0xb8, 0x7a, 0xdb, 0x54, 0xbd,
-		0xae, 0x83, 0xb9, 0xe7, 0x05, 0x84, 0xe2, 0x57, 0x62, 0x90, 0x62, 0x9e, 0x1c, 0xb2, 0x5b, 0x6c,
-		0xb8, 0xdd, 0xe2, 0xa3, 0xec, 0x96, 0x78, 0x78, 0xbb, 0x95, 0x01, 0x7c, 0x65, 0x5c, 0xfe, 0xf5,
-		0xf9, 0x90, 0x8a, 0x81, 0xa9, 0xd8, 0xd4, 0xdb, 0x7c, 0xa3, 0x86, 0x84, 0x8a, 0xff, 0x31, 0x46,
-		0x8a, 0x58, 0xde, 0x8f, 0xca, 0x30, 0x2d, 0xf4, 0x52, 0x76, 0x0d, 0xb5, 0xcd, 0x7d, 0xe7, 0xe4,
-		0x48, 0xe5, 0xae, 0x1a, 0x6a, 0x5b, 0xce, 0x72, 0x7d, 0x48, 0x63, 0xf8, 0x3a, 0xc4, 0x47, 0xac,
-		0x43, 0xcf, 0xc2, 0x27, 0x1e, 0x6e, 0xe1, 0x7b, 0x96, 0x28, 0xd9, 0xbf, 0x44, 0x5f, 0x8c, 0xd3,
-		0xc3, 0x8c, 0x6d, 0xb9, 0xaa, 0xf1, 0xfd, 0xd8, 0x11, 0x27, 0x20, 0x63, 0x5b, 0x86, 0xc2, 0x7a,
-		0xd8, 0xab, 0xdd, 0x69, 0xdb, 0x32, 0xe4, 0x81, 0x65, 0x9f, 0x7c, 0x44, 0xdb, 0x25, 0xf5, 0x08,
-		0xac, 0x36, 0xd5, 0x6f, 0x35, 0x07, 0x72, 0xcc, 0x14, 0x3c, 0x97, 0x3d, 0x47, 0x6c, 0x40, 0x93,
-		0x63, 0x6c, 0x30, 0xf7, 0x32, 0xb5, 0x19, 0xa7, 0xcc, 0xf9, 0x88, 0x04, 0x0b, 0xfd, 0xc3, 0x4e,
-		0xc1, 0x61, 0xb7, 0x94, 0x39, 0x5f, 0xf1, 0xa7, 0x63, 0x00, 0x6b, 0xc4, 0xb2, 0x74, 0xbe, 0x24,
-		0x0b, 0xb9, 0x54, 0x05, 0xa5, 0x67, 0xe4, 0xc5, 0x51, 0x8b, 0xc6, 0xc7, 0xcf, 0xb9, 0x61, 0xbd,
-		0xab, 0x30, 0x1d, 0x38, 0xa3, 0x8b, 0x85, 0x32, 0x8b, 0x07, 0x54, 0xd5, 0x4d, 0xec, 0xc9, 0xb9,
-		0xdb, 0xa1, 0x56, 0xf1, 0xd7, 0x62, 0x90, 0xa1, 0x3a, 0xad, 0x63, 0x4f, 0xed, 0x59, 0xc3, 0xd8,
-		0xc3, 0xaf, 0xe1, 0x49, 0x00, 0x06, 0xe3, 0xea, 0xf7, 0x30, 0xf7, 0xac, 0x0c, 0xa5, 0x34, 0xf5,
-		0x7b, 0x18, 0x5d, 0xf4, 0x0d, 0x9e, 0x38, 0xd8, 0xe0, 0xa2, 0xea, 0xe6, 0x66, 0x3f, 0x06, 0x53,
-		0xf4, 0xd7, 0x9a, 0xee, 0xba, 0xbc, 0x90, 0x4e, 0x99, 0xdd, 0xce, 0xd6, 0x5d, 0xb7, 0xf8, 0x26,
-		0x4c, 0x6d, 0xdd, 0x65, 0x77, 0x23, 0x27, 0x20, 0xe3, 0x58, 0x16, 0xcf, 0xc9, 0xac, 0x16, 0x4a,
-		0x13, 0x02, 0x4d, 0x41, 0xe2, 0x3e, 0x20, 0x1e, 0xdc, 0x07, 0x04, 0x17, 0x1a, 0x89, 0xb1, 0x2e,
-		0x34, 0xce, 0xfc, 0x66, 0x0c, 0xb2, 0xa1, 0xf8, 0x80, 0x9e, 0x87, 0x23, 0x95, 0xb5, 0xcd, 0xea,
-		0x0d, 0x65, 0xb5, 0xa6, 0x5c, 0x5d, 0x2b, 0x5f, 0x0b, 0x3e, 0x5e, 0x5a, 0x38, 0x7a, 0xff, 0xc1,
-		0x32, 0x0a, 0xf1, 0x6e, 0x9b, 0xf4, 0x9e, 0x1e, 0x9d, 0x83, 0xf9, 0x5e, 0x91, 0x72, 0xa5, 0x59,
-		0xdf, 0xd8, 0x92, 0x62, 0x0b, 0x47, 0xee, 0x3f, 0x58, 0x9e, 0x0d, 0x49, 0x94, 0x77, 0x5c, 0x6c,
-		0x7a, 0x83, 0x02, 0xd5, 0xcd, 0xf5, 0xf5, 0xd5, 0x2d, 0x29, 0x3e, 0x20, 0xc0, 0x03, 0xf6, 0x69,
-		0x98, 0xed, 0x15, 0xd8, 0x58, 0x5d, 0x93, 0x12, 0x0b, 0xe8, 0xfe, 0x83, 0xe5, 0x7c, 0x88, 0x7b,
-		0x43, 0x37, 0x16, 0xd2, 0x1f, 0xfb, 0xec, 0xe2, 0xc4, 0x2f, 0xfd, 0xc2, 0x62, 0x8c, 0xcc, 0x6c,
-		0xba, 0x27, 0x46, 0xa0, 0x67, 0xe1, 0x58, 0x73, 0xf5, 0xda, 0x46, 0xbd, 0xa6, 0xac, 0x37, 0xaf,
-		0x89, 0x9b, 0x6e, 0x31, 0xbb, 0x99, 0xfb, 0x0f, 0x96, 0xb3, 0x7c, 0x4a, 0xa3, 0xb8, 0x1b, 0x72,
-		0xfd, 0xe6, 0xe6, 0x56, 0x5d, 0x8a, 0x31, 0xee, 0x86, 0x83, 0x6f, 0x5b, 0x1e, 0xfb, 0x39, 0xb7,
-		0xe7, 0xe0, 0xf8, 0x10, 0x6e, 0x7f, 0x62, 0xb3, 0xf7, 0x1f, 0x2c, 0x4f, 0x37, 0x1c, 0xcc, 0xf6,
-		0x0f, 0x95, 0x58, 0x81, 0xc2, 0xa0, 0xc4, 0x66, 0x63, 0xb3, 0x59, 0x5e, 0x93, 0x96, 0x17, 0xa4,
-		0xfb, 0x0f, 0x96, 0x73, 0x22, 0x18, 0x12, 0xfe, 0x60, 0x66, 0xef, 0xe7, 0x89, 0xe7, 0x27, 0x5e,
-		0x80, 0x93, 0xae, 0xa7, 0xde, 0xd2, 0xcd, 0xb6, 0x7f, 0x6b, 0xcb, 0xdb, 0xfc, 0xc8, 0x73, 0xd2,
-		0xd0, 0x3f, 0xd2, 0xd5, 0x5b, 0x82, 0x28, 0xfe, 0x46, 0x5c, 0xe1, 0x8e, 0x7c, 0x72, 0xb9, 0x10,
-		0xf1, 0x50, 0x2f, 0xfa, 0xe8, 0x34, 0xfa, 0x7a, 0x78, 0x21, 0xe2, 0x12, 0x7a, 0xe1, 0xc0, 0xc3,
-		0x5d, 0xf1, 0xe3, 0x31, 0xc8, 0xbf, 0xa2, 0xbb, 0x9e, 0xe5, 0xe8, 0x9a, 0x6a, 0xd0, 0x4f, 0x96,
-		0x2e, 0x8e, 0x1b, 0x5b, 0xfb, 0xb6, 0xfa, 0x55, 0x48, 0xdd, 0x56, 0x0d, 0x16, 0xd4, 0xd8, 0x57,
-		0x61, 0x07, 0x5a, 0x31, 0x88, 0x70, 0x02, 0x87, 0x49, 0x17, 0xbf, 0x10, 0x87, 0x19, 0xba, 0x27,
-		0x5c, 0xf6, 0xa3, 0x5c, 0xe4, 0xa8, 0xd5, 0x80, 0xa4, 0xa3, 0x7a, 0xfc, 0xee, 0xb0, 0xf2, 0x03,
-		0xfc, 0x3a, 0xf8, 0xa9, 0xe8, 0x4b, 0xdd, 0x95, 0xc1, 0x1b, 0x63, 0x8a, 0x84, 0x5e, 0x85, 0x74,
-		0x47, 0xbd, 0xab, 0x50, 0xd4, 0xf8, 0x23, 0x40, 0x9d, 0xea, 0xa8, 0x77, 0x89, 0xae, 0xa8, 0x05,
-		0x33, 0x04, 0x58, 0xdb, 0x53, 0xcd, 0x36, 0x66, 0xf8, 0x89, 0x47, 0x80, 0x3f, 0xdd, 0x51, 0xef,
-		0x56, 0x29, 0x26, 0x19, 0xa5, 0x94, 0xfe, 0xc4, 0xdb, 0x4b, 0x13, 0xf4, 0xb6, 0xfd, 0xd7, 0x62,
-		0x00, 0x81, 0xb9, 0x90, 0x06, 0x92, 0xe6, 0xb7, 0xe8, 0xf0, 0x2e, 0x5f, 0xc7, 0x95, 0x88, 0xf5,
-		0xe8, 0xb3, 0x39, 0x4b, 0xd3, 0x5f, 0x7b, 0x67, 0x29, 0x26, 0xcf, 0x68, 0x7d, 0xcb, 0x51, 0x87,
-		0x6c, 0xd7, 0x6e, 0xa9, 0x1e, 0x56, 0xe8, 0x91, 0x2e, 0x7e, 0x88, 0x94, 0x0f, 0x4c, 0x90, 0x74,
-		0x85, 0x26, 0xf1, 0x85, 0x18, 0x64, 0x6b, 0xa1, 0x47, 0x7e, 0x05, 0x98, 0xea, 0x58, 0xa6, 0x7e,
-		0x8b, 0x3b, 0x61, 0x46, 0x16, 0x4d, 0xb4, 0x00, 0x69, 0xf6, 0xe9, 0xa6, 0xb7, 0x2f, 0xee, 0x3f,
-		0x45, 0x9b, 0x48, 0xdd, 0xc1, 0x3b, 0xae, 0x2e, 0x4c, 0x2e, 0x8b, 0x26, 0x39, 0xc8, 0xb8, 0x58,
-		0xeb, 0x3a, 0xba, 0xb7, 0xaf, 0x68, 0x96, 0xe9, 0xa9, 0x9a, 0xc7, 0x3f, 0x02, 0x9c, 0x11, 0xf4,
-		0x2a, 0x23, 0x13, 0x90, 0x16, 0xf6, 0x54, 0xdd, 0x70, 0x0b, 0xec, 0xb1, 0x98, 0x68, 0x86, 0xd4,
-		0xfd, 0xed, 0xa9, 0xf0, 0x85, 0x55, 0x15, 0x24, 0xcb, 0xc6, 0x4e, 0x4f, 0x81, 0xc9, 0x1c, 0xb5,
-		0xf0, 0x1b, 0x5f, 0x3a, 0x3b, 0xcf, 0x17, 0x91, 0x97, 0x98, 0xec, 0x2d, 0x57, 0x79, 0x46, 0x48,
-		0x88, 0xca, 0xf3, 0x75, 0xb2, 0x6e, 0xe2, 0xf4, 0x67, 0x77, 0x77, 0x82, 0x4b, 0xae, 0xf9, 0x01,
-		0xbb, 0x96, 0xcd, 0xfd, 0x4a, 0xe1, 0xd7, 0x03, 0xe8, 0xe0, 0x66, 0xe9, 0x06, 0xde, 0x27, 0xab,
-		0xc5, 0x71, 0x1a, 0x14, 0x86, 0x14, 0x8c, 0x6f, 0xaa, 0xba, 0x21, 0xbe, 0x48, 0x97, 0x79, 0x0b,
-		0x95, 0x21, 0xe5, 0x7a, 0xaa, 0xd7, 0x75, 0xf9, 0x2f, 0xc7, 0x9d, 0x8e, 0x70, 0x90, 0x8a, 0x65,
-		0xb6, 0x9a, 0x54, 0x40, 0xe6, 0x82, 0x68, 0x0b, 0x52, 0x9e, 0x75, 0x0b, 0x9b, 0xdc, 0x56, 0x87,
-		0xf2, 0xf1, 0x21, 0x0f, 0xa8, 0x18, 0x16, 0x6a, 0x83, 0xd4, 0xc2, 0x06, 0x6e, 0xb3, 0x2a, 0x69,
-		0x4f, 0x25, 0x87, 0x89, 0xd4, 0x23, 0xd8, 0x43, 0x33, 0x3e, 0x6a, 0x93, 0x82, 0x22, 0xb9, 0xf7,
-		0xd9, 0x33, 0xfb, 0xb5, 0xc5, 0x33, 0x11, 0x66, 0x08, 0xf9, 0xa9, 0xb8, 0x68, 0x08, 0x3f, 0xad,
-		0x3e, 0x0d, 0x52, 0xd7, 0xdc, 0xb1, 0x4c, 0xfa, 0x15, 0x29, 0x2f, 0xd4, 0xd3, 0xb4, 0xf4, 0x99,
-		0xf1, 0xe9, 0xaf, 0xb0, 0x8a, 0xfd, 0x06, 0xe4, 0x03, 0x56, 0xba, 0x93, 0x32, 0x87, 0xd8, 0x49,
-		0xd3, 0xbe, 0x2c, 0xe9, 0x45, 0x9b, 0x00, 0xc1, 0x36, 0xa5, 0x57, 0x07, 0xd9, 0xc8, 0x15, 0x0d,
-		0xb6, 0xbc, 0x38, 0x89, 0x05, 0x10, 0xe8, 0xcf, 0xc0, 0x09, 0x7e, 0x87, 0xeb, 0x57, 0xac, 0x64,
-		0x3c, 0xb1, 0x20, 0xd9, 0x47, 0xb0, 0x20, 0x05, 0x76, 0x15, 0xec, 0x27, 0x02, 0xe2, 0x60, 0x6c,
-		0x65, 0x0c, 0x98, 0x63, 0x83, 0xb3, 0x09, 0x88, 0x41, 0x73, 0x8f, 0x60, 0xd0, 0x59, 0x0a, 0xbc,
-		0x46, 0x71, 0xd9, 0x68, 0xa5, 0xdc, 0xc7, 0xde, 0x5e, 0x9a, 0xe0, 0xbb, 0x7b, 0xa2, 0xd8, 0xa0,
-		0x57, 0xe8, 0x7c, 0x63, 0x62, 0x17, 0x5d, 0x84, 0x8c, 0x2a, 0x1a, 0xf4, 0x62, 0xe3, 0xa0, 0x8d,
-		0x1d, 0xb0, 0xb2, 0x78, 0xf1, 0xd6, 0x7f, 0x58, 0x8e, 0x15, 0x7f, 0x21, 0x06, 0xa9, 0xda, 0xcd,
-		0x86, 0xaa, 0x3b, 0xa8, 0x0e, 0xb3, 0x81, 0x6f, 0x8f, 0x1b, 0x2d, 0x82, 0xed, 0x20, 0xc2, 0x45,
-		0x7d, 0xd4, 0xa9, 0xf6, 0x40, 0x98, 0xfe, 0xf3, 0x6e, 0xdf, 0xc4, 0xd7, 0x60, 0x8a, 0x69, 0x49,
-		0x7f, 0x43, 0xc5, 0x26, 0xff, 0xf0, 0x27, 0x06, 0x4f, 0x46, 0xed, 0x09, 0x2a, 0xe6, 0x5f, 0x74,
-		0x12, 0xc9, 0xe2, 0xf7, 0x62, 0x00, 0xb5, 0x9b, 0x37, 0xb7, 0x1c, 0xdd, 0x36, 0xb0, 0xf7, 0xa8,
-		0x26, 0xbe, 0x06, 0x47, 0x42, 0x27, 0x28, 0x47, 0x1b, 0x7b, 0xf2, 0x73, 0xc1, 0x19, 0xca, 0xd1,
-		0x86, 0xa2, 0xb5, 0x5c, 0xcf, 0x47, 0x4b, 0x8c, 0x8d, 0x56, 0x73, 0xbd, 0xe1, 0xd6, 0x7c, 0x03,
-		0xb2, 0xc1, 0xf4, 0x5d, 0x74, 0x03, 0xd2, 0x1e, 0xff, 0x9f, 0x1b, 0xf5, 0x74, 0xa4, 0x51, 0x85,
-		0x34, 0x37, 0xac, 0x0f, 0x50, 0xfc, 0xc5, 0x38, 0x40, 0x8d, 0x99, 0x86, 0x6c, 0xd5, 0x3f, 0x52,
-		0x4e, 0x45, 0x92, 0x02, 0xdf, 0xae, 0x8f, 0xa2, 0xf0, 0xe1, 0x58, 0xe8, 0x49, 0xc8, 0xf7, 0x06,
-		0xa2, 0x02, 0xfb, 0xf6, 0x21, 0x38, 0x50, 0x93, 0xf0, 0xd1, 0xb7, 0x06, 0xf7, 0xe3, 0x30, 0xb7,
-		0x2d, 0xc2, 0xe4, 0x1f, 0x59, 0x83, 0xbd, 0x0a, 0x53, 0xd8, 0xf4, 0x1c, 0x9d, 0x5a, 0x8c, 0x78,
-		0xc6, 0xa5, 0x08, 0xcf, 0x18, 0x32, 0x25, 0xfa, 0x73, 0x4c, 0xe2, 0xce, 0x9e, 0xa3, 0xf5, 0x19,
-		0xe3, 0xb7, 0xe3, 0x50, 0x18, 0x25, 0x89, 0x9e, 0x86, 0x19, 0xcd, 0xc1, 0x94, 0xa0, 0xf4, 0x5c,
-		0x1c, 0xe6, 0x05, 0x99, 0x27, 0xad, 0x75, 0x20, 0xe5, 0x20, 0x71, 0x43, 0xc2, 0x7a, 0xe8, 0xfa,
-		0x2f, 0x1f, 0x08, 0xd3, 0xb4, 0x85, 0x61, 0x46, 0x37, 0x75, 0x4f, 0x57, 0x0d, 0x65, 0x47, 0x35,
-		0x54, 0x53, 0x7b, 0x98, 0x72, 0x79, 0xb0, 0x94, 0xc8, 0x73, 0xd0, 0x0a, 0xc3, 0x44, 0x37, 0x61,
-		0x4a, 0xc0, 0x27, 0x1f, 0x01, 0xbc, 0x00, 0x0b, 0xd5, 0x84, 0xff, 0x2e, 0x0e, 0xb3, 0x32, 0x6e,
-		0xfd, 0xf1, 0x32, 0xeb, 0x0f, 0x02, 0xb0, 0xed, 0x49, 0x82, 0xe7, 0x43, 0x58, 0x76, 0x70, 0xbb,
-		0x67, 0x18, 0x5e, 0xcd, 0xf5, 0x42, 0xb6, 0xfd, 0x7a, 0x1c, 0x72, 0x61, 0xdb, 0xfe, 0x31, 0x48,
-		0x26, 0xa8, 0x11, 0x04, 0x05, 0x76, 0x91, 0xfe, 0x5c, 0x44, 0x50, 0x18, 0x70, 0xbe, 0x83, 0xa3,
-		0xc1, 0xdb, 0x29, 0x48, 0x35, 0x54, 0x47, 0xed, 0xb8, 0xe8, 0xfa, 0x40, 0x1d, 0x2a, 0x2e, 0x12,
-		0x07, 0x7e, 0x35, 0x9c, 0xdf, 0x5b, 0x30, 0xcf, 0xfb, 0xc4, 0x90, 0x32, 0xf4, 0x49, 0xc8, 0x93,
-		0xe3, 0x6f, 0xe8, 0x9d, 0x83, 0x38, 0x7d, 0x92, 0x4a, 0xce, 0xaf, 0xc1, 0x03, 0x2f, 0xb4, 0x04,
-		0x59, 0xc2, 0x16, 0x84, 0x3d, 0xc2, 0x03, 0x1d, 0xf5, 0x6e, 0x9d, 0x51, 0xd0, 0x59, 0x40, 0x7b,
-		0xfe, 0xbd, 0x84, 0x12, 0x58, 0x82, 0xf0, 0xcd, 0x06, 0x3d, 0x82, 0xfd, 0x24, 0x00, 0x2d, 0x4e,
-		0xd9, 0x7b, 0x6c, 0xec, 0xe0, 0x96, 0x21, 0x94, 0x1a, 0x7d, 0x97, 0xed, 0x87, 0x60, 0xae, 0xa3,
-		0x9b, 0x4a, 0xdf, 0xc9, 0x98, 0x1f, 0x2a, 0xd6, 0x0e, 0xe7, 0xb0, 0x7f, 0xf0, 0xce, 0xd2, 0xc2,
-		0xbe, 0xda, 0x31, 0x4a, 0xc5, 0x21, 0x90, 0x45, 0x79, 0xb6, 0xa3, 0x9b, 0xbd, 0x47, 0x69, 0xf4,
-		0xe7, 0x63, 0x61, 0xcf, 0xa0, 0x7a, 0xee, 0xaa, 0x9a, 0x67, 0x39, 0xec, 0xe7, 0xae, 0x2b, 0x1b,
-		0x87, 0x56, 0xe0, 0x31, 0xa6, 0xc0, 0x50, 0xd0, 0x62, 0xc8, 0x9f, 0x48, 0x4a, 0xbc, 0x4a, 0xa9,
-		0xe8, 0xc7, 0x62, 0x70, 0xbc, 0x6d, 0x58, 0x3b, 0xa1, 0x9a, 0x9a, 0x39, 0x90, 0xa2, 0xa9, 0x36,
-		0xfb, 0x25, 0xa1, 0x8a, 0x7c, 0x68, 0x45, 0x96, 0x99, 0x22, 0x23, 0x81, 0x8b, 0xf2, 0x51, 0xd6,
-		0xc7, 0xeb, 0x6d, 0xd6, 0x53, 0x55, 0x6d, 0xf4, 0xd3, 0x31, 0x78, 0x2c, 0xd0, 0x7f, 0x88, 0x4a,
-		0x19, 0xaa, 0xd2, 0xf6, 0xa1, 0x55, 0x7a, 0xa2, 0xdf, 0x36, 0xc3, 0xb4, 0x3a, 0xee, 0x77, 0xf7,
-		0x2b, 0x16, 0x0a, 0x3b, 0x9f, 0x8f, 0x01, 0x0a, 0xf2, 0xa4, 0x8c, 0x5d, 0x9b, 0x9c, 0xac, 0xc9,
-		0x49, 0x2b, 0xd8, 0x69, 0x7c, 0xab, 0x44, 0xd6, 0x72, 0xbe, 0x80, 0x38, 0x69, 0x85, 0xa2, 0xd9,
-		0xe5, 0x20, 0x39, 0xc5, 0xf9, 0xc6, 0x1b, 0xf2, 0xe6, 0xe8, 0x4a, 0xd5, 0xd2, 0x85, 0xf4, 0x40,
-		0xfe, 0x99, 0x28, 0x7e, 0x23, 0x06, 0xc7, 0x07, 0x42, 0x80, 0xaf, 0x33, 0x06, 0xe4, 0x84, 0x3a,
-		0xf9, 0xaf, 0x39, 0x32, 0xdd, 0x1f, 0x36, 0xb0, 0xcc, 0x3a, 0x03, 0xe9, 0xee, 0xfd, 0x4a, 0xb3,
-		0xec, 0xc5, 0xd2, 0x7f, 0x1d, 0x83, 0xf9, 0xb0, 0x32, 0xfe, 0xec, 0xb6, 0x21, 0x17, 0xd6, 0x85,
-		0xcf, 0xeb, 0x99, 0x43, 0xcc, 0x8b, 0x4f, 0xa9, 0x07, 0x06, 0xbd, 0x16, 0x84, 0x60, 0x76, 0xa5,
-		0xf9, 0xd2, 0x61, 0x2d, 0x25, 0x34, 0xec, 0x0f, 0xc5, 0x49, 0xba, 0x64, 0x1f, 0x8d, 0x43, 0xb2,
-		0x61, 0x59, 0x06, 0xfa, 0xb3, 0x30, 0x6b, 0x5a, 0x1e, 0xdd, 0xc4, 0xb8, 0xa5, 0xf0, 0x1b, 0x15,
-		0x96, 0xce, 0x3e, 0x7c, 0x38, 0x03, 0xfe, 0xce, 0x3b, 0x4b, 0x83, 0x50, 0x7d, 0x56, 0x9d, 0x31,
-		0x2d, 0xaf, 0x42, 0xfb, 0xb7, 0xd8, 0x7d, 0x8b, 0x03, 0xd3, 0xbd, 0x43, 0xb3, 0xf4, 0xb7, 0x7e,
-		0xe8, 0xa1, 0xa7, 0x0f, 0x1a, 0x36, 0xb7, 0x13, 0x1a, 0x93, 0xbd, 0x80, 0xf7, 0xfb, 0x64, 0x55,
-		0x7f, 0x24, 0x06, 0x73, 0x94, 0xa8, 0xdf, 0xc3, 0xf4, 0x3c, 0x2e, 0x63, 0xcd, 0x72, 0x5a, 0x28,
-		0x0f, 0x71, 0xfe, 0x48, 0x2b, 0x29, 0xc7, 0xf5, 0x16, 0x9a, 0x87, 0x49, 0xeb, 0x8e, 0xc9, 0xdf,
-		0x87, 0xc9, 0xc8, 0xac, 0x41, 0xf3, 0x8d, 0xd5, 0xea, 0x1a, 0x58, 0x51, 0x35, 0x8d, 0xbe, 0xad,
-		0xcc, 0xae, 0xfe, 0xa6, 0x19, 0xb5, 0xcc, 0x88, 0xe8, 0x31, 0xc8, 0xf8, 0x3b, 0x9e, 0xdf, 0xfc,
-		0x05, 0x04, 0xee, 0x5e, 0x7f, 0x12, 0x8a, 0x0d, 0xcc, 0x32, 0x59, 0x58, 0x9d, 0x72, 0xd7, 0xdb,
-		0xb3, 0x1c, 0xfd, 0x9e, 0xca, 0x7e, 0xff, 0xef, 0x21, 0x6f, 0x03, 0xce, 0x7c, 0x39, 0x06, 0x10,
-		0xdc, 0xa0, 0xa1, 0x67, 0xe1, 0x58, 0x65, 0x73, 0xa3, 0xa6, 0x34, 0xb7, 0xca, 0x5b, 0xdb, 0xcd,
-		0xde, 0xaf, 0x11, 0xc4, 0x73, 0x1a, 0xd7, 0xc6, 0x9a, 0xbe, 0xab, 0xe3, 0x16, 0x7a, 0x0a, 0xe6,
-		0x7b, 0xb9, 0x49, 0xab, 0x5e, 0x93, 0x62, 0x0b, 0xb9, 0xfb, 0x0f, 0x96, 0xd3, 0xac, 0xaa, 0xc7,
-		0x2d, 0x74, 0x0a, 0x8e, 0x0c, 0xf2, 0xad, 0x6e, 0x5c, 0x93, 0xe2, 0x0b, 0xd3, 0xf7, 0x1f, 0x2c,
-		0x67, 0xfc, 0xf2, 0x1f, 0x15, 0x01, 0x85, 0x39, 0x39, 0x5e, 0x62, 0x01, 0xee, 0x3f, 0x58, 0x4e,
-		0x31, 0xef, 0x58, 0x48, 0x7e, 0xec, 0xb3, 0x8b, 0x13, 0x95, 0xd7, 0x47, 0x3e, 0x89, 0x79, 0x39,
-		0xe4, 0x18, 0xfa, 0x47, 0x8c, 0x2e, 0x49, 0x78, 0xba, 0xa9, 0x9d, 0x63, 0x9b, 0x44, 0xf7, 0xf6,
-		0xcf, 0xf2, 0x0d, 0x72, 0x96, 0x2d, 0xc6, 0xb9, 0xbb, 0xe2, 0x39, 0x4b, 0xef, 0x13, 0x99, 0xff,
-		0x17, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xaf, 0x09, 0xd8, 0xae, 0x69, 0x00, 0x00,
+		0x75, 0x1f, 0xf7, 0x03, 0xc0, 0xee, 0xc3, 0x02, 0x18, 0x0c, 0x70, 0xbc, 0x25, 0x78, 0x04, 0x70,
+		0x7b, 0xba, 0x3b, 0x92, 0x27, 0x82, 0x77, 0xd4, 0x91, 0x3c, 0x2e, 0x2d, 0x9d, 0x77, 0x81, 0x25,
+		0x0e, 0x3c, 0x7c, 0xac, 0x66, 0x01, 0xde, 0x87, 0x92, 0x9a, 0x0c, 0x66, 0x1b, 0x8b, 0x39, 0xce,
+		0xce, 0x8c, 0x66, 0x66, 0x49, 0xe2, 0xe2, 0xa4, 0xce, 0x51, 0x3e, 0x64, 0xa6, 0x6c, 0xcb, 0x51,
+		0xca, 0x96, 0x65, 0x51, 0x91, 0x64, 0x3b, 0xa7, 0x28, 0x4a, 0x1c, 0x5b, 0x8a, 0x12, 0x27, 0x95,
+		0x94, 0xed, 0x54, 0x12, 0xc5, 0x55, 0x49, 0x49, 0xfe, 0x23, 0x76, 0xe2, 0xe4, 0xa2, 0x9c, 0x54,
+		0x89, 0xe2, 0xc8, 0xb1, 0xa3, 0x5c, 0xaa, 0x92, 0x52, 0xa9, 0x2a, 0xf5, 0xfa, 0x63, 0x3e, 0xf6,
+		0x03, 0xb3, 0x60, 0x78, 0xb2, 0xab, 0xfc, 0xd7, 0x6e, 0xbf, 0x7e, 0xef, 0x37, 0xaf, 0x5f, 0xbf,
+		0xee, 0x7e, 0xfd, 0xba, 0x67, 0xe0, 0x9f, 0x5d, 0x85, 0xc5, 0x96, 0x6d, 0xb7, 0x4c, 0x72, 0xde,
+		0x71, 0x6d, 0xdf, 0xde, 0xed, 0xec, 0x9d, 0x6f, 0x12, 0x4f, 0x77, 0x0d, 0xc7, 0xb7, 0xdd, 0x25,
+		0x4a, 0x93, 0xa7, 0x18, 0xc7, 0x92, 0xe0, 0x28, 0x6d, 0xc0, 0xf4, 0x35, 0xc3, 0x24, 0x2b, 0x01,
+		0x63, 0x83, 0xf8, 0xf2, 0x73, 0x90, 0xdd, 0x33, 0x4c, 0x52, 0x4c, 0x2d, 0x66, 0x4e, 0x8f, 0x5f,
+		0x78, 0xcf, 0x52, 0x97, 0xd0, 0x52, 0x5c, 0xa2, 0x8e, 0x64, 0x85, 0x4a, 0x94, 0xbe, 0x95, 0x85,
+		0x99, 0x3e, 0xb5, 0xb2, 0x0c, 0x59, 0x4b, 0x6b, 0x23, 0x62, 0xea, 0x74, 0x5e, 0xa1, 0xff, 0xe5,
+		0x22, 0x8c, 0x39, 0x9a, 0x7e, 0x53, 0x6b, 0x91, 0x62, 0x9a, 0x92, 0x45, 0x51, 0x9e, 0x07, 0x68,
+		0x12, 0x87, 0x58, 0x4d, 0x62, 0xe9, 0x07, 0xc5, 0xcc, 0x62, 0xe6, 0x74, 0x5e, 0x89, 0x50, 0xe4,
+		0xa7, 0x60, 0xda, 0xe9, 0xec, 0x9a, 0x86, 0xae, 0x46, 0xd8, 0x60, 0x31, 0x73, 0x7a, 0x44, 0x91,
+		0x58, 0xc5, 0x4a, 0xc8, 0xfc, 0x24, 0x4c, 0xdd, 0x26, 0xda, 0xcd, 0x28, 0xeb, 0x38, 0x65, 0x9d,
+		0x44, 0x72, 0x84, 0x71, 0x19, 0x0a, 0x6d, 0xe2, 0x79, 0x5a, 0x8b, 0xa8, 0xfe, 0x81, 0x43, 0x8a,
+		0x59, 0xda, 0xfa, 0xc5, 0x9e, 0xd6, 0x77, 0xb7, 0x7c, 0x9c, 0x4b, 0x6d, 0x1f, 0x38, 0x44, 0xae,
+		0x40, 0x9e, 0x58, 0x9d, 0x36, 0x43, 0x18, 0x19, 0x60, 0xbf, 0x9a, 0xd5, 0x69, 0x77, 0xa3, 0xe4,
+		0x50, 0x8c, 0x43, 0x8c, 0x79, 0xc4, 0xbd, 0x65, 0xe8, 0xa4, 0x38, 0x4a, 0x01, 0x9e, 0xec, 0x01,
+		0x68, 0xb0, 0xfa, 0x6e, 0x0c, 0x21, 0x27, 0x2f, 0x43, 0x9e, 0xdc, 0xf1, 0x89, 0xe5, 0x19, 0xb6,
+		0x55, 0x1c, 0xa3, 0x20, 0x8f, 0xf7, 0xe9, 0x45, 0x62, 0x36, 0xbb, 0x21, 0x42, 0x39, 0xf9, 0x12,
+		0x8c, 0xd9, 0x8e, 0x6f, 0xd8, 0x96, 0x57, 0xcc, 0x2d, 0xa6, 0x4e, 0x8f, 0x5f, 0x78, 0xa4, 0xaf,
+		0x23, 0x6c, 0x31, 0x1e, 0x45, 0x30, 0xcb, 0x6b, 0x20, 0x79, 0x76, 0xc7, 0xd5, 0x89, 0xaa, 0xdb,
+		0x4d, 0xa2, 0x1a, 0xd6, 0x9e, 0x5d, 0xcc, 0x53, 0x80, 0x85, 0xde, 0x86, 0x50, 0xc6, 0x65, 0xbb,
+		0x49, 0xd6, 0xac, 0x3d, 0x5b, 0x99, 0xf4, 0x62, 0x65, 0xf9, 0x38, 0x8c, 0x7a, 0x07, 0x96, 0xaf,
+		0xdd, 0x29, 0x16, 0xa8, 0x87, 0xf0, 0x52, 0xe9, 0x57, 0x47, 0x61, 0x6a, 0x18, 0x17, 0xbb, 0x0a,
+		0x23, 0x7b, 0xd8, 0xca, 0x62, 0xfa, 0x28, 0x36, 0x60, 0x32, 0x71, 0x23, 0x8e, 0xde, 0xa7, 0x11,
+		0x2b, 0x30, 0x6e, 0x11, 0xcf, 0x27, 0x4d, 0xe6, 0x11, 0x99, 0x21, 0x7d, 0x0a, 0x98, 0x50, 0xaf,
+		0x4b, 0x65, 0xef, 0xcb, 0xa5, 0x5e, 0x86, 0xa9, 0x40, 0x25, 0xd5, 0xd5, 0xac, 0x96, 0xf0, 0xcd,
+		0xf3, 0x49, 0x9a, 0x2c, 0xd5, 0x84, 0x9c, 0x82, 0x62, 0xca, 0x24, 0x89, 0x95, 0xe5, 0x15, 0x00,
+		0xdb, 0x22, 0xf6, 0x9e, 0xda, 0x24, 0xba, 0x59, 0xcc, 0x0d, 0xb0, 0xd2, 0x16, 0xb2, 0xf4, 0x58,
+		0xc9, 0x66, 0x54, 0xdd, 0x94, 0xaf, 0x84, 0xae, 0x36, 0x36, 0xc0, 0x53, 0x36, 0xd8, 0x20, 0xeb,
+		0xf1, 0xb6, 0x1d, 0x98, 0x74, 0x09, 0xfa, 0x3d, 0x69, 0xf2, 0x96, 0xe5, 0xa9, 0x12, 0x4b, 0x89,
+		0x2d, 0x53, 0xb8, 0x18, 0x6b, 0xd8, 0x84, 0x1b, 0x2d, 0xca, 0x8f, 0x41, 0x40, 0x50, 0xa9, 0x5b,
+		0x01, 0x9d, 0x85, 0x0a, 0x82, 0xb8, 0xa9, 0xb5, 0xc9, 0xdc, 0xeb, 0x30, 0x19, 0x37, 0x8f, 0x3c,
+		0x0b, 0x23, 0x9e, 0xaf, 0xb9, 0x3e, 0xf5, 0xc2, 0x11, 0x85, 0x15, 0x64, 0x09, 0x32, 0xc4, 0x6a,
+		0xd2, 0x59, 0x6e, 0x44, 0xc1, 0xbf, 0xf2, 0x0f, 0x87, 0x0d, 0xce, 0xd0, 0x06, 0x3f, 0xd1, 0xdb,
+		0xa3, 0x31, 0xe4, 0xee, 0x76, 0xcf, 0x5d, 0x86, 0x89, 0x58, 0x03, 0x86, 0x7d, 0x74, 0xe9, 0x47,
+		0xe0, 0xa1, 0xbe, 0xd0, 0xf2, 0xcb, 0x30, 0xdb, 0xb1, 0x0c, 0xcb, 0x27, 0xae, 0xe3, 0x12, 0xf4,
+		0x58, 0xf6, 0xa8, 0xe2, 0x7f, 0x1d, 0x1b, 0xe0, 0x73, 0x3b, 0x51, 0x6e, 0x86, 0xa2, 0xcc, 0x74,
+		0x7a, 0x89, 0x67, 0xf3, 0xb9, 0x6f, 0x8f, 0x49, 0x6f, 0xbc, 0xf1, 0xc6, 0x1b, 0xe9, 0xd2, 0xaf,
+		0x8f, 0xc2, 0x6c, 0xbf, 0x31, 0xd3, 0x77, 0xf8, 0x1e, 0x87, 0x51, 0xab, 0xd3, 0xde, 0x25, 0x2e,
+		0x35, 0xd2, 0x88, 0xc2, 0x4b, 0x72, 0x05, 0x46, 0x4c, 0x6d, 0x97, 0x98, 0xc5, 0xec, 0x62, 0xea,
+		0xf4, 0xe4, 0x85, 0xa7, 0x86, 0x1a, 0x95, 0x4b, 0xeb, 0x28, 0xa2, 0x30, 0x49, 0xf9, 0x03, 0x90,
+		0xe5, 0x53, 0x34, 0x22, 0x9c, 0x1d, 0x0e, 0x01, 0xc7, 0x92, 0x42, 0xe5, 0xe4, 0x93, 0x90, 0xc7,
+		0x5f, 0xe6, 0x1b, 0xa3, 0x54, 0xe7, 0x1c, 0x12, 0xd0, 0x2f, 0xe4, 0x39, 0xc8, 0xd1, 0x61, 0xd2,
+		0x24, 0x62, 0x69, 0x0b, 0xca, 0xe8, 0x58, 0x4d, 0xb2, 0xa7, 0x75, 0x4c, 0x5f, 0xbd, 0xa5, 0x99,
+		0x1d, 0x42, 0x1d, 0x3e, 0xaf, 0x14, 0x38, 0xf1, 0x06, 0xd2, 0xe4, 0x05, 0x18, 0x67, 0xa3, 0xca,
+		0xb0, 0x9a, 0xe4, 0x0e, 0x9d, 0x3d, 0x47, 0x14, 0x36, 0xd0, 0xd6, 0x90, 0x82, 0x8f, 0x7f, 0xcd,
+		0xb3, 0x2d, 0xe1, 0x9a, 0xf4, 0x11, 0x48, 0xa0, 0x8f, 0xbf, 0xdc, 0x3d, 0x71, 0x9f, 0xea, 0xdf,
+		0xbc, 0x9e, 0xb1, 0xf4, 0x24, 0x4c, 0x51, 0x8e, 0xf7, 0xf1, 0xae, 0xd7, 0xcc, 0xe2, 0xf4, 0x62,
+		0xea, 0x74, 0x4e, 0x99, 0x64, 0xe4, 0x2d, 0x4e, 0x2d, 0x7d, 0x25, 0x0d, 0x59, 0x3a, 0xb1, 0x4c,
+		0xc1, 0xf8, 0xf6, 0x2b, 0xf5, 0x9a, 0xba, 0xb2, 0xb5, 0x53, 0x5d, 0xaf, 0x49, 0x29, 0x79, 0x12,
+		0x80, 0x12, 0xae, 0xad, 0x6f, 0x55, 0xb6, 0xa5, 0x74, 0x50, 0x5e, 0xdb, 0xdc, 0xbe, 0xf4, 0xac,
+		0x94, 0x09, 0x04, 0x76, 0x18, 0x21, 0x1b, 0x65, 0x78, 0xdf, 0x05, 0x69, 0x44, 0x96, 0xa0, 0xc0,
+		0x00, 0xd6, 0x5e, 0xae, 0xad, 0x5c, 0x7a, 0x56, 0x1a, 0x8d, 0x53, 0xde, 0x77, 0x41, 0x1a, 0x93,
+		0x27, 0x20, 0x4f, 0x29, 0xd5, 0xad, 0xad, 0x75, 0x29, 0x17, 0x60, 0x36, 0xb6, 0x95, 0xb5, 0xcd,
+		0x55, 0x29, 0x1f, 0x60, 0xae, 0x2a, 0x5b, 0x3b, 0x75, 0x09, 0x02, 0x84, 0x8d, 0x5a, 0xa3, 0x51,
+		0x59, 0xad, 0x49, 0xe3, 0x01, 0x47, 0xf5, 0x95, 0xed, 0x5a, 0x43, 0x2a, 0xc4, 0xd4, 0x7a, 0xdf,
+		0x05, 0x69, 0x22, 0x78, 0x44, 0x6d, 0x73, 0x67, 0x43, 0x9a, 0x94, 0xa7, 0x61, 0x82, 0x3d, 0x42,
+		0x28, 0x31, 0xd5, 0x45, 0xba, 0xf4, 0xac, 0x24, 0x85, 0x8a, 0x30, 0x94, 0xe9, 0x18, 0xe1, 0xd2,
+		0xb3, 0x92, 0x5c, 0x5a, 0x86, 0x11, 0xea, 0x86, 0xb2, 0x0c, 0x93, 0xeb, 0x95, 0x6a, 0x6d, 0x5d,
+		0xdd, 0xaa, 0x6f, 0xaf, 0x6d, 0x6d, 0x56, 0xd6, 0xa5, 0x54, 0x48, 0x53, 0x6a, 0x1f, 0xdc, 0x59,
+		0x53, 0x6a, 0x2b, 0x52, 0x3a, 0x4a, 0xab, 0xd7, 0x2a, 0xdb, 0xb5, 0x15, 0x29, 0x53, 0xd2, 0x61,
+		0xb6, 0xdf, 0x84, 0xda, 0x77, 0x08, 0x45, 0x7c, 0x21, 0x3d, 0xc0, 0x17, 0x28, 0x56, 0xb7, 0x2f,
+		0x94, 0xbe, 0x99, 0x86, 0x99, 0x3e, 0x8b, 0x4a, 0xdf, 0x87, 0x3c, 0x0f, 0x23, 0xcc, 0x97, 0xd9,
+		0x32, 0x7b, 0xa6, 0xef, 0xea, 0x44, 0x3d, 0xbb, 0x67, 0xa9, 0xa5, 0x72, 0xd1, 0x50, 0x23, 0x33,
+		0x20, 0xd4, 0x40, 0x88, 0x1e, 0x87, 0xfd, 0xd3, 0x3d, 0x93, 0x3f, 0x5b, 0x1f, 0x2f, 0x0d, 0xb3,
+		0x3e, 0x52, 0xda, 0xd1, 0x16, 0x81, 0x91, 0x3e, 0x8b, 0xc0, 0x55, 0x98, 0xee, 0x01, 0x1a, 0x7a,
+		0x32, 0xfe, 0x48, 0x0a, 0x8a, 0x83, 0x8c, 0x93, 0x30, 0x25, 0xa6, 0x63, 0x53, 0xe2, 0xd5, 0x6e,
+		0x0b, 0x3e, 0x3a, 0xb8, 0x13, 0x7a, 0xfa, 0xfa, 0xcd, 0x14, 0x1c, 0xef, 0x1f, 0x52, 0xf6, 0xd5,
+		0xe1, 0x03, 0x30, 0xda, 0x26, 0xfe, 0xbe, 0x2d, 0xc2, 0xaa, 0x27, 0xfa, 0x2c, 0xd6, 0x58, 0xdd,
+		0xdd, 0xd9, 0x5c, 0x4a, 0xbe, 0xd2, 0xad, 0xeb, 0xc2, 0xa0, 0x00, 0xb7, 0x47, 0xd3, 0x1f, 0x4b,
+		0xc3, 0x43, 0x7d, 0xc1, 0xfb, 0x2a, 0x7a, 0x0a, 0xc0, 0xb0, 0x9c, 0x8e, 0xcf, 0x42, 0x27, 0x36,
+		0x13, 0xe7, 0x29, 0x85, 0x4e, 0x5e, 0x38, 0xcb, 0x76, 0xfc, 0xa0, 0x3e, 0x43, 0xeb, 0x81, 0x91,
+		0x28, 0xc3, 0x73, 0xa1, 0xa2, 0x59, 0xaa, 0xe8, 0xfc, 0x80, 0x96, 0xf6, 0x38, 0xe6, 0xd3, 0x20,
+		0xe9, 0xa6, 0x41, 0x2c, 0x5f, 0xf5, 0x7c, 0x97, 0x68, 0x6d, 0xc3, 0x6a, 0xd1, 0xa5, 0x26, 0x57,
+		0x1e, 0xd9, 0xd3, 0x4c, 0x8f, 0x28, 0x53, 0xac, 0xba, 0x21, 0x6a, 0x51, 0x82, 0x3a, 0x90, 0x1b,
+		0x91, 0x18, 0x8d, 0x49, 0xb0, 0xea, 0x40, 0xa2, 0xf4, 0x53, 0x79, 0x18, 0x8f, 0x04, 0xe0, 0xf2,
+		0xa3, 0x50, 0x78, 0x4d, 0xbb, 0xa5, 0xa9, 0x62, 0x53, 0xc5, 0x2c, 0x31, 0x8e, 0xb4, 0x3a, 0x23,
+		0xc9, 0x4f, 0xc3, 0x2c, 0x65, 0xb1, 0x3b, 0x3e, 0x71, 0x55, 0xdd, 0xd4, 0x3c, 0x8f, 0x1a, 0x2d,
+		0x47, 0x59, 0x65, 0xac, 0xdb, 0xc2, 0xaa, 0x65, 0x51, 0x23, 0x5f, 0x84, 0x19, 0x2a, 0xd1, 0xee,
+		0x98, 0xbe, 0xe1, 0x98, 0x44, 0xc5, 0x6d, 0x9e, 0x57, 0x84, 0xa8, 0x66, 0xd3, 0xc8, 0xb1, 0xc1,
+		0x19, 0x50, 0x23, 0x4f, 0x5e, 0x81, 0x53, 0x54, 0xac, 0x45, 0x2c, 0xe2, 0x6a, 0x3e, 0x51, 0xc9,
+		0x87, 0x3b, 0x9a, 0xe9, 0xa9, 0x9a, 0xd5, 0x54, 0xf7, 0x35, 0x6f, 0xbf, 0x38, 0x8b, 0x00, 0xd5,
+		0x74, 0x31, 0xa5, 0x9c, 0x40, 0xc6, 0x55, 0xce, 0x57, 0xa3, 0x6c, 0x15, 0xab, 0xf9, 0x82, 0xe6,
+		0xed, 0xcb, 0x65, 0x38, 0x4e, 0x51, 0x3c, 0xdf, 0x35, 0xac, 0x96, 0xaa, 0xef, 0x13, 0xfd, 0xa6,
+		0xda, 0xf1, 0xf7, 0x9e, 0x2b, 0x9e, 0x8c, 0x3e, 0x9f, 0x6a, 0xd8, 0xa0, 0x3c, 0xcb, 0xc8, 0xb2,
+		0xe3, 0xef, 0x3d, 0x27, 0x37, 0xa0, 0x80, 0x9d, 0xd1, 0x36, 0x5e, 0x27, 0xea, 0x9e, 0xed, 0xd2,
+		0x35, 0x74, 0xb2, 0xcf, 0xd4, 0x14, 0xb1, 0xe0, 0xd2, 0x16, 0x17, 0xd8, 0xb0, 0x9b, 0xa4, 0x3c,
+		0xd2, 0xa8, 0xd7, 0x6a, 0x2b, 0xca, 0xb8, 0x40, 0xb9, 0x66, 0xbb, 0xe8, 0x50, 0x2d, 0x3b, 0x30,
+		0xf0, 0x38, 0x73, 0xa8, 0x96, 0x2d, 0xcc, 0x7b, 0x11, 0x66, 0x74, 0x9d, 0xb5, 0xd9, 0xd0, 0x55,
+		0xbe, 0x19, 0xf3, 0x8a, 0x52, 0xcc, 0x58, 0xba, 0xbe, 0xca, 0x18, 0xb8, 0x8f, 0x7b, 0xf2, 0x15,
+		0x78, 0x28, 0x34, 0x56, 0x54, 0x70, 0xba, 0xa7, 0x95, 0xdd, 0xa2, 0x17, 0x61, 0xc6, 0x39, 0xe8,
+		0x15, 0x94, 0x63, 0x4f, 0x74, 0x0e, 0xba, 0xc5, 0x2e, 0xc3, 0xac, 0xb3, 0xef, 0xf4, 0xca, 0x9d,
+		0x8d, 0xca, 0xc9, 0xce, 0xbe, 0xd3, 0x2d, 0xf8, 0x38, 0xdd, 0x99, 0xbb, 0x44, 0xd7, 0x7c, 0xd2,
+		0x2c, 0x3e, 0x1c, 0x65, 0x8f, 0x54, 0xc8, 0x4b, 0x20, 0xe9, 0xba, 0x4a, 0x2c, 0x6d, 0xd7, 0x24,
+		0xaa, 0xe6, 0x12, 0x4b, 0xf3, 0x8a, 0x0b, 0x94, 0x39, 0xeb, 0xbb, 0x1d, 0xa2, 0x4c, 0xea, 0x7a,
+		0x8d, 0x56, 0x56, 0x68, 0x9d, 0x7c, 0x16, 0xa6, 0xed, 0xdd, 0xd7, 0x74, 0xe6, 0x91, 0xaa, 0xe3,
+		0x92, 0x3d, 0xe3, 0x4e, 0xf1, 0x3d, 0xd4, 0xbc, 0x53, 0x58, 0x41, 0xfd, 0xb1, 0x4e, 0xc9, 0xf2,
+		0x19, 0x90, 0x74, 0x6f, 0x5f, 0x73, 0x1d, 0x3a, 0x25, 0x7b, 0x8e, 0xa6, 0x93, 0xe2, 0xe3, 0x8c,
+		0x95, 0xd1, 0x37, 0x05, 0x19, 0x47, 0x84, 0x77, 0xdb, 0xd8, 0xf3, 0x05, 0xe2, 0x93, 0x6c, 0x44,
+		0x50, 0x1a, 0x47, 0x3b, 0x0d, 0x12, 0x5a, 0x22, 0xf6, 0xe0, 0xd3, 0x94, 0x6d, 0xd2, 0xd9, 0x77,
+		0xa2, 0xcf, 0x7d, 0x0c, 0x26, 0x9c, 0xfd, 0xe8, 0x43, 0xcf, 0xb0, 0xc0, 0xcd, 0xd9, 0x8f, 0x3c,
+		0xf1, 0x59, 0x38, 0x8e, 0x4c, 0x6d, 0xe2, 0x6b, 0x4d, 0xcd, 0xd7, 0x22, 0xdc, 0xef, 0xa5, 0xdc,
+		0x68, 0xf6, 0x0d, 0x5e, 0x19, 0xd3, 0xd3, 0xed, 0xec, 0x1e, 0x04, 0x8e, 0x75, 0x8e, 0xe9, 0x89,
+		0x34, 0xe1, 0x5a, 0xef, 0x5a, 0x70, 0x5e, 0x2a, 0x43, 0x21, 0xea, 0xf7, 0x72, 0x1e, 0x98, 0xe7,
+		0x4b, 0x29, 0x0c, 0x82, 0x96, 0xb7, 0x56, 0x30, 0x7c, 0x79, 0xb5, 0x26, 0xa5, 0x31, 0x8c, 0x5a,
+		0x5f, 0xdb, 0xae, 0xa9, 0xca, 0xce, 0xe6, 0xf6, 0xda, 0x46, 0x4d, 0xca, 0x44, 0x02, 0xfb, 0xeb,
+		0xd9, 0xdc, 0x13, 0xd2, 0x93, 0x18, 0x35, 0x4c, 0xc6, 0x77, 0x6a, 0xf2, 0x0f, 0xc1, 0xc3, 0x22,
+		0xad, 0xe2, 0x11, 0x5f, 0xbd, 0x6d, 0xb8, 0x74, 0x40, 0xb6, 0x35, 0xb6, 0x38, 0x06, 0xfe, 0x33,
+		0xcb, 0xb9, 0x1a, 0xc4, 0x7f, 0xc9, 0x70, 0x71, 0xb8, 0xb5, 0x35, 0x5f, 0x5e, 0x87, 0x05, 0xcb,
+		0x56, 0x3d, 0x5f, 0xb3, 0x9a, 0x9a, 0xdb, 0x54, 0xc3, 0x84, 0x96, 0xaa, 0xe9, 0x3a, 0xf1, 0x3c,
+		0x9b, 0x2d, 0x84, 0x01, 0xca, 0x23, 0x96, 0xdd, 0xe0, 0xcc, 0xe1, 0x0a, 0x51, 0xe1, 0xac, 0x5d,
+		0xee, 0x9b, 0x19, 0xe4, 0xbe, 0x27, 0x21, 0xdf, 0xd6, 0x1c, 0x95, 0x58, 0xbe, 0x7b, 0x40, 0xe3,
+		0xf3, 0x9c, 0x92, 0x6b, 0x6b, 0x4e, 0x0d, 0xcb, 0x3f, 0x90, 0x6d, 0xd2, 0xf5, 0x6c, 0x2e, 0x2b,
+		0x8d, 0x5c, 0xcf, 0xe6, 0x46, 0xa4, 0xd1, 0xeb, 0xd9, 0xdc, 0xa8, 0x34, 0x76, 0x3d, 0x9b, 0xcb,
+		0x49, 0xf9, 0xeb, 0xd9, 0x5c, 0x5e, 0x82, 0xd2, 0xdb, 0x19, 0x28, 0x44, 0x23, 0x78, 0xdc, 0x10,
+		0xe9, 0x74, 0x0d, 0x4b, 0xd1, 0x59, 0xee, 0xb1, 0x43, 0xe3, 0xfd, 0xa5, 0x65, 0x5c, 0xdc, 0xca,
+		0xa3, 0x2c, 0x5c, 0x56, 0x98, 0x24, 0x06, 0x16, 0xe8, 0x7e, 0x84, 0x85, 0x27, 0x39, 0x85, 0x97,
+		0xe4, 0x55, 0x18, 0x7d, 0xcd, 0xa3, 0xd8, 0xa3, 0x14, 0xfb, 0x3d, 0x87, 0x63, 0x5f, 0x6f, 0x50,
+		0xf0, 0xfc, 0xf5, 0x86, 0xba, 0xb9, 0xa5, 0x6c, 0x54, 0xd6, 0x15, 0x2e, 0x2e, 0x9f, 0x80, 0xac,
+		0xa9, 0xbd, 0x7e, 0x10, 0x5f, 0x06, 0x29, 0x69, 0xd8, 0x6e, 0x39, 0x01, 0x59, 0x4c, 0xd9, 0xc5,
+		0x17, 0x1f, 0x4a, 0x7a, 0x17, 0x87, 0xc7, 0x79, 0x18, 0xa1, 0xf6, 0x92, 0x01, 0xb8, 0xc5, 0xa4,
+		0x63, 0x72, 0x0e, 0xb2, 0xcb, 0x5b, 0x0a, 0x0e, 0x11, 0x09, 0x0a, 0x8c, 0xaa, 0xd6, 0xd7, 0x6a,
+		0xcb, 0x35, 0x29, 0x5d, 0xba, 0x08, 0xa3, 0xcc, 0x08, 0x38, 0x7c, 0x02, 0x33, 0x48, 0xc7, 0x78,
+		0x91, 0x63, 0xa4, 0x44, 0xed, 0xce, 0x46, 0xb5, 0xa6, 0x48, 0xe9, 0x9e, 0xce, 0x2f, 0x79, 0x50,
+		0x88, 0x46, 0xe6, 0x3f, 0x98, 0xed, 0xf9, 0xaf, 0xa5, 0x60, 0x3c, 0x12, 0x69, 0x63, 0x88, 0xa4,
+		0x99, 0xa6, 0x7d, 0x5b, 0xd5, 0x4c, 0x43, 0xf3, 0xb8, 0x6b, 0x00, 0x25, 0x55, 0x90, 0x32, 0x6c,
+		0xd7, 0xfd, 0x80, 0x06, 0xcd, 0x88, 0x34, 0x5a, 0xfa, 0x74, 0x0a, 0xa4, 0xee, 0x50, 0xb7, 0x4b,
+		0xcd, 0xd4, 0x1f, 0xa5, 0x9a, 0xa5, 0x4f, 0xa5, 0x60, 0x32, 0x1e, 0xdf, 0x76, 0xa9, 0xf7, 0xe8,
+		0x1f, 0xa9, 0x7a, 0xdf, 0x48, 0xc3, 0x44, 0x2c, 0xaa, 0x1d, 0x56, 0xbb, 0x0f, 0xc3, 0xb4, 0xd1,
+		0x24, 0x6d, 0xc7, 0xf6, 0x31, 0x9d, 0xae, 0x9a, 0xe4, 0x16, 0x31, 0x8b, 0x25, 0x3a, 0x69, 0x9c,
+		0x3f, 0x3c, 0x6e, 0x5e, 0x5a, 0x0b, 0xe5, 0xd6, 0x51, 0xac, 0x3c, 0xb3, 0xb6, 0x52, 0xdb, 0xa8,
+		0x6f, 0x6d, 0xd7, 0x36, 0x97, 0x5f, 0x51, 0x77, 0x36, 0x5f, 0xdc, 0xdc, 0x7a, 0x69, 0x53, 0x91,
+		0x8c, 0x2e, 0xb6, 0x77, 0x71, 0xd8, 0xd7, 0x41, 0xea, 0x56, 0x4a, 0x7e, 0x18, 0xfa, 0xa9, 0x25,
+		0x1d, 0x93, 0x67, 0x60, 0x6a, 0x73, 0x4b, 0x6d, 0xac, 0xad, 0xd4, 0xd4, 0xda, 0xb5, 0x6b, 0xb5,
+		0xe5, 0xed, 0x06, 0xcb, 0x84, 0x04, 0xdc, 0xdb, 0xb1, 0x01, 0x5e, 0xfa, 0x64, 0x06, 0x66, 0xfa,
+		0x68, 0x22, 0x57, 0xf8, 0x1e, 0x86, 0x6d, 0xab, 0xce, 0x0d, 0xa3, 0xfd, 0x12, 0x46, 0x11, 0x75,
+		0xcd, 0xf5, 0xf9, 0x96, 0xe7, 0x0c, 0xa0, 0x95, 0x2c, 0xdf, 0xd8, 0x33, 0x88, 0xcb, 0x33, 0x4c,
+		0x6c, 0x63, 0x33, 0x15, 0xd2, 0x59, 0x92, 0xe9, 0xbd, 0x20, 0x3b, 0xb6, 0x67, 0xf8, 0xc6, 0x2d,
+		0x4c, 0xd2, 0x8b, 0x74, 0x14, 0x6e, 0x74, 0xb2, 0x8a, 0x24, 0x6a, 0xd6, 0x2c, 0x3f, 0xe0, 0xb6,
+		0x48, 0x4b, 0xeb, 0xe2, 0xc6, 0xc9, 0x3c, 0xa3, 0x48, 0xa2, 0x26, 0xe0, 0x7e, 0x14, 0x0a, 0x4d,
+		0xbb, 0x83, 0xd1, 0x1f, 0xe3, 0xc3, 0xb5, 0x23, 0xa5, 0x8c, 0x33, 0x5a, 0xc0, 0xc2, 0xe3, 0xfa,
+		0x30, 0x0f, 0x56, 0x50, 0xc6, 0x19, 0x8d, 0xb1, 0x3c, 0x09, 0x53, 0x5a, 0xab, 0xe5, 0x22, 0xb8,
+		0x00, 0x62, 0x3b, 0x95, 0xc9, 0x80, 0x4c, 0x19, 0xe7, 0xae, 0x43, 0x4e, 0xd8, 0x01, 0x17, 0x6f,
+		0xb4, 0x84, 0xea, 0xb0, 0xed, 0x77, 0x1a, 0x53, 0x63, 0x96, 0xa8, 0x7c, 0x14, 0x0a, 0x86, 0xa7,
+		0x86, 0x69, 0xfd, 0xf4, 0x62, 0xfa, 0x74, 0x4e, 0x19, 0x37, 0xbc, 0x20, 0x25, 0x5a, 0x7a, 0x33,
+		0x0d, 0x93, 0xf1, 0x63, 0x09, 0x79, 0x05, 0x72, 0xa6, 0xad, 0x6b, 0xd4, 0xb5, 0xd8, 0x99, 0xd8,
+		0xe9, 0x84, 0x93, 0x8c, 0xa5, 0x75, 0xce, 0xaf, 0x04, 0x92, 0x73, 0xff, 0x26, 0x05, 0x39, 0x41,
+		0x96, 0x8f, 0x43, 0xd6, 0xd1, 0xfc, 0x7d, 0x0a, 0x37, 0x52, 0x4d, 0x4b, 0x29, 0x85, 0x96, 0x91,
+		0xee, 0x39, 0x9a, 0x55, 0x4c, 0x87, 0x74, 0x2c, 0x63, 0xbf, 0x9a, 0x44, 0x6b, 0xd2, 0x6d, 0x90,
+		0xdd, 0x6e, 0x13, 0xcb, 0xf7, 0x44, 0xbf, 0x72, 0xfa, 0x32, 0x27, 0xe3, 0xe9, 0x98, 0xef, 0x6a,
+		0x86, 0x19, 0xe3, 0xcd, 0x52, 0x5e, 0x49, 0x54, 0x04, 0xcc, 0x65, 0x38, 0x21, 0x70, 0x9b, 0xc4,
+		0xd7, 0xf4, 0x7d, 0xd2, 0x0c, 0x85, 0x46, 0x69, 0xba, 0xe3, 0x61, 0xce, 0xb0, 0xc2, 0xeb, 0x85,
+		0x6c, 0xe9, 0xeb, 0x29, 0x98, 0x16, 0x1b, 0xb7, 0x66, 0x60, 0xac, 0x0d, 0x00, 0xcd, 0xb2, 0x6c,
+		0x3f, 0x6a, 0xae, 0x5e, 0x57, 0xee, 0x91, 0x5b, 0xaa, 0x04, 0x42, 0x4a, 0x04, 0x60, 0xae, 0x0d,
+		0x10, 0xd6, 0x0c, 0x34, 0xdb, 0x02, 0x8c, 0xf3, 0x33, 0x27, 0x7a, 0x70, 0xc9, 0xb6, 0xfa, 0xc0,
+		0x48, 0xb8, 0xc3, 0xc3, 0x84, 0xcc, 0x2e, 0x69, 0x19, 0x16, 0xcf, 0x24, 0xb3, 0x82, 0x48, 0xc8,
+		0x64, 0x83, 0x84, 0x4c, 0xf5, 0xcf, 0xc3, 0x8c, 0x6e, 0xb7, 0xbb, 0xd5, 0xad, 0x4a, 0x5d, 0xe9,
+		0x06, 0xef, 0x85, 0xd4, 0xab, 0xe7, 0x38, 0x53, 0xcb, 0x36, 0x35, 0xab, 0xb5, 0x64, 0xbb, 0xad,
+		0xf0, 0xe0, 0x15, 0x23, 0x1e, 0x2f, 0x72, 0xfc, 0xea, 0xec, 0xfe, 0x9f, 0x54, 0xea, 0x73, 0xe9,
+		0xcc, 0x6a, 0xbd, 0xfa, 0x85, 0xf4, 0xdc, 0x2a, 0x13, 0xac, 0x0b, 0x63, 0x28, 0x64, 0xcf, 0x24,
+		0x3a, 0x36, 0x10, 0x7e, 0xff, 0x29, 0x98, 0x6d, 0xd9, 0x2d, 0x9b, 0x22, 0x9d, 0xc7, 0x7f, 0xfc,
+		0xe4, 0x36, 0x1f, 0x50, 0xe7, 0x12, 0x8f, 0x79, 0xcb, 0x9b, 0x30, 0xc3, 0x99, 0x55, 0x7a, 0x74,
+		0xc4, 0x36, 0x36, 0xf2, 0xa1, 0x59, 0xb5, 0xe2, 0x2f, 0x7f, 0x8b, 0x2e, 0xdf, 0xca, 0x34, 0x17,
+		0xc5, 0x3a, 0xb6, 0xf7, 0x29, 0x2b, 0xf0, 0x50, 0x0c, 0x8f, 0x0d, 0x52, 0xe2, 0x26, 0x20, 0xfe,
+		0x73, 0x8e, 0x38, 0x13, 0x41, 0x6c, 0x70, 0xd1, 0xf2, 0x32, 0x4c, 0x1c, 0x05, 0xeb, 0x5f, 0x70,
+		0xac, 0x02, 0x89, 0x82, 0xac, 0xc2, 0x14, 0x05, 0xd1, 0x3b, 0x9e, 0x6f, 0xb7, 0xe9, 0x0c, 0x78,
+		0x38, 0xcc, 0xbf, 0xfc, 0x16, 0x1b, 0x35, 0x93, 0x28, 0xb6, 0x1c, 0x48, 0x95, 0xcb, 0x40, 0x4f,
+		0xcb, 0xf0, 0x14, 0x2b, 0x01, 0xe1, 0xab, 0x5c, 0x91, 0x80, 0xbf, 0x7c, 0x03, 0x66, 0xf1, 0x3f,
+		0x9d, 0xa0, 0xa2, 0x9a, 0x24, 0xa7, 0xe0, 0x8a, 0x5f, 0xff, 0x08, 0x1b, 0x98, 0x33, 0x01, 0x40,
+		0x44, 0xa7, 0x48, 0x2f, 0xb6, 0x88, 0xef, 0x13, 0xd7, 0x53, 0x35, 0xb3, 0x9f, 0x7a, 0x91, 0x1c,
+		0x46, 0xf1, 0x67, 0xbf, 0x13, 0xef, 0xc5, 0x55, 0x26, 0x59, 0x31, 0xcd, 0xf2, 0x0e, 0x3c, 0xdc,
+		0xc7, 0x2b, 0x86, 0xc0, 0xfc, 0x24, 0xc7, 0x9c, 0xed, 0xf1, 0x0c, 0x84, 0xad, 0x83, 0xa0, 0x07,
+		0x7d, 0x39, 0x04, 0xe6, 0xcf, 0x71, 0x4c, 0x99, 0xcb, 0x8a, 0x2e, 0x45, 0xc4, 0xeb, 0x30, 0x7d,
+		0x8b, 0xb8, 0xbb, 0xb6, 0xc7, 0xf3, 0x46, 0x43, 0xc0, 0x7d, 0x8a, 0xc3, 0x4d, 0x71, 0x41, 0x9a,
+		0x48, 0x42, 0xac, 0x2b, 0x90, 0xdb, 0xd3, 0x74, 0x32, 0x04, 0xc4, 0x3d, 0x0e, 0x31, 0x86, 0xfc,
+		0x28, 0x5a, 0x81, 0x42, 0xcb, 0xe6, 0x6b, 0x54, 0xb2, 0xf8, 0xa7, 0xb9, 0xf8, 0xb8, 0x90, 0xe1,
+		0x10, 0x8e, 0xed, 0x74, 0x4c, 0x5c, 0xc0, 0x92, 0x21, 0xfe, 0x86, 0x80, 0x10, 0x32, 0x1c, 0xe2,
+		0x08, 0x66, 0xfd, 0x8c, 0x80, 0xf0, 0x22, 0xf6, 0x7c, 0x1e, 0x8f, 0x93, 0xcc, 0x03, 0xdb, 0x1a,
+		0x46, 0x89, 0xcf, 0x72, 0x04, 0xe0, 0x22, 0x08, 0x70, 0x15, 0xf2, 0xc3, 0x76, 0xc4, 0x2f, 0x7e,
+		0x47, 0x0c, 0x0f, 0xd1, 0x03, 0xab, 0x30, 0x25, 0x26, 0x28, 0x3c, 0x7e, 0x4e, 0x86, 0xf8, 0x9b,
+		0x1c, 0x62, 0x32, 0x22, 0xc6, 0x9b, 0xe1, 0x13, 0xcf, 0x6f, 0x91, 0x61, 0x40, 0xde, 0x14, 0xcd,
+		0xe0, 0x22, 0xdc, 0x94, 0xbb, 0xc4, 0xd2, 0xf7, 0x87, 0x43, 0xf8, 0xbc, 0x30, 0xa5, 0x90, 0x41,
+		0x88, 0x65, 0x98, 0x68, 0x6b, 0xae, 0xb7, 0xaf, 0x99, 0x43, 0x75, 0xc7, 0xdf, 0xe2, 0x18, 0x85,
+		0x40, 0x88, 0x5b, 0xa4, 0x63, 0x1d, 0x05, 0xe6, 0x0b, 0xc2, 0x22, 0x1d, 0x2b, 0x06, 0x54, 0x87,
+		0x59, 0xcf, 0xa7, 0x49, 0xb6, 0xa3, 0xa0, 0xfd, 0x6d, 0x31, 0xf4, 0x98, 0xec, 0x46, 0x14, 0xf1,
+		0x2a, 0xe4, 0x3d, 0xe3, 0xf5, 0xa1, 0x60, 0xbe, 0x28, 0x7a, 0x9a, 0x0a, 0xa0, 0xf0, 0x2b, 0x70,
+		0xa2, 0xef, 0x32, 0x31, 0x04, 0xd8, 0xdf, 0xe1, 0x60, 0xc7, 0xfb, 0x2c, 0x15, 0x7c, 0x4a, 0x38,
+		0x2a, 0xe4, 0xdf, 0x15, 0x53, 0x02, 0xe9, 0xc2, 0xaa, 0xe3, 0xae, 0xc1, 0xd3, 0xf6, 0x8e, 0x66,
+		0xb5, 0x5f, 0x12, 0x56, 0x63, 0xb2, 0x31, 0xab, 0x6d, 0xc3, 0x71, 0x8e, 0x78, 0xb4, 0x7e, 0xfd,
+		0x7b, 0x62, 0x62, 0x65, 0xd2, 0x3b, 0xf1, 0xde, 0xfd, 0x10, 0xcc, 0x05, 0xe6, 0x14, 0xe1, 0xa9,
+		0xa7, 0x62, 0x66, 0x2a, 0x19, 0xf9, 0x97, 0x39, 0xb2, 0x98, 0xf1, 0x83, 0xf8, 0xd6, 0xdb, 0xd0,
+		0x1c, 0x04, 0x7f, 0x19, 0x8a, 0x02, 0xbc, 0x63, 0xb9, 0x44, 0xb7, 0x5b, 0x96, 0xf1, 0x3a, 0x69,
+		0x0e, 0x01, 0xfd, 0x2b, 0x5d, 0x5d, 0xb5, 0x13, 0x11, 0x47, 0xe4, 0x35, 0x90, 0x82, 0x58, 0x45,
+		0x35, 0xda, 0x8e, 0xed, 0xfa, 0x09, 0x88, 0x5f, 0x12, 0x3d, 0x15, 0xc8, 0xad, 0x51, 0xb1, 0x72,
+		0x0d, 0xd8, 0xc9, 0xf3, 0xb0, 0x2e, 0xf9, 0x65, 0x0e, 0x34, 0x11, 0x4a, 0xf1, 0x89, 0x43, 0xb7,
+		0xdb, 0x8e, 0xe6, 0x0e, 0x33, 0xff, 0xfd, 0x7d, 0x31, 0x71, 0x70, 0x11, 0x3e, 0x71, 0x60, 0x44,
+		0x87, 0xab, 0xfd, 0x10, 0x08, 0x5f, 0x11, 0x13, 0x87, 0x90, 0xe1, 0x10, 0x22, 0x60, 0x18, 0x02,
+		0xe2, 0x1f, 0x08, 0x08, 0x21, 0x83, 0x10, 0x1f, 0x0c, 0x17, 0x5a, 0x97, 0xb4, 0x0c, 0xcf, 0x77,
+		0x59, 0x50, 0x7c, 0x38, 0xd4, 0x3f, 0xfc, 0x4e, 0x3c, 0x08, 0x53, 0x22, 0xa2, 0x38, 0x13, 0xf1,
+		0xb4, 0x2b, 0xdd, 0x33, 0x25, 0x2b, 0xf6, 0xab, 0x62, 0x26, 0x8a, 0x88, 0xa1, 0x6e, 0x91, 0x08,
+		0x11, 0xcd, 0xae, 0xe3, 0x4e, 0x61, 0x08, 0xb8, 0x7f, 0xd4, 0xa5, 0x5c, 0x43, 0xc8, 0x22, 0x66,
+		0x24, 0xfe, 0xe9, 0x58, 0x37, 0xc9, 0xc1, 0x50, 0xde, 0xf9, 0x8f, 0xbb, 0xe2, 0x9f, 0x1d, 0x26,
+		0xc9, 0xe6, 0x90, 0xa9, 0xae, 0x78, 0x4a, 0x4e, 0xba, 0x67, 0x54, 0xfc, 0xd1, 0x77, 0x78, 0x7b,
+		0xe3, 0xe1, 0x54, 0x79, 0x1d, 0x24, 0x4e, 0x09, 0x03, 0xd8, 0x44, 0xb0, 0x8f, 0xbc, 0x13, 0xf8,
+		0x79, 0x2c, 0xe6, 0x29, 0x5f, 0x83, 0x89, 0x58, 0xc0, 0x93, 0x0c, 0xf5, 0x17, 0x39, 0x54, 0x21,
+		0x1a, 0xef, 0x94, 0x2f, 0x42, 0x16, 0x83, 0x97, 0x64, 0xf1, 0xbf, 0xc4, 0xc5, 0x29, 0x7b, 0xf9,
+		0xfd, 0x90, 0x13, 0x41, 0x4b, 0xb2, 0xe8, 0x5f, 0xe6, 0xa2, 0x81, 0x08, 0x8a, 0x8b, 0x80, 0x25,
+		0x59, 0xfc, 0xaf, 0x08, 0x71, 0x21, 0x82, 0xe2, 0xc3, 0x9b, 0xf0, 0xd7, 0xfe, 0x6a, 0x96, 0x89,
+		0x0b, 0x91, 0x32, 0x9e, 0x7c, 0xb3, 0x48, 0x25, 0x59, 0xfa, 0xc7, 0xf8, 0xc3, 0x85, 0x44, 0xf9,
+		0x32, 0x8c, 0x0c, 0x69, 0xf0, 0x1f, 0xe7, 0xa2, 0x8c, 0xbf, 0xbc, 0x0c, 0xe3, 0x91, 0xe8, 0x24,
+		0x59, 0xfc, 0x27, 0xb8, 0x78, 0x54, 0x0a, 0x55, 0xe7, 0xd1, 0x49, 0x32, 0xc0, 0x4f, 0x0a, 0xd5,
+		0xb9, 0x04, 0x9a, 0x4d, 0x04, 0x26, 0xc9, 0xd2, 0x1f, 0x13, 0x56, 0x17, 0x22, 0xe5, 0xe7, 0x21,
+		0x1f, 0x2c, 0x36, 0xc9, 0xf2, 0x3f, 0xc5, 0xe5, 0x43, 0x19, 0xb4, 0x40, 0xc7, 0x3a, 0x02, 0xc4,
+		0x5f, 0x13, 0x16, 0x88, 0x48, 0xe1, 0x30, 0xea, 0x0e, 0x60, 0x92, 0x91, 0x3e, 0x2e, 0x86, 0x51,
+		0x57, 0xfc, 0x82, 0xbd, 0x49, 0xe7, 0xfc, 0x64, 0x88, 0xbf, 0x2e, 0x7a, 0x93, 0xf2, 0xa3, 0x1a,
+		0xdd, 0x11, 0x41, 0x32, 0xc6, 0xcf, 0x08, 0x35, 0xba, 0x02, 0x82, 0x72, 0x1d, 0xe4, 0xde, 0x68,
+		0x20, 0x19, 0xef, 0x13, 0x1c, 0x6f, 0xba, 0x27, 0x18, 0x28, 0xbf, 0x04, 0xc7, 0xfb, 0x47, 0x02,
+		0xc9, 0xa8, 0x3f, 0xfb, 0x4e, 0xd7, 0xde, 0x2d, 0x1a, 0x08, 0x94, 0xb7, 0x61, 0xb6, 0x5f, 0x14,
+		0x90, 0x0c, 0xfb, 0xc9, 0x77, 0xe2, 0x13, 0x77, 0x34, 0x08, 0x28, 0x57, 0x00, 0xc2, 0x05, 0x38,
+		0x19, 0xeb, 0x53, 0x1c, 0x2b, 0x22, 0x84, 0x43, 0x83, 0xaf, 0xbf, 0xc9, 0xf2, 0xf7, 0xc4, 0xd0,
+		0xe0, 0x12, 0x38, 0x34, 0xc4, 0xd2, 0x9b, 0x2c, 0xfd, 0x69, 0x31, 0x34, 0x84, 0x08, 0x7a, 0x76,
+		0x64, 0x75, 0x4b, 0x46, 0xf8, 0xac, 0xf0, 0xec, 0x88, 0x54, 0x79, 0x13, 0xa6, 0x7b, 0x16, 0xc4,
+		0x64, 0xa8, 0xcf, 0x71, 0x28, 0xa9, 0x7b, 0x3d, 0x8c, 0x2e, 0x5e, 0x7c, 0x31, 0x4c, 0x46, 0xfb,
+		0xf9, 0xae, 0xc5, 0x8b, 0xaf, 0x85, 0xe5, 0xab, 0x90, 0xb3, 0x3a, 0xa6, 0x89, 0x83, 0x47, 0x3e,
+		0xfc, 0x6e, 0x60, 0xf1, 0xbf, 0x7d, 0x8f, 0x5b, 0x47, 0x08, 0x94, 0x2f, 0xc2, 0x08, 0x69, 0xef,
+		0x92, 0x66, 0x92, 0xe4, 0xef, 0x7d, 0x4f, 0x4c, 0x98, 0xc8, 0x5d, 0x7e, 0x1e, 0x80, 0xa5, 0x46,
+		0xe8, 0x61, 0x60, 0x82, 0xec, 0x7f, 0xff, 0x1e, 0xbf, 0x8c, 0x13, 0x8a, 0x84, 0x00, 0xec, 0x6a,
+		0xcf, 0xe1, 0x00, 0xdf, 0x89, 0x03, 0xd0, 0x1e, 0xb9, 0x02, 0x63, 0x78, 0x45, 0xd2, 0xd7, 0x5a,
+		0x49, 0xd2, 0xbf, 0xcf, 0xa5, 0x05, 0x3f, 0x1a, 0xac, 0x6d, 0xbb, 0xc4, 0xd7, 0x5a, 0x5e, 0x92,
+		0xec, 0xff, 0xe0, 0xb2, 0x81, 0x00, 0x0a, 0xeb, 0x9a, 0xe7, 0x0f, 0xd3, 0xee, 0x3f, 0x10, 0xc2,
+		0x42, 0x00, 0x95, 0xc6, 0xff, 0x37, 0xc9, 0x41, 0x92, 0xec, 0x1f, 0x0a, 0xa5, 0x39, 0x7f, 0xf9,
+		0xfd, 0x90, 0xc7, 0xbf, 0xec, 0x86, 0x5d, 0x82, 0xf0, 0xff, 0xe4, 0xc2, 0xa1, 0x04, 0x3e, 0xd9,
+		0xf3, 0x9b, 0xbe, 0x91, 0x6c, 0xec, 0xef, 0xf2, 0x9e, 0x16, 0xfc, 0xe5, 0x0a, 0x8c, 0x7b, 0x7e,
+		0xb3, 0xd9, 0xe1, 0xf1, 0x69, 0x82, 0xf8, 0xff, 0xfa, 0x5e, 0x90, 0xb2, 0x08, 0x64, 0xb0, 0xb7,
+		0x6f, 0xdf, 0xf4, 0x1d, 0x9b, 0x1e, 0x78, 0x24, 0x21, 0xbc, 0xc3, 0x11, 0x22, 0x22, 0xe5, 0x65,
+		0x28, 0x60, 0x5b, 0x5c, 0xe2, 0x10, 0x7a, 0x3a, 0x95, 0x00, 0xf1, 0xbf, 0xb9, 0x01, 0x62, 0x42,
+		0xd5, 0x3f, 0xf3, 0xd5, 0xb7, 0xe7, 0x53, 0x5f, 0x7b, 0x7b, 0x3e, 0xf5, 0x8d, 0xb7, 0xe7, 0x53,
+		0x1f, 0xfb, 0xe6, 0xfc, 0xb1, 0xaf, 0x7d, 0x73, 0xfe, 0xd8, 0xef, 0x7c, 0x73, 0xfe, 0x58, 0xff,
+		0x2c, 0x31, 0xac, 0xda, 0xab, 0x36, 0xcb, 0x0f, 0xbf, 0xfa, 0x78, 0xcb, 0xf0, 0xf7, 0x3b, 0xbb,
+		0x4b, 0xba, 0xdd, 0x3e, 0xaf, 0xdb, 0x5e, 0xdb, 0xf6, 0xce, 0xc7, 0xf3, 0xba, 0xf4, 0x1f, 0x7c,
+		0x3f, 0x05, 0x27, 0x18, 0x4c, 0x98, 0xce, 0xd5, 0xac, 0x83, 0x41, 0xaf, 0xeb, 0x5c, 0x82, 0x4c,
+		0xc5, 0x3a, 0x90, 0x4f, 0xb0, 0x09, 0x4e, 0xed, 0xb8, 0x26, 0xbf, 0xe6, 0x35, 0x86, 0xe5, 0x1d,
+		0xd7, 0xc4, 0x44, 0xb7, 0xb8, 0x8b, 0x89, 0xe7, 0x29, 0xac, 0x50, 0xfd, 0x89, 0xd4, 0xd1, 0x5a,
+		0x92, 0xab, 0x58, 0x07, 0xb4, 0x21, 0xf5, 0xd4, 0xab, 0xef, 0x4d, 0xcc, 0x73, 0xdf, 0xb4, 0xec,
+		0xdb, 0x16, 0xaa, 0xed, 0xec, 0x8a, 0x1c, 0xf7, 0x7c, 0x77, 0x8e, 0xfb, 0x25, 0x62, 0x9a, 0x2f,
+		0x22, 0x1f, 0x1e, 0x8d, 0x7b, 0xbb, 0xa3, 0xec, 0x46, 0x31, 0x7c, 0x3c, 0x0d, 0xf3, 0x3d, 0xe9,
+		0x6c, 0xee, 0x04, 0x83, 0x8c, 0x50, 0x86, 0xdc, 0x8a, 0xf0, 0xad, 0x22, 0xbe, 0x2c, 0xa3, 0xdb,
+		0x56, 0xd3, 0xa3, 0x86, 0xc8, 0x28, 0xa2, 0x88, 0x86, 0xb0, 0x34, 0xcb, 0xf6, 0xf8, 0x45, 0x49,
+		0x56, 0xa8, 0xfe, 0xdc, 0x11, 0x0d, 0x31, 0x21, 0x9e, 0x24, 0xac, 0xf1, 0xcc, 0x90, 0xd6, 0x10,
+		0x8d, 0x88, 0x65, 0xfe, 0x87, 0xb5, 0xca, 0xcf, 0xa4, 0x61, 0xa1, 0xdb, 0x2a, 0x38, 0xb2, 0x3c,
+		0x5f, 0x6b, 0x3b, 0x83, 0xcc, 0x72, 0x15, 0xf2, 0xdb, 0x82, 0xe7, 0xc8, 0x76, 0xb9, 0x77, 0x44,
+		0xbb, 0x4c, 0x06, 0x8f, 0x12, 0x86, 0xb9, 0x30, 0xa4, 0x61, 0x82, 0x76, 0xdc, 0x97, 0x65, 0xfe,
+		0xef, 0x28, 0x9c, 0x60, 0xc3, 0x49, 0x65, 0x43, 0x89, 0x15, 0xb8, 0x4d, 0x0a, 0xd1, 0xaa, 0xe4,
+		0x73, 0x92, 0xd2, 0x8b, 0x30, 0xb3, 0x86, 0xb3, 0x05, 0xee, 0x82, 0xc2, 0x13, 0x9e, 0xbe, 0x77,
+		0x49, 0x17, 0x63, 0x01, 0x3f, 0x3f, 0x61, 0x8a, 0x92, 0x4a, 0x3f, 0x9a, 0x02, 0xa9, 0xa1, 0x6b,
+		0xa6, 0xe6, 0xfe, 0xff, 0x42, 0xc9, 0x97, 0x01, 0xe8, 0x3b, 0x48, 0xe1, 0x4b, 0x43, 0x93, 0x17,
+		0x8a, 0x4b, 0xd1, 0xc6, 0x2d, 0xb1, 0x27, 0xd1, 0x37, 0x12, 0xf2, 0x94, 0x17, 0xff, 0x9e, 0x7d,
+		0x19, 0x20, 0xac, 0x90, 0x4f, 0xc2, 0xc3, 0x8d, 0xe5, 0xca, 0x7a, 0x45, 0x51, 0xd9, 0xe5, 0xf6,
+		0xcd, 0x46, 0xbd, 0xb6, 0xbc, 0x76, 0x6d, 0xad, 0xb6, 0x22, 0x1d, 0x93, 0x8f, 0x83, 0x1c, 0xad,
+		0x0c, 0xee, 0xa5, 0x3c, 0x04, 0xd3, 0x51, 0x3a, 0xbb, 0x21, 0x9f, 0xc6, 0x48, 0xd1, 0x68, 0x3b,
+		0x26, 0xa1, 0x47, 0x7f, 0xaa, 0x21, 0xac, 0x96, 0x1c, 0x84, 0xfc, 0xab, 0x7f, 0xcb, 0x6e, 0x4d,
+		0xcf, 0x84, 0xe2, 0x81, 0xcd, 0xcb, 0xeb, 0x30, 0x8d, 0xf7, 0xb8, 0x9c, 0x18, 0x64, 0xc2, 0x54,
+		0x8d, 0x80, 0xf4, 0x30, 0x93, 0x4b, 0x86, 0x68, 0x97, 0x61, 0xd4, 0xa3, 0xad, 0x4f, 0x82, 0xf8,
+		0x4d, 0x0e, 0xc1, 0xd9, 0xcb, 0x16, 0x4c, 0x63, 0xe4, 0x87, 0x09, 0xa2, 0x50, 0x8d, 0xc3, 0xf3,
+		0x0c, 0xff, 0xe4, 0x4b, 0x4f, 0xd3, 0xa3, 0xcd, 0x47, 0xe3, 0xdd, 0xd2, 0xc7, 0x9d, 0x14, 0x89,
+		0x63, 0x87, 0x8a, 0x12, 0x98, 0x14, 0xcf, 0xe3, 0x0a, 0x1f, 0xfe, 0xb0, 0x7f, 0xca, 0x1f, 0x36,
+		0xdf, 0xcf, 0x07, 0x22, 0x4f, 0x9a, 0xe0, 0xa8, 0xac, 0xa2, 0x5a, 0x1b, 0x34, 0xa6, 0x5f, 0x7d,
+		0xaa, 0x77, 0x75, 0x62, 0x3f, 0xe7, 0x28, 0xf2, 0xd5, 0xe8, 0x63, 0x82, 0xb1, 0xf7, 0xdb, 0x19,
+		0x98, 0xe7, 0xcc, 0xbb, 0x9a, 0x47, 0xce, 0xdf, 0x7a, 0x66, 0x97, 0xf8, 0xda, 0x33, 0xe7, 0x75,
+		0xdb, 0x10, 0x73, 0xf5, 0x0c, 0x1f, 0x8e, 0x58, 0xbf, 0xc4, 0xeb, 0xe7, 0xfa, 0x1e, 0x68, 0xce,
+		0x0d, 0x1e, 0xc6, 0xa5, 0x1d, 0xc8, 0x2e, 0xdb, 0x86, 0x85, 0x53, 0x55, 0x93, 0x58, 0x76, 0x9b,
+		0x8f, 0x1e, 0x56, 0x90, 0x9f, 0x81, 0x51, 0xad, 0x6d, 0x77, 0x2c, 0x9f, 0x8d, 0x9c, 0xea, 0x89,
+		0xaf, 0xbe, 0xb5, 0x70, 0xec, 0xdf, 0xbf, 0xb5, 0x90, 0x59, 0xb3, 0xfc, 0xdf, 0xfa, 0xf2, 0x39,
+		0xe0, 0x50, 0x6b, 0x96, 0xaf, 0x70, 0xc6, 0x72, 0xf6, 0xdb, 0x9f, 0x59, 0x48, 0x95, 0x5e, 0x86,
+		0xb1, 0x15, 0xa2, 0xdf, 0x0f, 0xf2, 0x0a, 0xd1, 0x23, 0xc8, 0x2b, 0x44, 0xef, 0x42, 0xbe, 0x0c,
+		0xb9, 0x35, 0xcb, 0x67, 0x17, 0xd1, 0x9f, 0x82, 0x8c, 0x61, 0xb1, 0xbb, 0x8d, 0x87, 0xea, 0x86,
+		0x5c, 0x28, 0xb8, 0x42, 0xf4, 0x40, 0xb0, 0x49, 0xf4, 0x62, 0x2a, 0xe9, 0xd1, 0xc8, 0x55, 0x5d,
+		0xf9, 0x9d, 0xff, 0x3c, 0x7f, 0xec, 0x8d, 0xb7, 0xe7, 0x8f, 0x0d, 0xec, 0xe2, 0xd2, 0xc0, 0x2e,
+		0xf6, 0x9a, 0x37, 0xd9, 0x8c, 0x1c, 0xf4, 0xec, 0x17, 0xb2, 0x70, 0x8a, 0xbe, 0x9f, 0xe4, 0xb6,
+		0x0d, 0xcb, 0x3f, 0xaf, 0xbb, 0x07, 0x8e, 0x6f, 0xe3, 0xbc, 0x69, 0xef, 0xf1, 0x8e, 0x9d, 0x0e,
+		0xab, 0x97, 0x58, 0x75, 0xff, 0x6e, 0x2d, 0xed, 0xc1, 0x48, 0x1d, 0xe5, 0xd0, 0xc4, 0xbe, 0xed,
+		0x6b, 0x26, 0x5f, 0x7f, 0x58, 0x01, 0xa9, 0xec, 0x9d, 0xa6, 0x34, 0xa3, 0x1a, 0xe2, 0x75, 0x26,
+		0x93, 0x68, 0x7b, 0xec, 0x6a, 0x78, 0x86, 0x06, 0x2e, 0x39, 0x24, 0xd0, 0x5b, 0xe0, 0xb3, 0x30,
+		0xa2, 0x75, 0xd8, 0x1d, 0x86, 0x0c, 0x46, 0x34, 0xb4, 0x50, 0x7a, 0x11, 0xc6, 0xf8, 0x49, 0x2a,
+		0x9e, 0xe2, 0xdf, 0x24, 0x07, 0xf4, 0x39, 0x05, 0x05, 0xff, 0xca, 0x4b, 0x30, 0x42, 0x95, 0xe7,
+		0xef, 0xbc, 0x14, 0x97, 0x7a, 0xb4, 0x5f, 0xa2, 0x4a, 0x2a, 0x8c, 0xad, 0x74, 0x1d, 0x72, 0x2b,
+		0x76, 0xdb, 0xb0, 0xec, 0x38, 0x5a, 0x9e, 0xa1, 0x51, 0x9d, 0x9d, 0x0e, 0xf7, 0x0a, 0x85, 0x15,
+		0xf0, 0xc2, 0x24, 0x7b, 0x55, 0x80, 0xdf, 0xc3, 0xe0, 0xa5, 0xd2, 0x32, 0x8c, 0x51, 0xec, 0x2d,
+		0x07, 0x27, 0xff, 0xe0, 0x56, 0x66, 0x9e, 0xbf, 0x38, 0xc6, 0xe1, 0xd3, 0xa1, 0xb2, 0x32, 0x64,
+		0x9b, 0x9a, 0xaf, 0xf1, 0x76, 0xd3, 0xff, 0xa5, 0x0f, 0x40, 0x8e, 0x83, 0x78, 0xf2, 0x05, 0xc8,
+		0xd8, 0x8e, 0xc7, 0x6f, 0x52, 0xcc, 0x0d, 0x6a, 0xca, 0x96, 0x53, 0xcd, 0xa2, 0xcf, 0x28, 0xc8,
+		0x5c, 0x55, 0x06, 0xba, 0xc5, 0x73, 0x11, 0xb7, 0x88, 0x74, 0x79, 0xe4, 0x2f, 0xeb, 0xd2, 0x1e,
+		0x77, 0x08, 0x9c, 0xe5, 0xb3, 0x69, 0x98, 0x8f, 0xd4, 0xde, 0x22, 0xae, 0x67, 0xd8, 0x16, 0xf3,
+		0x28, 0xee, 0x2d, 0x72, 0x44, 0x49, 0x5e, 0x3f, 0xc0, 0x5d, 0xde, 0x0f, 0x99, 0x8a, 0xe3, 0xe0,
+		0x1b, 0x73, 0xb4, 0xac, 0xdb, 0xcc, 0x5f, 0xb2, 0x4a, 0x50, 0xc6, 0x3a, 0xcf, 0xde, 0xf3, 0x6f,
+		0x6b, 0x6e, 0xf0, 0x36, 0x9d, 0x28, 0x97, 0xae, 0x40, 0x7e, 0xd9, 0xb6, 0x3c, 0x62, 0x79, 0x1d,
+		0x1a, 0xd9, 0xec, 0x9a, 0xb6, 0x7e, 0x93, 0x23, 0xb0, 0x02, 0x1a, 0x5c, 0x73, 0x1c, 0x2a, 0x99,
+		0x55, 0xf0, 0x2f, 0x1b, 0xb3, 0xd5, 0xc6, 0x40, 0x13, 0x5d, 0x39, 0xba, 0x89, 0x78, 0x23, 0x03,
+		0x1b, 0x7d, 0x3f, 0x05, 0x8f, 0xf4, 0x0e, 0xa8, 0x9b, 0xe4, 0xc0, 0x3b, 0xea, 0x78, 0x7a, 0x19,
+		0xf2, 0x75, 0xfa, 0x4a, 0xfb, 0x8b, 0xe4, 0x40, 0x9e, 0x83, 0x31, 0xd2, 0xbc, 0x70, 0xf1, 0xe2,
+		0x33, 0x57, 0x98, 0xb7, 0xbf, 0x70, 0x4c, 0x11, 0x04, 0x79, 0x1e, 0xf2, 0x1e, 0xd1, 0x9d, 0x0b,
+		0x17, 0x2f, 0xdd, 0x7c, 0x86, 0xb9, 0xd7, 0x0b, 0xc7, 0x94, 0x90, 0x54, 0xce, 0x61, 0xab, 0xbf,
+		0xfd, 0xd9, 0x85, 0x54, 0x75, 0x04, 0x32, 0x5e, 0xa7, 0xfd, 0xae, 0xfa, 0xc8, 0x27, 0x47, 0x60,
+		0x31, 0x2a, 0x49, 0xe3, 0xbf, 0x5b, 0x9a, 0x69, 0x34, 0xb5, 0xf0, 0x63, 0x04, 0x52, 0xc4, 0x06,
+		0x94, 0x63, 0xc0, 0x4a, 0x71, 0xa8, 0x25, 0x4b, 0xbf, 0x92, 0x82, 0xc2, 0x0d, 0x81, 0x8c, 0x5f,
+		0x2f, 0xb8, 0x0a, 0x10, 0x3c, 0x49, 0x0c, 0x9b, 0x93, 0x4b, 0xdd, 0xcf, 0x5a, 0x0a, 0x64, 0x94,
+		0x08, 0xbb, 0x7c, 0x99, 0x3a, 0xa2, 0x63, 0x7b, 0xfc, 0x0d, 0xab, 0x04, 0xd1, 0x80, 0x19, 0xef,
+		0xc7, 0xd1, 0x19, 0x4e, 0xbd, 0x65, 0xfb, 0x78, 0x61, 0xc0, 0xb1, 0x6f, 0xf3, 0xf7, 0x56, 0x33,
+		0x8a, 0x44, 0x6b, 0x6e, 0xd0, 0x8a, 0x3a, 0xd2, 0x51, 0xe9, 0x7c, 0x80, 0x82, 0xc1, 0xba, 0xd6,
+		0x6c, 0xba, 0xc4, 0xf3, 0xf8, 0x24, 0x26, 0x8a, 0xf8, 0x5a, 0x97, 0xd3, 0xd9, 0x55, 0xc5, 0x8c,
+		0x81, 0x2f, 0xc6, 0xf5, 0x19, 0xff, 0xc2, 0x3f, 0xf8, 0x0c, 0x30, 0xea, 0x74, 0x76, 0xd1, 0x5b,
+		0x1e, 0x85, 0x42, 0x1f, 0x65, 0xc6, 0x6f, 0x85, 0x7a, 0xd0, 0x2f, 0x29, 0xf0, 0x16, 0xa8, 0x8e,
+		0x6b, 0xd8, 0xae, 0xe1, 0x1f, 0xd0, 0xeb, 0x50, 0x19, 0x45, 0x12, 0x15, 0x75, 0x4e, 0x2f, 0xdd,
+		0x84, 0xa9, 0x06, 0x0d, 0xe2, 0x42, 0xcd, 0x2f, 0x86, 0xfa, 0xa5, 0x92, 0xf5, 0x1b, 0xa8, 0x59,
+		0xba, 0x47, 0xb3, 0xea, 0x07, 0x07, 0x7a, 0xe7, 0xe5, 0xa3, 0x7b, 0x67, 0x7c, 0xb5, 0xfb, 0x83,
+		0x13, 0xf0, 0x48, 0x77, 0x65, 0x6c, 0xfa, 0x1a, 0xd6, 0x31, 0x93, 0xf6, 0x68, 0x73, 0x87, 0x2f,
+		0xaa, 0x73, 0x09, 0xd3, 0xe8, 0x5c, 0xe2, 0x10, 0x2a, 0x5d, 0x81, 0x09, 0xbc, 0xd7, 0xd8, 0x20,
+		0xfe, 0x0b, 0x44, 0x6b, 0x12, 0x37, 0xbe, 0xea, 0x4e, 0x88, 0x55, 0x57, 0x86, 0x2c, 0x5d, 0x5a,
+		0xd9, 0xaa, 0x43, 0xff, 0x97, 0xf6, 0x21, 0x8b, 0xa2, 0xe1, 0x8a, 0xcc, 0x25, 0x68, 0x01, 0xa9,
+		0xbb, 0x07, 0x3e, 0xf1, 0x44, 0x1a, 0x81, 0x16, 0xe4, 0x67, 0xc5, 0xba, 0x9a, 0x39, 0x7c, 0x5d,
+		0xe5, 0x8e, 0xc8, 0x57, 0x57, 0x13, 0xc6, 0xaa, 0x38, 0x15, 0xaf, 0xad, 0x04, 0x8a, 0xa4, 0x42,
+		0x45, 0xe4, 0x0d, 0x98, 0x72, 0x34, 0xd7, 0xa7, 0x6f, 0x87, 0xec, 0xd3, 0x56, 0x70, 0x5f, 0x5f,
+		0xe8, 0x1d, 0x79, 0xb1, 0xc6, 0xf2, 0xa7, 0x4c, 0x38, 0x51, 0x62, 0xe9, 0xbf, 0x64, 0x61, 0x94,
+		0x1b, 0xe3, 0xfd, 0x30, 0xc6, 0xcd, 0xca, 0xbd, 0xf3, 0xd4, 0x52, 0xef, 0xc2, 0xb4, 0x14, 0x2c,
+		0x20, 0x1c, 0x4f, 0xc8, 0xc8, 0x4f, 0x40, 0x4e, 0xdf, 0xd7, 0x0c, 0x4b, 0x35, 0x9a, 0x3c, 0x20,
+		0x1c, 0x7f, 0xfb, 0xad, 0x85, 0xb1, 0x65, 0xa4, 0xad, 0xad, 0x28, 0x63, 0xb4, 0x72, 0xad, 0x89,
+		0x91, 0xc0, 0x3e, 0x31, 0x5a, 0xfb, 0x3e, 0x1f, 0x61, 0xbc, 0x84, 0x9f, 0x51, 0x41, 0x87, 0xe0,
+		0xef, 0x0e, 0xce, 0xf5, 0x44, 0xf8, 0xc1, 0x16, 0xba, 0x9a, 0xc3, 0x07, 0x7f, 0xec, 0x3f, 0x2d,
+		0xa4, 0x14, 0x2a, 0x21, 0x2f, 0xc3, 0x84, 0xa9, 0x79, 0xbe, 0x4a, 0x57, 0x30, 0x7c, 0xfc, 0x08,
+		0x85, 0x38, 0xd1, 0x6b, 0x10, 0x6e, 0x58, 0xae, 0xfa, 0x38, 0x4a, 0x31, 0x52, 0x13, 0x5f, 0x6d,
+		0xa2, 0x20, 0x78, 0x9d, 0xd3, 0xf0, 0x59, 0x6c, 0x35, 0x4a, 0xed, 0x3e, 0x89, 0xf4, 0x65, 0x4a,
+		0xa6, 0x11, 0xd6, 0x49, 0xc8, 0xd3, 0xb7, 0x95, 0x28, 0x0b, 0xbb, 0x87, 0x9b, 0x43, 0x02, 0xad,
+		0x7c, 0x12, 0xa6, 0xc2, 0xf9, 0x91, 0xb1, 0xe4, 0x18, 0x4a, 0x48, 0xa6, 0x8c, 0x4f, 0xc3, 0xac,
+		0x45, 0xee, 0xf8, 0x6a, 0x48, 0x66, 0xdc, 0x79, 0xca, 0x2d, 0x63, 0xdd, 0x8d, 0xb8, 0xc4, 0xe3,
+		0x30, 0xa9, 0x0b, 0xe3, 0x33, 0x5e, 0xa0, 0xbc, 0x13, 0x01, 0x95, 0xb2, 0x9d, 0x80, 0x9c, 0xe6,
+		0x38, 0x8c, 0x61, 0x9c, 0xcf, 0x8f, 0x8e, 0x43, 0xab, 0xce, 0xc2, 0x34, 0x6d, 0xa3, 0x4b, 0xbc,
+		0x8e, 0xe9, 0x73, 0x90, 0x02, 0xe5, 0x99, 0xc2, 0x0a, 0x85, 0xd1, 0x29, 0xef, 0x63, 0x30, 0x41,
+		0x6e, 0x19, 0x4d, 0x62, 0xe9, 0x84, 0xf1, 0x4d, 0x50, 0xbe, 0x82, 0x20, 0x52, 0xa6, 0x33, 0x10,
+		0xcc, 0x7b, 0xaa, 0x98, 0x93, 0x27, 0x19, 0x9e, 0xa0, 0x57, 0x18, 0xb9, 0x54, 0x84, 0xec, 0x8a,
+		0xe6, 0x6b, 0x18, 0x60, 0xf8, 0x77, 0xd8, 0x42, 0x53, 0x50, 0xf0, 0x6f, 0xe9, 0xdb, 0x69, 0xc8,
+		0xde, 0xb0, 0x7d, 0x22, 0xbf, 0x2f, 0x12, 0x00, 0x4e, 0xf6, 0xf3, 0xe7, 0x86, 0xd1, 0xb2, 0x48,
+		0x73, 0xc3, 0x6b, 0x45, 0x3e, 0x2d, 0x10, 0xba, 0x53, 0x3a, 0xe6, 0x4e, 0xb3, 0x30, 0xe2, 0xda,
+		0x1d, 0xab, 0x29, 0xae, 0xb0, 0xd2, 0x82, 0x5c, 0x83, 0x5c, 0xe0, 0x25, 0xd9, 0x24, 0x2f, 0x99,
+		0x42, 0x2f, 0x41, 0x1f, 0xe6, 0x04, 0x65, 0x6c, 0x97, 0x3b, 0x4b, 0x15, 0xf2, 0xc1, 0xe4, 0x55,
+		0x1c, 0x39, 0x82, 0xc3, 0x86, 0x62, 0xb8, 0x98, 0x04, 0x7d, 0x1f, 0x18, 0x8f, 0x79, 0x9c, 0x14,
+		0x54, 0x70, 0xeb, 0xc5, 0xdc, 0x8a, 0x7f, 0xe6, 0x60, 0x8c, 0xb6, 0x2b, 0x74, 0x2b, 0xf6, 0xa9,
+		0x83, 0x47, 0xf0, 0x46, 0x52, 0xcb, 0xd2, 0xfc, 0x8e, 0x4b, 0xb8, 0xe7, 0x85, 0x04, 0x7c, 0x61,
+		0x65, 0x94, 0x79, 0x72, 0xc4, 0x6e, 0xa9, 0xfe, 0x76, 0x4b, 0x0f, 0xb2, 0x5b, 0xe6, 0xfe, 0xed,
+		0x56, 0x01, 0x08, 0x94, 0xf1, 0xf8, 0xdb, 0xe7, 0x7d, 0x22, 0x06, 0xa6, 0x62, 0xc3, 0x68, 0xf1,
+		0x81, 0x1a, 0x11, 0x2a, 0xfd, 0xc7, 0x14, 0xe4, 0x83, 0x7a, 0xb9, 0x02, 0x13, 0x42, 0x2f, 0x75,
+		0xcf, 0xd4, 0x5a, 0xdc, 0x77, 0x4e, 0x0d, 0x54, 0xee, 0x9a, 0xa9, 0xb5, 0x94, 0x71, 0xae, 0x0f,
+		0x16, 0xfa, 0xf7, 0x43, 0x7a, 0x40, 0x3f, 0xc4, 0x3a, 0x3e, 0x73, 0x7f, 0x1d, 0x1f, 0xeb, 0xa2,
+		0x6c, 0x77, 0x17, 0x7d, 0x29, 0x4d, 0x37, 0x33, 0x8e, 0xed, 0x69, 0xe6, 0x0f, 0x62, 0x44, 0x9c,
+		0x84, 0xbc, 0x63, 0x9b, 0x2a, 0xab, 0x61, 0x57, 0xbb, 0x73, 0x8e, 0x6d, 0x2a, 0x3d, 0xdd, 0x3e,
+		0xf2, 0x80, 0x86, 0xcb, 0xe8, 0x03, 0xb0, 0xda, 0x58, 0xb7, 0xd5, 0x5c, 0x28, 0x30, 0x53, 0xf0,
+		0xb5, 0xec, 0x69, 0xb4, 0x01, 0xfe, 0x2b, 0xa6, 0x7a, 0xd7, 0x5e, 0xa6, 0x36, 0xe3, 0x54, 0x46,
+		0xf7, 0x03, 0x09, 0x36, 0xf5, 0x17, 0xd3, 0x83, 0x24, 0x98, 0xdb, 0x29, 0x9c, 0xaf, 0xf4, 0xd3,
+		0x29, 0x80, 0x75, 0xb4, 0x2c, 0x6d, 0x2f, 0xae, 0x42, 0x1e, 0x55, 0x41, 0x8d, 0x3d, 0x79, 0x7e,
+		0x50, 0xa7, 0xf1, 0xe7, 0x17, 0xbc, 0xa8, 0xde, 0xcb, 0x30, 0x11, 0x3a, 0xa3, 0x47, 0x84, 0x32,
+		0xf3, 0x87, 0x44, 0xd5, 0x0d, 0xe2, 0x2b, 0x85, 0x5b, 0x91, 0x52, 0xe9, 0x37, 0x52, 0x90, 0xa7,
+		0x3a, 0xe1, 0xbb, 0xb3, 0xb1, 0x3e, 0x4c, 0xdd, 0x7f, 0x1f, 0x9e, 0x02, 0x60, 0x30, 0x78, 0x3e,
+		0xcb, 0x3d, 0x2b, 0x4f, 0x29, 0x78, 0xea, 0x2a, 0x5f, 0x0a, 0x0c, 0x9e, 0x39, 0xdc, 0xe0, 0x22,
+		0xea, 0xe6, 0x66, 0x7f, 0x18, 0xc6, 0xe8, 0xd7, 0x9a, 0xee, 0x78, 0x3c, 0x90, 0xc6, 0x4f, 0x34,
+		0x6c, 0xdf, 0xf1, 0x4a, 0xaf, 0xc1, 0xd8, 0xf6, 0x1d, 0x96, 0x1b, 0x39, 0x09, 0x79, 0xd7, 0xb6,
+		0xf9, 0x9a, 0xcc, 0x62, 0xa1, 0x1c, 0x12, 0xe8, 0x12, 0x24, 0xf2, 0x01, 0xe9, 0x30, 0x1f, 0x10,
+		0x26, 0x34, 0x32, 0x43, 0x25, 0x34, 0xce, 0xfe, 0x76, 0x0a, 0xc6, 0x23, 0xf3, 0x83, 0xfc, 0x0c,
+		0x3c, 0x54, 0x5d, 0xdf, 0x5a, 0x7e, 0x51, 0x5d, 0x5b, 0x51, 0xaf, 0xad, 0x57, 0x56, 0xc3, 0x97,
+		0x97, 0xe6, 0x8e, 0xdf, 0xbd, 0xb7, 0x28, 0x47, 0x78, 0x77, 0x2c, 0x9a, 0xa7, 0x97, 0xcf, 0xc3,
+		0x6c, 0x5c, 0xa4, 0x52, 0x6d, 0xe0, 0x9b, 0x4c, 0xa9, 0xb9, 0x87, 0xee, 0xde, 0x5b, 0x9c, 0x8e,
+		0x48, 0x54, 0x76, 0x3d, 0x62, 0xf9, 0xbd, 0x02, 0xcb, 0x5b, 0x1b, 0x1b, 0x6b, 0xdb, 0x52, 0xba,
+		0x47, 0x80, 0x4f, 0xd8, 0x67, 0x60, 0x3a, 0x2e, 0xb0, 0xb9, 0xb6, 0x2e, 0x65, 0xe6, 0xe4, 0xbb,
+		0xf7, 0x16, 0x27, 0x23, 0xdc, 0x9b, 0x86, 0x39, 0x97, 0xfb, 0xe8, 0xcf, 0xcf, 0x1f, 0xfb, 0xfc,
+		0x2f, 0xcc, 0xa7, 0xb0, 0x65, 0x13, 0xb1, 0x39, 0x42, 0x7e, 0x2f, 0x3c, 0xdc, 0x58, 0x5b, 0xdd,
+		0xac, 0xad, 0xa8, 0x1b, 0x8d, 0x55, 0x91, 0xe9, 0x16, 0xad, 0x9b, 0xba, 0x7b, 0x6f, 0x71, 0x9c,
+		0x37, 0x69, 0x10, 0x77, 0x5d, 0xa9, 0xdd, 0xd8, 0xda, 0xae, 0x49, 0x29, 0xc6, 0x5d, 0x77, 0xc9,
+		0x2d, 0xdb, 0x67, 0x9f, 0x73, 0x7b, 0x1a, 0x4e, 0xf4, 0xe1, 0x0e, 0x1a, 0x36, 0x7d, 0xf7, 0xde,
+		0xe2, 0x44, 0xdd, 0x25, 0x6c, 0xfc, 0x50, 0x89, 0x25, 0x28, 0xf6, 0x4a, 0x6c, 0xd5, 0xb7, 0x1a,
+		0x95, 0x75, 0x69, 0x71, 0x4e, 0xba, 0x7b, 0x6f, 0xb1, 0x20, 0x26, 0x43, 0xe4, 0x0f, 0x5b, 0xf6,
+		0x6e, 0xee, 0x78, 0x7e, 0xf2, 0x2a, 0x9c, 0xf2, 0x7c, 0xed, 0xa6, 0x61, 0xb5, 0x82, 0xac, 0x2d,
+		0x2f, 0xf3, 0x2d, 0xcf, 0x29, 0xd3, 0xf8, 0x70, 0xc7, 0x68, 0x0a, 0xa2, 0xf8, 0x4d, 0x48, 0xe1,
+		0x0e, 0x3c, 0xb9, 0x9c, 0x4b, 0x38, 0xd4, 0x4b, 0xde, 0x3a, 0x0d, 0x4e, 0x0f, 0xcf, 0x25, 0x24,
+		0xa1, 0xe7, 0x0e, 0xdd, 0xdc, 0x95, 0x3e, 0x96, 0x82, 0xc9, 0x17, 0x0c, 0xcf, 0xb7, 0x5d, 0x43,
+		0xd7, 0x4c, 0xfa, 0xca, 0xd2, 0xa5, 0x61, 0xe7, 0xd6, 0xae, 0xa1, 0x7e, 0x0d, 0x46, 0x6f, 0x69,
+		0x26, 0x9b, 0xd4, 0xd8, 0x5b, 0x61, 0x87, 0x5a, 0x31, 0x9c, 0xe1, 0x04, 0x0e, 0x93, 0x2e, 0x7d,
+		0x31, 0x0d, 0x53, 0x74, 0x4c, 0x78, 0xec, 0xa3, 0x5c, 0xb8, 0xd5, 0xaa, 0x43, 0xd6, 0xd5, 0x7c,
+		0x9e, 0x3b, 0xac, 0xfe, 0x10, 0x4f, 0x07, 0x3f, 0x91, 0x9c, 0xd4, 0x5d, 0xea, 0xcd, 0x18, 0x53,
+		0x24, 0xf9, 0x25, 0xc8, 0xb5, 0xb5, 0x3b, 0x2a, 0x45, 0x4d, 0x3f, 0x00, 0xd4, 0xb1, 0xb6, 0x76,
+		0x07, 0x75, 0x95, 0x9b, 0x30, 0x85, 0xc0, 0xfa, 0xbe, 0x66, 0xb5, 0x08, 0xc3, 0xcf, 0x3c, 0x00,
+		0xfc, 0x89, 0xb6, 0x76, 0x67, 0x99, 0x62, 0xe2, 0x53, 0xca, 0xb9, 0x4f, 0x7c, 0x66, 0xe1, 0x18,
+		0xcd, 0xb6, 0xff, 0x46, 0x0a, 0x20, 0x34, 0x97, 0xac, 0x83, 0xa4, 0x07, 0x25, 0xfa, 0x78, 0x8f,
+		0xf7, 0xe3, 0x52, 0x42, 0x7f, 0x74, 0xd9, 0x9c, 0x2d, 0xd3, 0x5f, 0x7b, 0x6b, 0x21, 0xa5, 0x4c,
+		0xe9, 0x5d, 0xdd, 0x51, 0x83, 0xf1, 0x8e, 0xd3, 0xd4, 0x7c, 0xa2, 0xd2, 0x2d, 0x5d, 0xfa, 0x08,
+		0x4b, 0x3e, 0x30, 0x41, 0xac, 0x8a, 0x34, 0xe2, 0x8b, 0x29, 0x18, 0x5f, 0x89, 0x1c, 0xf9, 0x15,
+		0x61, 0xac, 0x6d, 0x5b, 0xc6, 0x4d, 0xee, 0x84, 0x79, 0x45, 0x14, 0x31, 0xff, 0xc9, 0x5e, 0xdd,
+		0xf4, 0x0f, 0x44, 0xfe, 0x53, 0x94, 0x51, 0xea, 0x36, 0xd9, 0xf5, 0x0c, 0x61, 0x72, 0x45, 0x14,
+		0x71, 0x23, 0xe3, 0x11, 0xbd, 0x83, 0x89, 0x1b, 0x55, 0xb7, 0x2d, 0x5f, 0xd3, 0x7d, 0xfe, 0x12,
+		0xe0, 0x94, 0xa0, 0x2f, 0x33, 0x32, 0x82, 0x34, 0x89, 0xaf, 0x19, 0xa6, 0x57, 0x64, 0xc7, 0x62,
+		0xa2, 0x18, 0x51, 0xf7, 0x77, 0xc7, 0xa2, 0x09, 0xab, 0x65, 0x90, 0x6c, 0x87, 0xb8, 0xb1, 0x00,
+		0x93, 0x39, 0x6a, 0xf1, 0xb7, 0xbe, 0x7c, 0x6e, 0x96, 0x77, 0x22, 0x0f, 0x31, 0xd9, 0x2d, 0x57,
+		0x65, 0x4a, 0x48, 0x70, 0xb2, 0xfc, 0x0a, 0x48, 0xc1, 0x3e, 0x4f, 0x75, 0x3a, 0xbb, 0x61, 0x92,
+		0x6b, 0xb6, 0xc7, 0xae, 0x15, 0xeb, 0xa0, 0x5a, 0xfc, 0xcd, 0x10, 0x3a, 0xcc, 0x2c, 0x61, 0x5a,
+		0x69, 0x2a, 0xc0, 0xa9, 0x53, 0x18, 0x0c, 0x18, 0x5f, 0xd3, 0x0c, 0x53, 0xbc, 0x91, 0xae, 0xf0,
+		0x92, 0x5c, 0x81, 0x51, 0xcf, 0xd7, 0xfc, 0x8e, 0xc7, 0xbf, 0x1c, 0x77, 0x26, 0xc1, 0x41, 0xaa,
+		0xb6, 0xd5, 0x6c, 0x50, 0x01, 0x85, 0x0b, 0xca, 0xdb, 0x30, 0xea, 0xdb, 0x37, 0x89, 0xc5, 0x6d,
+		0x75, 0x24, 0x1f, 0xef, 0x73, 0x40, 0xc5, 0xb0, 0xe4, 0x16, 0x48, 0x4d, 0x62, 0x92, 0x16, 0x8b,
+		0x92, 0xf6, 0x35, 0xdc, 0x4c, 0x8c, 0x3e, 0x80, 0x31, 0x34, 0x15, 0xa0, 0x36, 0x28, 0xa8, 0xac,
+		0xc4, 0xcf, 0x9e, 0xd9, 0xd7, 0x16, 0xcf, 0x26, 0x98, 0x21, 0xe2, 0xa7, 0x22, 0xd1, 0x10, 0x01,
+		0x41, 0x57, 0xeb, 0x58, 0xbb, 0xb6, 0x45, 0xdf, 0x22, 0xe5, 0x81, 0x7a, 0x8e, 0x86, 0x3e, 0x53,
+		0x01, 0xfd, 0x05, 0x4a, 0x96, 0x5f, 0x84, 0xc9, 0x90, 0x95, 0x8e, 0xa4, 0xfc, 0x11, 0x46, 0xd2,
+		0x44, 0x20, 0x8b, 0xb5, 0xf2, 0x16, 0x40, 0x38, 0x4c, 0x69, 0xea, 0x60, 0xfc, 0xc2, 0x99, 0xa1,
+		0x87, 0xbc, 0xd8, 0x89, 0x85, 0x10, 0xf2, 0x9f, 0x85, 0x93, 0x3c, 0x87, 0x1b, 0x44, 0xac, 0xf8,
+		0x3c, 0xd1, 0x21, 0xe3, 0x0f, 0xa0, 0x43, 0x8a, 0x2c, 0x15, 0x1c, 0x2c, 0x04, 0xe8, 0x60, 0xac,
+		0x67, 0x4c, 0x98, 0x61, 0x0f, 0x67, 0x0d, 0x10, 0x0f, 0x2d, 0x3c, 0x80, 0x87, 0x4e, 0x53, 0xe0,
+		0x75, 0x8a, 0xcb, 0x9e, 0x56, 0x2e, 0x7c, 0xf4, 0x33, 0x0b, 0xc7, 0xf8, 0xe8, 0x3e, 0x56, 0xaa,
+		0xd3, 0x14, 0x3a, 0x1f, 0x98, 0xc4, 0x93, 0x2f, 0x41, 0x5e, 0x13, 0x05, 0x9a, 0xd8, 0x38, 0x6c,
+		0x60, 0x87, 0xac, 0x6c, 0xbe, 0x78, 0xe3, 0x3f, 0x2c, 0xa6, 0x4a, 0xbf, 0x90, 0x82, 0xd1, 0x95,
+		0x1b, 0x75, 0xcd, 0x70, 0xe5, 0x1a, 0x4c, 0x07, 0x5e, 0x38, 0xf4, 0x6c, 0x11, 0x0e, 0x07, 0x4e,
+		0x47, 0x98, 0xfe, 0xbb, 0xda, 0x43, 0x61, 0xba, 0xf7, 0xbb, 0x5d, 0x0d, 0x5f, 0x87, 0x31, 0xa6,
+		0x25, 0xfd, 0x86, 0x8a, 0x83, 0x7f, 0xf8, 0x89, 0xc1, 0xe3, 0x49, 0x63, 0x82, 0x8a, 0x05, 0x89,
+		0x4e, 0x94, 0x2c, 0x7d, 0x3f, 0x05, 0xb0, 0x72, 0xe3, 0xc6, 0xb6, 0x6b, 0x38, 0x26, 0xf1, 0x1f,
+		0x54, 0xc3, 0xd7, 0xe1, 0xa1, 0xb0, 0xe1, 0x9e, 0xab, 0x0f, 0xdd, 0xf8, 0x99, 0x70, 0x0f, 0xe5,
+		0xea, 0x7d, 0xd1, 0x9a, 0x9e, 0x1f, 0xa0, 0x65, 0x86, 0x46, 0x5b, 0xf1, 0xfc, 0xfe, 0xd6, 0x7c,
+		0x15, 0xc6, 0xc3, 0xe6, 0x7b, 0xf2, 0x8b, 0x90, 0xf3, 0xf9, 0x7f, 0x6e, 0xd4, 0x33, 0x89, 0x46,
+		0x15, 0xd2, 0xdc, 0xb0, 0x01, 0x40, 0xe9, 0x17, 0xd3, 0x00, 0x2b, 0xcc, 0x34, 0x38, 0x54, 0xff,
+		0x58, 0x39, 0x15, 0x2e, 0x0a, 0x7c, 0xb8, 0x3e, 0x88, 0xc0, 0x87, 0x63, 0x61, 0x7a, 0x34, 0x3e,
+		0x11, 0x15, 0xd9, 0xbb, 0x0f, 0x13, 0xb7, 0xa2, 0xd3, 0x47, 0x57, 0x1f, 0xdc, 0x4d, 0xe3, 0xb7,
+		0x25, 0xf8, 0x34, 0xf9, 0xc7, 0xd6, 0x60, 0x2f, 0xc1, 0x18, 0xb1, 0x7c, 0xd7, 0xa0, 0x16, 0x43,
+		0xcf, 0xb8, 0x9c, 0xe0, 0x19, 0x7d, 0x9a, 0x44, 0x3f, 0xc7, 0x24, 0x72, 0xf6, 0x1c, 0xad, 0xcb,
+		0x18, 0xbf, 0x9b, 0x86, 0xe2, 0x20, 0x49, 0xcc, 0x40, 0xea, 0x2e, 0xa1, 0x04, 0x35, 0x96, 0x38,
+		0x9c, 0x14, 0x64, 0xbe, 0x68, 0x6d, 0x00, 0x86, 0x83, 0xe8, 0x86, 0xc8, 0x7a, 0xe4, 0xf8, 0x6f,
+		0x32, 0x14, 0xc6, 0x6a, 0x99, 0xc0, 0x94, 0x61, 0x19, 0xbe, 0xa1, 0x99, 0xea, 0xae, 0x66, 0x6a,
+		0x96, 0x7e, 0x3f, 0xe1, 0x72, 0x6f, 0x28, 0x31, 0xc9, 0x41, 0xab, 0x0c, 0x53, 0xbe, 0x01, 0x63,
+		0x02, 0x3e, 0xfb, 0x00, 0xe0, 0x05, 0x58, 0x24, 0x26, 0xfc, 0x77, 0x69, 0x98, 0x56, 0x48, 0xf3,
+		0x4f, 0x96, 0x59, 0x3f, 0x04, 0xc0, 0x86, 0x27, 0x4e, 0x9e, 0xc5, 0xec, 0x03, 0x18, 0xee, 0x79,
+		0x86, 0xb7, 0xe2, 0xf9, 0x11, 0xdb, 0x7e, 0x3d, 0x0d, 0x85, 0xa8, 0x6d, 0xff, 0x04, 0x2c, 0x26,
+		0x72, 0x3d, 0x9c, 0x14, 0x58, 0x22, 0xfd, 0xe9, 0x84, 0x49, 0xa1, 0xc7, 0xf9, 0x0e, 0x9f, 0x0d,
+		0x7e, 0x69, 0x1c, 0x46, 0xeb, 0x9a, 0xab, 0xb5, 0x3d, 0xf9, 0x7a, 0x4f, 0x1c, 0x2a, 0x12, 0x89,
+		0x3d, 0x5f, 0x0d, 0xe7, 0x79, 0x0b, 0xe6, 0x79, 0x9f, 0xe8, 0x13, 0x86, 0x3e, 0x0e, 0x93, 0xb8,
+		0xfd, 0x8d, 0xdc, 0x39, 0x48, 0xd3, 0x93, 0x54, 0xdc, 0xbf, 0x86, 0x07, 0x5e, 0xf8, 0x89, 0x12,
+		0x64, 0x0b, 0xa7, 0x3d, 0xe4, 0x81, 0xb6, 0x76, 0xa7, 0xc6, 0x28, 0xf2, 0x39, 0x90, 0xf7, 0x83,
+		0xbc, 0x84, 0x1a, 0x5a, 0x02, 0xf9, 0xa6, 0xc3, 0x1a, 0xc1, 0x8e, 0xe9, 0x4b, 0x0c, 0x4e, 0xd9,
+		0x3d, 0x36, 0xb6, 0x71, 0xcb, 0x23, 0x65, 0x05, 0x09, 0xf2, 0x8f, 0xc0, 0x4c, 0xdb, 0xb0, 0xd4,
+		0xae, 0x9d, 0x31, 0xdf, 0x54, 0xac, 0x1f, 0xcd, 0x61, 0xbf, 0xfb, 0xd6, 0xc2, 0xdc, 0x81, 0xd6,
+		0x36, 0xcb, 0xa5, 0x3e, 0x90, 0x25, 0x65, 0xba, 0x6d, 0x58, 0xf1, 0xad, 0xb4, 0xfc, 0x17, 0x52,
+		0x51, 0xcf, 0xa0, 0x7a, 0xee, 0x69, 0xba, 0x6f, 0xbb, 0xec, 0x73, 0xd7, 0xd5, 0xcd, 0x23, 0x2b,
+		0xf0, 0x08, 0x53, 0xa0, 0x2f, 0x68, 0x49, 0x99, 0x89, 0x2d, 0x89, 0xd7, 0x28, 0x55, 0xfe, 0x71,
+		0xbc, 0x5b, 0x6f, 0xda, 0xbb, 0x91, 0x98, 0x9a, 0x39, 0x90, 0xaa, 0x6b, 0x0e, 0xfb, 0x92, 0x50,
+		0x55, 0x39, 0xb2, 0x22, 0x8b, 0x4c, 0x91, 0x81, 0xc0, 0x25, 0xe5, 0x38, 0xab, 0xe3, 0xf1, 0x36,
+		0xab, 0x59, 0xd6, 0x1c, 0xf9, 0xa7, 0x53, 0xf0, 0x48, 0xa8, 0x7f, 0x1f, 0x95, 0xf2, 0x54, 0xa5,
+		0x9d, 0x23, 0xab, 0xf4, 0x58, 0xb7, 0x6d, 0xfa, 0x69, 0x75, 0x22, 0xa8, 0xee, 0x51, 0xec, 0xd7,
+		0x53, 0x30, 0xb8, 0x3d, 0xaa, 0x87, 0x1f, 0xfd, 0xe9, 0x98, 0xec, 0x13, 0xf8, 0xe3, 0x17, 0xaa,
+		0x09, 0x83, 0x72, 0xb5, 0x6f, 0xe3, 0x1b, 0x1c, 0x84, 0x0d, 0xd3, 0x67, 0xb0, 0x89, 0xdf, 0x7d,
+		0x6b, 0xe1, 0x4c, 0x82, 0x2d, 0x83, 0x67, 0x97, 0x94, 0xf9, 0xd6, 0xa1, 0xb8, 0xf2, 0xc7, 0x53,
+		0x70, 0xd2, 0x71, 0x6d, 0x3c, 0xcb, 0xed, 0x6b, 0x5b, 0xb6, 0x79, 0xdb, 0x3e, 0xb2, 0x6d, 0x4b,
+		0x4c, 0xc5, 0x43, 0xa0, 0x4b, 0x4a, 0x51, 0xd4, 0xf6, 0x58, 0xf6, 0xcd, 0x14, 0xf4, 0xb8, 0xac,
+		0xe1, 0x7a, 0xbe, 0x6a, 0xda, 0x9e, 0xa7, 0xee, 0xb9, 0x1a, 0xfd, 0xea, 0x0f, 0xdf, 0xe4, 0x7d,
+		0xe8, 0xc8, 0xca, 0x9d, 0xe9, 0x3f, 0x28, 0x7a, 0x9f, 0x50, 0x52, 0xe6, 0xe3, 0x23, 0x04, 0x59,
+		0xd6, 0x6d, 0xcf, 0xbb, 0xc6, 0x19, 0xe4, 0x8f, 0xa6, 0xe0, 0x64, 0x17, 0x4e, 0xcb, 0xc5, 0x0f,
+		0xa7, 0x38, 0xc4, 0x35, 0xec, 0x66, 0x71, 0x22, 0x69, 0x7e, 0x5c, 0xe2, 0x7d, 0x5a, 0xea, 0xab,
+		0x53, 0x14, 0xab, 0x44, 0x67, 0xd1, 0x62, 0x4c, 0xa1, 0x55, 0xac, 0xaf, 0xd3, 0xea, 0xc8, 0x2a,
+		0xf8, 0x66, 0x0a, 0x1e, 0x1b, 0xc2, 0x9f, 0x82, 0x9b, 0x16, 0xa9, 0x23, 0xdf, 0xb4, 0xf8, 0x61,
+		0xc8, 0xa0, 0x77, 0xb0, 0xd5, 0x6f, 0xe9, 0x68, 0x1d, 0xa0, 0xa0, 0x28, 0xbf, 0x01, 0xfc, 0x85,
+		0x14, 0xc8, 0x61, 0x80, 0xa9, 0x10, 0xcf, 0xb1, 0x2d, 0x8f, 0xa6, 0x28, 0xc2, 0x25, 0x8a, 0xab,
+		0x97, 0xb8, 0x09, 0x0a, 0x04, 0x44, 0x8a, 0x22, 0x84, 0xc0, 0x6f, 0x67, 0x8b, 0xe8, 0x26, 0xcd,
+		0x7b, 0xa4, 0xcf, 0x95, 0xeb, 0x25, 0xbc, 0xe4, 0x2c, 0x16, 0xc3, 0xee, 0xc0, 0xed, 0x58, 0xe9,
+		0x1b, 0x29, 0x38, 0xd1, 0xb3, 0x76, 0x06, 0x3a, 0x13, 0x90, 0xdd, 0x48, 0x25, 0xff, 0x0c, 0x2a,
+		0xd3, 0xfd, 0x7e, 0x57, 0xe4, 0x69, 0xb7, 0xbb, 0xe2, 0x5d, 0x8b, 0x4f, 0x59, 0x7f, 0xfc, 0xeb,
+		0x14, 0xcc, 0x46, 0x95, 0x09, 0x5a, 0xb7, 0x03, 0x85, 0xa8, 0x2e, 0xbc, 0x5d, 0x4f, 0x1d, 0xa1,
+		0x5d, 0xbc, 0x49, 0x31, 0x18, 0xf9, 0xe5, 0x30, 0x76, 0x61, 0x67, 0x01, 0xcf, 0x1d, 0xd5, 0x52,
+		0x42, 0xc3, 0xee, 0x18, 0x26, 0x4b, 0xbb, 0xec, 0x23, 0x69, 0xc8, 0xd6, 0x6d, 0xdb, 0x94, 0xff,
+		0x1c, 0x4c, 0x5b, 0xb6, 0x4f, 0x07, 0x15, 0x69, 0xaa, 0x3c, 0x15, 0xc9, 0xe2, 0xc0, 0x0f, 0x1e,
+		0xcd, 0x80, 0xbf, 0xf7, 0xd6, 0x42, 0x2f, 0x54, 0x97, 0x55, 0xa7, 0x2c, 0xdb, 0xaf, 0xd2, 0xfa,
+		0x6d, 0x5a, 0x2d, 0xbb, 0x30, 0x11, 0x7f, 0x34, 0x1b, 0x39, 0x1b, 0x47, 0x7e, 0xf4, 0xc4, 0x61,
+		0x8f, 0x2d, 0xec, 0x46, 0x9e, 0xc9, 0x6e, 0xae, 0xfe, 0x21, 0xf6, 0xea, 0xe7, 0x52, 0x30, 0x43,
+		0x89, 0xc6, 0xeb, 0x84, 0x26, 0xb2, 0x14, 0xa2, 0xdb, 0x6e, 0x53, 0x9e, 0x84, 0x34, 0x3f, 0x0b,
+		0xce, 0x2a, 0x69, 0xa3, 0x89, 0x17, 0x03, 0xec, 0xdb, 0x16, 0xbf, 0x48, 0x96, 0x57, 0x58, 0x81,
+		0x06, 0x6a, 0x36, 0x4e, 0x1a, 0xf8, 0xed, 0x60, 0x7a, 0xcd, 0x9f, 0xe5, 0xcc, 0x27, 0x18, 0xb5,
+		0xc2, 0x88, 0x78, 0x2e, 0x1f, 0x4c, 0x4d, 0x3c, 0x65, 0x1e, 0x12, 0x30, 0x1b, 0x8f, 0x1b, 0x0f,
+		0x7a, 0xb9, 0x60, 0x84, 0xbd, 0x1a, 0x2b, 0xca, 0xdc, 0xf5, 0xfe, 0x14, 0x94, 0xea, 0x84, 0x85,
+		0x87, 0x51, 0x55, 0x2b, 0x1d, 0x7f, 0xdf, 0x76, 0x8d, 0xd7, 0x35, 0xf6, 0x51, 0xcd, 0xfb, 0x4c,
+		0xb1, 0x95, 0x3e, 0x9f, 0x82, 0x85, 0x18, 0xee, 0xba, 0xad, 0xdf, 0xac, 0xe0, 0x07, 0x59, 0x4d,
+		0xc3, 0xf3, 0xd9, 0xd0, 0xba, 0x04, 0x79, 0x97, 0xe8, 0x86, 0x63, 0x90, 0xe0, 0x45, 0x84, 0x43,
+		0xb0, 0x03, 0x56, 0xdc, 0x91, 0xe1, 0x6a, 0x70, 0x4b, 0xbb, 0xcf, 0x1d, 0x59, 0x28, 0x8c, 0xd5,
+		0x67, 0xbf, 0x92, 0x02, 0x08, 0x33, 0xe8, 0x78, 0xf2, 0x5a, 0xdd, 0xda, 0x5c, 0x51, 0x1b, 0xdb,
+		0x95, 0xed, 0x9d, 0x46, 0xfc, 0x6d, 0x24, 0x71, 0x4e, 0xeb, 0x39, 0x44, 0xc7, 0x4f, 0x54, 0x36,
+		0xe5, 0x27, 0x60, 0x36, 0xce, 0x8d, 0x25, 0xfc, 0x2a, 0xf5, 0x5c, 0xe1, 0xee, 0xbd, 0xc5, 0x1c,
+		0xdb, 0xd5, 0x13, 0xbc, 0xe5, 0xf6, 0x50, 0x2f, 0x1f, 0xbe, 0xc9, 0x94, 0x9e, 0x9b, 0xb8, 0x7b,
+		0x6f, 0x31, 0x1f, 0x6c, 0xff, 0xe5, 0x12, 0xc8, 0x51, 0x4e, 0x8e, 0x97, 0x99, 0x83, 0xbb, 0xf7,
+		0x16, 0x47, 0x99, 0x93, 0xcf, 0x65, 0xf1, 0x34, 0xb6, 0xfa, 0xca, 0xc0, 0x93, 0xd8, 0xe7, 0x23,
+		0xfe, 0x6d, 0x7c, 0xd8, 0xec, 0x60, 0xc0, 0x6b, 0x58, 0xfa, 0x79, 0x36, 0xd6, 0x0d, 0xff, 0xe0,
+		0x1c, 0x1f, 0xe7, 0xe7, 0x98, 0x4f, 0x9d, 0xbf, 0x23, 0xce, 0x59, 0xe3, 0x27, 0xb2, 0xff, 0x6f,
+		0x00, 0xfd, 0x23, 0xbc, 0xc4, 0xae, 0x6d, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2104,6 +2276,50 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
 		return false
 	}
+	if len(this.GlobalLiquidStakingCapSchedule) != len(that1.GlobalLiquidStakingCapSchedule) {
+		return false
+	}
+	for i := range this.GlobalLiquidStakingCapSchedule {
+		if !this.GlobalLiquidStakingCapSchedule[i].Equal(&that1.GlobalLiquidStakingCapSchedule[i]) {
+			return false
+		}
+	}
+	if !this.ProviderLiquidStakingCap.Equal(that1.ProviderLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorBondFirstLossFraction.Equal(that1.ValidatorBondFirstLossFraction) {
+		return false
+	}
+	if this.ValidatorBondGracePeriod != that1.ValidatorBondGracePeriod {
+		return false
+	}
+	return true
+}
+func (this *GlobalLiquidStakingCapScheduleEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GlobalLiquidStakingCapScheduleEntry)
+	if !ok {
+		that2, ok := that.(GlobalLiquidStakingCapScheduleEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.Cap.Equal(that1.Cap) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2191,6 +2407,9 @@ func (this *TokenizeShareRecord) Equal(that interface{}) bool {
 	if this.Validator != that1.Validator {
 		return false
 	}
+	if this.Compound != that1.Compound {
+		return false
+	}
 	return true
 }
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
@@ -3007,6 +3226,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ValidatorBondGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ValidatorBondGracePeriod):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.ValidatorBondFirstLossFraction.Size()
+		i -= size
+		if _, err := m.ValidatorBondFirstLossFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.ProviderLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ProviderLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.GlobalLiquidStakingCapSchedule) > 0 {
+		for iNdEx := len(m.GlobalLiquidStakingCapSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GlobalLiquidStakingCapSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size := m.ValidatorLiquidStakingCap.Size()
 		i -= size
@@ -3069,12 +3330,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err10 != nil {
-		return 0, err10
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalLiquidStakingCapScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalLiquidStakingCapScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalLiquidStakingCapScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3276,6 +3578,16 @@ func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Compound {
+		i--
+		if m.Compound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Validator) > 0 {
 		i -= len(m.Validator)
 		copy(dAtA[i:], m.Validator)
@@ -3337,6 +3649,44 @@ func (m *PendingTokenizeShareAuthorizations) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareLockAllowlistEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareLockAllowlistEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareLockAllowlistEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintStaking(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3682,6 +4032,31 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.ValidatorLiquidStakingCap.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if len(m.GlobalLiquidStakingCapSchedule) > 0 {
+		for _, e := range m.GlobalLiquidStakingCapSchedule {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	l = m.ProviderLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFirstLossFraction.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ValidatorBondGracePeriod)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *GlobalLiquidStakingCapScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Cap.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3762,6 +4137,9 @@ func (m *TokenizeShareRecord) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	if m.Compound {
+		n += 2
+	}
 	return n
 }
 
@@ -3780,6 +4158,21 @@ func (m *PendingTokenizeShareAuthorizations) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareLockAllowlistEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6443,11 +6836,263 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCapSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalLiquidStakingCapSchedule = append(m.GlobalLiquidStakingCapSchedule, GlobalLiquidStakingCapScheduleEntry{})
+			if err := m.GlobalLiquidStakingCapSchedule[len(m.GlobalLiquidStakingCapSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFirstLossFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFirstLossFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ValidatorBondGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalLiquidStakingCapScheduleEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalLiquidStakingCapScheduleEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalLiquidStakingCapScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthStaking
@@ -7076,6 +7721,26 @@ func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 			}
 			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -7179,6 +7844,121 @@ func (m *PendingTokenizeShareAuthorizations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareLockAllowlistEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareLockAllowlistEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareLockAllowlistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
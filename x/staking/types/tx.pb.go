@@ -720,6 +720,8 @@ type MsgTokenizeShares struct {
 	ValidatorAddress    string      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Amount              types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 	TokenizedShareOwner string      `protobuf:"bytes,4,opt,name=tokenized_share_owner,json=tokenizedShareOwner,proto3" json:"tokenized_share_owner,omitempty"`
+	// compound defines whether the record's rewards in the bond denom are delegated back to the validator
+	Compound bool `protobuf:"varint,5,opt,name=compound,proto3" json:"compound,omitempty"`
 }
 
 func (m *MsgTokenizeShares) Reset()         { *m = MsgTokenizeShares{} }
@@ -1112,6 +1114,172 @@ func (m *MsgEnableTokenizeSharesResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgAllowTokenizeSharesRecipient adds recipient_address to the delegator's tokenize
+// share lock allowlist. The entry only takes effect after the unbonding period.
+type MsgAllowTokenizeSharesRecipient struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+}
+
+func (m *MsgAllowTokenizeSharesRecipient) Reset()         { *m = MsgAllowTokenizeSharesRecipient{} }
+func (m *MsgAllowTokenizeSharesRecipient) String() string { return proto.CompactTextString(m) }
+func (*MsgAllowTokenizeSharesRecipient) ProtoMessage()    {}
+func (*MsgAllowTokenizeSharesRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc1f14f20335eae7, []int{24}
+}
+func (m *MsgAllowTokenizeSharesRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAllowTokenizeSharesRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAllowTokenizeSharesRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAllowTokenizeSharesRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAllowTokenizeSharesRecipient.Merge(m, src)
+}
+func (m *MsgAllowTokenizeSharesRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAllowTokenizeSharesRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAllowTokenizeSharesRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAllowTokenizeSharesRecipient proto.InternalMessageInfo
+
+type MsgAllowTokenizeSharesRecipientResponse struct {
+	ActivationTime time.Time `protobuf:"bytes,1,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
+}
+
+func (m *MsgAllowTokenizeSharesRecipientResponse) Reset() {
+	*m = MsgAllowTokenizeSharesRecipientResponse{}
+}
+func (m *MsgAllowTokenizeSharesRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAllowTokenizeSharesRecipientResponse) ProtoMessage()    {}
+func (*MsgAllowTokenizeSharesRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc1f14f20335eae7, []int{25}
+}
+func (m *MsgAllowTokenizeSharesRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAllowTokenizeSharesRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAllowTokenizeSharesRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAllowTokenizeSharesRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAllowTokenizeSharesRecipientResponse.Merge(m, src)
+}
+func (m *MsgAllowTokenizeSharesRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAllowTokenizeSharesRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAllowTokenizeSharesRecipientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAllowTokenizeSharesRecipientResponse proto.InternalMessageInfo
+
+func (m *MsgAllowTokenizeSharesRecipientResponse) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return time.Time{}
+}
+
+// MsgDisallowTokenizeSharesRecipient removes recipient_address from the delegator's
+// tokenize share lock allowlist. The removal takes effect immediately.
+type MsgDisallowTokenizeSharesRecipient struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+}
+
+func (m *MsgDisallowTokenizeSharesRecipient) Reset()         { *m = MsgDisallowTokenizeSharesRecipient{} }
+func (m *MsgDisallowTokenizeSharesRecipient) String() string { return proto.CompactTextString(m) }
+func (*MsgDisallowTokenizeSharesRecipient) ProtoMessage()    {}
+func (*MsgDisallowTokenizeSharesRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc1f14f20335eae7, []int{26}
+}
+func (m *MsgDisallowTokenizeSharesRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisallowTokenizeSharesRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisallowTokenizeSharesRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisallowTokenizeSharesRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisallowTokenizeSharesRecipient.Merge(m, src)
+}
+func (m *MsgDisallowTokenizeSharesRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisallowTokenizeSharesRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisallowTokenizeSharesRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisallowTokenizeSharesRecipient proto.InternalMessageInfo
+
+type MsgDisallowTokenizeSharesRecipientResponse struct {
+}
+
+func (m *MsgDisallowTokenizeSharesRecipientResponse) Reset() {
+	*m = MsgDisallowTokenizeSharesRecipientResponse{}
+}
+func (m *MsgDisallowTokenizeSharesRecipientResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgDisallowTokenizeSharesRecipientResponse) ProtoMessage() {}
+func (*MsgDisallowTokenizeSharesRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc1f14f20335eae7, []int{27}
+}
+func (m *MsgDisallowTokenizeSharesRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisallowTokenizeSharesRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisallowTokenizeSharesRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisallowTokenizeSharesRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisallowTokenizeSharesRecipientResponse.Merge(m, src)
+}
+func (m *MsgDisallowTokenizeSharesRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisallowTokenizeSharesRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisallowTokenizeSharesRecipientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisallowTokenizeSharesRecipientResponse proto.InternalMessageInfo
+
 // MsgValidatorBond defines a SDK message for performing validator self-bond of delegated coins
 // from a delegator to a validator.
 type MsgValidatorBond struct {
@@ -1123,7 +1291,7 @@ func (m *MsgValidatorBond) Reset()         { *m = MsgValidatorBond{} }
 func (m *MsgValidatorBond) String() string { return proto.CompactTextString(m) }
 func (*MsgValidatorBond) ProtoMessage()    {}
 func (*MsgValidatorBond) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc1f14f20335eae7, []int{24}
+	return fileDescriptor_dc1f14f20335eae7, []int{28}
 }
 func (m *MsgValidatorBond) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1327,7 @@ func (m *MsgValidatorBondResponse) Reset()         { *m = MsgValidatorBondRespon
 func (m *MsgValidatorBondResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValidatorBondResponse) ProtoMessage()    {}
 func (*MsgValidatorBondResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc1f14f20335eae7, []int{25}
+	return fileDescriptor_dc1f14f20335eae7, []int{29}
 }
 func (m *MsgValidatorBondResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1213,6 +1381,10 @@ func init() {
 	proto.RegisterType((*MsgDisableTokenizeSharesResponse)(nil), "liquidstaking.staking.v1beta1.MsgDisableTokenizeSharesResponse")
 	proto.RegisterType((*MsgEnableTokenizeShares)(nil), "liquidstaking.staking.v1beta1.MsgEnableTokenizeShares")
 	proto.RegisterType((*MsgEnableTokenizeSharesResponse)(nil), "liquidstaking.staking.v1beta1.MsgEnableTokenizeSharesResponse")
+	proto.RegisterType((*MsgAllowTokenizeSharesRecipient)(nil), "liquidstaking.staking.v1beta1.MsgAllowTokenizeSharesRecipient")
+	proto.RegisterType((*MsgAllowTokenizeSharesRecipientResponse)(nil), "liquidstaking.staking.v1beta1.MsgAllowTokenizeSharesRecipientResponse")
+	proto.RegisterType((*MsgDisallowTokenizeSharesRecipient)(nil), "liquidstaking.staking.v1beta1.MsgDisallowTokenizeSharesRecipient")
+	proto.RegisterType((*MsgDisallowTokenizeSharesRecipientResponse)(nil), "liquidstaking.staking.v1beta1.MsgDisallowTokenizeSharesRecipientResponse")
 	proto.RegisterType((*MsgValidatorBond)(nil), "liquidstaking.staking.v1beta1.MsgValidatorBond")
 	proto.RegisterType((*MsgValidatorBondResponse)(nil), "liquidstaking.staking.v1beta1.MsgValidatorBondResponse")
 }
@@ -1220,93 +1392,100 @@ func init() {
 func init() { proto.RegisterFile("staking/v1beta1/tx.proto", fileDescriptor_dc1f14f20335eae7) }
 
 var fileDescriptor_dc1f14f20335eae7 = []byte{
-	// 1363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x3a, 0x6e, 0xbe, 0xe9, 0xf4, 0xdb, 0xa4, 0xdd, 0x24, 0xd4, 0xd9, 0xb6, 0x76, 0x65,
-	0xa1, 0x52, 0x55, 0x64, 0x4d, 0x4a, 0xa1, 0x25, 0xfc, 0xa8, 0xea, 0xba, 0x88, 0x8a, 0x5a, 0xa0,
-	0x4d, 0x8a, 0x04, 0x1c, 0xac, 0xf5, 0xee, 0x78, 0x33, 0x64, 0x77, 0xc6, 0xdd, 0x59, 0xb7, 0x35,
-	0x42, 0x2a, 0x70, 0xea, 0x09, 0x95, 0x1b, 0x42, 0x42, 0xaa, 0x04, 0xa7, 0x9e, 0x10, 0xea, 0x1f,
-	0x51, 0x21, 0x0e, 0x51, 0x4f, 0x88, 0x43, 0x40, 0xc9, 0x01, 0x6e, 0xa0, 0xfe, 0x05, 0x68, 0x67,
-	0x67, 0xc7, 0xeb, 0xf5, 0xda, 0x5e, 0x37, 0x89, 0xf8, 0x75, 0xb2, 0x77, 0xe7, 0x7d, 0x3e, 0xf3,
-	0xe6, 0xf3, 0xde, 0xbc, 0x37, 0xb3, 0x20, 0x4f, 0x3d, 0x7d, 0x1d, 0x61, 0xab, 0x7c, 0x63, 0xa9,
-	0x01, 0x3d, 0x7d, 0xa9, 0xec, 0xdd, 0x52, 0x5b, 0x2e, 0xf1, 0x88, 0x7c, 0xdc, 0x46, 0xd7, 0xdb,
-	0xc8, 0xe4, 0xe3, 0x6a, 0xf8, 0xcb, 0xed, 0x94, 0x05, 0x8b, 0x10, 0xcb, 0x86, 0x65, 0x66, 0xdc,
-	0x68, 0x37, 0xcb, 0x3a, 0xee, 0x04, 0x48, 0xa5, 0x18, 0x1f, 0xf2, 0x90, 0x03, 0xa9, 0xa7, 0x3b,
-	0x2d, 0x6e, 0x30, 0x67, 0x11, 0x8b, 0xb0, 0xbf, 0x65, 0xff, 0x1f, 0x7f, 0xbb, 0x60, 0x10, 0xea,
-	0x10, 0x5a, 0x0f, 0x06, 0x82, 0x07, 0x3e, 0x54, 0x08, 0x9e, 0xca, 0x0d, 0x9d, 0x42, 0xe1, 0xa9,
-	0x41, 0x10, 0xe6, 0xe3, 0xc7, 0xe3, 0xab, 0x08, 0xbd, 0x0d, 0x86, 0x8f, 0x70, 0xb8, 0x43, 0x7d,
-	0x0b, 0xff, 0x27, 0x18, 0x28, 0xfd, 0x9e, 0x03, 0x72, 0x8d, 0x5a, 0x97, 0x5c, 0xa8, 0x7b, 0xf0,
-	0x1d, 0xdd, 0x46, 0xa6, 0xee, 0x11, 0x57, 0xd6, 0xc0, 0x01, 0x13, 0x52, 0xc3, 0x45, 0x2d, 0x0f,
-	0x11, 0x9c, 0x97, 0x4e, 0x48, 0xa7, 0x0e, 0x9c, 0x39, 0xad, 0x0e, 0x15, 0x44, 0xad, 0x76, 0x11,
-	0x95, 0xdc, 0xc3, 0xcd, 0x62, 0x46, 0x8b, 0x92, 0xc8, 0xab, 0x00, 0x18, 0xc4, 0x71, 0x10, 0xa5,
-	0x3e, 0x65, 0x96, 0x51, 0xaa, 0x23, 0x28, 0x2f, 0x09, 0x80, 0xa6, 0x7b, 0x90, 0x72, 0xda, 0x08,
-	0x8f, 0x6c, 0x83, 0x59, 0x07, 0xe1, 0x3a, 0x85, 0x76, 0xb3, 0x6e, 0x42, 0x1b, 0x5a, 0x3a, 0xf3,
-	0x78, 0xe2, 0x84, 0x74, 0x6a, 0x7f, 0xe5, 0x15, 0xdf, 0xfc, 0xa7, 0xcd, 0xe2, 0x49, 0x0b, 0x79,
-	0x6b, 0xed, 0x86, 0x6a, 0x10, 0x87, 0xcb, 0xca, 0x7f, 0x16, 0xa9, 0xb9, 0x5e, 0xf6, 0x3a, 0x2d,
-	0x48, 0xd5, 0x2b, 0xd8, 0x7b, 0xf4, 0x60, 0x11, 0x70, 0xd5, 0xaf, 0x60, 0x4f, 0x3b, 0xec, 0x20,
-	0xbc, 0x02, 0xed, 0x66, 0x55, 0xd0, 0xca, 0x97, 0xc1, 0x61, 0x3e, 0x09, 0x71, 0xeb, 0xba, 0x69,
-	0xba, 0x90, 0xd2, 0x7c, 0x8e, 0xcd, 0x95, 0x7f, 0xf4, 0x60, 0x71, 0x8e, 0xa3, 0x2f, 0x06, 0x23,
-	0x2b, 0x9e, 0x8b, 0xb0, 0xa5, 0x1d, 0x12, 0x10, 0xfe, 0xde, 0xa7, 0xb9, 0x11, 0x6a, 0x2d, 0x68,
-	0xf6, 0x8d, 0xa2, 0x11, 0x90, 0x90, 0xe6, 0x75, 0x30, 0xd9, 0x6a, 0x37, 0xd6, 0x61, 0x27, 0x3f,
-	0xc9, 0xd4, 0x9c, 0x53, 0x83, 0xbc, 0x53, 0xc3, 0xbc, 0x53, 0x2f, 0xe2, 0x4e, 0x25, 0xff, 0x7d,
-	0x97, 0xd1, 0x70, 0x3b, 0x2d, 0x8f, 0xa8, 0x6f, 0xb7, 0x1b, 0x6f, 0xc2, 0x8e, 0xc6, 0xd1, 0xf2,
-	0x0b, 0x60, 0xdf, 0x0d, 0xdd, 0x6e, 0xc3, 0xfc, 0xff, 0x18, 0xcd, 0x82, 0xca, 0xad, 0xfd, 0x64,
-	0x8b, 0x84, 0x02, 0x85, 0x61, 0x0d, 0xac, 0x97, 0xcf, 0xde, 0xb9, 0x57, 0xcc, 0xfc, 0x76, 0xaf,
-	0x98, 0xf9, 0xf4, 0xd7, 0x6f, 0x4f, 0xf7, 0xeb, 0xc2, 0xde, 0xf6, 0x2d, 0xb3, 0x74, 0x0c, 0x28,
-	0xfd, 0x09, 0xa7, 0x41, 0xda, 0x22, 0x98, 0xc2, 0xd2, 0x97, 0x13, 0xe0, 0x50, 0x8d, 0x5a, 0x97,
-	0x4d, 0xe4, 0xed, 0x6d, 0x36, 0x26, 0x86, 0x20, 0x3b, 0x76, 0x08, 0x74, 0x30, 0xd3, 0x4d, 0xc6,
-	0xba, 0xab, 0x7b, 0x90, 0xa7, 0xde, 0xf9, 0x94, 0x69, 0x57, 0x85, 0x46, 0x24, 0xed, 0xaa, 0xd0,
-	0xd0, 0xa6, 0x8d, 0x9e, 0xa4, 0x97, 0xd7, 0x92, 0x33, 0x3c, 0x37, 0xd6, 0x34, 0x69, 0xb2, 0x7b,
-	0xb9, 0xd0, 0x13, 0xd0, 0xfe, 0xd0, 0x29, 0x20, 0x1f, 0x8f, 0x8d, 0x08, 0xdc, 0x1f, 0x12, 0x38,
-	0x50, 0xa3, 0x16, 0x67, 0x83, 0xc9, 0x3b, 0x45, 0xda, 0x9d, 0x9d, 0x32, 0x7e, 0x98, 0xce, 0x81,
-	0x49, 0xdd, 0x21, 0x6d, 0xec, 0xb1, 0xe8, 0xa4, 0x48, 0x71, 0x6e, 0xbe, 0xac, 0x0c, 0xce, 0xef,
-	0xd2, 0x3c, 0x98, 0x8d, 0xac, 0x58, 0x28, 0xf1, 0x43, 0x96, 0x95, 0xd4, 0x0a, 0xb4, 0x10, 0xd6,
-	0xa0, 0xb9, 0xcb, 0x82, 0x5c, 0x05, 0xf3, 0x5d, 0x41, 0xa8, 0x6b, 0xa4, 0x16, 0x65, 0x56, 0xc0,
-	0x56, 0x5c, 0x23, 0x91, 0xcd, 0xa4, 0x9e, 0x60, 0x9b, 0x48, 0xcd, 0x56, 0xa5, 0x5e, 0xbf, 0xca,
-	0xb9, 0xdd, 0x53, 0x79, 0x9d, 0xd5, 0x8b, 0x98, 0x9a, 0xa1, 0xd8, 0x72, 0x8d, 0xed, 0xbf, 0x96,
-	0x0d, 0xfd, 0x04, 0xae, 0xfb, 0x6d, 0x96, 0x97, 0x07, 0xa5, 0xaf, 0x16, 0xae, 0x86, 0x3d, 0xb8,
-	0x32, 0xe5, 0x4f, 0x7e, 0xf7, 0xe7, 0xa2, 0xc4, 0xf6, 0x1a, 0x07, 0xfb, 0xc3, 0xa5, 0xc7, 0x12,
-	0x38, 0x58, 0xa3, 0xd6, 0x35, 0x6c, 0xfe, 0x87, 0xf2, 0xb8, 0x09, 0xe6, 0x7b, 0xd6, 0xbc, 0x57,
-	0xe2, 0x5e, 0x63, 0xfb, 0xe2, 0x1a, 0x6e, 0x10, 0x6c, 0x76, 0x8b, 0xfb, 0x85, 0x24, 0x65, 0x02,
-	0x81, 0xe5, 0xc7, 0x9b, 0xc5, 0xe9, 0x8e, 0xee, 0xd8, 0xcb, 0xa5, 0xd0, 0xd7, 0x7e, 0x4d, 0x78,
-	0x43, 0x89, 0xd1, 0x8a, 0xdd, 0x78, 0x3f, 0x0b, 0x8e, 0xf9, 0xfd, 0x46, 0xc7, 0x06, 0xb4, 0x03,
-	0x23, 0x84, 0xad, 0x51, 0x2d, 0xfd, 0x1f, 0x17, 0x60, 0xf9, 0x19, 0x30, 0x63, 0xf8, 0x3d, 0xd5,
-	0x8f, 0xd4, 0x1a, 0x44, 0xd6, 0x5a, 0xb0, 0x09, 0x27, 0xb4, 0xe9, 0xf0, 0xf5, 0x1b, 0xec, 0xed,
-	0xd0, 0x4c, 0x38, 0x09, 0x9e, 0x1e, 0xa6, 0x95, 0x10, 0xf5, 0xbb, 0x2c, 0x38, 0x5c, 0xa3, 0xd6,
-	0x2a, 0x59, 0x87, 0x18, 0x7d, 0x08, 0x57, 0xd6, 0x74, 0x17, 0xd2, 0x7f, 0x8b, 0x92, 0x57, 0xc1,
-	0xbc, 0xc7, 0x17, 0x66, 0xd6, 0xa9, 0xbf, 0xb4, 0x3a, 0xb9, 0x89, 0xa1, 0x3b, 0xf2, 0x9c, 0x37,
-	0x2b, 0x60, 0x4c, 0x90, 0xb7, 0x7c, 0xd0, 0xf2, 0x54, 0xd8, 0x53, 0x4b, 0xab, 0x60, 0xa1, 0x4f,
-	0x33, 0xb1, 0xd5, 0xba, 0xde, 0x4a, 0x63, 0x79, 0x5b, 0xfa, 0x46, 0x62, 0x4d, 0xd9, 0x2f, 0x8d,
-	0xd0, 0x61, 0xe4, 0xb4, 0x49, 0xdc, 0xdd, 0x8d, 0x48, 0xd7, 0xb9, 0xec, 0x78, 0x55, 0xa7, 0xbb,
-	0xf8, 0xf7, 0xc1, 0x89, 0x41, 0x5e, 0xee, 0x5c, 0x83, 0x2f, 0x24, 0x50, 0xf0, 0xa5, 0x75, 0x75,
-	0x4c, 0x9b, 0xd0, 0xed, 0x91, 0x58, 0x83, 0x06, 0x71, 0x4d, 0xf9, 0x1c, 0xc8, 0x87, 0xd1, 0xe1,
-	0x31, 0x75, 0xd9, 0x40, 0x1d, 0x99, 0x6c, 0xb6, 0x9c, 0x26, 0x82, 0x1e, 0x81, 0x5d, 0x31, 0xe5,
-	0xa7, 0xc0, 0x24, 0x85, 0xd8, 0x84, 0x6e, 0x90, 0x82, 0x1a, 0x7f, 0x92, 0x8f, 0x82, 0xfd, 0x18,
-	0xde, 0xe4, 0x99, 0xc1, 0xba, 0xa5, 0x36, 0x85, 0xe1, 0xcd, 0x78, 0xd0, 0x4f, 0x81, 0x93, 0xc3,
-	0x3d, 0x13, 0x7b, 0xea, 0x93, 0x20, 0x90, 0x55, 0x44, 0xf5, 0x86, 0x0d, 0xf7, 0x64, 0x6b, 0xc5,
-	0x0e, 0x78, 0xfd, 0xfb, 0xbf, 0xc4, 0xa2, 0x94, 0xe8, 0x82, 0xf0, 0xf3, 0x63, 0x09, 0x1c, 0xf1,
-	0x4f, 0x81, 0xf8, 0xaf, 0x73, 0xb3, 0x05, 0x8a, 0x03, 0x3c, 0xd8, 0xab, 0xd6, 0x75, 0x5f, 0x62,
-	0xd7, 0x12, 0xd1, 0x5e, 0x2a, 0x04, 0x9b, 0x7f, 0xaf, 0x7a, 0x17, 0xc9, 0xb9, 0xe0, 0x98, 0xde,
-	0xe3, 0x6b, 0xa8, 0xcb, 0x99, 0x8d, 0x83, 0x60, 0xa2, 0x46, 0x2d, 0xf9, 0x36, 0x98, 0x89, 0xdf,
-	0xf9, 0x97, 0x46, 0x5c, 0xa8, 0xfa, 0x6f, 0x6d, 0xca, 0x4b, 0x63, 0x43, 0x44, 0x80, 0x3a, 0xe0,
-	0x60, 0xef, 0x25, 0xaf, 0x3c, 0x9a, 0xab, 0x07, 0xa0, 0x9c, 0x1b, 0x13, 0x20, 0xa6, 0xfe, 0x00,
-	0x4c, 0x89, 0x6b, 0xca, 0xe9, 0xd1, 0x24, 0xa1, 0xad, 0x72, 0x26, 0xbd, 0xad, 0x98, 0xeb, 0x36,
-	0x98, 0x89, 0x5f, 0x04, 0x52, 0xe8, 0x1c, 0x83, 0xa4, 0xd1, 0x79, 0xd0, 0x01, 0xb9, 0x05, 0x40,
-	0xe4, 0x34, 0xfb, 0xec, 0x68, 0xa2, 0xae, 0xb5, 0x72, 0x76, 0x1c, 0xeb, 0xe8, 0x92, 0xe3, 0x67,
-	0xbc, 0xa5, 0x34, 0x44, 0x3d, 0x90, 0x34, 0x4b, 0x1e, 0x70, 0xe4, 0x93, 0xbf, 0x92, 0xc0, 0xc2,
-	0xe0, 0xf3, 0xde, 0xcb, 0x29, 0x72, 0x76, 0x10, 0x58, 0xb9, 0xb4, 0x03, 0xb0, 0xf0, 0xef, 0x23,
-	0x30, 0x1d, 0xab, 0x9b, 0xcf, 0x8d, 0xa6, 0xed, 0x45, 0x28, 0xe7, 0xc7, 0x45, 0x88, 0xd9, 0xef,
-	0x48, 0xe0, 0xff, 0xd1, 0x3e, 0x2c, 0xa7, 0xd8, 0x47, 0x89, 0x7d, 0x5b, 0xb9, 0xf0, 0x84, 0x40,
-	0xe1, 0xca, 0xd7, 0x12, 0x38, 0x3a, 0xac, 0x69, 0xbf, 0x9a, 0x62, 0x91, 0x83, 0xe1, 0xca, 0xe5,
-	0x1d, 0xc1, 0x85, 0x97, 0x9f, 0x4b, 0x60, 0x3e, 0xb9, 0x2b, 0xa7, 0x50, 0x2e, 0x11, 0x98, 0x46,
-	0xb9, 0xa1, 0x4d, 0x58, 0xfe, 0x4c, 0x02, 0x73, 0x89, 0x1d, 0xf8, 0xc5, 0x14, 0x45, 0x31, 0x01,
-	0xa7, 0xbc, 0xf6, 0x64, 0xb8, 0x68, 0x39, 0xef, 0x6d, 0x8e, 0x29, 0xca, 0x79, 0x0f, 0x20, 0x4d,
-	0x39, 0x4f, 0x6c, 0x69, 0x95, 0x77, 0x1f, 0x6e, 0x15, 0xa4, 0x8d, 0xad, 0x82, 0xf4, 0xcb, 0x56,
-	0x41, 0xba, 0xbb, 0x5d, 0xc8, 0x6c, 0x6c, 0x17, 0x32, 0x3f, 0x6e, 0x17, 0x32, 0xef, 0x5d, 0x88,
-	0x7c, 0x18, 0x43, 0xd7, 0xed, 0x36, 0x45, 0x04, 0x23, 0x6c, 0x94, 0x83, 0x89, 0x90, 0xd7, 0x59,
-	0xe4, 0x93, 0x2c, 0x3a, 0xc4, 0x6c, 0xdb, 0xb0, 0x7c, 0x2b, 0xfc, 0x6c, 0x1e, 0x7c, 0x35, 0x6b,
-	0x4c, 0xb2, 0x43, 0xc2, 0xf3, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xdd, 0xf8, 0xdc, 0xc2, 0x24,
-	0x18, 0x00, 0x00,
+	// 1473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0x93, 0x34, 0xdf, 0xf4, 0xf5, 0xdb, 0xa4, 0x75, 0x12, 0xba, 0x71, 0xdb, 0xdd, 0x68,
+	0x85, 0xda, 0x2a, 0x6a, 0x76, 0x49, 0x29, 0xa4, 0x0d, 0x3f, 0xa2, 0x6c, 0xd3, 0x8a, 0x88, 0xae,
+	0x40, 0x4e, 0x8a, 0x04, 0x1c, 0x56, 0x5e, 0x7b, 0xe2, 0x0c, 0xf1, 0xce, 0x6c, 0x3d, 0xb3, 0x49,
+	0x17, 0x21, 0x15, 0x38, 0xf5, 0x84, 0xca, 0x0d, 0x21, 0x21, 0x55, 0x02, 0x71, 0x80, 0x03, 0x1c,
+	0xfa, 0x47, 0x54, 0x08, 0x41, 0xd5, 0x13, 0xe2, 0x50, 0x50, 0x73, 0x80, 0x1b, 0xa8, 0x7f, 0x01,
+	0xb2, 0x3d, 0x9e, 0xf5, 0xfe, 0xf6, 0xe6, 0x87, 0x80, 0x72, 0xda, 0xd8, 0xf3, 0x3e, 0xef, 0xc7,
+	0xe7, 0xbd, 0x99, 0xf7, 0xc6, 0x81, 0x04, 0xe3, 0xc6, 0x06, 0x26, 0x76, 0x76, 0x73, 0xb6, 0x88,
+	0xb8, 0x31, 0x9b, 0xe5, 0x37, 0x32, 0x65, 0x97, 0x72, 0xaa, 0x9e, 0x74, 0xf0, 0xf5, 0x0a, 0xb6,
+	0xc4, 0x7a, 0x26, 0xfc, 0x15, 0x72, 0xda, 0xa4, 0x4d, 0xa9, 0xed, 0xa0, 0xac, 0x2f, 0x5c, 0xac,
+	0xac, 0x65, 0x0d, 0x52, 0x0d, 0x90, 0x5a, 0xaa, 0x71, 0x89, 0xe3, 0x12, 0x62, 0xdc, 0x28, 0x95,
+	0x85, 0xc0, 0xb8, 0x4d, 0x6d, 0xea, 0xff, 0x99, 0xf5, 0xfe, 0x12, 0x6f, 0x27, 0x4d, 0xca, 0x4a,
+	0x94, 0x15, 0x82, 0x85, 0xe0, 0x41, 0x2c, 0x25, 0x83, 0xa7, 0x6c, 0xd1, 0x60, 0x48, 0x7a, 0x6a,
+	0x52, 0x4c, 0xc4, 0xfa, 0xc9, 0xc6, 0x28, 0x42, 0x6f, 0x83, 0xe5, 0x63, 0x02, 0x5e, 0x62, 0x9e,
+	0x84, 0xf7, 0x13, 0x2c, 0xa4, 0xff, 0x18, 0x04, 0x35, 0xcf, 0xec, 0x4b, 0x2e, 0x32, 0x38, 0x7a,
+	0xc3, 0x70, 0xb0, 0x65, 0x70, 0xea, 0xaa, 0x3a, 0x1c, 0xb2, 0x10, 0x33, 0x5d, 0x5c, 0xe6, 0x98,
+	0x92, 0x84, 0x32, 0xa5, 0x9c, 0x39, 0x74, 0x6e, 0x3a, 0xd3, 0x91, 0x90, 0xcc, 0x52, 0x0d, 0x91,
+	0x1b, 0xbc, 0xf7, 0x30, 0xd5, 0xa7, 0x47, 0x95, 0xa8, 0xab, 0x00, 0x26, 0x2d, 0x95, 0x30, 0x63,
+	0x9e, 0xca, 0x7e, 0x5f, 0x65, 0xa6, 0x8b, 0xca, 0x4b, 0x12, 0xa0, 0x1b, 0x1c, 0x31, 0xa1, 0x36,
+	0xa2, 0x47, 0x75, 0x60, 0xac, 0x84, 0x49, 0x81, 0x21, 0x67, 0xad, 0x60, 0x21, 0x07, 0xd9, 0x86,
+	0xef, 0xf1, 0xc0, 0x94, 0x72, 0xe6, 0x60, 0xee, 0x45, 0x4f, 0xfc, 0xe7, 0x87, 0xa9, 0x53, 0x36,
+	0xe6, 0xeb, 0x95, 0x62, 0xc6, 0xa4, 0x25, 0x41, 0xab, 0xf8, 0x99, 0x61, 0xd6, 0x46, 0x96, 0x57,
+	0xcb, 0x88, 0x65, 0x96, 0x09, 0x7f, 0x70, 0x77, 0x06, 0x04, 0xeb, 0xcb, 0x84, 0xeb, 0x47, 0x4b,
+	0x98, 0xac, 0x20, 0x67, 0x6d, 0x49, 0xaa, 0x55, 0x2f, 0xc3, 0x51, 0x61, 0x84, 0xba, 0x05, 0xc3,
+	0xb2, 0x5c, 0xc4, 0x58, 0x62, 0xd0, 0xb7, 0x95, 0x78, 0x70, 0x77, 0x66, 0x5c, 0xa0, 0x17, 0x83,
+	0x95, 0x15, 0xee, 0x62, 0x62, 0xeb, 0x47, 0x24, 0x44, 0xbc, 0xf7, 0xd4, 0x6c, 0x86, 0x5c, 0x4b,
+	0x35, 0x07, 0xba, 0xa9, 0x91, 0x90, 0x50, 0xcd, 0x15, 0x18, 0x2a, 0x57, 0x8a, 0x1b, 0xa8, 0x9a,
+	0x18, 0xf2, 0xd9, 0x1c, 0xcf, 0x04, 0x75, 0x97, 0x09, 0xeb, 0x2e, 0xb3, 0x48, 0xaa, 0xb9, 0xc4,
+	0x77, 0x35, 0x8d, 0xa6, 0x5b, 0x2d, 0x73, 0x9a, 0x79, 0xbd, 0x52, 0x7c, 0x15, 0x55, 0x75, 0x81,
+	0x56, 0x9f, 0x83, 0x03, 0x9b, 0x86, 0x53, 0x41, 0x89, 0xff, 0xf9, 0x6a, 0x26, 0x33, 0x42, 0xda,
+	0x2b, 0xb6, 0x48, 0x2a, 0x70, 0x98, 0xd6, 0x40, 0x7a, 0xfe, 0xfc, 0xad, 0x3b, 0xa9, 0xbe, 0xdf,
+	0xef, 0xa4, 0xfa, 0x3e, 0xfc, 0xed, 0xdb, 0xe9, 0x66, 0x5e, 0xfc, 0xb7, 0x4d, 0x61, 0xa6, 0x4f,
+	0x80, 0xd6, 0x5c, 0x70, 0x3a, 0x62, 0x65, 0x4a, 0x18, 0x4a, 0x7f, 0x3a, 0x00, 0x47, 0xf2, 0xcc,
+	0xbe, 0x6c, 0x61, 0xbe, 0xbf, 0xd5, 0xd8, 0x32, 0x05, 0xfd, 0x3d, 0xa7, 0xc0, 0x80, 0xd1, 0x5a,
+	0x31, 0x16, 0x5c, 0x83, 0x23, 0x51, 0x7a, 0x17, 0x62, 0x96, 0xdd, 0x12, 0x32, 0x23, 0x65, 0xb7,
+	0x84, 0x4c, 0x7d, 0xc4, 0xac, 0x2b, 0x7a, 0x75, 0xbd, 0x75, 0x85, 0x0f, 0xf6, 0x64, 0x26, 0x4e,
+	0x75, 0xcf, 0x27, 0xeb, 0x12, 0xda, 0x9c, 0x3a, 0x0d, 0x12, 0x8d, 0xb9, 0x91, 0x89, 0xfb, 0x53,
+	0x81, 0x43, 0x79, 0x66, 0x0b, 0x6d, 0xa8, 0xf5, 0x4e, 0x51, 0xf6, 0x66, 0xa7, 0xf4, 0x9e, 0xa6,
+	0x39, 0x18, 0x32, 0x4a, 0xb4, 0x42, 0x78, 0x62, 0x20, 0x5e, 0x89, 0x0b, 0xf1, 0x79, 0xad, 0x7d,
+	0x7d, 0xa7, 0x27, 0x60, 0x2c, 0x12, 0xb1, 0x64, 0xe2, 0xfb, 0x7e, 0xff, 0x48, 0xcd, 0x21, 0x1b,
+	0x13, 0x1d, 0x59, 0x7b, 0x4c, 0xc8, 0x55, 0x98, 0xa8, 0x11, 0xc2, 0x5c, 0x33, 0x36, 0x29, 0x63,
+	0x12, 0xb6, 0xe2, 0x9a, 0x2d, 0xb5, 0x59, 0x8c, 0x4b, 0x6d, 0x03, 0xb1, 0xb5, 0x2d, 0x31, 0xde,
+	0xcc, 0xf2, 0xe0, 0xde, 0xb1, 0xbc, 0x01, 0x5a, 0x33, 0x9b, 0x21, 0xd9, 0x6a, 0xde, 0xdf, 0x7f,
+	0x65, 0x07, 0x79, 0x05, 0x5c, 0xf0, 0xda, 0xac, 0x38, 0x1e, 0xb4, 0xa6, 0xb3, 0x70, 0x35, 0xec,
+	0xc1, 0xb9, 0x61, 0xcf, 0xf8, 0xed, 0x5f, 0x52, 0x8a, 0x3e, 0x52, 0x03, 0x7b, 0xcb, 0xe9, 0xc7,
+	0x0a, 0x1c, 0xce, 0x33, 0xfb, 0x1a, 0xb1, 0xfe, 0x43, 0x75, 0xbc, 0x06, 0x13, 0x75, 0x31, 0xef,
+	0x17, 0xb9, 0xd7, 0xfc, 0x7d, 0x71, 0x8d, 0x14, 0x29, 0xb1, 0x6a, 0x87, 0xfb, 0x42, 0x2b, 0x66,
+	0x02, 0x82, 0xd5, 0xc7, 0x0f, 0x53, 0x23, 0x55, 0xa3, 0xe4, 0xcc, 0xa7, 0x43, 0x5f, 0x9b, 0x39,
+	0x11, 0x0d, 0xa5, 0x41, 0xad, 0xdc, 0x8d, 0x5f, 0xf5, 0xc3, 0x09, 0xaf, 0xdf, 0x18, 0xc4, 0x44,
+	0x4e, 0x20, 0x84, 0x89, 0xdd, 0xad, 0xa5, 0xff, 0xeb, 0x12, 0xac, 0x9e, 0x86, 0x51, 0xd3, 0xeb,
+	0xa9, 0x5e, 0xa6, 0xd6, 0x11, 0xb6, 0xd7, 0x83, 0x4d, 0x38, 0xa0, 0x8f, 0x84, 0xaf, 0x5f, 0xf1,
+	0xdf, 0x76, 0xac, 0x84, 0x53, 0xf0, 0x74, 0x27, 0xae, 0x24, 0xa9, 0x3f, 0xf4, 0xc3, 0xd1, 0x3c,
+	0xb3, 0x57, 0xe9, 0x06, 0x22, 0xf8, 0x5d, 0xb4, 0xb2, 0x6e, 0xb8, 0x88, 0x3d, 0x29, 0x4c, 0x5e,
+	0x85, 0x09, 0x2e, 0x02, 0xb3, 0x0a, 0xcc, 0x0b, 0xad, 0x40, 0xb7, 0x08, 0x72, 0xbb, 0xce, 0x79,
+	0x63, 0x12, 0xe6, 0x13, 0xf2, 0x9a, 0x07, 0x52, 0x35, 0x18, 0xf6, 0xb6, 0x01, 0xad, 0x10, 0xcb,
+	0x9f, 0xf0, 0x86, 0x75, 0xf9, 0x3c, 0x3f, 0x1c, 0xf6, 0xdb, 0xf4, 0x2a, 0x4c, 0x36, 0xf1, 0x29,
+	0xb7, 0x61, 0x2d, 0x12, 0xa5, 0xa7, 0x48, 0xd2, 0x5f, 0x28, 0x7e, 0xc3, 0xf6, 0x8e, 0x4d, 0x54,
+	0xf2, 0x95, 0xb3, 0x35, 0xea, 0xee, 0x6d, 0xb6, 0x6a, 0xce, 0xf5, 0xf7, 0x76, 0x22, 0xd5, 0x82,
+	0x7f, 0x1b, 0xa6, 0xda, 0x79, 0xb9, 0x7b, 0x0e, 0x3e, 0x51, 0x20, 0xe9, 0x51, 0xeb, 0x1a, 0x84,
+	0xad, 0x21, 0xb7, 0x8e, 0x62, 0x1d, 0x99, 0xd4, 0xb5, 0xd4, 0x39, 0x48, 0x84, 0x99, 0x13, 0xf9,
+	0x76, 0xfd, 0x85, 0x02, 0xb6, 0x7c, 0x6b, 0x83, 0xfa, 0x04, 0x6f, 0x86, 0x2d, 0x5b, 0xea, 0x53,
+	0x30, 0xc4, 0x10, 0xb1, 0x90, 0x1b, 0x94, 0xa7, 0x2e, 0x9e, 0xd4, 0xe3, 0x70, 0x90, 0xa0, 0x2d,
+	0x51, 0x35, 0x7e, 0x27, 0xd5, 0x87, 0x09, 0xda, 0xf2, 0x0b, 0x22, 0x12, 0xf7, 0x19, 0x38, 0xd5,
+	0xd9, 0x33, 0xb9, 0xdf, 0x3e, 0x08, 0x12, 0xb9, 0x84, 0x99, 0x51, 0x74, 0xd0, 0xbe, 0x6c, 0xbb,
+	0x86, 0xe1, 0xaf, 0xf9, 0x6c, 0x48, 0xc3, 0x54, 0x3b, 0x17, 0xa4, 0x9f, 0xef, 0x2b, 0x70, 0xcc,
+	0x9b, 0x10, 0xc9, 0xdf, 0xe7, 0x66, 0x19, 0x52, 0x6d, 0x3c, 0xd8, 0xaf, 0xb6, 0xf6, 0xa3, 0xe2,
+	0x9b, 0x5c, 0x74, 0x1c, 0xba, 0xd5, 0x68, 0xd1, 0xc4, 0x65, 0x8c, 0x08, 0xdf, 0xc3, 0xa3, 0xd1,
+	0x0d, 0x75, 0xc6, 0x3f, 0x1a, 0x25, 0x24, 0x2e, 0x87, 0x37, 0xe0, 0x74, 0x97, 0x80, 0xa2, 0x5c,
+	0x1a, 0x26, 0xc7, 0x9b, 0xc6, 0x0e, 0xb9, 0xac, 0x81, 0x7d, 0x2e, 0x1f, 0x28, 0x90, 0x16, 0x55,
+	0xf6, 0xe4, 0xd0, 0x79, 0x16, 0xa6, 0xbb, 0xc7, 0x54, 0x1b, 0x58, 0x14, 0xff, 0x06, 0x2c, 0x27,
+	0x99, 0x1c, 0x25, 0xd6, 0x3f, 0xab, 0xb5, 0x46, 0x8e, 0xb0, 0xe0, 0x46, 0x58, 0xe7, 0x6b, 0x18,
+	0xc8, 0xb9, 0xaf, 0x8f, 0xc0, 0x40, 0x9e, 0xd9, 0xea, 0x4d, 0x18, 0x6d, 0xfc, 0xbc, 0x34, 0xdb,
+	0xe5, 0xee, 0xde, 0xfc, 0x81, 0x40, 0xbb, 0xd8, 0x33, 0x44, 0xd6, 0x68, 0x15, 0x0e, 0xd7, 0x7f,
+	0x4f, 0xc8, 0x76, 0xd7, 0x55, 0x07, 0xd0, 0xe6, 0x7a, 0x04, 0x48, 0xd3, 0xef, 0xc0, 0xb0, 0xbc,
+	0x11, 0x4f, 0x77, 0x57, 0x12, 0xca, 0x6a, 0xe7, 0xe2, 0xcb, 0x4a, 0x5b, 0x37, 0x61, 0xb4, 0xf1,
+	0xce, 0x19, 0x83, 0xe7, 0x06, 0x88, 0x76, 0xb1, 0x67, 0x88, 0x74, 0xa0, 0x0c, 0x10, 0xb9, 0x38,
+	0x9d, 0xed, 0xae, 0xa8, 0x26, 0xad, 0x9d, 0xef, 0x45, 0x3a, 0x1a, 0x72, 0xe3, 0x75, 0x62, 0x36,
+	0x8e, 0xa2, 0x3a, 0x88, 0x76, 0xb1, 0x67, 0x88, 0x74, 0xe0, 0x33, 0x05, 0x26, 0xdb, 0x5f, 0x2d,
+	0x5e, 0x88, 0x51, 0xb3, 0xed, 0xc0, 0xda, 0xa5, 0x5d, 0x80, 0xa5, 0x7f, 0xef, 0xc1, 0x48, 0x43,
+	0x1b, 0x7e, 0xa6, 0xbb, 0xda, 0x7a, 0x84, 0x76, 0xa1, 0x57, 0x84, 0xb4, 0x7e, 0x4b, 0x81, 0xff,
+	0x47, 0xc7, 0x3a, 0x35, 0xc6, 0x3e, 0x6a, 0x39, 0x06, 0x6a, 0x0b, 0x3b, 0x04, 0x4a, 0x57, 0x3e,
+	0x57, 0xe0, 0x78, 0xa7, 0x19, 0xf0, 0xa5, 0x18, 0x41, 0xb6, 0x87, 0x6b, 0x97, 0x77, 0x05, 0x97,
+	0x5e, 0x7e, 0xac, 0xc0, 0x44, 0xeb, 0x21, 0x2f, 0x06, 0x73, 0x2d, 0x81, 0xda, 0xc2, 0x0e, 0x81,
+	0xd2, 0xa7, 0x8f, 0x14, 0x18, 0x6f, 0x39, 0xd0, 0x3d, 0x1f, 0xe3, 0x50, 0x6c, 0x81, 0xd3, 0x5e,
+	0xde, 0x19, 0x4e, 0x3a, 0xf4, 0xa5, 0x02, 0x27, 0x3a, 0x0e, 0x5b, 0x31, 0x0c, 0x74, 0xc2, 0x6b,
+	0x57, 0x76, 0x87, 0x97, 0x8e, 0x7e, 0xa3, 0x40, 0xaa, 0xdb, 0x24, 0xb3, 0x18, 0x2f, 0x3d, 0x9d,
+	0xdc, 0x5d, 0xde, 0xb5, 0x8a, 0x68, 0xa7, 0xac, 0x9f, 0x3b, 0x62, 0x74, 0xca, 0x3a, 0x80, 0x36,
+	0xd7, 0x23, 0x20, 0x34, 0x9d, 0x7b, 0xf3, 0xde, 0xa3, 0xa4, 0x72, 0xff, 0x51, 0x52, 0xf9, 0xf5,
+	0x51, 0x52, 0xb9, 0xbd, 0x9d, 0xec, 0xbb, 0xbf, 0x9d, 0xec, 0xfb, 0x69, 0x3b, 0xd9, 0xf7, 0xd6,
+	0x42, 0xe4, 0xf3, 0x36, 0xbe, 0xee, 0x54, 0x18, 0xa6, 0x04, 0x13, 0x33, 0x1b, 0x18, 0xc2, 0xbc,
+	0x3a, 0x23, 0x8c, 0xcc, 0x94, 0xa8, 0x55, 0x71, 0x50, 0xf6, 0x46, 0xf8, 0xcf, 0xaf, 0xe0, 0xdb,
+	0x77, 0x71, 0xc8, 0x1f, 0x41, 0x9f, 0xfd, 0x6b, 0x00, 0x0c, 0xf6, 0x72, 0x67, 0xea, 0x1b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1355,6 +1534,12 @@ type MsgClient interface {
 	// EnableTokenizeShares defines a method to re-enable the tokenization of an addresseses stake
 	// after it has been disabled
 	EnableTokenizeShares(ctx context.Context, in *MsgEnableTokenizeShares, opts ...grpc.CallOption) (*MsgEnableTokenizeSharesResponse, error)
+	// AllowTokenizeSharesRecipient defines a method to add an address to the allowlist of
+	// tokenized share owners that remain permitted while tokenization is disabled
+	AllowTokenizeSharesRecipient(ctx context.Context, in *MsgAllowTokenizeSharesRecipient, opts ...grpc.CallOption) (*MsgAllowTokenizeSharesRecipientResponse, error)
+	// DisallowTokenizeSharesRecipient defines a method to remove an address from the
+	// tokenize share lock allowlist
+	DisallowTokenizeSharesRecipient(ctx context.Context, in *MsgDisallowTokenizeSharesRecipient, opts ...grpc.CallOption) (*MsgDisallowTokenizeSharesRecipientResponse, error)
 	// ValidatorBond defines a method for performing a validator self-bond
 	ValidatorBond(ctx context.Context, in *MsgValidatorBond, opts ...grpc.CallOption) (*MsgValidatorBondResponse, error)
 }
@@ -1475,6 +1660,24 @@ func (c *msgClient) EnableTokenizeShares(ctx context.Context, in *MsgEnableToken
 	return out, nil
 }
 
+func (c *msgClient) AllowTokenizeSharesRecipient(ctx context.Context, in *MsgAllowTokenizeSharesRecipient, opts ...grpc.CallOption) (*MsgAllowTokenizeSharesRecipientResponse, error) {
+	out := new(MsgAllowTokenizeSharesRecipientResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.staking.v1beta1.Msg/AllowTokenizeSharesRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisallowTokenizeSharesRecipient(ctx context.Context, in *MsgDisallowTokenizeSharesRecipient, opts ...grpc.CallOption) (*MsgDisallowTokenizeSharesRecipientResponse, error) {
+	out := new(MsgDisallowTokenizeSharesRecipientResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.staking.v1beta1.Msg/DisallowTokenizeSharesRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ValidatorBond(ctx context.Context, in *MsgValidatorBond, opts ...grpc.CallOption) (*MsgValidatorBondResponse, error) {
 	out := new(MsgValidatorBondResponse)
 	err := c.cc.Invoke(ctx, "/liquidstaking.staking.v1beta1.Msg/ValidatorBond", in, out, opts...)
@@ -1520,6 +1723,12 @@ type MsgServer interface {
 	// EnableTokenizeShares defines a method to re-enable the tokenization of an addresseses stake
 	// after it has been disabled
 	EnableTokenizeShares(context.Context, *MsgEnableTokenizeShares) (*MsgEnableTokenizeSharesResponse, error)
+	// AllowTokenizeSharesRecipient defines a method to add an address to the allowlist of
+	// tokenized share owners that remain permitted while tokenization is disabled
+	AllowTokenizeSharesRecipient(context.Context, *MsgAllowTokenizeSharesRecipient) (*MsgAllowTokenizeSharesRecipientResponse, error)
+	// DisallowTokenizeSharesRecipient defines a method to remove an address from the
+	// tokenize share lock allowlist
+	DisallowTokenizeSharesRecipient(context.Context, *MsgDisallowTokenizeSharesRecipient) (*MsgDisallowTokenizeSharesRecipientResponse, error)
 	// ValidatorBond defines a method for performing a validator self-bond
 	ValidatorBond(context.Context, *MsgValidatorBond) (*MsgValidatorBondResponse, error)
 }
@@ -1564,6 +1773,12 @@ func (*UnimplementedMsgServer) DisableTokenizeShares(ctx context.Context, req *M
 func (*UnimplementedMsgServer) EnableTokenizeShares(ctx context.Context, req *MsgEnableTokenizeShares) (*MsgEnableTokenizeSharesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnableTokenizeShares not implemented")
 }
+func (*UnimplementedMsgServer) AllowTokenizeSharesRecipient(ctx context.Context, req *MsgAllowTokenizeSharesRecipient) (*MsgAllowTokenizeSharesRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowTokenizeSharesRecipient not implemented")
+}
+func (*UnimplementedMsgServer) DisallowTokenizeSharesRecipient(ctx context.Context, req *MsgDisallowTokenizeSharesRecipient) (*MsgDisallowTokenizeSharesRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisallowTokenizeSharesRecipient not implemented")
+}
 func (*UnimplementedMsgServer) ValidatorBond(ctx context.Context, req *MsgValidatorBond) (*MsgValidatorBondResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorBond not implemented")
 }
@@ -1788,6 +2003,42 @@ func _Msg_EnableTokenizeShares_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AllowTokenizeSharesRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAllowTokenizeSharesRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AllowTokenizeSharesRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.staking.v1beta1.Msg/AllowTokenizeSharesRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AllowTokenizeSharesRecipient(ctx, req.(*MsgAllowTokenizeSharesRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisallowTokenizeSharesRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisallowTokenizeSharesRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisallowTokenizeSharesRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.staking.v1beta1.Msg/DisallowTokenizeSharesRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisallowTokenizeSharesRecipient(ctx, req.(*MsgDisallowTokenizeSharesRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ValidatorBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgValidatorBond)
 	if err := dec(in); err != nil {
@@ -1858,6 +2109,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EnableTokenizeShares",
 			Handler:    _Msg_EnableTokenizeShares_Handler,
 		},
+		{
+			MethodName: "AllowTokenizeSharesRecipient",
+			Handler:    _Msg_AllowTokenizeSharesRecipient_Handler,
+		},
+		{
+			MethodName: "DisallowTokenizeSharesRecipient",
+			Handler:    _Msg_DisallowTokenizeSharesRecipient_Handler,
+		},
 		{
 			MethodName: "ValidatorBond",
 			Handler:    _Msg_ValidatorBond_Handler,
@@ -2447,6 +2706,16 @@ func (m *MsgTokenizeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Compound {
+		i--
+		if m.Compound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.TokenizedShareOwner) > 0 {
 		i -= len(m.TokenizedShareOwner)
 		copy(dAtA[i:], m.TokenizedShareOwner)
@@ -2766,6 +3035,134 @@ func (m *MsgEnableTokenizeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAllowTokenizeSharesRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAllowTokenizeSharesRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAllowTokenizeSharesRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAllowTokenizeSharesRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAllowTokenizeSharesRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAllowTokenizeSharesRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintTx(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisallowTokenizeSharesRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisallowTokenizeSharesRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisallowTokenizeSharesRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisallowTokenizeSharesRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisallowTokenizeSharesRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisallowTokenizeSharesRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgValidatorBond) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3072,6 +3469,9 @@ func (m *MsgTokenizeShares) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Compound {
+		n += 2
+	}
 	return n
 }
 
@@ -3187,7 +3587,7 @@ func (m *MsgEnableTokenizeSharesResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgValidatorBond) Size() (n int) {
+func (m *MsgAllowTokenizeSharesRecipient) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3197,24 +3597,78 @@ func (m *MsgValidatorBond) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ValidatorAddress)
+	l = len(m.RecipientAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgValidatorBondResponse) Size() (n int) {
+func (m *MsgAllowTokenizeSharesRecipientResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgDisallowTokenizeSharesRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisallowTokenizeSharesRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgValidatorBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgValidatorBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -4984,6 +5438,26 @@ func (m *MsgTokenizeShares) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenizedShareOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5766,6 +6240,367 @@ func (m *MsgEnableTokenizeSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAllowTokenizeSharesRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAllowTokenizeSharesRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAllowTokenizeSharesRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAllowTokenizeSharesRecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAllowTokenizeSharesRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAllowTokenizeSharesRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisallowTokenizeSharesRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisallowTokenizeSharesRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisallowTokenizeSharesRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisallowTokenizeSharesRecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisallowTokenizeSharesRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisallowTokenizeSharesRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgValidatorBond) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0